@@ -0,0 +1,304 @@
+package goqube
+
+import (
+	"fmt"
+	"strings"
+)
+
+type AlterTableAction struct {
+	Type       AlterTableActionType
+	Column     *ColumnDefinition
+	Name       string
+	NewName    string
+	ColumnType ColumnType
+}
+
+func (a *AlterTableAction) validate() error {
+	switch a.Type {
+	case AlterTableActionAddColumn:
+		if a.Column == nil {
+			return ErrFieldIsNil
+		}
+
+		return a.Column.validate()
+
+	case AlterTableActionDropColumn:
+		if !isValidIdentifier(a.Name) {
+			return fmt.Errorf(errSchemaColumnf, ErrIdentifierIsInvalid, a.Name)
+		}
+
+	case AlterTableActionRenameColumn:
+		if !isValidIdentifier(a.Name) {
+			return fmt.Errorf(errSchemaColumnf, ErrIdentifierIsInvalid, a.Name)
+		}
+
+		if !isValidIdentifier(a.NewName) {
+			return fmt.Errorf(errSchemaColumnf, ErrIdentifierIsInvalid, a.NewName)
+		}
+
+	case AlterTableActionChangeColumnType:
+		return NewColumnDefinition(a.Name, a.ColumnType).validate()
+
+	case AlterTableActionSetNotNull, AlterTableActionSetIdentity:
+		if a.Column == nil {
+			return ErrFieldIsNil
+		}
+
+		if !isValidIdentifier(a.Column.Name) {
+			return fmt.Errorf(errSchemaColumnf, ErrIdentifierIsInvalid, a.Column.Name)
+		}
+
+	case AlterTableActionModifyColumn:
+		if a.Column == nil {
+			return ErrFieldIsNil
+		}
+
+		return a.Column.validate()
+
+	default:
+		return ErrAlterTableActionIsInvalid
+	}
+
+	return nil
+}
+
+func (a *AlterTableAction) toSQL(bc *buildContext, table string) (string, error) {
+	switch a.Type {
+	case AlterTableActionAddColumn:
+		var (
+			column string
+			err    error
+		)
+
+		column, err = a.Column.toSQL(bc, table)
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("add column %s", column), nil
+
+	case AlterTableActionDropColumn:
+		return fmt.Sprintf("drop column %s", bc.tableColumn(table, a.Name)), nil
+
+	case AlterTableActionRenameColumn:
+		return fmt.Sprintf("rename column %s to %s", bc.tableColumn(table, a.Name), bc.tableColumn(table, a.NewName)), nil
+
+	case AlterTableActionSetNotNull:
+		if bc.dialect != DialectPostgres {
+			return "", ErrUnsupportedAlterTableAction
+		}
+
+		if a.Column.NotNull {
+			return fmt.Sprintf("alter column %s set not null", bc.tableColumn(table, a.Column.Name)), nil
+		}
+
+		return fmt.Sprintf("alter column %s drop not null", bc.tableColumn(table, a.Column.Name)), nil
+
+	case AlterTableActionSetIdentity:
+		if bc.dialect != DialectPostgres {
+			return "", ErrUnsupportedAlterTableAction
+		}
+
+		if a.Column.AutoIncrement {
+			return fmt.Sprintf("alter column %s add %s", bc.tableColumn(table, a.Column.Name), autoIncrementMap[bc.dialect]), nil
+		}
+
+		return fmt.Sprintf("alter column %s drop identity", bc.tableColumn(table, a.Column.Name)), nil
+
+	case AlterTableActionModifyColumn:
+		var (
+			column string
+			err    error
+		)
+
+		if bc.dialect != DialectMySQL {
+			return "", ErrUnsupportedAlterTableAction
+		}
+
+		column, err = a.Column.toSQL(bc, table)
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("modify column %s", column), nil
+	}
+
+	if bc.dialect == DialectMySQL {
+		return "", ErrUnsupportedAlterTableAction
+	}
+
+	return fmt.Sprintf("alter column %s type %s", bc.tableColumn(table, a.Name), a.ColumnType.toSQL(bc.dialect)), nil
+}
+
+type AlterTableQuery struct {
+	Table   string
+	Actions []*AlterTableAction
+}
+
+func AlterTable(table string) *AlterTableQuery {
+	return &AlterTableQuery{
+		Table: table,
+	}
+}
+
+func (q *AlterTableQuery) AddColumn(column *ColumnDefinition) *AlterTableQuery {
+	q.Actions = append(q.Actions, &AlterTableAction{Type: AlterTableActionAddColumn, Column: column})
+	return q
+}
+
+func (q *AlterTableQuery) DropColumn(name string) *AlterTableQuery {
+	q.Actions = append(q.Actions, &AlterTableAction{Type: AlterTableActionDropColumn, Name: name})
+	return q
+}
+
+func (q *AlterTableQuery) RenameColumn(name, newName string) *AlterTableQuery {
+	q.Actions = append(q.Actions, &AlterTableAction{Type: AlterTableActionRenameColumn, Name: name, NewName: newName})
+	return q
+}
+
+func (q *AlterTableQuery) ChangeColumnType(name string, columnType ColumnType) *AlterTableQuery {
+	q.Actions = append(q.Actions, &AlterTableAction{Type: AlterTableActionChangeColumnType, Name: name, ColumnType: columnType})
+	return q
+}
+
+func (q *AlterTableQuery) SetNotNull(name string, notNull bool) *AlterTableQuery {
+	q.Actions = append(q.Actions, &AlterTableAction{Type: AlterTableActionSetNotNull, Column: &ColumnDefinition{Name: name, NotNull: notNull}})
+	return q
+}
+
+func (q *AlterTableQuery) SetIdentity(name string, identity bool) *AlterTableQuery {
+	q.Actions = append(q.Actions, &AlterTableAction{Type: AlterTableActionSetIdentity, Column: &ColumnDefinition{Name: name, AutoIncrement: identity}})
+	return q
+}
+
+func (q *AlterTableQuery) ModifyColumn(column *ColumnDefinition) *AlterTableQuery {
+	q.Actions = append(q.Actions, &AlterTableAction{Type: AlterTableActionModifyColumn, Column: column})
+	return q
+}
+
+func (q *AlterTableQuery) validate(dialect Dialect) error {
+	if dialect == "" {
+		return ErrDialectIsRequired
+	}
+
+	if q.Table == "" {
+		return ErrTableIsRequired
+	}
+
+	if !isValidIdentifier(q.Table) {
+		return ErrIdentifierIsInvalid
+	}
+
+	if len(q.Actions) == 0 {
+		return ErrAlterTableActionsIsRequired
+	}
+
+	for i := range q.Actions {
+		var path string = fmt.Sprintf("Actions[%d]", i)
+
+		if q.Actions[i] == nil {
+			return wrapPath(path, ErrFieldIsNil)
+		}
+
+		var err error = q.Actions[i].validate()
+		if err != nil {
+			return wrapPath(path, err)
+		}
+	}
+
+	return nil
+}
+
+func (q *AlterTableQuery) queries(bc *buildContext) ([]string, error) {
+	var (
+		table   string = bc.tableName(q.Table)
+		actions []string
+		queries []string
+		err     error
+	)
+
+	err = q.validate(bc.dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range q.Actions {
+		var action string
+
+		action, err = q.Actions[i].toSQL(bc, q.Table)
+		if err != nil {
+			return nil, wrapPath(fmt.Sprintf("Actions[%d]", i), err)
+		}
+
+		if bc.dialect == DialectPostgres && q.Actions[i].Type == AlterTableActionRenameColumn {
+			if len(actions) > 0 {
+				queries = append(queries, fmt.Sprintf("alter table %s %s", table, strings.Join(actions, ", ")))
+				actions = nil
+			}
+
+			queries = append(queries, fmt.Sprintf("alter table %s %s", table, action))
+			continue
+		}
+
+		actions = append(actions, action)
+	}
+
+	if len(actions) > 0 {
+		queries = append(queries, fmt.Sprintf("alter table %s %s", table, strings.Join(actions, ", ")))
+	}
+
+	return queries, nil
+}
+
+func (q *AlterTableQuery) toSQLWithArgs(bc *buildContext, args []interface{}) (string, []interface{}, error) {
+	var (
+		queries []string
+		err     error
+	)
+
+	queries, err = q.queries(bc)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if len(queries) > 1 {
+		return "", nil, ErrAlterTableRequiresStatements
+	}
+
+	return queries[0], args, nil
+}
+
+func (q *AlterTableQuery) toStatements(bc *buildContext) ([]*Statement, error) {
+	var (
+		queries    []string
+		statements []*Statement
+		err        error
+	)
+
+	queries, err = q.queries(bc)
+	if err != nil {
+		return nil, err
+	}
+
+	statements = []*Statement{}
+	for i := range queries {
+		statements = append(statements, &Statement{Query: queries[i], Args: []interface{}{}})
+	}
+
+	return statements, nil
+}
+
+func (q *AlterTableQuery) ToSQL(dialect Dialect) (string, error) {
+	var (
+		query string
+		err   error
+	)
+
+	query, _, err = q.toSQLWithArgs(newDialectBuildContext(dialect), []interface{}{})
+
+	return query, err
+}
+
+func (q *AlterTableQuery) ToStatements(dialect Dialect) ([]*Statement, error) {
+	return q.toStatements(newDialectBuildContext(dialect))
+}
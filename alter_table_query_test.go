@@ -0,0 +1,381 @@
+package goqube
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestAlterTableQuery_ToStatements(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Query       *AlterTableQuery
+		Expectation struct {
+			Queries []string
+			Err     error
+		}
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Query       *AlterTableQuery
+		Expectation struct {
+			Queries []string
+			Err     error
+		}
+	}{
+		{
+			Name:    fmt.Sprintf("alter table with dialect %s", DialectMySQL),
+			Dialect: DialectMySQL,
+			Query: AlterTable("users").
+				AddColumn(NewColumnDefinition("active", ColumnTypeBoolean).NotNullable().DefaultValue(true)).
+				DropColumn("nickname").
+				RenameColumn("mail", "email").
+				ModifyColumn(NewColumnDefinition("bio", ColumnTypeText).NotNullable()),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: []string{
+					"alter table users add column active tinyint(1) not null default true, drop column nickname, rename column mail to email, modify column bio text not null",
+				},
+				Err: nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("alter table with dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			Query: AlterTable("users").
+				AddColumn(NewColumnDefinition("active", ColumnTypeBoolean).NotNullable().DefaultValue(true)).
+				DropColumn("nickname").
+				RenameColumn("mail", "email").
+				ChangeColumnType("bio", ColumnTypeText).
+				ChangeColumnType("payload", ColumnTypeJSON),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: []string{
+					"alter table users add column active boolean not null default true, drop column nickname",
+					"alter table users rename column mail to email",
+					"alter table users alter column bio type text, alter column payload type jsonb",
+				},
+				Err: nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("alter not null and identity with dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			Query: AlterTable("users").
+				SetNotNull("email", true).
+				SetNotNull("bio", false).
+				SetIdentity("id", true).
+				SetIdentity("legacy_id", false),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: []string{
+					"alter table users alter column email set not null, alter column bio drop not null, alter column id add generated by default as identity, alter column legacy_id drop identity",
+				},
+				Err: nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("modify column with dialect %s", DialectMySQL),
+			Dialect: DialectMySQL,
+			Query:   AlterTable("users").ModifyColumn(NewColumnDefinition("id", ColumnTypeBigInt).NotNullable().AsAutoIncrement()),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: []string{
+					"alter table users modify column id bigint not null auto_increment",
+				},
+				Err: nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("modify column with dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			Query:   AlterTable("users").ModifyColumn(NewColumnDefinition("id", ColumnTypeBigInt)),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     &PathError{Path: "Actions[0]", Err: ErrUnsupportedAlterTableAction},
+			},
+		},
+		{
+			Name:    fmt.Sprintf("change column type with dialect %s", DialectMySQL),
+			Dialect: DialectMySQL,
+			Query:   AlterTable("users").ChangeColumnType("bio", ColumnTypeText),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     &PathError{Path: "Actions[0]", Err: ErrUnsupportedAlterTableAction},
+			},
+		},
+		{
+			Name:    fmt.Sprintf("set not null with dialect %s", DialectMySQL),
+			Dialect: DialectMySQL,
+			Query:   AlterTable("users").SetNotNull("email", true),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     &PathError{Path: "Actions[0]", Err: ErrUnsupportedAlterTableAction},
+			},
+		},
+		{
+			Name:    "set identity column is invalid",
+			Dialect: DialectPostgres,
+			Query:   AlterTable("users").SetIdentity("id; drop table users", true),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     &PathError{Path: "Actions[0]", Err: fmt.Errorf(errSchemaColumnf, ErrIdentifierIsInvalid, "id; drop table users")},
+			},
+		},
+		{
+			Name:    "dialect is empty",
+			Dialect: "",
+			Query:   AlterTable("users").DropColumn("nickname"),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     ErrDialectIsRequired,
+			},
+		},
+		{
+			Name:    "table is empty",
+			Dialect: DialectPostgres,
+			Query:   AlterTable("").DropColumn("nickname"),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     ErrTableIsRequired,
+			},
+		},
+		{
+			Name:    "table is invalid",
+			Dialect: DialectPostgres,
+			Query:   AlterTable("users; drop table users").DropColumn("nickname"),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     ErrIdentifierIsInvalid,
+			},
+		},
+		{
+			Name:    "actions is empty",
+			Dialect: DialectPostgres,
+			Query:   AlterTable("users"),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     ErrAlterTableActionsIsRequired,
+			},
+		},
+		{
+			Name:    "action is nil",
+			Dialect: DialectPostgres,
+			Query:   &AlterTableQuery{Table: "users", Actions: []*AlterTableAction{nil}},
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     &PathError{Path: "Actions[0]", Err: ErrFieldIsNil},
+			},
+		},
+		{
+			Name:    "action type is invalid",
+			Dialect: DialectPostgres,
+			Query:   &AlterTableQuery{Table: "users", Actions: []*AlterTableAction{{Type: "truncate"}}},
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     &PathError{Path: "Actions[0]", Err: ErrAlterTableActionIsInvalid},
+			},
+		},
+		{
+			Name:    "add column is nil",
+			Dialect: DialectPostgres,
+			Query:   AlterTable("users").AddColumn(nil),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     &PathError{Path: "Actions[0]", Err: ErrFieldIsNil},
+			},
+		},
+		{
+			Name:    "drop column is invalid",
+			Dialect: DialectPostgres,
+			Query:   AlterTable("users").DropColumn("nickname; drop table users"),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     &PathError{Path: "Actions[0]", Err: fmt.Errorf(errSchemaColumnf, ErrIdentifierIsInvalid, "nickname; drop table users")},
+			},
+		},
+		{
+			Name:    "rename column new name is invalid",
+			Dialect: DialectPostgres,
+			Query:   AlterTable("users").RenameColumn("mail", ""),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     &PathError{Path: "Actions[0]", Err: fmt.Errorf(errSchemaColumnf, ErrIdentifierIsInvalid, "")},
+			},
+		},
+		{
+			Name:    "change column type is invalid",
+			Dialect: DialectPostgres,
+			Query:   AlterTable("users").ChangeColumnType("bio", "text; drop table users"),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     &PathError{Path: "Actions[0]", Err: fmt.Errorf(errSchemaColumnf, ErrColumnTypeIsInvalid, "bio")},
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualStatements []*Statement
+				actualQueries    []string
+				actualErr        error
+			)
+
+			actualStatements, actualErr = testCases[i].Query.ToStatements(testCases[i].Dialect)
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Errorf("expectation error is nil, got %s", actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Err != nil && (actualErr == nil || testCases[i].Expectation.Err.Error() != actualErr.Error()) {
+				t.Errorf("expectation error is %s, got %v", testCases[i].Expectation.Err.Error(), actualErr)
+			}
+
+			for j := range actualStatements {
+				actualQueries = append(actualQueries, actualStatements[j].Query)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Queries, actualQueries) {
+				t.Errorf("expectation queries is %+v, got %+v", testCases[i].Expectation.Queries, actualQueries)
+			}
+		})
+	}
+}
+
+func TestAlterTableQuery_Build(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Config      *Config
+		Query       *AlterTableQuery
+		Expectation struct {
+			Query string
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Config      *Config
+		Query       *AlterTableQuery
+		Expectation struct {
+			Query string
+			Err   error
+		}
+	}{
+		{
+			Name:   "alter table with table prefix",
+			Config: NewConfig(DialectPostgres).SetTablePrefix("app_"),
+			Query:  AlterTable("users").DropColumn("nickname").ChangeColumnType("id", ColumnTypeUUID),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "alter table app_users drop column nickname, alter column id type uuid",
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "alter table with rename and other actions",
+			Config: NewConfig(DialectPostgres),
+			Query:  AlterTable("users").DropColumn("nickname").RenameColumn("mail", "email"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrAlterTableRequiresStatements,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Config.Build(testCases[i].Query)
+
+			if !errors.Is(actualErr, testCases[i].Expectation.Err) {
+				t.Errorf("expectation error is %v, got %v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if actualErr == nil && len(actualArgs) != 0 {
+				t.Errorf("expectation args is empty, got %+v", actualArgs)
+			}
+		})
+	}
+
+	t.Run("to sql", func(t *testing.T) {
+		var (
+			actualQuery string
+			actualErr   error
+		)
+
+		actualQuery, actualErr = AlterTable("users").RenameColumn("mail", "email").ToSQL(DialectPostgres)
+
+		if actualErr != nil {
+			t.Errorf("expectation error is nil, got %s", actualErr.Error())
+		}
+
+		if actualQuery != "alter table users rename column mail to email" {
+			t.Errorf("expectation query is %s, got %s", "alter table users rename column mail to email", actualQuery)
+		}
+	})
+}
@@ -0,0 +1,125 @@
+package goqube
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var arrayElementReplacer *strings.Replacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+type ArrayValue struct {
+	Elements interface{}
+}
+
+func Array(elements interface{}) *ArrayValue {
+	return &ArrayValue{
+		Elements: elements,
+	}
+}
+
+func (a *ArrayValue) Value() (driver.Value, error) {
+	var (
+		builder strings.Builder
+		err     error
+	)
+
+	if a.Elements == nil {
+		return nil, nil
+	}
+
+	err = writeArrayLiteral(&builder, reflect.ValueOf(a.Elements))
+	if err != nil {
+		return nil, err
+	}
+
+	return builder.String(), nil
+}
+
+func writeArrayLiteral(builder *strings.Builder, value reflect.Value) error {
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return &UnsupportedValueTypeError{Kind: value.Kind()}
+	}
+
+	builder.WriteString("{")
+	for i := 0; i < value.Len(); i++ {
+		var err error
+
+		if i > 0 {
+			builder.WriteString(",")
+		}
+
+		err = writeArrayElement(builder, value.Index(i))
+		if err != nil {
+			return err
+		}
+	}
+	builder.WriteString("}")
+
+	return nil
+}
+
+func writeArrayElement(builder *strings.Builder, value reflect.Value) error {
+	if (value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface) && value.IsNil() {
+		builder.WriteString("NULL")
+		return nil
+	}
+
+	if valuer, ok := value.Interface().(driver.Valuer); ok {
+		var (
+			driverValue driver.Value
+			err         error
+		)
+
+		driverValue, err = valuer.Value()
+		if err != nil {
+			return err
+		}
+
+		if driverValue == nil {
+			builder.WriteString("NULL")
+			return nil
+		}
+
+		return writeArrayElement(builder, reflect.ValueOf(driverValue))
+	}
+
+	if timeValue, ok := value.Interface().(time.Time); ok {
+		builder.WriteString(`"` + timeValue.Format(time.RFC3339Nano) + `"`)
+		return nil
+	}
+
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return writeArrayElement(builder, value.Elem())
+
+	case reflect.String:
+		builder.WriteString(`"` + arrayElementReplacer.Replace(value.String()) + `"`)
+
+	case reflect.Bool:
+		if value.Bool() {
+			builder.WriteString("t")
+		} else {
+			builder.WriteString("f")
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		builder.WriteString(strconv.FormatInt(value.Int(), 10))
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		builder.WriteString(strconv.FormatUint(value.Uint(), 10))
+
+	case reflect.Float32, reflect.Float64:
+		builder.WriteString(strconv.FormatFloat(value.Float(), 'g', -1, 64))
+
+	case reflect.Slice, reflect.Array:
+		return writeArrayLiteral(builder, value)
+
+	default:
+		return &UnsupportedValueTypeError{Kind: value.Kind()}
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package goqube
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestArrayValue_Array(t *testing.T) {
+	var (
+		expectation *ArrayValue = &ArrayValue{Elements: []int{1, 2}}
+		actual      *ArrayValue = Array([]int{1, 2})
+	)
+
+	if !deepEqual(expectation, actual) {
+		t.Errorf("expectation is %+v, got %+v", expectation, actual)
+	}
+}
+
+func TestArrayValue_Value(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Array       *ArrayValue
+		Expectation struct {
+			Value driver.Value
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Array       *ArrayValue
+		Expectation struct {
+			Value driver.Value
+			Err   error
+		}
+	}{
+		{
+			Name:  "elements is nil",
+			Array: Array(nil),
+			Expectation: struct {
+				Value driver.Value
+				Err   error
+			}{
+				Value: nil,
+				Err:   nil,
+			},
+		},
+		{
+			Name:  "empty slice",
+			Array: Array([]int64{}),
+			Expectation: struct {
+				Value driver.Value
+				Err   error
+			}{
+				Value: "{}",
+				Err:   nil,
+			},
+		},
+		{
+			Name:  "numeric and bool elements",
+			Array: Array([]interface{}{1, uint8(2), 1.5, true, false}),
+			Expectation: struct {
+				Value driver.Value
+				Err   error
+			}{
+				Value: "{1,2,1.5,t,f}",
+				Err:   nil,
+			},
+		},
+		{
+			Name:  "string elements are quoted and escaped",
+			Array: Array([]interface{}{"a", `b"c`, `d\e`, nil}),
+			Expectation: struct {
+				Value driver.Value
+				Err   error
+			}{
+				Value: `{"a","b\"c","d\\e",NULL}`,
+				Err:   nil,
+			},
+		},
+		{
+			Name:  "time elements",
+			Array: Array([]time.Time{time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}),
+			Expectation: struct {
+				Value driver.Value
+				Err   error
+			}{
+				Value: `{"2024-01-02T03:04:05Z"}`,
+				Err:   nil,
+			},
+		},
+		{
+			Name:  "nested slices",
+			Array: Array([][]int{{1, 2}, {3, 4}}),
+			Expectation: struct {
+				Value driver.Value
+				Err   error
+			}{
+				Value: "{{1,2},{3,4}}",
+				Err:   nil,
+			},
+		},
+		{
+			Name:  "elements is not a slice",
+			Array: Array(1),
+			Expectation: struct {
+				Value driver.Value
+				Err   error
+			}{
+				Value: nil,
+				Err:   &UnsupportedValueTypeError{Kind: reflect.Int},
+			},
+		},
+		{
+			Name:  "element type is unsupported",
+			Array: Array([]interface{}{struct{}{}}),
+			Expectation: struct {
+				Value driver.Value
+				Err   error
+			}{
+				Value: nil,
+				Err:   &UnsupportedValueTypeError{Kind: reflect.Struct},
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualValue driver.Value
+				actualErr   error
+			)
+
+			actualValue, actualErr = testCases[i].Array.Value()
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Errorf("expectation error is %+v, got nil", testCases[i].Expectation.Err)
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Errorf("expectation error is nil, got %+v", actualErr)
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %+v, got %+v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Value, actualValue) {
+				t.Errorf("expectation value is %+v, got %+v", testCases[i].Expectation.Value, actualValue)
+			}
+		})
+	}
+}
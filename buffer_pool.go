@@ -0,0 +1,31 @@
+package goqube
+
+import (
+	"bytes"
+	"sync"
+)
+
+const maxPooledBufferSize int = 64 * 1024
+
+var bufferPool sync.Pool = sync.Pool{
+	New: func() interface{} {
+		return &bytes.Buffer{}
+	},
+}
+
+func getBuffer(size int) *bytes.Buffer {
+	var buffer *bytes.Buffer = bufferPool.Get().(*bytes.Buffer)
+
+	buffer.Reset()
+	buffer.Grow(size)
+
+	return buffer
+}
+
+func putBuffer(buffer *bytes.Buffer) {
+	if buffer.Cap() > maxPooledBufferSize {
+		return
+	}
+
+	bufferPool.Put(buffer)
+}
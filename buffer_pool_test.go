@@ -0,0 +1,39 @@
+package goqube
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestBufferPool_getBuffer(t *testing.T) {
+	var buffer *bytes.Buffer = getBuffer(128)
+
+	buffer.WriteString("select 1")
+	putBuffer(buffer)
+
+	buffer = getBuffer(128)
+	defer putBuffer(buffer)
+
+	if buffer.Len() != 0 {
+		t.Errorf("expectation length is %d, got %d", 0, buffer.Len())
+	}
+
+	if buffer.Cap() < 128 {
+		t.Errorf("expectation capacity is at least %d, got %d", 128, buffer.Cap())
+	}
+}
+
+func TestBufferPool_putBuffer(t *testing.T) {
+	var buffer *bytes.Buffer = getBuffer(maxPooledBufferSize + 1)
+
+	buffer.WriteString(strings.Repeat("a", maxPooledBufferSize+1))
+	putBuffer(buffer)
+
+	buffer = getBuffer(0)
+	defer putBuffer(buffer)
+
+	if buffer.Cap() > maxPooledBufferSize {
+		t.Errorf("expectation capacity is at most %d, got %d", maxPooledBufferSize, buffer.Cap())
+	}
+}
@@ -0,0 +1,47 @@
+package goqube
+
+import "time"
+
+type buildStage int
+
+const (
+	buildStageFields buildStage = iota
+	buildStageJoins
+	buildStageFilter
+)
+
+type BuildStats struct {
+	Fields time.Duration
+	Joins  time.Duration
+	Filter time.Duration
+	Total  time.Duration
+}
+
+type BuildResult struct {
+	Query string
+	Args  []interface{}
+	Stats *BuildStats
+}
+
+func (bc *buildContext) startTrace() time.Time {
+	if bc.stats == nil {
+		return time.Time{}
+	}
+
+	return time.Now()
+}
+
+func (bc *buildContext) endTrace(stage buildStage, start time.Time) {
+	if bc.stats == nil {
+		return
+	}
+
+	switch stage {
+	case buildStageFields:
+		bc.stats.Fields += time.Since(start)
+	case buildStageJoins:
+		bc.stats.Joins += time.Since(start)
+	case buildStageFilter:
+		bc.stats.Filter += time.Since(start)
+	}
+}
@@ -0,0 +1,155 @@
+package goqube
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuildStats_endTrace(t *testing.T) {
+	var (
+		bc    *buildContext
+		start time.Time
+	)
+
+	bc = newDialectBuildContext(DialectPostgres)
+	start = bc.startTrace()
+	if !start.IsZero() {
+		t.Errorf("expectation start is zero, got %v", start)
+	}
+
+	bc.endTrace(buildStageFilter, start)
+
+	bc.stats = &BuildStats{}
+	start = bc.startTrace().Add(-time.Second)
+	bc.endTrace(buildStageFields, start)
+	bc.endTrace(buildStageJoins, start)
+	bc.endTrace(buildStageFilter, start)
+
+	if bc.stats.Fields < time.Second {
+		t.Errorf("expectation fields duration is at least %v, got %v", time.Second, bc.stats.Fields)
+	}
+
+	if bc.stats.Joins < time.Second {
+		t.Errorf("expectation joins duration is at least %v, got %v", time.Second, bc.stats.Joins)
+	}
+
+	if bc.stats.Filter < time.Second {
+		t.Errorf("expectation filter duration is at least %v, got %v", time.Second, bc.stats.Filter)
+	}
+}
+
+func TestBuildStats_BuildWithResult(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Config      *Config
+		Query       Query
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Stats bool
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Config      *Config
+		Query       Query
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Stats bool
+			Err   error
+		}
+	}{
+		{
+			Name:   "query is invalid",
+			Config: NewConfig(DialectPostgres).SetTracing(true),
+			Query:  nil,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Stats bool
+				Err   error
+			}{
+				Err: ErrQueryIsRequired,
+			},
+		},
+		{
+			Name:   "tracing is disabled",
+			Config: NewConfig(DialectPostgres),
+			Query:  DeleteFrom("table1").Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Stats bool
+				Err   error
+			}{
+				Query: "delete from table1 where id = $1",
+				Args:  []interface{}{1},
+				Stats: false,
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "tracing is enabled",
+			Config: NewConfig(DialectPostgres).SetTracing(true),
+			Query: Select(NewField("id")).
+				From(NewTable("table1")).
+				Join(InnerJoin(NewTable("table2")).On(NewFilter().SetCondition(NewField("id").FromTable("table2"), OperatorEqual, NewColumnFilterValue("id").FromTable("table1")))).
+				Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Stats bool
+				Err   error
+			}{
+				Query: "select id from table1 inner join table2 on table2.id = table1.id where id = $1",
+				Args:  []interface{}{1},
+				Stats: true,
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualResult *BuildResult
+				actualErr    error
+			)
+
+			actualResult, actualErr = testCases[i].Config.BuildWithResult(testCases[i].Query)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if actualResult == nil {
+				return
+			}
+
+			if testCases[i].Expectation.Query != actualResult.Query {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualResult.Query)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualResult.Args) {
+				t.Errorf("expectation args is %v, got %v", testCases[i].Expectation.Args, actualResult.Args)
+			}
+
+			if testCases[i].Expectation.Stats != (actualResult.Stats != nil) {
+				t.Errorf("expectation stats is present %t, got %+v", testCases[i].Expectation.Stats, actualResult.Stats)
+			}
+
+			if actualResult.Stats != nil && actualResult.Stats.Total < actualResult.Stats.Fields+actualResult.Stats.Joins+actualResult.Stats.Filter {
+				t.Errorf("expectation total duration covers stages, got %+v", actualResult.Stats)
+			}
+		})
+	}
+}
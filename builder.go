@@ -0,0 +1,206 @@
+package goqube
+
+type BuilderOption func(config *Config)
+
+type Builder struct {
+	config *Config
+}
+
+func NewBuilder(dialect Dialect, options ...BuilderOption) *Builder {
+	var config *Config = NewConfig(dialect)
+
+	for i := range options {
+		if options[i] != nil {
+			options[i](config)
+		}
+	}
+
+	return &Builder{
+		config: config,
+	}
+}
+
+func WithSensitivity(column string, sensitivity *Sensitivity) BuilderOption {
+	return func(config *Config) {
+		config.SetSensitivity(column, sensitivity)
+	}
+}
+
+func WithSchema(schema *Schema) BuilderOption {
+	return func(config *Config) {
+		config.SetSchema(schema)
+	}
+}
+
+func WithGeneratedColumnPolicy(policy GeneratedColumnPolicy) BuilderOption {
+	return func(config *Config) {
+		config.SetGeneratedColumnPolicy(policy)
+	}
+}
+
+func WithTracing(tracing bool) BuilderOption {
+	return func(config *Config) {
+		config.SetTracing(tracing)
+	}
+}
+
+func WithStrictArgs(strictArgs bool) BuilderOption {
+	return func(config *Config) {
+		config.SetStrictArgs(strictArgs)
+	}
+}
+
+func WithStrictMode(strictMode bool) BuilderOption {
+	return func(config *Config) {
+		config.SetStrictMode(strictMode)
+	}
+}
+
+func WithTablePrefix(prefix string) BuilderOption {
+	return func(config *Config) {
+		config.SetTablePrefix(prefix)
+	}
+}
+
+func WithNamedParameterStyle(style NamedParameterStyle) BuilderOption {
+	return func(config *Config) {
+		config.SetNamedParameterStyle(style)
+	}
+}
+
+func WithVariant(variant Variant) BuilderOption {
+	return func(config *Config) {
+		config.SetVariant(variant)
+	}
+}
+
+func WithPlanCache(planCache *PlanCache) BuilderOption {
+	return func(config *Config) {
+		config.SetPlanCache(planCache)
+	}
+}
+
+func WithInlineLimitOffset(inlineLimitOffset bool) BuilderOption {
+	return func(config *Config) {
+		config.SetInlineLimitOffset(inlineLimitOffset)
+	}
+}
+
+func WithSoftDelete(softDelete *SoftDelete) BuilderOption {
+	return func(config *Config) {
+		config.SetSoftDelete(softDelete)
+	}
+}
+
+func WithNameMapper(nameMapper *NameMapper) BuilderOption {
+	return func(config *Config) {
+		config.SetNameMapper(nameMapper)
+	}
+}
+
+func WithNilAsNull(nilAsNull bool) BuilderOption {
+	return func(config *Config) {
+		config.SetNilAsNull(nilAsNull)
+	}
+}
+
+func WithServerVersion(version string) BuilderOption {
+	return func(config *Config) {
+		config.SetServerVersion(version)
+	}
+}
+
+func WithReadOnlyRaw(readOnlyRaw bool) BuilderOption {
+	return func(config *Config) {
+		config.SetReadOnlyRaw(readOnlyRaw)
+	}
+}
+
+func WithLimits(limits *Limits) BuilderOption {
+	return func(config *Config) {
+		config.SetLimits(limits)
+	}
+}
+
+func WithBeforeBuild(hooks ...BeforeBuildHook) BuilderOption {
+	return func(config *Config) {
+		config.BeforeBuild(hooks...)
+	}
+}
+
+func WithRewriteFilter(rewriters ...FilterRewriter) BuilderOption {
+	return func(config *Config) {
+		config.RewriteFilter(rewriters...)
+	}
+}
+
+func WithValueAdapter(adapters ...ValueAdapter) BuilderOption {
+	return func(config *Config) {
+		config.RegisterValueAdapter(adapters...)
+	}
+}
+
+func (c *Config) clone() *Config {
+	var config Config = *c
+
+	config.Sensitivities = make(map[string]*Sensitivity, len(c.Sensitivities))
+	for column, sensitivity := range c.Sensitivities {
+		config.Sensitivities[column] = sensitivity
+	}
+
+	config.BeforeBuildHooks = append([]BeforeBuildHook(nil), c.BeforeBuildHooks...)
+	config.FilterRewriters = append([]FilterRewriter(nil), c.FilterRewriters...)
+	config.ValueAdapters = append([]ValueAdapter(nil), c.ValueAdapters...)
+
+	return &config
+}
+
+func (b *Builder) With(options ...BuilderOption) *Builder {
+	var config *Config = b.config.clone()
+
+	for i := range options {
+		if options[i] != nil {
+			options[i](config)
+		}
+	}
+
+	return &Builder{
+		config: config,
+	}
+}
+
+func (b *Builder) RegisterValueAdapter(adapters ...ValueAdapter) *Builder {
+	return b.With(WithValueAdapter(adapters...))
+}
+
+func (b *Builder) Dialect() Dialect {
+	return b.config.Dialect
+}
+
+func (b *Builder) Config() *Config {
+	return b.config.clone()
+}
+
+func (b *Builder) Build(query Query) (string, []interface{}, error) {
+	return b.config.Build(query)
+}
+
+func (b *Builder) BuildWithResult(query Query) (*BuildResult, error) {
+	return b.config.BuildWithResult(query)
+}
+
+func (b *Builder) BuildNamed(query Query) (string, map[string]interface{}, error) {
+	return b.config.BuildNamed(query)
+}
+
+func (b *Builder) DebugSQL(query Query) (string, error) {
+	return b.config.DebugSQL(query)
+}
+
+func (b *Builder) ReferenceSyncStatements(referenceSync *ReferenceSync) ([]*Statement, error) {
+	return b.config.ReferenceSyncStatements(referenceSync)
+}
+
+func (b *Builder) TransactionSteps(transactionPlan *TransactionPlan) ([]*TransactionStep, error) {
+	return b.config.TransactionSteps(transactionPlan)
+}
@@ -0,0 +1,235 @@
+package goqube
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestBuilder_NewBuilder(t *testing.T) {
+	var (
+		schema      *Schema      = NewSchema()
+		sensitivity *Sensitivity = NewSensitivity().WrapPlaceholder("encrypt(%s)")
+		nameMapper  *NameMapper  = NewNameMapper()
+		softDelete  *SoftDelete  = NewSoftDelete("deleted_at")
+		planCache   *PlanCache   = NewPlanCache(10)
+		actual      *Config
+	)
+
+	actual = NewBuilder(
+		DialectPostgres,
+		WithSensitivity("users.email", sensitivity),
+		WithSchema(schema),
+		WithGeneratedColumnPolicy(GeneratedColumnPolicyReject),
+		WithTracing(true),
+		WithStrictArgs(true),
+		WithStrictMode(true),
+		WithTablePrefix("app_"),
+		WithNamedParameterStyle(NamedParameterStyleColon),
+		WithVariant(VariantCockroach),
+		WithPlanCache(planCache),
+		WithInlineLimitOffset(true),
+		WithSoftDelete(softDelete),
+		WithNameMapper(nameMapper),
+		WithNilAsNull(true),
+		WithServerVersion("16.2"),
+		nil,
+	).config
+
+	if actual.Dialect != DialectPostgres {
+		t.Errorf("expectation dialect is %s, got %s", DialectPostgres, actual.Dialect)
+	}
+
+	if actual.Sensitivities["users.email"] != sensitivity {
+		t.Errorf("expectation sensitivity is %+v, got %+v", sensitivity, actual.Sensitivities["users.email"])
+	}
+
+	if actual.Schema != schema || actual.NameMapper != nameMapper || actual.SoftDelete != softDelete || actual.PlanCache != planCache {
+		t.Errorf("expectation schema, name mapper, soft delete and plan cache are set, got %+v", actual)
+	}
+
+	if actual.GeneratedColumnPolicy != GeneratedColumnPolicyReject || actual.TablePrefix != "app_" || actual.NamedParameterStyle != NamedParameterStyleColon || actual.Variant != VariantCockroach {
+		t.Errorf("expectation generated column policy, table prefix, named parameter style and variant are set, got %+v", actual)
+	}
+
+	if actual.ServerVersion != "16.2" {
+		t.Errorf("expectation server version is %s, got %s", "16.2", actual.ServerVersion)
+	}
+
+	if !actual.Tracing || !actual.StrictArgs || !actual.StrictMode || !actual.InlineLimitOffset || !actual.NilAsNull {
+		t.Errorf("expectation tracing, strict args, strict mode, inline limit offset and nil as null are %t, got %+v", true, actual)
+	}
+}
+
+func TestBuilder_With(t *testing.T) {
+	var (
+		base    *Builder = NewBuilder(DialectPostgres, WithSensitivity("users.email", NewSensitivity()))
+		derived *Builder
+	)
+
+	derived = base.With(WithTablePrefix("app_"), WithSensitivity("users.phone", NewSensitivity()))
+
+	if base.config.TablePrefix != "" {
+		t.Errorf("expectation base table prefix is empty, got %s", base.config.TablePrefix)
+	}
+
+	if len(base.config.Sensitivities) != 1 {
+		t.Errorf("expectation base sensitivities length is %d, got %d", 1, len(base.config.Sensitivities))
+	}
+
+	if derived.config.TablePrefix != "app_" {
+		t.Errorf("expectation derived table prefix is %s, got %s", "app_", derived.config.TablePrefix)
+	}
+
+	if len(derived.config.Sensitivities) != 2 {
+		t.Errorf("expectation derived sensitivities length is %d, got %d", 2, len(derived.config.Sensitivities))
+	}
+
+	if derived.Dialect() != DialectPostgres {
+		t.Errorf("expectation dialect is %s, got %s", DialectPostgres, derived.Dialect())
+	}
+}
+
+func TestBuilder_Config(t *testing.T) {
+	var (
+		builder *Builder = NewBuilder(DialectMySQL, WithTablePrefix("app_"))
+		actual  *Config
+	)
+
+	actual = builder.Config()
+	actual.SetTablePrefix("other_").SetSensitivity("users.email", NewSensitivity())
+
+	if builder.config.TablePrefix != "app_" {
+		t.Errorf("expectation table prefix is %s, got %s", "app_", builder.config.TablePrefix)
+	}
+
+	if len(builder.config.Sensitivities) != 0 {
+		t.Errorf("expectation sensitivities length is %d, got %d", 0, len(builder.config.Sensitivities))
+	}
+}
+
+func TestBuilder_Build(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Builder     *Builder
+		Query       Query
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Builder     *Builder
+		Query       Query
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:    "query is nil",
+			Builder: NewBuilder(DialectPostgres),
+			Query:   nil,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrQueryIsRequired,
+			},
+		},
+		{
+			Name:    "builder with options",
+			Builder: NewBuilder(DialectPostgres, WithTablePrefix("app_"), WithInlineLimitOffset(true)),
+			Query:   Select(NewField("id")).From(NewTable("users")).Where(Eq("status", "active")).Limit(10),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from app_users where status = $1 limit 10",
+				Args:  []interface{}{"active"},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Builder.Build(testCases[i].Query)
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Errorf("expectation error is nil, got %s", actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Err != nil && (actualErr == nil || testCases[i].Expectation.Err.Error() != actualErr.Error()) {
+				t.Errorf("expectation error is %s, got %v", testCases[i].Expectation.Err.Error(), actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %+v, got %+v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
+
+func TestBuilder_Build_Concurrent(t *testing.T) {
+	var (
+		builder   *Builder = NewBuilder(DialectPostgres, WithPlanCache(NewPlanCache(10)), WithTracing(true))
+		waitGroup sync.WaitGroup
+	)
+
+	for i := 0; i < 16; i++ {
+		waitGroup.Add(1)
+
+		go func(i int) {
+			var (
+				query  *SelectQuery = Select(NewField("id")).From(NewTable("users")).Where(Eq("id", i))
+				result *BuildResult
+				err    error
+			)
+
+			defer waitGroup.Done()
+
+			result, err = builder.BuildWithResult(query)
+			if err != nil {
+				t.Errorf("expectation error is nil, got %s", err.Error())
+				return
+			}
+
+			if result.Query != "select id from users where id = $1" || !deepEqual([]interface{}{i}, result.Args) {
+				t.Errorf("expectation query and args is %s %v, got %s %v", "select id from users where id = $1", []interface{}{i}, result.Query, result.Args)
+			}
+
+			_, _, err = builder.Build(query)
+			if err != nil {
+				t.Errorf("expectation error is nil, got %s", err.Error())
+			}
+
+			_, err = builder.DebugSQL(query)
+			if err != nil {
+				t.Errorf("expectation error is nil, got %s", err.Error())
+			}
+
+			_, _, err = builder.With(WithNamedParameterStyle(NamedParameterStyleAt)).BuildNamed(query)
+			if err != nil {
+				t.Errorf("expectation error is nil, got %s", err.Error())
+			}
+		}(i)
+	}
+
+	waitGroup.Wait()
+}
@@ -0,0 +1,278 @@
+package goqube
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var bulkLoadValueReplacer *strings.Replacer = strings.NewReplacer(`\`, `\\`, "\t", `\t`, "\n", `\n`, "\r", `\r`)
+
+type RowSource func() ([]interface{}, error)
+
+func RowsOf(rows [][]interface{}) RowSource {
+	var index int
+
+	return func() ([]interface{}, error) {
+		if index >= len(rows) {
+			return nil, io.EOF
+		}
+
+		index++
+
+		return rows[index-1], nil
+	}
+}
+
+type BulkLoad struct {
+	Table   string
+	Columns []string
+	Mode    BulkLoadMode
+}
+
+func NewBulkLoad(table string, columns ...string) *BulkLoad {
+	return &BulkLoad{
+		Table:   table,
+		Columns: columns,
+	}
+}
+
+func (b *BulkLoad) SetMode(mode BulkLoadMode) *BulkLoad {
+	b.Mode = mode
+	return b
+}
+
+func (b *BulkLoad) validate(dialect Dialect) error {
+	if dialect == "" {
+		return ErrDialectIsRequired
+	}
+
+	if b.Table == "" {
+		return ErrTableIsRequired
+	}
+
+	if !isValidIdentifier(b.Table) {
+		return ErrIdentifierIsInvalid
+	}
+
+	if len(b.Columns) == 0 {
+		return ErrFieldsIsRequired
+	}
+
+	for i := range b.Columns {
+		if b.Columns[i] == "" {
+			return ErrFieldIsRequired
+		}
+
+		if !isValidIdentifier(b.Columns[i]) {
+			return fmt.Errorf(errSchemaColumnf, ErrIdentifierIsInvalid, b.Columns[i])
+		}
+
+		if containsString(b.Columns[:i], b.Columns[i]) {
+			return ErrFieldIsDuplicated
+		}
+	}
+
+	if b.Mode != "" && b.Mode != BulkLoadModeInsert && b.Mode != BulkLoadModeNative {
+		return ErrBulkLoadModeIsInvalid
+	}
+
+	return nil
+}
+
+func (b *BulkLoad) toSQL(bc *buildContext) (string, error) {
+	var (
+		columns []string
+		err     error
+	)
+
+	err = b.validate(bc.dialect)
+	if err != nil {
+		return "", err
+	}
+
+	columns = make([]string, len(b.Columns))
+	for i := range b.Columns {
+		columns[i] = bc.tableColumn(b.Table, b.Columns[i])
+	}
+
+	switch bc.dialect {
+	case DialectPostgres:
+		return fmt.Sprintf(postgresCopyFromStdinf, bc.tableName(b.Table), strings.Join(columns, ", ")), nil
+	case DialectMySQL:
+		return fmt.Sprintf(mysqlLoadDataf, b.Table, bc.tableName(b.Table), strings.Join(columns, ", ")), nil
+	}
+
+	return "", ErrUnsupportedBulkLoad
+}
+
+func (b *BulkLoad) ToSQL(dialect Dialect) (string, error) {
+	return b.toSQL(newDialectBuildContext(dialect))
+}
+
+func (b *BulkLoad) insertQuery(rows [][]interface{}) *InsertQuery {
+	var insertQuery *InsertQuery = InsertInto(b.Table).Columns(b.Columns...)
+
+	for i := range rows {
+		insertQuery.Values(rows[i]...)
+	}
+
+	return insertQuery
+}
+
+func (b *BulkLoad) EncodeRow(dialect Dialect, values []interface{}) ([]byte, error) {
+	var buffer bytes.Buffer
+
+	if len(values) != len(b.Columns) {
+		return nil, ErrValueLengthIsNotEqualToFieldsLength
+	}
+
+	for i := range values {
+		var (
+			encoded string
+			err     error
+		)
+
+		encoded, err = encodeBulkLoadValue(dialect, values[i])
+		if err != nil {
+			return nil, err
+		}
+
+		if i > 0 {
+			buffer.WriteByte('\t')
+		}
+
+		buffer.WriteString(encoded)
+	}
+
+	buffer.WriteByte('\n')
+
+	return buffer.Bytes(), nil
+}
+
+func (b *BulkLoad) Reader(dialect Dialect, rows RowSource) io.Reader {
+	return &bulkLoadReader{
+		bulkLoad: b,
+		dialect:  dialect,
+		rows:     rows,
+	}
+}
+
+type bulkLoadReader struct {
+	bulkLoad *BulkLoad
+	dialect  Dialect
+	rows     RowSource
+	buffer   bytes.Buffer
+	err      error
+}
+
+func (r *bulkLoadReader) Read(p []byte) (int, error) {
+	for r.buffer.Len() == 0 && r.err == nil {
+		var (
+			values  []interface{}
+			encoded []byte
+		)
+
+		if r.rows == nil {
+			r.err = ErrRowSourceIsRequired
+			break
+		}
+
+		values, r.err = r.rows()
+		if r.err != nil {
+			break
+		}
+
+		encoded, r.err = r.bulkLoad.EncodeRow(r.dialect, values)
+		r.buffer.Write(encoded)
+	}
+
+	if r.buffer.Len() > 0 {
+		return r.buffer.Read(p)
+	}
+
+	return 0, r.err
+}
+
+func encodeBulkLoadValue(dialect Dialect, value interface{}) (string, error) {
+	var reflectValue reflect.Value
+
+	if value == nil {
+		return `\N`, nil
+	}
+
+	switch typedValue := normalizeValue(value).(type) {
+	case driver.Valuer:
+		var (
+			driverValue driver.Value
+			err         error
+		)
+
+		reflectValue = reflect.ValueOf(value)
+		if reflectValue.Kind() == reflect.Ptr && reflectValue.IsNil() {
+			return `\N`, nil
+		}
+
+		driverValue, err = typedValue.Value()
+		if err != nil {
+			return "", err
+		}
+
+		return encodeBulkLoadValue(dialect, driverValue)
+
+	case time.Time:
+		if dialect == DialectPostgres {
+			return typedValue.Format("2006-01-02 15:04:05.999999999Z07:00"), nil
+		}
+
+		return typedValue.Format("2006-01-02 15:04:05.999999999"), nil
+
+	case []byte:
+		if dialect == DialectPostgres {
+			return `\\x` + hex.EncodeToString(typedValue), nil
+		}
+
+		return bulkLoadValueReplacer.Replace(string(typedValue)), nil
+	}
+
+	reflectValue = reflect.ValueOf(value)
+	switch reflectValue.Kind() {
+	case reflect.Ptr:
+		if reflectValue.IsNil() {
+			return `\N`, nil
+		}
+
+		return encodeBulkLoadValue(dialect, reflectValue.Elem().Interface())
+
+	case reflect.String:
+		return bulkLoadValueReplacer.Replace(reflectValue.String()), nil
+
+	case reflect.Bool:
+		if dialect == DialectMySQL {
+			if reflectValue.Bool() {
+				return "1", nil
+			}
+
+			return "0", nil
+		}
+
+		return strconv.FormatBool(reflectValue.Bool()), nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(reflectValue.Int(), 10), nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(reflectValue.Uint(), 10), nil
+
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(reflectValue.Float(), 'g', -1, 64), nil
+	}
+
+	return "", &UnsupportedValueTypeError{Kind: reflectValue.Kind()}
+}
@@ -0,0 +1,330 @@
+package goqube
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBulkLoad_NewBulkLoad(t *testing.T) {
+	var (
+		expectation *BulkLoad = &BulkLoad{Table: "users", Columns: []string{"name", "age"}, Mode: BulkLoadModeNative}
+		actual      *BulkLoad = NewBulkLoad("users", "name", "age").SetMode(BulkLoadModeNative)
+	)
+
+	if !deepEqual(expectation, actual) {
+		t.Errorf("expectation is %+v, got %+v", expectation, actual)
+	}
+}
+
+func TestBulkLoad_ToSQL(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		BulkLoad    *BulkLoad
+		Expectation struct {
+			Query string
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		BulkLoad    *BulkLoad
+		Expectation struct {
+			Query string
+			Err   error
+		}
+	}{
+		{
+			Name:     "dialect is empty",
+			Dialect:  "",
+			BulkLoad: NewBulkLoad("users", "name"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrDialectIsRequired,
+			},
+		},
+		{
+			Name:     "table is empty",
+			Dialect:  DialectPostgres,
+			BulkLoad: NewBulkLoad("", "name"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrTableIsRequired,
+			},
+		},
+		{
+			Name:     "table is invalid",
+			Dialect:  DialectMySQL,
+			BulkLoad: NewBulkLoad("users' into table admins", "name"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrIdentifierIsInvalid,
+			},
+		},
+		{
+			Name:     "columns is empty",
+			Dialect:  DialectPostgres,
+			BulkLoad: NewBulkLoad("users"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrFieldsIsRequired,
+			},
+		},
+		{
+			Name:     "column is empty",
+			Dialect:  DialectPostgres,
+			BulkLoad: NewBulkLoad("users", "name", ""),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrFieldIsRequired,
+			},
+		},
+		{
+			Name:     "column is invalid",
+			Dialect:  DialectPostgres,
+			BulkLoad: NewBulkLoad("users", "name) from program 'id'; --"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   fmt.Errorf(errSchemaColumnf, ErrIdentifierIsInvalid, "name) from program 'id'; --"),
+			},
+		},
+		{
+			Name:     "column is duplicated",
+			Dialect:  DialectPostgres,
+			BulkLoad: NewBulkLoad("users", "name", "name"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrFieldIsDuplicated,
+			},
+		},
+		{
+			Name:     "mode is invalid",
+			Dialect:  DialectPostgres,
+			BulkLoad: NewBulkLoad("users", "name").SetMode("stream"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrBulkLoadModeIsInvalid,
+			},
+		},
+		{
+			Name:     fmt.Sprintf("dialect %s", DialectPostgres),
+			Dialect:  DialectPostgres,
+			BulkLoad: NewBulkLoad("users", "name", "age"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "copy users (name, age) from stdin",
+				Err:   nil,
+			},
+		},
+		{
+			Name:     fmt.Sprintf("dialect %s", DialectMySQL),
+			Dialect:  DialectMySQL,
+			BulkLoad: NewBulkLoad("users", "name", "age"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: `load data local infile 'Reader::users' into table users fields terminated by '\t' escaped by '\\' lines terminated by '\n' (name, age)`,
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualErr   error
+			)
+
+			actualQuery, actualErr = testCases[i].BulkLoad.ToSQL(testCases[i].Dialect)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Errorf("expectation error is %s, got nil", testCases[i].Expectation.Err.Error())
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Errorf("expectation error is nil, got %s", actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+		})
+	}
+}
+
+func TestBulkLoad_EncodeRow(t *testing.T) {
+	var (
+		name      string    = "john"
+		createdAt time.Time = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		testCases []struct {
+			Name        string
+			Dialect     Dialect
+			Values      []interface{}
+			Expectation struct {
+				Row string
+				Err error
+			}
+		}
+	)
+
+	testCases = []struct {
+		Name        string
+		Dialect     Dialect
+		Values      []interface{}
+		Expectation struct {
+			Row string
+			Err error
+		}
+	}{
+		{
+			Name:    "values length is not equal to columns length",
+			Dialect: DialectPostgres,
+			Values:  []interface{}{1},
+			Expectation: struct {
+				Row string
+				Err error
+			}{
+				Row: "",
+				Err: ErrValueLengthIsNotEqualToFieldsLength,
+			},
+		},
+		{
+			Name:    "unsupported value type",
+			Dialect: DialectPostgres,
+			Values:  []interface{}{1, map[string]int{}, nil},
+			Expectation: struct {
+				Row string
+				Err error
+			}{
+				Row: "",
+				Err: &UnsupportedValueTypeError{Kind: reflect.Map},
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			Values:  []interface{}{1, "tab\there\nnew \\ line", createdAt},
+			Expectation: struct {
+				Row string
+				Err error
+			}{
+				Row: "1\ttab\\there\\nnew \\\\ line\t2024-01-02 03:04:05Z\n",
+				Err: nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s with null values", DialectPostgres),
+			Dialect: DialectPostgres,
+			Values:  []interface{}{(*int)(nil), sql.NullString{}, []byte{0xde, 0xad}},
+			Expectation: struct {
+				Row string
+				Err error
+			}{
+				Row: "\\N\t\\N\t\\\\xdead\n",
+				Err: nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s", DialectMySQL),
+			Dialect: DialectMySQL,
+			Values:  []interface{}{true, &name, createdAt},
+			Expectation: struct {
+				Row string
+				Err error
+			}{
+				Row: "1\tjohn\t2024-01-02 03:04:05\n",
+				Err: nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualRow []byte
+				actualErr error
+			)
+
+			actualRow, actualErr = NewBulkLoad("users", "id", "name", "created_at").EncodeRow(testCases[i].Dialect, testCases[i].Values)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Errorf("expectation error is %s, got nil", testCases[i].Expectation.Err.Error())
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Errorf("expectation error is nil, got %s", actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Row != string(actualRow) {
+				t.Errorf("expectation row is %q, got %q", testCases[i].Expectation.Row, string(actualRow))
+			}
+		})
+	}
+}
+
+func TestBulkLoad_Reader(t *testing.T) {
+	var (
+		bulkLoad *BulkLoad = NewBulkLoad("users", "id", "name")
+		actual   []byte
+		err      error
+	)
+
+	actual, err = io.ReadAll(bulkLoad.Reader(DialectMySQL, RowsOf([][]interface{}{{1, "a"}, {2, nil}})))
+	if err != nil {
+		t.Errorf("expectation error is nil, got %s", err.Error())
+	}
+
+	if string(actual) != "1\ta\n2\t\\N\n" {
+		t.Errorf("expectation content is %q, got %q", "1\ta\n2\t\\N\n", string(actual))
+	}
+
+	_, err = io.ReadAll(bulkLoad.Reader(DialectMySQL, RowsOf([][]interface{}{{1}})))
+	if err != ErrValueLengthIsNotEqualToFieldsLength {
+		t.Errorf("expectation error is %v, got %v", ErrValueLengthIsNotEqualToFieldsLength, err)
+	}
+
+	_, err = io.ReadAll(bulkLoad.Reader(DialectMySQL, nil))
+	if err != ErrRowSourceIsRequired {
+		t.Errorf("expectation error is %v, got %v", ErrRowSourceIsRequired, err)
+	}
+}
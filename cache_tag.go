@@ -0,0 +1,50 @@
+package goqube
+
+import "fmt"
+
+func cacheTags(tables []string) []string {
+	var tags []string = []string{}
+
+	for i := range tables {
+		if tables[i] == "" {
+			continue
+		}
+
+		tags = append(tags, fmt.Sprintf(cacheTagf, tables[i]))
+	}
+
+	return tags
+}
+
+func ReadCacheTags(query Query) []string {
+	return cacheTags(ListReferencedTables(query))
+}
+
+func WriteCacheTags(query Query) []string {
+	switch q := query.(type) {
+	case *InsertQuery:
+		return cacheTags([]string{q.Table})
+	case *UpdateQuery:
+		return cacheTags([]string{q.Table})
+	case *DeleteQuery:
+		return cacheTags([]string{q.Table})
+	case *MergeQuery:
+		return cacheTags([]string{q.Table})
+	}
+
+	return []string{}
+}
+
+func CacheTagsOverlap(readTags, writeTags []string) bool {
+	for i := range writeTags {
+		if containsString(readTags, writeTags[i]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func IsInvalidatedBy(readQuery, writeQuery Query) bool {
+	return CacheTagsOverlap(ReadCacheTags(readQuery), WriteCacheTags(writeQuery))
+}
@@ -0,0 +1,151 @@
+package goqube
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCacheTag_ReadCacheTags(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Query       Query
+		Expectation []string
+	} = []struct {
+		Name        string
+		Query       Query
+		Expectation []string
+	}{
+		{
+			Name:        "query is nil",
+			Query:       nil,
+			Expectation: []string{},
+		},
+		{
+			Name: "select query with join and subquery",
+			Query: Select(NewField("id").FromTable("u")).
+				From(NewTable("users").As("u")).
+				Join(InnerJoin(NewTable("orders").As("o")).On(NewFilter().SetCondition(NewField("user_id").FromTable("o"), OperatorEqual, NewColumnFilterValue("id").FromTable("u")))).
+				Where(NewFilter().SetCondition(NewField("region").FromTable("u"), OperatorIn, NewSelectQueryFilterValue(Select(NewField("code")).From(NewTable("regions"))))),
+			Expectation: []string{"table:orders", "table:regions", "table:users"},
+		},
+		{
+			Name: "update query reads subquery tables",
+			Query: Update("orders").
+				Set("total", Select(NewField("sum(amount)")).From(NewTable("order_items"))).
+				Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Expectation: []string{"table:order_items", "table:orders"},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual []string = ReadCacheTags(testCases[i].Query)
+
+			if !reflect.DeepEqual(testCases[i].Expectation, actual) {
+				t.Errorf("expectation tags is %v, got %v", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestCacheTag_WriteCacheTags(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Query       Query
+		Expectation []string
+	} = []struct {
+		Name        string
+		Query       Query
+		Expectation []string
+	}{
+		{
+			Name:        "select query",
+			Query:       Select(NewField("id")).From(NewTable("users")),
+			Expectation: []string{},
+		},
+		{
+			Name:        "insert query",
+			Query:       InsertInto("users").Value("name", "name1"),
+			Expectation: []string{"table:users"},
+		},
+		{
+			Name: "update query",
+			Query: Update("orders").
+				Set("total", Select(NewField("sum(amount)")).From(NewTable("order_items"))).
+				Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Expectation: []string{"table:orders"},
+		},
+		{
+			Name:        "delete query",
+			Query:       DeleteFrom("sessions"),
+			Expectation: []string{"table:sessions"},
+		},
+		{
+			Name:        "delete query without table",
+			Query:       Delete(),
+			Expectation: []string{},
+		},
+		{
+			Name:        "merge query",
+			Query:       MergeInto("products", "id"),
+			Expectation: []string{"table:products"},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual []string = WriteCacheTags(testCases[i].Query)
+
+			if !reflect.DeepEqual(testCases[i].Expectation, actual) {
+				t.Errorf("expectation tags is %v, got %v", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestCacheTag_IsInvalidatedBy(t *testing.T) {
+	var (
+		readQuery *SelectQuery
+		testCases []struct {
+			Name        string
+			WriteQuery  Query
+			Expectation bool
+		}
+	)
+
+	readQuery = Select(NewField("id").FromTable("u")).
+		From(NewTable("users").As("u")).
+		Join(InnerJoin(NewTable("orders").As("o")).On(NewFilter().SetCondition(NewField("user_id").FromTable("o"), OperatorEqual, NewColumnFilterValue("id").FromTable("u"))))
+
+	testCases = []struct {
+		Name        string
+		WriteQuery  Query
+		Expectation bool
+	}{
+		{
+			Name:        "write query touches joined table",
+			WriteQuery:  InsertInto("orders").Value("user_id", 1),
+			Expectation: true,
+		},
+		{
+			Name:        "write query touches other table",
+			WriteQuery:  DeleteFrom("sessions").Where(NewFilter().SetCondition(NewField("user_id"), OperatorEqual, NewFilterValue(1))),
+			Expectation: false,
+		},
+		{
+			Name:        "write query is select query",
+			WriteQuery:  Select(NewField("id")).From(NewTable("users")),
+			Expectation: false,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual bool = IsInvalidatedBy(readQuery, testCases[i].WriteQuery)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation invalidated is %t, got %t", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package goqube
+
+import "bytes"
+
+type CaseExpression struct {
+	Whens   []*Filter
+	HasElse bool
+}
+
+func Case() *Expression {
+	return &Expression{
+		Case: &CaseExpression{},
+	}
+}
+
+func (e *Expression) When(filter *Filter, result interface{}) *Expression {
+	if e.Case == nil {
+		e.Case = &CaseExpression{}
+	}
+
+	if e.Case.HasElse {
+		e.Operands = append(e.Operands[:len(e.Operands)-1], result, e.Operands[len(e.Operands)-1])
+	} else {
+		e.Operands = append(e.Operands, result)
+	}
+
+	e.Case.Whens = append(e.Case.Whens, filter)
+	return e
+}
+
+func (e *Expression) Else(result interface{}) *Expression {
+	if e.Case == nil {
+		e.Case = &CaseExpression{}
+	}
+
+	if e.Case.HasElse {
+		e.Operands[len(e.Operands)-1] = result
+		return e
+	}
+
+	e.Operands = append(e.Operands, result)
+	e.Case.HasElse = true
+	return e
+}
+
+func (c *CaseExpression) validate(operands []interface{}) error {
+	var resultCount int = len(c.Whens)
+
+	if len(c.Whens) == 0 {
+		return ErrCaseWhenIsRequired
+	}
+
+	if c.HasElse {
+		resultCount++
+	}
+
+	if len(operands) != resultCount {
+		return ErrOperandsIsInvalid
+	}
+
+	for i := range c.Whens {
+		if c.Whens[i] == nil {
+			return ErrFilterIsRequired
+		}
+	}
+
+	return nil
+}
+
+func (c *CaseExpression) referencesTable(qualifier string) bool {
+	for i := range c.Whens {
+		if c.Whens[i].referencesTable(qualifier) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e *Expression) caseToSQLWithArgs(bc *buildContext, args []interface{}) (string, []interface{}, error) {
+	var (
+		buffer *bytes.Buffer
+		query  string
+		err    error
+	)
+
+	buffer = getBuffer(64 * (len(e.Operands) + 1))
+	defer putBuffer(buffer)
+
+	buffer.WriteString("case")
+	for i := range e.Case.Whens {
+		query, args, err = e.Case.Whens[i].toRootSQLWithArgs(bc, args)
+		if err != nil {
+			return "", nil, err
+		}
+
+		buffer.WriteString(" when ")
+		buffer.WriteString(query)
+
+		query, args, err = e.operandToSQLWithArgs(bc, e.Operands[i], args)
+		if err != nil {
+			return "", nil, err
+		}
+
+		buffer.WriteString(" then ")
+		buffer.WriteString(query)
+	}
+
+	if e.Case.HasElse {
+		query, args, err = e.operandToSQLWithArgs(bc, e.Operands[len(e.Operands)-1], args)
+		if err != nil {
+			return "", nil, err
+		}
+
+		buffer.WriteString(" else ")
+		buffer.WriteString(query)
+	}
+
+	buffer.WriteString(" end")
+
+	return buffer.String(), args, nil
+}
@@ -0,0 +1,164 @@
+package goqube
+
+import "testing"
+
+func TestExpression_Case(t *testing.T) {
+	var (
+		filter *Filter = Eq("status", "vip")
+		actual *Expression
+	)
+
+	actual = Case().When(filter, 1).Else(3).When(Eq("status", "new"), 2)
+
+	if len(actual.Case.Whens) != 2 || actual.Case.Whens[0] != filter {
+		t.Errorf("expectation whens length is 2, got %d", len(actual.Case.Whens))
+	}
+
+	if !actual.Case.HasElse {
+		t.Errorf("expectation has else is %t, got %t", true, actual.Case.HasElse)
+	}
+
+	if !deepEqual([]interface{}{1, 2, 3}, actual.Operands) {
+		t.Errorf("expectation operands is %+v, got %+v", []interface{}{1, 2, 3}, actual.Operands)
+	}
+
+	actual = (&Expression{}).Else(1).Else(2)
+	if !deepEqual([]interface{}{2}, actual.Operands) {
+		t.Errorf("expectation operands is %+v, got %+v", []interface{}{2}, actual.Operands)
+	}
+}
+
+func TestExpression_ToSQLWithArgs_Case(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Expression  *Expression
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Expression  *Expression
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:       "case with else",
+			Dialect:    DialectPostgres,
+			Expression: Case().When(Eq("status", "vip"), 1).When(Eq("status", "new"), NewField("priority")).Else(3),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "case when status = $1 then $2 when status = $3 then priority else $4 end",
+				Args:  []interface{}{"vip", 1, "new", 3},
+				Err:   nil,
+			},
+		},
+		{
+			Name:       "case without else",
+			Dialect:    DialectMySQL,
+			Expression: Case().When(NewFilter().SetCondition(NewField("deleted_at"), OperatorIsNull, nil), NewRaw("'active'")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "case when deleted_at is null then 'active' end",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:       "case without when",
+			Dialect:    DialectPostgres,
+			Expression: Case().Else(1),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrCaseWhenIsRequired,
+			},
+		},
+		{
+			Name:       "case when filter is nil",
+			Dialect:    DialectPostgres,
+			Expression: Case().When(nil, 1),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrFilterIsRequired,
+			},
+		},
+		{
+			Name:       "case with function",
+			Dialect:    DialectPostgres,
+			Expression: NewFunctionExpression("coalesce").When(Eq("status", "vip"), 1),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrConflictExpressionOperatorAndFunction,
+			},
+		},
+		{
+			Name:       "case with invalid operands",
+			Dialect:    DialectPostgres,
+			Expression: &Expression{Case: &CaseExpression{Whens: []*Filter{Eq("status", "vip")}}},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrOperandsIsInvalid,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Expression.ToSQLWithArgs(testCases[i].Dialect, []interface{}{})
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Errorf("expectation error is nil, got %s", actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Err != nil && (actualErr == nil || testCases[i].Expectation.Err.Error() != actualErr.Error()) {
+				t.Errorf("expectation error is %s, got %v", testCases[i].Expectation.Err.Error(), actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %+v, got %+v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
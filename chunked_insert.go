@@ -0,0 +1,190 @@
+package goqube
+
+import (
+	"context"
+	"database/sql"
+)
+
+func (i *InsertQuery) rowCount() int {
+	var rowCount int
+
+	for _, values := range i.FieldsValues {
+		if rowCount < len(values) {
+			rowCount = len(values)
+		}
+	}
+
+	return rowCount
+}
+
+func (i *InsertQuery) chunkQuery(start, end int) *InsertQuery {
+	var chunkQuery InsertQuery = *i
+
+	chunkQuery.FieldsValues = make(map[string][]interface{}, len(i.FieldsValues))
+	for field, values := range i.FieldsValues {
+		var chunkStart, chunkEnd int = start, end
+
+		if chunkStart > len(values) {
+			chunkStart = len(values)
+		}
+
+		if chunkEnd > len(values) {
+			chunkEnd = len(values)
+		}
+
+		chunkQuery.FieldsValues[field] = values[chunkStart:chunkEnd]
+	}
+
+	return &chunkQuery
+}
+
+func (i *InsertQuery) chunkSize(bc *buildContext, maxParameters int) (int, error) {
+	var (
+		columns    []string
+		rowsValues [][]interface{}
+		rowArgs    int
+		args       []interface{}
+		err        error
+	)
+
+	columns, rowsValues = i.getColumnsAndRowsValues()
+
+	for columnIndex := range rowsValues[0] {
+		if _, ok := literalValue(rowsValues[0][columnIndex]); !ok {
+			rowArgs++
+		}
+	}
+
+	_, args, err = bc.config.build(bc, i.chunkQuery(0, 1))
+	if err != nil {
+		return 0, err
+	}
+
+	if len(columns) == 0 || maxParameters-len(args)+rowArgs < len(columns) {
+		return 0, ErrParameterLimitIsExceeded
+	}
+
+	return (maxParameters - len(args) + rowArgs) / len(columns), nil
+}
+
+func (i *InsertQuery) buildChunked(bc *buildContext, maxParameters int) ([]*Statement, error) {
+	var (
+		rowCount   int
+		chunkSize  int
+		statements []*Statement
+		err        error
+	)
+
+	err = i.validateWithContext(bc)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxParameters <= 0 {
+		maxParameters = dialectMaxParameters[bc.dialect]
+	}
+
+	rowCount = i.rowCount()
+	chunkSize = rowCount
+	if maxParameters > 0 {
+		chunkSize, err = i.chunkSize(bc, maxParameters)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	statements = []*Statement{}
+	for start := 0; start < rowCount; start += chunkSize {
+		var (
+			end       int
+			statement *Statement
+		)
+
+		end = start + chunkSize
+		if end > rowCount {
+			end = rowCount
+		}
+
+		statement = &Statement{}
+		statement.Query, statement.Args, err = bc.config.build(bc, i.chunkQuery(start, end))
+		if err != nil {
+			return nil, err
+		}
+
+		statements = append(statements, statement)
+	}
+
+	return statements, nil
+}
+
+func (i *InsertQuery) BuildChunked(dialect Dialect, maxParameters int) ([]*Statement, error) {
+	return i.buildChunked(newDialectBuildContext(dialect), maxParameters)
+}
+
+func (c *Config) BuildChunked(insertQuery *InsertQuery, maxParameters int) ([]*Statement, error) {
+	if insertQuery == nil {
+		return nil, ErrQueryIsRequired
+	}
+
+	return insertQuery.buildChunked(newBuildContext(c), maxParameters)
+}
+
+func (b *Builder) BuildChunked(insertQuery *InsertQuery, maxParameters int) ([]*Statement, error) {
+	return b.config.BuildChunked(insertQuery, maxParameters)
+}
+
+func (e *Executor) ExecChunked(ctx context.Context, insertQuery *InsertQuery, maxParameters int) (int64, error) {
+	var (
+		statements []*Statement
+		count      int64
+		err        error
+	)
+
+	if insertQuery == nil {
+		return 0, ErrQueryIsRequired
+	}
+
+	if e.DB == nil && e.tx == nil {
+		return 0, ErrDBIsRequired
+	}
+
+	if e.Config == nil {
+		return 0, ErrConfigIsRequired
+	}
+
+	statements, err = e.Config.BuildChunked(insertQuery, maxParameters)
+	if err != nil {
+		return 0, err
+	}
+
+	err = e.RunInTransaction(ctx, func(runner Runner) error {
+		var executor *Executor = runner.(*Executor)
+
+		for i := range statements {
+			var (
+				result   sql.Result
+				affected int64
+				err      error
+			)
+
+			result, err = executor.execContext(ctx, statements[i].Query, statements[i].Args)
+			if err != nil {
+				return err
+			}
+
+			affected, err = result.RowsAffected()
+			if err != nil {
+				return err
+			}
+
+			count += affected
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
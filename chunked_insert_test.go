@@ -0,0 +1,243 @@
+package goqube
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+)
+
+func TestInsertQuery_BuildChunked(t *testing.T) {
+	var testCases []struct {
+		Name          string
+		Dialect       Dialect
+		MaxParameters int
+		Query         *InsertQuery
+		Expectation   struct {
+			Statements []*Statement
+			Err        error
+		}
+	} = []struct {
+		Name          string
+		Dialect       Dialect
+		MaxParameters int
+		Query         *InsertQuery
+		Expectation   struct {
+			Statements []*Statement
+			Err        error
+		}
+	}{
+		{
+			Name:          "dialect is empty",
+			Dialect:       "",
+			MaxParameters: 4,
+			Query:         InsertInto("users").Value("name", "a"),
+			Expectation: struct {
+				Statements []*Statement
+				Err        error
+			}{
+				Statements: nil,
+				Err:        ErrDialectIsRequired,
+			},
+		},
+		{
+			Name:          "table is empty",
+			Dialect:       DialectPostgres,
+			MaxParameters: 4,
+			Query:         Insert().Value("name", "a"),
+			Expectation: struct {
+				Statements []*Statement
+				Err        error
+			}{
+				Statements: nil,
+				Err:        ErrTableIsRequired,
+			},
+		},
+		{
+			Name:          "parameter limit is exceeded",
+			Dialect:       DialectPostgres,
+			MaxParameters: 1,
+			Query:         InsertInto("users").Columns("name", "age").Values("a", 1),
+			Expectation: struct {
+				Statements []*Statement
+				Err        error
+			}{
+				Statements: nil,
+				Err:        ErrParameterLimitIsExceeded,
+			},
+		},
+		{
+			Name:          fmt.Sprintf("dialect %s", DialectMySQL),
+			Dialect:       DialectMySQL,
+			MaxParameters: 5,
+			Query:         InsertInto("users").Columns("name", "age").Values("a", 1).Values("b", 2).Values("c", 3).Values("d", 4).Values("e", 5),
+			Expectation: struct {
+				Statements []*Statement
+				Err        error
+			}{
+				Statements: []*Statement{
+					{Query: "insert into users(name, age) values (?, ?), (?, ?)", Args: []interface{}{"a", 1, "b", 2}},
+					{Query: "insert into users(name, age) values (?, ?), (?, ?)", Args: []interface{}{"c", 3, "d", 4}},
+					{Query: "insert into users(name, age) values (?, ?)", Args: []interface{}{"e", 5}},
+				},
+				Err: nil,
+			},
+		},
+		{
+			Name:          fmt.Sprintf("dialect %s with literal values", DialectPostgres),
+			Dialect:       DialectPostgres,
+			MaxParameters: 4,
+			Query:         InsertInto("users").Columns("name", "created_at").Values("a", DefaultValue).Values("b", DefaultValue).Values("c", DefaultValue),
+			Expectation: struct {
+				Statements []*Statement
+				Err        error
+			}{
+				Statements: []*Statement{
+					{Query: "insert into users(name, created_at) values ($1, default), ($2, default)", Args: []interface{}{"a", "b"}},
+					{Query: "insert into users(name, created_at) values ($1, default)", Args: []interface{}{"c"}},
+				},
+				Err: nil,
+			},
+		},
+		{
+			Name:          fmt.Sprintf("dialect %s with default parameter limit", DialectPostgres),
+			Dialect:       DialectPostgres,
+			MaxParameters: 0,
+			Query:         InsertInto("users").Columns("name").Values("a").Values("b"),
+			Expectation: struct {
+				Statements []*Statement
+				Err        error
+			}{
+				Statements: []*Statement{
+					{Query: "insert into users(name) values ($1), ($2)", Args: []interface{}{"a", "b"}},
+				},
+				Err: nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualStatements []*Statement
+				actualErr        error
+			)
+
+			actualStatements, actualErr = testCases[i].Query.BuildChunked(testCases[i].Dialect, testCases[i].MaxParameters)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Errorf("expectation error is nil, got %s", actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if !deepEqual(testCases[i].Expectation.Statements, actualStatements) {
+				t.Errorf("expectation statements is %+v, got %+v", testCases[i].Expectation.Statements, actualStatements)
+			}
+		})
+	}
+}
+
+func TestInsertQuery_BuildChunked_DialectParameterLimit(t *testing.T) {
+	var (
+		query      *InsertQuery = InsertInto("events").Columns("id")
+		statements []*Statement
+		err        error
+	)
+
+	for i := 0; i < dialectMaxParameters[DialectPostgres]+10; i++ {
+		query.Values(i)
+	}
+
+	statements, err = query.BuildChunked(DialectPostgres, 0)
+	if err != nil {
+		t.Errorf("expectation error is nil, got %s", err.Error())
+	}
+
+	if len(statements) != 2 {
+		t.Fatalf("expectation statements length is %d, got %d", 2, len(statements))
+	}
+
+	if len(statements[0].Args) != dialectMaxParameters[DialectPostgres] {
+		t.Errorf("expectation first statement args length is %d, got %d", dialectMaxParameters[DialectPostgres], len(statements[0].Args))
+	}
+
+	if len(statements[1].Args) != 10 {
+		t.Errorf("expectation second statement args length is %d, got %d", 10, len(statements[1].Args))
+	}
+}
+
+func TestConfig_BuildChunked(t *testing.T) {
+	var (
+		config     *Config = NewConfig(DialectPostgres).SetTablePrefix("app_")
+		statements []*Statement
+		err        error
+	)
+
+	_, err = config.BuildChunked(nil, 4)
+	if err != ErrQueryIsRequired {
+		t.Errorf("expectation error is %v, got %v", ErrQueryIsRequired, err)
+	}
+
+	statements, err = NewBuilder(DialectPostgres, WithTablePrefix("app_")).BuildChunked(InsertInto("users").Columns("name", "age").Values("a", 1).Values("b", 2).Values("c", 3), 4)
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %s", err.Error())
+	}
+
+	if !deepEqual([]*Statement{
+		{Query: "insert into app_users(name, age) values ($1, $2), ($3, $4)", Args: []interface{}{"a", 1, "b", 2}},
+		{Query: "insert into app_users(name, age) values ($1, $2)", Args: []interface{}{"c", 3}},
+	}, statements) {
+		t.Errorf("expectation statements is prefixed, got %+v", statements)
+	}
+
+	statements, err = config.BuildChunked(InsertInto("users").Columns("name").Values("a").Values("b"), 1)
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %s", err.Error())
+	}
+
+	if len(statements) != 2 || statements[0].Query != "insert into app_users(name) values ($1)" {
+		t.Errorf("expectation statements is prefixed, got %+v", statements)
+	}
+}
+
+func TestExecutor_ExecChunked(t *testing.T) {
+	var (
+		db       *sql.DB
+		executor *Executor
+		count    int64
+		err      error
+	)
+
+	_, err = NewExecutor(nil, DialectPostgres).ExecChunked(context.Background(), InsertInto("users").Value("name", "a"), 0)
+	if err != ErrDBIsRequired {
+		t.Errorf("expectation error is %v, got %v", ErrDBIsRequired, err)
+	}
+
+	db = openFakeDB(t, nil)
+	executor = NewExecutor(db, DialectPostgres)
+
+	_, err = executor.ExecChunked(context.Background(), nil, 0)
+	if err != ErrQueryIsRequired {
+		t.Errorf("expectation error is %v, got %v", ErrQueryIsRequired, err)
+	}
+
+	count, err = executor.ExecChunked(context.Background(), InsertInto("users").Columns("name").Values("a").Values("b").Values("c"), 2)
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %s", err.Error())
+	}
+
+	if count != 2 {
+		t.Errorf("expectation count is %d, got %d", 2, count)
+	}
+
+	if !deepEqual([]string{"begin", "insert into users(name) values ($1), ($2)", "insert into users(name) values ($1)", "commit"}, fakeDriverInstance.queries) {
+		t.Errorf("expectation queries is %+v, got %+v", []string{"begin", "insert into users(name) values ($1), ($2)", "insert into users(name) values ($1)", "commit"}, fakeDriverInstance.queries)
+	}
+}
@@ -0,0 +1,316 @@
+package goqube
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"sort"
+)
+
+var dialectMaxParameters map[Dialect]int = map[Dialect]int{
+	DialectMySQL:    65535,
+	DialectPostgres: 65535,
+}
+
+type ChunkedSelect struct {
+	Query     *SelectQuery
+	Key       *Field
+	Keys      interface{}
+	ChunkSize int
+}
+
+func NewChunkedSelect(query *SelectQuery) *ChunkedSelect {
+	return &ChunkedSelect{
+		Query: query,
+	}
+}
+
+func (c *ChunkedSelect) ChunkBy(key *Field, keys interface{}, chunkSize int) *ChunkedSelect {
+	c.Key = key
+	c.Keys = keys
+	c.ChunkSize = chunkSize
+	return c
+}
+
+func (c *ChunkedSelect) validate(dialect Dialect) error {
+	if dialect == "" {
+		return ErrDialectIsRequired
+	}
+
+	if c.Query == nil {
+		return ErrQueryIsRequired
+	}
+
+	if c.Key == nil {
+		return ErrFieldIsRequired
+	}
+
+	if c.ChunkSize <= 0 {
+		return ErrChunkSizeIsRequired
+	}
+
+	if c.Query.TakeIsSet || c.Query.Take > 0 || c.Query.Skip > 0 {
+		return ErrLimitOffsetIsNotAllowed
+	}
+
+	return nil
+}
+
+func (c *ChunkedSelect) chunkQuery(chunk []interface{}) *SelectQuery {
+	var (
+		chunkQuery SelectQuery = *c.Query
+		keyFilter  *Filter     = NewFilter().SetCondition(c.Key, OperatorIn, NewFilterValue(chunk))
+		keySort    *Sort       = NewSort(c.Key, SortDirectionAscending)
+	)
+
+	chunkQuery.Filter = keyFilter
+	if c.Query.Filter != nil {
+		chunkQuery.Filter = NewFilter().SetLogic(LogicAnd).AddFilters(c.Query.Filter, keyFilter)
+	}
+
+	chunkQuery.Sorts = append([]*Sort{keySort}, c.Query.Sorts...)
+
+	return &chunkQuery
+}
+
+func (c *ChunkedSelect) chunkSize(bc *buildContext) (int, error) {
+	var (
+		chunkSize     int = c.ChunkSize
+		maxParameters int
+		hasLimit      bool
+		args          []interface{}
+		err           error
+	)
+
+	if bc.config.Limits != nil && bc.config.Limits.MaxInListSize > 0 && bc.config.Limits.MaxInListSize < chunkSize {
+		chunkSize = bc.config.Limits.MaxInListSize
+	}
+
+	maxParameters, hasLimit = dialectMaxParameters[bc.dialect]
+	if !hasLimit {
+		return chunkSize, nil
+	}
+
+	_, args, err = bc.config.build(bc, c.chunkQuery([]interface{}{nil}))
+	if err != nil {
+		return 0, err
+	}
+
+	if maxParameters-len(args)+1 < chunkSize {
+		chunkSize = maxParameters - len(args) + 1
+	}
+
+	if chunkSize <= 0 {
+		return 0, ErrParameterLimitIsExceeded
+	}
+
+	return chunkSize, nil
+}
+
+func (c *ChunkedSelect) toStatements(bc *buildContext) ([]*Statement, error) {
+	var (
+		keys       []interface{}
+		chunkSize  int
+		statements []*Statement
+		err        error
+	)
+
+	err = c.validate(bc.dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err = typedSliceToInterfaceSlice(c.Keys)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(keys) == 0 {
+		return nil, ErrValuesIsRequired
+	}
+
+	keys, err = uniqueKeys(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	chunkSize, err = c.chunkSize(bc)
+	if err != nil {
+		return nil, err
+	}
+
+	statements = []*Statement{}
+	for start := 0; start < len(keys); start += chunkSize {
+		var (
+			end       int
+			statement *Statement
+		)
+
+		end = start + chunkSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		statement = &Statement{}
+		statement.Query, statement.Args, err = bc.config.build(bc, c.chunkQuery(keys[start:end]))
+		if err != nil {
+			return nil, err
+		}
+
+		statements = append(statements, statement)
+	}
+
+	return statements, nil
+}
+
+func (c *ChunkedSelect) ToStatements(dialect Dialect) ([]*Statement, error) {
+	return c.toStatements(newDialectBuildContext(dialect))
+}
+
+func compareKeys(a, b interface{}) (int, error) {
+	var (
+		valueA reflect.Value = reflect.ValueOf(a)
+		valueB reflect.Value = reflect.ValueOf(b)
+	)
+
+	switch {
+	case valueA.Kind() >= reflect.Int && valueA.Kind() <= reflect.Int64 && valueB.Kind() >= reflect.Int && valueB.Kind() <= reflect.Int64:
+		return compareOrdered(valueA.Int() < valueB.Int(), valueA.Int() > valueB.Int()), nil
+
+	case valueA.Kind() >= reflect.Uint && valueA.Kind() <= reflect.Uint64 && valueB.Kind() >= reflect.Uint && valueB.Kind() <= reflect.Uint64:
+		return compareOrdered(valueA.Uint() < valueB.Uint(), valueA.Uint() > valueB.Uint()), nil
+
+	case (valueA.Kind() == reflect.Float32 || valueA.Kind() == reflect.Float64) && (valueB.Kind() == reflect.Float32 || valueB.Kind() == reflect.Float64):
+		return compareOrdered(valueA.Float() < valueB.Float(), valueA.Float() > valueB.Float()), nil
+
+	case valueA.Kind() == reflect.String && valueB.Kind() == reflect.String:
+		return compareOrdered(valueA.String() < valueB.String(), valueA.String() > valueB.String()), nil
+	}
+
+	return 0, ErrKeyIsNotSortable
+}
+
+func compareOrdered(less, greater bool) int {
+	if less {
+		return -1
+	}
+
+	if greater {
+		return 1
+	}
+
+	return 0
+}
+
+func uniqueKeys(keys []interface{}) ([]interface{}, error) {
+	var (
+		sorted []interface{}
+		result []interface{}
+		err    error
+	)
+
+	for i := range keys {
+		_, err = compareKeys(keys[0], keys[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sorted = append([]interface{}{}, keys...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		var comparison int
+
+		comparison, _ = compareKeys(sorted[i], sorted[j])
+		return comparison < 0
+	})
+
+	result = []interface{}{}
+	for i := range sorted {
+		if i > 0 {
+			var comparison int
+
+			comparison, _ = compareKeys(result[len(result)-1], sorted[i])
+			if comparison == 0 {
+				continue
+			}
+		}
+
+		result = append(result, sorted[i])
+	}
+
+	return result, nil
+}
+
+type ChunkedRows struct {
+	ctx        context.Context
+	executor   *Executor
+	statements []*Statement
+	index      int
+	rows       *sql.Rows
+	columns    []string
+	err        error
+}
+
+func (r *ChunkedRows) Next() bool {
+	for r.err == nil {
+		if r.rows != nil {
+			if r.rows.Next() {
+				return true
+			}
+
+			r.err = r.rows.Err()
+			if r.err != nil {
+				return false
+			}
+
+			r.err = r.rows.Close()
+			r.rows = nil
+			continue
+		}
+
+		if r.index >= len(r.statements) {
+			return false
+		}
+
+		r.rows, r.err = r.executor.queryContext(r.ctx, r.statements[r.index].Query, r.statements[r.index].Args)
+		r.index++
+		if r.err != nil {
+			return false
+		}
+
+		r.columns, r.err = r.rows.Columns()
+	}
+
+	return false
+}
+
+func (r *ChunkedRows) Scan(dest interface{}) error {
+	var err error
+
+	err = validateScanDestination(dest)
+	if err != nil {
+		return err
+	}
+
+	if r.rows == nil {
+		return sql.ErrNoRows
+	}
+
+	return scanRow(r.rows, r.columns, reflect.ValueOf(dest).Elem())
+}
+
+func (r *ChunkedRows) Err() error {
+	return r.err
+}
+
+func (r *ChunkedRows) Close() error {
+	var err error
+
+	r.index = len(r.statements)
+	if r.rows != nil {
+		err = r.rows.Close()
+		r.rows = nil
+	}
+
+	return err
+}
@@ -0,0 +1,226 @@
+package goqube
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestChunkedSelect_NewChunkedSelect(t *testing.T) {
+	var (
+		query  *SelectQuery
+		actual *ChunkedSelect
+	)
+
+	query = Select(NewField("id")).From(NewTable("users"))
+	actual = NewChunkedSelect(query).ChunkBy(NewField("id"), []int{1, 2, 3}, 2)
+
+	if actual.Query != query {
+		t.Errorf("expectation query is %+v, got %+v", query, actual.Query)
+	}
+
+	if !deepEqual(NewField("id"), actual.Key) {
+		t.Errorf("expectation key is %+v, got %+v", NewField("id"), actual.Key)
+	}
+
+	if !deepEqual([]int{1, 2, 3}, actual.Keys) {
+		t.Errorf("expectation keys is %+v, got %+v", []int{1, 2, 3}, actual.Keys)
+	}
+
+	if actual.ChunkSize != 2 {
+		t.Errorf("expectation chunk size is %d, got %d", 2, actual.ChunkSize)
+	}
+}
+
+func TestChunkedSelect_ToStatements(t *testing.T) {
+	var (
+		query     *SelectQuery
+		testCases []struct {
+			Name          string
+			Dialect       Dialect
+			ChunkedSelect *ChunkedSelect
+			Expectation   struct {
+				Statements []*Statement
+				Err        error
+			}
+		}
+	)
+
+	query = Select(NewField("id"), NewField("name")).
+		From(NewTable("users")).
+		Where(NewFilter().SetCondition(NewField("deleted_at"), OperatorIsNull, nil)).
+		OrderBy(NewSort(NewField("name"), SortDirectionAscending))
+
+	testCases = []struct {
+		Name          string
+		Dialect       Dialect
+		ChunkedSelect *ChunkedSelect
+		Expectation   struct {
+			Statements []*Statement
+			Err        error
+		}
+	}{
+		{
+			Name:          "dialect is empty",
+			Dialect:       "",
+			ChunkedSelect: NewChunkedSelect(query).ChunkBy(NewField("id"), []int{1}, 2),
+			Expectation: struct {
+				Statements []*Statement
+				Err        error
+			}{
+				Statements: nil,
+				Err:        ErrDialectIsRequired,
+			},
+		},
+		{
+			Name:          "query has limit",
+			Dialect:       DialectPostgres,
+			ChunkedSelect: NewChunkedSelect(Select(NewField("id")).From(NewTable("users")).Limit(10)).ChunkBy(NewField("id"), []int{1}, 2),
+			Expectation: struct {
+				Statements []*Statement
+				Err        error
+			}{
+				Statements: nil,
+				Err:        ErrLimitOffsetIsNotAllowed,
+			},
+		},
+		{
+			Name:          "query is nil",
+			Dialect:       DialectPostgres,
+			ChunkedSelect: NewChunkedSelect(nil).ChunkBy(NewField("id"), []int{1}, 2),
+			Expectation: struct {
+				Statements []*Statement
+				Err        error
+			}{
+				Statements: nil,
+				Err:        ErrQueryIsRequired,
+			},
+		},
+		{
+			Name:          "key is nil",
+			Dialect:       DialectPostgres,
+			ChunkedSelect: NewChunkedSelect(query).ChunkBy(nil, []int{1}, 2),
+			Expectation: struct {
+				Statements []*Statement
+				Err        error
+			}{
+				Statements: nil,
+				Err:        ErrFieldIsRequired,
+			},
+		},
+		{
+			Name:          "chunk size is zero",
+			Dialect:       DialectPostgres,
+			ChunkedSelect: NewChunkedSelect(query).ChunkBy(NewField("id"), []int{1}, 0),
+			Expectation: struct {
+				Statements []*Statement
+				Err        error
+			}{
+				Statements: nil,
+				Err:        ErrChunkSizeIsRequired,
+			},
+		},
+		{
+			Name:          "keys is empty",
+			Dialect:       DialectPostgres,
+			ChunkedSelect: NewChunkedSelect(query).ChunkBy(NewField("id"), []int{}, 2),
+			Expectation: struct {
+				Statements []*Statement
+				Err        error
+			}{
+				Statements: nil,
+				Err:        ErrValuesIsRequired,
+			},
+		},
+		{
+			Name:          "keys is not sortable",
+			Dialect:       DialectPostgres,
+			ChunkedSelect: NewChunkedSelect(query).ChunkBy(NewField("id"), []interface{}{1, "2"}, 2),
+			Expectation: struct {
+				Statements []*Statement
+				Err        error
+			}{
+				Statements: nil,
+				Err:        ErrKeyIsNotSortable,
+			},
+		},
+		{
+			Name:          fmt.Sprintf("dialect %s", DialectMySQL),
+			Dialect:       DialectMySQL,
+			ChunkedSelect: NewChunkedSelect(query).ChunkBy(NewField("id"), []int{5, 3, 1, 3, 4, 2}, 2),
+			Expectation: struct {
+				Statements []*Statement
+				Err        error
+			}{
+				Statements: []*Statement{
+					{Query: "select id, name from users where deleted_at is null and id in (?, ?) order by id asc, name asc", Args: []interface{}{1, 2}},
+					{Query: "select id, name from users where deleted_at is null and id in (?, ?) order by id asc, name asc", Args: []interface{}{3, 4}},
+					{Query: "select id, name from users where deleted_at is null and id in (?) order by id asc, name asc", Args: []interface{}{5}},
+				},
+				Err: nil,
+			},
+		},
+		{
+			Name:          fmt.Sprintf("dialect %s", DialectPostgres),
+			Dialect:       DialectPostgres,
+			ChunkedSelect: NewChunkedSelect(Select(NewField("id")).From(NewTable("users"))).ChunkBy(NewField("code"), []string{"delta", "bravo", "echo", "alpha", "bravo", "charlie"}, 2),
+			Expectation: struct {
+				Statements []*Statement
+				Err        error
+			}{
+				Statements: []*Statement{
+					{Query: "select id from users where code in ($1, $2) order by code asc", Args: []interface{}{"alpha", "bravo"}},
+					{Query: "select id from users where code in ($1, $2) order by code asc", Args: []interface{}{"charlie", "delta"}},
+					{Query: "select id from users where code in ($1) order by code asc", Args: []interface{}{"echo"}},
+				},
+				Err: nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualStatements []*Statement
+				actualErr        error
+			)
+
+			actualStatements, actualErr = testCases[i].ChunkedSelect.ToStatements(testCases[i].Dialect)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if !deepEqual(testCases[i].Expectation.Statements, actualStatements) {
+				t.Errorf("expectation statements is %+v, got %+v", testCases[i].Expectation.Statements, actualStatements)
+			}
+		})
+	}
+}
+
+func TestChunkedSelect_chunkSize(t *testing.T) {
+	var (
+		chunkedSelect *ChunkedSelect
+		actual        int
+		err           error
+	)
+
+	chunkedSelect = NewChunkedSelect(Select(NewField("id")).From(NewTable("users")).Where(NewFilter().SetCondition(NewField("status"), OperatorEqual, NewFilterValue("active")))).
+		ChunkBy(NewField("id"), []int{1}, 100000)
+
+	actual, err = chunkedSelect.chunkSize(newDialectBuildContext(DialectPostgres))
+	if err != nil {
+		t.Errorf("expectation error is nil, got %s", err.Error())
+	}
+
+	if actual != dialectMaxParameters[DialectPostgres]-1 {
+		t.Errorf("expectation chunk size is %d, got %d", dialectMaxParameters[DialectPostgres]-1, actual)
+	}
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/fikri240794/goqube"
+)
+
+const (
+	queryTypeDelete string = "delete"
+	queryTypeInsert string = "insert"
+	queryTypeSelect string = "select"
+	queryTypeUpdate string = "update"
+)
+
+const (
+	errUnsupportedQueryTypef string = "unsupported query type %s"
+	outputf                  string = "query: %s\nargs: %v\n"
+	usage                    string = "usage: goqube -dialect <mysql|postgres> [file]"
+)
+
+var (
+	errDialectIsInvalid     error = errors.New("dialect must be mysql or postgres")
+	errDefinitionIsRequired error = errors.New("query definition is required")
+	errTooManyFiles         error = errors.New("only one query definition file is allowed")
+)
+
+type definition struct {
+	Type  string          `json:"type"`
+	Query json.RawMessage `json:"query"`
+}
+
+func (d *definition) toQuery() (goqube.Query, error) {
+	var (
+		query goqube.Query
+		err   error
+	)
+
+	if len(d.Query) == 0 {
+		return nil, errDefinitionIsRequired
+	}
+
+	switch d.Type {
+	case queryTypeSelect:
+		query = &goqube.SelectQuery{}
+	case queryTypeInsert:
+		query = &goqube.InsertQuery{}
+	case queryTypeUpdate:
+		query = &goqube.UpdateQuery{}
+	case queryTypeDelete:
+		query = &goqube.DeleteQuery{}
+	default:
+		return nil, fmt.Errorf(errUnsupportedQueryTypef, d.Type)
+	}
+
+	err = decodeJSON(d.Query, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return query, nil
+}
+
+func decodeJSON(data []byte, v interface{}) error {
+	var decoder *json.Decoder = json.NewDecoder(bytes.NewReader(data))
+
+	decoder.UseNumber()
+	decoder.DisallowUnknownFields()
+
+	return decoder.Decode(v)
+}
+
+func readDefinition(reader io.Reader) (*definition, error) {
+	var (
+		data []byte
+		def  *definition
+		err  error
+	)
+
+	data, err = io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	def = &definition{}
+
+	err = decodeJSON(data, def)
+	if err != nil {
+		return nil, err
+	}
+
+	return def, nil
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer) error {
+	var (
+		flagSet *flag.FlagSet
+		dialect string
+		reader  io.Reader
+		def     *definition
+		query   goqube.Query
+		sql     string
+		sqlArgs []interface{}
+		err     error
+	)
+
+	flagSet = flag.NewFlagSet("goqube", flag.ContinueOnError)
+	flagSet.SetOutput(io.Discard)
+	flagSet.StringVar(&dialect, "dialect", string(goqube.DialectPostgres), "sql dialect")
+
+	err = flagSet.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	if goqube.Dialect(dialect) != goqube.DialectMySQL && goqube.Dialect(dialect) != goqube.DialectPostgres {
+		return errDialectIsInvalid
+	}
+
+	switch flagSet.NArg() {
+	case 0:
+		reader = stdin
+	case 1:
+		var file *os.File
+
+		file, err = os.Open(flagSet.Arg(0))
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		reader = file
+	default:
+		return errTooManyFiles
+	}
+
+	def, err = readDefinition(reader)
+	if err != nil {
+		return err
+	}
+
+	query, err = def.toQuery()
+	if err != nil {
+		return err
+	}
+
+	sql, sqlArgs, err = goqube.NewConfig(goqube.Dialect(dialect)).Build(query)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(stdout, outputf, sql, sqlArgs)
+
+	return err
+}
+
+func main() {
+	var err error = run(os.Args[1:], os.Stdin, os.Stdout)
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(1)
+	}
+}
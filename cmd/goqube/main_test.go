@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Args        []string
+		Stdin       string
+		Expectation struct {
+			Output string
+			Err    error
+		}
+	} = []struct {
+		Name        string
+		Args        []string
+		Stdin       string
+		Expectation struct {
+			Output string
+			Err    error
+		}
+	}{
+		{
+			Name:  "dialect is invalid",
+			Args:  []string{"-dialect", "sqlite"},
+			Stdin: `{"type":"delete","query":{"Table":"users"}}`,
+			Expectation: struct {
+				Output string
+				Err    error
+			}{
+				Output: "",
+				Err:    errDialectIsInvalid,
+			},
+		},
+		{
+			Name:  "too many files",
+			Args:  []string{"testdata/select.json", "testdata/select.json"},
+			Stdin: "",
+			Expectation: struct {
+				Output string
+				Err    error
+			}{
+				Output: "",
+				Err:    errTooManyFiles,
+			},
+		},
+		{
+			Name:  "query definition is empty",
+			Args:  []string{},
+			Stdin: `{"type":"select"}`,
+			Expectation: struct {
+				Output string
+				Err    error
+			}{
+				Output: "",
+				Err:    errDefinitionIsRequired,
+			},
+		},
+		{
+			Name:  "query type is unsupported",
+			Args:  []string{},
+			Stdin: `{"type":"merge","query":{}}`,
+			Expectation: struct {
+				Output string
+				Err    error
+			}{
+				Output: "",
+				Err:    errors.New("unsupported query type merge"),
+			},
+		},
+		{
+			Name:  "query definition has unknown field",
+			Args:  []string{},
+			Stdin: `{"type":"delete","query":{"Tabel":"users"}}`,
+			Expectation: struct {
+				Output string
+				Err    error
+			}{
+				Output: "",
+				Err:    errors.New(`json: unknown field "Tabel"`),
+			},
+		},
+		{
+			Name:  "query is invalid",
+			Args:  []string{},
+			Stdin: `{"type":"delete","query":{"Table":"users"}}`,
+			Expectation: struct {
+				Output string
+				Err    error
+			}{
+				Output: "",
+				Err:    errors.New("filter is required"),
+			},
+		},
+		{
+			Name:  "select query from file",
+			Args:  []string{"-dialect", "postgres", "testdata/select.json"},
+			Stdin: "",
+			Expectation: struct {
+				Output string
+				Err    error
+			}{
+				Output: "query: select id, name from users where status = $1 limit $2\nargs: [active 10]\n",
+				Err:    nil,
+			},
+		},
+		{
+			Name:  "insert query from stdin",
+			Args:  []string{"-dialect", "mysql"},
+			Stdin: `{"type":"insert","query":{"Table":"users","Fields":["name","age"],"FieldsValues":{"name":["name1"],"age":[20]}}}`,
+			Expectation: struct {
+				Output string
+				Err    error
+			}{
+				Output: "query: insert into users(name, age) values (?, ?)\nargs: [name1 20]\n",
+				Err:    nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				stdout    *bytes.Buffer = &bytes.Buffer{}
+				actualErr error
+			)
+
+			actualErr = run(testCases[i].Args, strings.NewReader(testCases[i].Stdin), stdout)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Output != stdout.String() {
+				t.Errorf("expectation output is %q, got %q", testCases[i].Expectation.Output, stdout.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package goqube
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+)
+
+func setComment(comments map[string]string, key, value string) map[string]string {
+	if comments == nil {
+		comments = map[string]string{}
+	}
+
+	comments[key] = value
+	return comments
+}
+
+func validateComments(comments map[string]string) error {
+	for key := range comments {
+		if key == "" {
+			return ErrCommentKeyIsRequired
+		}
+	}
+
+	return nil
+}
+
+func commentSQL(comments map[string]string) string {
+	var (
+		keys  []string
+		pairs []string
+	)
+
+	if len(comments) == 0 {
+		return ""
+	}
+
+	keys = make([]string, 0, len(comments))
+	for key := range comments {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs = make([]string, len(keys))
+	for i := range keys {
+		pairs[i] = url.PathEscape(keys[i]) + "='" + url.PathEscape(comments[keys[i]]) + "'"
+	}
+
+	return " /*" + strings.Join(pairs, ",") + "*/"
+}
+
+func (s *SelectQuery) Comment(key, value string) *SelectQuery {
+	s.Comments = setComment(s.Comments, key, value)
+	return s
+}
+
+func (i *InsertQuery) Comment(key, value string) *InsertQuery {
+	i.Comments = setComment(i.Comments, key, value)
+	return i
+}
+
+func (u *UpdateQuery) Comment(key, value string) *UpdateQuery {
+	u.Comments = setComment(u.Comments, key, value)
+	return u
+}
+
+func (d *DeleteQuery) Comment(key, value string) *DeleteQuery {
+	d.Comments = setComment(d.Comments, key, value)
+	return d
+}
+
+func (c *CompoundQuery) Comment(key, value string) *CompoundQuery {
+	c.Comments = setComment(c.Comments, key, value)
+	return c
+}
+
+func (m *MergeQuery) Comment(key, value string) *MergeQuery {
+	m.Comments = setComment(m.Comments, key, value)
+	return m
+}
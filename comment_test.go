@@ -0,0 +1,164 @@
+package goqube
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSelectQuery_Comment(t *testing.T) {
+	var actual *SelectQuery = Select(NewField("id")).From(NewTable("users")).Comment("app", "checkout").Comment("route", "GET /orders")
+
+	if !deepEqual(map[string]string{"app": "checkout", "route": "GET /orders"}, actual.Comments) {
+		t.Errorf("expectation comments is %+v, got %+v", map[string]string{"app": "checkout", "route": "GET /orders"}, actual.Comments)
+	}
+}
+
+func Test_commentSQL(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Comments    map[string]string
+		Expectation string
+	} = []struct {
+		Name        string
+		Comments    map[string]string
+		Expectation string
+	}{
+		{
+			Name:        "comments is empty",
+			Comments:    nil,
+			Expectation: "",
+		},
+		{
+			Name:        "comments is sorted by key",
+			Comments:    map[string]string{"route": "GET /orders", "app": "checkout"},
+			Expectation: " /*app='checkout',route='GET%20%2Forders'*/",
+		},
+		{
+			Name:        "comment breaks out",
+			Comments:    map[string]string{"a*/ drop": "x'*/ drop table users; --"},
+			Expectation: " /*a%2A%2F%20drop='x%27%2A%2F%20drop%20table%20users%3B%20--'*/",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = commentSQL(testCases[i].Comments)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %s, got %s", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestComment_ToSQLWithArgs(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Query       Query
+		Expectation struct {
+			Query string
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Query       Query
+		Expectation struct {
+			Query string
+			Err   error
+		}
+	}{
+		{
+			Name:  "comment key is empty",
+			Query: Select(NewField("id")).From(NewTable("users")).Comment("", "checkout"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrCommentKeyIsRequired,
+			},
+		},
+		{
+			Name:  "select query",
+			Query: Select(NewField("id")).From(NewTable("users")).Where(Eq("id", 1)).Limit(1).ForUpdate().Comment("app", "checkout"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "select id from users where id = $1 limit $2 for update /*app='checkout'*/",
+				Err:   nil,
+			},
+		},
+		{
+			Name:  "insert query",
+			Query: InsertInto("users").Value("name", "john").Returning(NewField("id")).Comment("app", "checkout"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "insert into users(name) values ($1) returning id /*app='checkout'*/",
+				Err:   nil,
+			},
+		},
+		{
+			Name:  "update query",
+			Query: Update("users").Set("name", "john").Where(Eq("id", 1)).Comment("app", "checkout"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "update users set name = $1 where id = $2 /*app='checkout'*/",
+				Err:   nil,
+			},
+		},
+		{
+			Name:  "delete query",
+			Query: DeleteFrom("users").Where(Eq("id", 1)).Comment("app", "checkout"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "delete from users where id = $1 /*app='checkout'*/",
+				Err:   nil,
+			},
+		},
+		{
+			Name:  "compound query",
+			Query: Union(Select(NewField("id")).From(NewTable("users")), Select(NewField("id")).From(NewTable("admins"))).Comment("app", "checkout"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "(select id from users) union (select id from admins) /*app='checkout'*/",
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(fmt.Sprintf("%s with dialect %s", testCases[i].Name, DialectPostgres), func(t *testing.T) {
+			var (
+				actualQuery string
+				actualErr   error
+			)
+
+			actualQuery, _, actualErr = NewConfig(DialectPostgres).Build(testCases[i].Query)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Errorf("expectation error is %s, got nil", testCases[i].Expectation.Err.Error())
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Errorf("expectation error is nil, got %s", actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+		})
+	}
+}
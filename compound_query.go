@@ -0,0 +1,187 @@
+package goqube
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+type CompoundBranch struct {
+	Operator CompoundOperator
+	Query    Query
+}
+
+type CompoundQuery struct {
+	Branches  []*CompoundBranch
+	Sorts     []*Sort
+	Take      uint64
+	TakeIsSet bool
+	Skip      uint64
+	Comments  map[string]string
+}
+
+func Union(queries ...Query) *CompoundQuery {
+	var compoundQuery *CompoundQuery = &CompoundQuery{}
+
+	for i := range queries {
+		compoundQuery.Union(queries[i])
+	}
+
+	return compoundQuery
+}
+
+func UnionAll(queries ...Query) *CompoundQuery {
+	var compoundQuery *CompoundQuery = &CompoundQuery{}
+
+	for i := range queries {
+		compoundQuery.UnionAll(queries[i])
+	}
+
+	return compoundQuery
+}
+
+func (c *CompoundQuery) addBranch(operator CompoundOperator, query Query) *CompoundQuery {
+	if len(c.Branches) == 0 {
+		operator = ""
+	}
+
+	c.Branches = append(c.Branches, &CompoundBranch{
+		Operator: operator,
+		Query:    query,
+	})
+
+	return c
+}
+
+func (c *CompoundQuery) Union(query Query) *CompoundQuery {
+	return c.addBranch(CompoundOperatorUnion, query)
+}
+
+func (c *CompoundQuery) UnionAll(query Query) *CompoundQuery {
+	return c.addBranch(CompoundOperatorUnionAll, query)
+}
+
+func (c *CompoundQuery) OrderBy(sorts ...*Sort) *CompoundQuery {
+	c.Sorts = sorts
+	return c
+}
+
+func (c *CompoundQuery) Limit(take uint64) *CompoundQuery {
+	c.Take = take
+	c.TakeIsSet = true
+	return c
+}
+
+func (c *CompoundQuery) NoLimit() *CompoundQuery {
+	c.Take = 0
+	c.TakeIsSet = false
+	return c
+}
+
+func (c *CompoundQuery) Offset(skip uint64) *CompoundQuery {
+	c.Skip = skip
+	return c
+}
+
+func (c *CompoundQuery) validate(dialect Dialect) error {
+	var err error
+
+	if dialect == "" {
+		return ErrDialectIsRequired
+	}
+
+	err = validateComments(c.Comments)
+	if err != nil {
+		return err
+	}
+
+	if len(c.Branches) < 2 {
+		return ErrQueriesIsRequired
+	}
+
+	for i := range c.Branches {
+		if c.Branches[i] == nil || c.Branches[i].Query == nil {
+			return ErrQueryIsRequired
+		}
+
+		switch c.Branches[i].Query.(type) {
+		case *SelectQuery, *CompoundQuery, *Raw:
+		default:
+			return fmt.Errorf(errUnsupportedQueryTypef, c.Branches[i].Query)
+		}
+
+		if i == 0 {
+			continue
+		}
+
+		if c.Branches[i].Operator != CompoundOperatorUnion && c.Branches[i].Operator != CompoundOperatorUnionAll {
+			return ErrCompoundOperatorIsInvalid
+		}
+	}
+
+	return nil
+}
+
+func (c *CompoundQuery) toSQLWithArgs(bc *buildContext, args []interface{}) (string, []interface{}, error) {
+	var (
+		query         string
+		orderBy       string
+		orderByClause []string
+		buffer        *bytes.Buffer
+		err           error
+	)
+
+	err = c.validate(bc.dialect)
+	if err != nil {
+		return "", nil, err
+	}
+
+	for i := range c.Branches {
+		var branch string
+
+		branch, args, err = c.Branches[i].Query.toSQLWithArgs(bc, args)
+		if err != nil {
+			return "", nil, err
+		}
+
+		if i == 0 {
+			query = fmt.Sprintf("(%s)", branch)
+			continue
+		}
+
+		query = fmt.Sprintf("%s %s (%s)", query, c.Branches[i].Operator, branch)
+	}
+
+	if len(c.Sorts) > 0 {
+		orderByClause = []string{}
+		for i := range c.Sorts {
+			if c.Sorts[i] == nil {
+				continue
+			}
+
+			orderBy, args, err = c.Sorts[i].toSQLWithArgs(bc, args)
+			if err != nil {
+				return "", nil, err
+			}
+
+			orderByClause = append(orderByClause, orderBy)
+		}
+
+		if len(orderByClause) > 0 {
+			query = fmt.Sprintf("%s order by %s", query, strings.Join(orderByClause, ", "))
+		}
+	}
+
+	buffer = getBuffer(len(query) + 32)
+	defer putBuffer(buffer)
+
+	buffer.WriteString(query)
+	args = writeLimitOffset(bc, buffer, c.Take, c.TakeIsSet, c.Skip, args)
+	buffer.WriteString(commentSQL(c.Comments))
+
+	return buffer.String(), args, nil
+}
+
+func (c *CompoundQuery) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+	return buildStatement(dialect, c, args)
+}
@@ -0,0 +1,322 @@
+package goqube
+
+import (
+	"fmt"
+	"testing"
+)
+
+func testCompoundQuery_CompoundQueryEquality(t *testing.T, expectation, actual *CompoundQuery) {
+	if expectation == nil && actual == nil {
+		t.Skip("expectation and actual is nil")
+	}
+
+	if expectation == nil && actual != nil {
+		t.Errorf("expectation is nil, got %+v", actual)
+	}
+
+	if expectation != nil && actual == nil {
+		t.Errorf("expectation is %+v, got nil", expectation)
+	}
+
+	if !deepEqual(expectation.Branches, actual.Branches) {
+		t.Errorf("expectation branches is %+v, got %+v", expectation.Branches, actual.Branches)
+	}
+
+	if !deepEqual(expectation.Sorts, actual.Sorts) {
+		t.Errorf("expectation sorts is %+v, got %+v", expectation.Sorts, actual.Sorts)
+	}
+
+	if expectation.Take != actual.Take {
+		t.Errorf("expectation take is %d, got %d", expectation.Take, actual.Take)
+	}
+
+	if expectation.Skip != actual.Skip {
+		t.Errorf("expectation skip is %d, got %d", expectation.Skip, actual.Skip)
+	}
+}
+
+func TestCompoundQuery_Union(t *testing.T) {
+	var (
+		query1      *SelectQuery
+		query2      *SelectQuery
+		expectation *CompoundQuery
+		actual      *CompoundQuery
+	)
+
+	query1 = Select(NewField("id")).From(NewTable("table1"))
+	query2 = Select(NewField("id")).From(NewTable("table2"))
+
+	expectation = &CompoundQuery{
+		Branches: []*CompoundBranch{
+			{
+				Query: query1,
+			},
+			{
+				Operator: CompoundOperatorUnion,
+				Query:    query2,
+			},
+		},
+	}
+
+	actual = Union(query1, query2)
+
+	testCompoundQuery_CompoundQueryEquality(t, expectation, actual)
+}
+
+func TestCompoundQuery_UnionAll(t *testing.T) {
+	var (
+		query1      *SelectQuery
+		query2      *SelectQuery
+		query3      *SelectQuery
+		expectation *CompoundQuery
+		actual      *CompoundQuery
+	)
+
+	query1 = Select(NewField("id")).From(NewTable("table1"))
+	query2 = Select(NewField("id")).From(NewTable("table2"))
+	query3 = Select(NewField("id")).From(NewTable("table3"))
+
+	expectation = &CompoundQuery{
+		Branches: []*CompoundBranch{
+			{
+				Query: query1,
+			},
+			{
+				Operator: CompoundOperatorUnionAll,
+				Query:    query2,
+			},
+			{
+				Operator: CompoundOperatorUnion,
+				Query:    query3,
+			},
+		},
+		Sorts: []*Sort{
+			NewSort(NewField("id"), SortDirectionAscending),
+		},
+		Take: 10,
+		Skip: 20,
+	}
+
+	actual = UnionAll(query1, query2).
+		Union(query3).
+		OrderBy(NewSort(NewField("id"), SortDirectionAscending)).
+		Limit(10).
+		Offset(20)
+
+	testCompoundQuery_CompoundQueryEquality(t, expectation, actual)
+}
+
+func TestCompoundQuery_validate(t *testing.T) {
+	var testCases []struct {
+		Name          string
+		Dialect       Dialect
+		CompoundQuery *CompoundQuery
+		Expectation   error
+	} = []struct {
+		Name          string
+		Dialect       Dialect
+		CompoundQuery *CompoundQuery
+		Expectation   error
+	}{
+		{
+			Name:          "dialect is empty",
+			Dialect:       "",
+			CompoundQuery: &CompoundQuery{},
+			Expectation:   ErrDialectIsRequired,
+		},
+		{
+			Name:          "branches is less than two",
+			Dialect:       DialectPostgres,
+			CompoundQuery: Union(Select(NewField("id")).From(NewTable("table1"))),
+			Expectation:   ErrQueriesIsRequired,
+		},
+		{
+			Name:          "branch query is nil",
+			Dialect:       DialectPostgres,
+			CompoundQuery: Union(Select(NewField("id")).From(NewTable("table1")), nil),
+			Expectation:   ErrQueryIsRequired,
+		},
+		{
+			Name:          "branch query type is unsupported",
+			Dialect:       DialectPostgres,
+			CompoundQuery: Union(Select(NewField("id")).From(NewTable("table1")), DeleteFrom("table1")),
+			Expectation:   fmt.Errorf(errUnsupportedQueryTypef, DeleteFrom("table1")),
+		},
+		{
+			Name:    "branch operator is invalid",
+			Dialect: DialectPostgres,
+			CompoundQuery: &CompoundQuery{
+				Branches: []*CompoundBranch{
+					{Query: Select(NewField("id")).From(NewTable("table1"))},
+					{Operator: "intersect", Query: Select(NewField("id")).From(NewTable("table2"))},
+				},
+			},
+			Expectation: ErrCompoundOperatorIsInvalid,
+		},
+		{
+			Name:          "compound query is valid",
+			Dialect:       DialectPostgres,
+			CompoundQuery: Union(Select(NewField("id")).From(NewTable("table1")), NewRaw("select 1")),
+			Expectation:   nil,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual error = testCases[i].CompoundQuery.validate(testCases[i].Dialect)
+
+			if testCases[i].Expectation != nil && actual == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation == nil && actual != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation != nil && actual != nil && testCases[i].Expectation.Error() != actual.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Error(), actual.Error())
+			}
+		})
+	}
+}
+
+func TestCompoundQuery_ToSQLWithArgs(t *testing.T) {
+	var testCases []struct {
+		Name          string
+		CompoundQuery *CompoundQuery
+		Dialect       Dialect
+		Expectation   struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name          string
+		CompoundQuery *CompoundQuery
+		Dialect       Dialect
+		Expectation   struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:          "compound query is invalid",
+			CompoundQuery: &CompoundQuery{},
+			Dialect:       DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrQueriesIsRequired,
+			},
+		},
+		{
+			Name: "branch query is invalid",
+			CompoundQuery: Union(
+				Select(NewField("id")).From(NewTable("table1")),
+				Select(NewField("id")),
+			),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrTableIsRequired,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s", DialectMySQL),
+			CompoundQuery: UnionAll(
+				Select(NewField("id")).From(NewTable("table1")).Where(NewFilter().SetCondition(NewField("status"), OperatorEqual, NewFilterValue("active"))),
+				Select(NewField("id")).From(NewTable("table2")).Where(NewFilter().SetCondition(NewField("status"), OperatorEqual, NewFilterValue("pending"))),
+			).
+				Offset(5),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "(select id from table1 where status = ?) union all (select id from table2 where status = ?) limit 18446744073709551615 offset ?",
+				Args:  []interface{}{"active", "pending", uint64(5)},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with deeply nested branches", DialectPostgres),
+			CompoundQuery: Union(
+				Select(NewField("id")).
+					From(NewTable("table1")).
+					Where(NewFilter().SetCondition(NewField("status"), OperatorEqual, NewFilterValue("active"))).
+					Limit(2),
+				UnionAll(
+					Select(NewField("id")).
+						From(NewSelectQueryTable(Select(NewField("id")).From(NewTable("table2")).Where(NewFilter().SetCondition(NewField("score"), OperatorGreaterThan, NewFilterValue(10)))).As("t2")).
+						Where(NewFilter().SetCondition(NewField("id"), OperatorIn, NewFilterValue([]int{1, 2}))),
+					Union(
+						NewRaw("select id from table3 where code = ?", "x"),
+						Select(NewField("id")).From(NewTable("table4")).Where(NewFilter().SetCondition(NewField("code"), OperatorEqual, NewFilterValue("y"))),
+					),
+				),
+			).
+				OrderBy(NewSort(NewField("id"), SortDirectionDescending)).
+				Limit(10).
+				Offset(20),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "(select id from table1 where status = $1 limit $2) union ((select id from (select id from table2 where score > $3) as t2 where id in ($4, $5)) union all ((select id from table3 where code = $6) union (select id from table4 where code = $7))) order by id desc limit $8 offset $9",
+				Args:  []interface{}{"active", uint64(2), 10, 1, 2, "x", "y", uint64(10), uint64(20)},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].CompoundQuery.ToSQLWithArgs(testCases[i].Dialect, []interface{}{})
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if len(testCases[i].Expectation.Args) != len(actualArgs) {
+				t.Errorf("expectation length of args is %d, got %d", len(testCases[i].Expectation.Args), len(actualArgs))
+			}
+
+			for j := range testCases[i].Expectation.Args {
+				if j < len(actualArgs) && !deepEqual(testCases[i].Expectation.Args[j], actualArgs[j]) {
+					t.Errorf("expectation element of args is %v, got %v", testCases[i].Expectation.Args[j], actualArgs[j])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,283 @@
+package goqube
+
+import "time"
+
+type Query interface {
+	toSQLWithArgs(bc *buildContext, args []interface{}) (string, []interface{}, error)
+}
+
+type Config struct {
+	Dialect               Dialect
+	Sensitivities         map[string]*Sensitivity
+	Schema                *Schema
+	GeneratedColumnPolicy GeneratedColumnPolicy
+	Tracing               bool
+	StrictArgs            bool
+	StrictMode            bool
+	TablePrefix           string
+	NamedParameterStyle   NamedParameterStyle
+	Variant               Variant
+	PlanCache             *PlanCache
+	InlineLimitOffset     bool
+	SoftDelete            *SoftDelete
+	NameMapper            *NameMapper
+	NilAsNull             bool
+	ServerVersion         string
+	BeforeBuildHooks      []BeforeBuildHook
+	FilterRewriters       []FilterRewriter
+	ValueAdapters         []ValueAdapter
+	Limits                *Limits
+	ReadOnlyRaw           bool
+}
+
+func NewConfig(dialect Dialect) *Config {
+	return &Config{
+		Dialect:       dialect,
+		Sensitivities: map[string]*Sensitivity{},
+	}
+}
+
+func (c *Config) SetSensitivity(column string, sensitivity *Sensitivity) *Config {
+	if c.Sensitivities == nil {
+		c.Sensitivities = map[string]*Sensitivity{}
+	}
+
+	c.Sensitivities[column] = sensitivity
+	return c
+}
+
+func (c *Config) SetSchema(schema *Schema) *Config {
+	c.Schema = schema
+	return c
+}
+
+func (c *Config) SetGeneratedColumnPolicy(policy GeneratedColumnPolicy) *Config {
+	c.GeneratedColumnPolicy = policy
+	return c
+}
+
+func (c *Config) SetTracing(tracing bool) *Config {
+	c.Tracing = tracing
+	return c
+}
+
+func (c *Config) SetStrictArgs(strictArgs bool) *Config {
+	c.StrictArgs = strictArgs
+	return c
+}
+
+func (c *Config) SetStrictMode(strictMode bool) *Config {
+	c.StrictMode = strictMode
+	return c
+}
+
+func (c *Config) SetTablePrefix(prefix string) *Config {
+	c.TablePrefix = prefix
+	return c
+}
+
+func (c *Config) SetVariant(variant Variant) *Config {
+	c.Variant = variant
+	return c
+}
+
+func (c *Config) SetPlanCache(planCache *PlanCache) *Config {
+	c.PlanCache = planCache
+	return c
+}
+
+func (c *Config) SetInlineLimitOffset(inlineLimitOffset bool) *Config {
+	c.InlineLimitOffset = inlineLimitOffset
+	return c
+}
+
+func (c *Config) SetNilAsNull(nilAsNull bool) *Config {
+	c.NilAsNull = nilAsNull
+	return c
+}
+
+func (c *Config) SetReadOnlyRaw(readOnlyRaw bool) *Config {
+	c.ReadOnlyRaw = readOnlyRaw
+	return c
+}
+
+func (c *Config) SetLimits(limits *Limits) *Config {
+	c.Limits = limits
+	return c
+}
+
+func (c *Config) SetSoftDelete(softDelete *SoftDelete) *Config {
+	c.SoftDelete = softDelete
+	return c
+}
+
+func (c *Config) validateVariant() error {
+	if c.Variant == "" {
+		return nil
+	}
+
+	if c.Variant == VariantCockroach && c.Dialect == DialectPostgres {
+		return nil
+	}
+
+	if c.Variant != VariantMariaDB || c.Dialect != DialectMySQL {
+		return ErrVariantIsInvalid
+	}
+
+	return nil
+}
+
+func (c *Config) build(bc *buildContext, query Query) (string, []interface{}, error) {
+	var (
+		sql  string
+		args []interface{}
+		err  error
+	)
+
+	if query == nil {
+		return "", nil, ErrQueryIsRequired
+	}
+
+	query, err = c.runHooks(query)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if c.SoftDelete != nil {
+		query, err = c.SoftDelete.rewrite(query)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	err = c.validateVariant()
+	if err != nil {
+		return "", nil, err
+	}
+
+	if c.StrictArgs {
+		err = bc.checkStrictArgs(query)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	if raw, ok := query.(*Raw); ok && c.ReadOnlyRaw && raw.Fragment == nil {
+		err = sanitizeRaw(c.Dialect, raw.SQL, true)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	if c.Limits != nil {
+		err = c.Limits.check(query)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	if c.StrictMode {
+		err = c.Schema.checkReferences(query)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	if c.PlanCache != nil && bc.stats == nil {
+		sql, args, err = c.PlanCache.build(bc, query)
+	} else {
+		sql, args, err = query.toSQLWithArgs(bc, []interface{}{})
+	}
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = checkParameterCount(c.Dialect, args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if debugInvariants {
+		err = checkInvariants(c.Dialect, sql, args)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	return sql, args, nil
+}
+
+func (c *Config) Build(query Query) (string, []interface{}, error) {
+	return c.build(newBuildContext(c), query)
+}
+
+func (c *Config) BuildWithResult(query Query) (*BuildResult, error) {
+	var (
+		bc        *buildContext
+		result    *BuildResult
+		startedAt time.Time
+		err       error
+	)
+
+	bc = newBuildContext(c)
+	if c.Tracing {
+		bc.stats = &BuildStats{}
+	}
+
+	result = &BuildResult{}
+	startedAt = bc.startTrace()
+
+	result.Query, result.Args, err = c.build(bc, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if bc.stats != nil {
+		bc.stats.Total = time.Since(startedAt)
+		result.Stats = bc.stats
+	}
+
+	return result, nil
+}
+
+type buildContext struct {
+	dialect Dialect
+	config  *Config
+	stats   *BuildStats
+	scope   *referenceScope
+}
+
+func newBuildContext(config *Config) *buildContext {
+	if config == nil {
+		config = &Config{}
+	}
+
+	return &buildContext{
+		dialect: config.Dialect,
+		config:  config,
+	}
+}
+
+func (bc *buildContext) tableName(name string) string {
+	name = bc.mapTableName(name)
+
+	if bc.config.TablePrefix == "" {
+		return name
+	}
+
+	return bc.config.TablePrefix + name
+}
+
+func (bc *buildContext) qualifiedTableName(database, name string) string {
+	name = bc.tableName(name)
+
+	if database == "" {
+		return name
+	}
+
+	return database + "." + name
+}
+
+func newDialectBuildContext(dialect Dialect) *buildContext {
+	return newBuildContext(&Config{Dialect: dialect})
+}
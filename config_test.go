@@ -0,0 +1,451 @@
+package goqube
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestConfig_NewConfig(t *testing.T) {
+	var actual *Config = NewConfig(DialectPostgres)
+
+	if actual.Dialect != DialectPostgres {
+		t.Errorf("expectation dialect is %s, got %s", DialectPostgres, actual.Dialect)
+	}
+
+	if actual.Sensitivities == nil {
+		t.Error("expectation sensitivities is not nil, got nil")
+	}
+}
+
+func TestConfig_SetSensitivity(t *testing.T) {
+	var (
+		sensitivity *Sensitivity
+		actual      *Config
+	)
+
+	sensitivity = NewSensitivity().WrapPlaceholder("encrypt(%s)")
+	actual = (&Config{}).SetSensitivity("table1.field1", sensitivity)
+
+	if actual.Sensitivities["table1.field1"] != sensitivity {
+		t.Errorf("expectation sensitivity is %+v, got %+v", sensitivity, actual.Sensitivities["table1.field1"])
+	}
+}
+
+func TestConfig_SetSchema(t *testing.T) {
+	var (
+		schema *Schema
+		actual *Config
+	)
+
+	schema = NewSchema()
+	actual = NewConfig(DialectPostgres).SetSchema(schema)
+
+	if actual.Schema != schema {
+		t.Errorf("expectation schema is %+v, got %+v", schema, actual.Schema)
+	}
+}
+
+func TestConfig_SetGeneratedColumnPolicy(t *testing.T) {
+	var actual *Config = NewConfig(DialectPostgres).SetGeneratedColumnPolicy(GeneratedColumnPolicyReject)
+
+	if actual.GeneratedColumnPolicy != GeneratedColumnPolicyReject {
+		t.Errorf("expectation generated column policy is %s, got %s", GeneratedColumnPolicyReject, actual.GeneratedColumnPolicy)
+	}
+}
+
+func TestConfig_SetTracing(t *testing.T) {
+	var actual *Config = NewConfig(DialectPostgres).SetTracing(true)
+
+	if !actual.Tracing {
+		t.Errorf("expectation tracing is %t, got %t", true, actual.Tracing)
+	}
+}
+
+func TestConfig_SetStrictArgs(t *testing.T) {
+	var actual *Config = NewConfig(DialectPostgres).SetStrictArgs(true)
+
+	if !actual.StrictArgs {
+		t.Errorf("expectation strict args is %t, got %t", true, actual.StrictArgs)
+	}
+}
+
+func TestConfig_SetTablePrefix(t *testing.T) {
+	var actual *Config = NewConfig(DialectPostgres).SetTablePrefix("staging_")
+
+	if actual.TablePrefix != "staging_" {
+		t.Errorf("expectation table prefix is %s, got %s", "staging_", actual.TablePrefix)
+	}
+}
+
+func TestConfig_SetVariant(t *testing.T) {
+	var actual *Config = NewConfig(DialectPostgres).SetVariant(VariantCockroach)
+
+	if actual.Variant != VariantCockroach {
+		t.Errorf("expectation variant is %s, got %s", VariantCockroach, actual.Variant)
+	}
+}
+
+func TestConfig_SetInlineLimitOffset(t *testing.T) {
+	var actual *Config = NewConfig(DialectMySQL).SetInlineLimitOffset(true)
+
+	if !actual.InlineLimitOffset {
+		t.Errorf("expectation inline limit offset is %t, got %t", true, actual.InlineLimitOffset)
+	}
+}
+
+func TestConfig_SetNilAsNull(t *testing.T) {
+	var actual *Config = NewConfig(DialectPostgres).SetNilAsNull(true)
+
+	if !actual.NilAsNull {
+		t.Errorf("expectation nil as null is %t, got %t", true, actual.NilAsNull)
+	}
+}
+
+func TestConfig_Build(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Config      *Config
+		Query       Query
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Config      *Config
+		Query       Query
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:   "query is nil",
+			Config: NewConfig(DialectPostgres),
+			Query:  nil,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrQueryIsRequired,
+			},
+		},
+		{
+			Name:   "dialect is empty",
+			Config: NewConfig(""),
+			Query:  Delete().From("table1"),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrDialectIsRequired,
+			},
+		},
+		{
+			Name:   "inline limit offset with dialect mysql",
+			Config: NewConfig(DialectMySQL).SetInlineLimitOffset(true),
+			Query:  Select(NewField("id")).From(NewTable("table1")).Where(NewFilter().SetCondition(NewField("status"), OperatorEqual, NewFilterValue("active"))).Limit(10).Offset(20),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from table1 where status = ? limit 10 offset 20",
+				Args:  []interface{}{"active"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "inline offset only with dialect mysql",
+			Config: NewConfig(DialectMySQL).SetInlineLimitOffset(true),
+			Query:  Select(NewField("id")).From(NewTable("table1")).Offset(5),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from table1 limit 18446744073709551615 offset 5",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "inline limit with ties with dialect postgres",
+			Config: NewConfig(DialectPostgres).SetInlineLimitOffset(true),
+			Query:  Select(NewField("id")).From(NewTable("table1")).OrderBy(NewSort(NewField("score"), SortDirectionDescending)).Limit(3).Offset(6).WithTies(),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from table1 order by score desc offset 6 rows fetch first 3 rows with ties",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "inline limit offset on compound query",
+			Config: NewConfig(DialectPostgres).SetInlineLimitOffset(true),
+			Query:  Union(Select(NewField("id")).From(NewTable("table1")), Select(NewField("id")).From(NewTable("table2"))).Limit(10),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "(select id from table1) union (select id from table2) limit 10",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "variant is invalid for dialect",
+			Config: NewConfig(DialectMySQL).SetVariant(VariantCockroach),
+			Query:  Select(NewField("id")).From(NewTable("table1")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrVariantIsInvalid,
+			},
+		},
+		{
+			Name:   "variant is unknown",
+			Config: NewConfig(DialectPostgres).SetVariant("yugabyte"),
+			Query:  Select(NewField("id")).From(NewTable("table1")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrVariantIsInvalid,
+			},
+		},
+		{
+			Name:   "as of system time without variant",
+			Config: NewConfig(DialectPostgres),
+			Query:  Select(NewField("id")).From(NewTable("table1")).AsOfSystemTime("-10s"),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrUnsupportedAsOfSystemTime,
+			},
+		},
+		{
+			Name:   "as of system time with variant cockroach",
+			Config: NewConfig(DialectPostgres).SetVariant(VariantCockroach),
+			Query:  Select(NewField("id").FromTable("t1")).From(NewTable("table1").As("t1")).Join(InnerJoin(NewTable("table2").As("t2")).On(NewFilter().SetCondition(NewField("id").FromTable("t2"), OperatorEqual, NewColumnFilterValue("id").FromTable("t1")))).Where(NewFilter().SetCondition(NewField("status").FromTable("t1"), OperatorEqual, NewFilterValue("active"))).AsOfSystemTime("-10s'"),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select t1.id from table1 as t1 inner join table2 as t2 on t2.id = t1.id as of system time '-10s''' where t1.status = $1",
+				Args:  []interface{}{"active"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "as of system time follower read timestamp",
+			Config: NewConfig(DialectPostgres).SetVariant(VariantCockroach),
+			Query:  Select(NewField("id")).From(NewTable("table1")).AsOfSystemTime(FollowerReadTimestamp),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from table1 as of system time follower_read_timestamp()",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "upsert without variant",
+			Config: NewConfig(DialectPostgres),
+			Query:  InsertInto("table1").Value("id", 1).AsUpsert(),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrUnsupportedUpsert,
+			},
+		},
+		{
+			Name:   "upsert with variant cockroach",
+			Config: NewConfig(DialectPostgres).SetVariant(VariantCockroach),
+			Query:  InsertInto("table1").Value("id", 1).Value("name", "name1").AsUpsert(),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "upsert into table1(id, name) values ($1, $2)",
+				Args:  []interface{}{1, "name1"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   fmt.Sprintf("select query with dialect %s", DialectPostgres),
+			Config: NewConfig(DialectPostgres),
+			Query: Select(NewField("field1")).
+				From(NewTable("table1")).
+				Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2"))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select field1 from table1 where field2 = $1",
+				Args:  []interface{}{"value2"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   fmt.Sprintf("insert query with dialect %s", DialectMySQL),
+			Config: NewConfig(DialectMySQL),
+			Query:  Insert().Into("table1").Value("field1", "value1"),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into table1(field1) values (?)",
+				Args:  []interface{}{"value1"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   fmt.Sprintf("select query with dialect %s and table prefix", DialectPostgres),
+			Config: NewConfig(DialectPostgres).SetTablePrefix("staging_"),
+			Query: Select(NewField("id").FromTable("u"), NewField("name").FromTable("o")).
+				From(NewTable("users").As("u")).
+				Join(InnerJoin(NewTable("orders").FromDatabase("shop").As("o")).On(NewFilter().SetCondition(NewField("user_id").FromTable("o"), OperatorEqual, NewColumnFilterValue("id").FromTable("u")))).
+				Where(NewFilter().SetCondition(NewField("id").FromTable("u"), OperatorIn, NewSelectQueryFilterValue(Select(NewField("user_id")).From(NewTable("banned_users"))))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select u.id, o.name from staging_users as u inner join shop.staging_orders as o on o.user_id = u.id where u.id in (select user_id from staging_banned_users)",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   fmt.Sprintf("select query with dialect %s, table prefix and table qualifiers", DialectPostgres),
+			Config: NewConfig(DialectPostgres).SetTablePrefix("staging_"),
+			Query: Select(NewField("id").FromTable("users")).
+				From(NewTable("users")).
+				Join(InnerJoin(NewTable("orders")).On(NewFilter().SetCondition(NewField("user_id").FromTable("orders"), OperatorEqual, NewColumnFilterValue("id").FromTable("users")))).
+				OrderBy(NewSort(NewField("createdAt").FromTable("users"), SortDirectionDescending)),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select staging_users.id from staging_users inner join staging_orders on staging_orders.user_id = staging_users.id order by staging_users.createdAt desc",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   fmt.Sprintf("insert query with dialect %s and table prefix", DialectMySQL),
+			Config: NewConfig(DialectMySQL).SetTablePrefix("staging_"),
+			Query:  InsertInto("table1").Value("field1", "value1"),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into staging_table1(field1) values (?)",
+				Args:  []interface{}{"value1"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   fmt.Sprintf("update query with dialect %s and table prefix", DialectMySQL),
+			Config: NewConfig(DialectMySQL).SetTablePrefix("staging_"),
+			Query:  Update("table1").Set("field1", "value1").Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update staging_table1 set field1 = ? where id = ?",
+				Args:  []interface{}{"value1", 1},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   fmt.Sprintf("delete query with dialect %s and table prefix", DialectPostgres),
+			Config: NewConfig(DialectPostgres).SetTablePrefix("staging_"),
+			Query:  DeleteFrom("table1").Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "delete from staging_table1 where id = $1",
+				Args:  []interface{}{1},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Config.Build(testCases[i].Query)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if len(testCases[i].Expectation.Args) != len(actualArgs) {
+				t.Errorf("expectation length of args is %d, got %d", len(testCases[i].Expectation.Args), len(actualArgs))
+			}
+
+			for j := range testCases[i].Expectation.Args {
+				if !deepEqual(testCases[i].Expectation.Args[j], actualArgs[j]) {
+					t.Errorf("expectation element of args is %v, got %v", testCases[i].Expectation.Args[j], actualArgs[j])
+				}
+			}
+		})
+	}
+}
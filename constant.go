@@ -9,6 +9,30 @@ const (
 	DialectPostgres Dialect = "postgres"
 )
 
+const mysqlMaxLimit string = "18446744073709551615"
+
+const bigRatPrecision int = 30
+
+const cacheTagf string = "table:%s"
+
+const allColumns string = "*"
+
+const (
+	identifierByteLength int    = 16
+	ulidEncodedLength    int    = 26
+	ulidAlphabet         string = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	uuidTypeName         string = "UUID"
+	ulidTypeName         string = "ULID"
+	postgresUUIDCast     string = "::uuid"
+)
+
+var rawWriteKeywords []string = []string{
+	"alter", "call", "copy", "create", "delete", "do", "drop", "exec", "execute", "grant", "handler", "insert",
+	"into", "load", "lock", "merge", "rename", "revoke", "set", "truncate", "update", "vacuum",
+}
+
+var rawReadKeywords []string = []string{"select", "with", "values", "table"}
+
 var placeholderMap map[Dialect]string = map[Dialect]string{
 	DialectMySQL:    "?",
 	DialectPostgres: "$",
@@ -33,6 +57,20 @@ const (
 	OperatorNotIn              Operator = "not_in"
 	OperatorLike               Operator = "like"
 	OperatorNotLike            Operator = "not_like"
+	OperatorLikePattern        Operator = "like_pattern"
+	OperatorNotLikePattern     Operator = "not_like_pattern"
+	OperatorTrue               Operator = "true"
+	OperatorFalse              Operator = "false"
+	OperatorStartsWith         Operator = "starts_with"
+	OperatorEndsWith           Operator = "ends_with"
+	OperatorContains           Operator = "contains"
+	OperatorEqualAny           Operator = "equal_any"
+	OperatorNotEqualAll        Operator = "not_equal_all"
+	OperatorArrayContains      Operator = "array_contains"
+	OperatorArrayOverlap       Operator = "array_overlap"
+	OperatorSampleByHash       Operator = "sample_by_hash"
+	OperatorSearch             Operator = "search"
+	OperatorGeo                Operator = "geo"
 )
 
 var filterOperatorMap map[Operator]string = map[Operator]string{
@@ -48,6 +86,168 @@ var filterOperatorMap map[Operator]string = map[Operator]string{
 	OperatorNotIn:              "not in",
 	OperatorLike:               "like",
 	OperatorNotLike:            "not like",
+	OperatorLikePattern:        "like",
+	OperatorNotLikePattern:     "not like",
+}
+
+const (
+	queryParamSortKey           string = "sort"
+	queryParamFilterPrefix      string = "filter["
+	queryParamPageSizeKey       string = "page[size]"
+	queryParamPageNumberKey     string = "page[number]"
+	queryParamOperatorSeparator string = "__"
+	queryParamNullOperator      string = "null"
+	queryParamListSeparator     string = ","
+)
+
+var queryParamOperatorMap map[string]Operator = map[string]Operator{
+	"eq":         OperatorEqual,
+	"ne":         OperatorNotEqual,
+	"gt":         OperatorGreaterThan,
+	"gte":        OperatorGreaterThanOrEqual,
+	"lt":         OperatorLessThan,
+	"lte":        OperatorLessThanOrEqual,
+	"in":         OperatorIn,
+	"nin":        OperatorNotIn,
+	"like":       OperatorLike,
+	"startswith": OperatorStartsWith,
+	"endswith":   OperatorEndsWith,
+	"contains":   OperatorContains,
+}
+
+var nullOperatorMap map[Operator]Operator = map[Operator]Operator{
+	OperatorEqual:    OperatorIsNull,
+	OperatorNotEqual: OperatorIsNotNull,
+}
+
+var likePatternFormatMap map[Operator]string = map[Operator]string{
+	OperatorStartsWith: "%s%%",
+	OperatorEndsWith:   "%%%s",
+	OperatorContains:   "%%%s%%",
+}
+
+var likePatternConditionFormatMap map[Dialect]string = map[Dialect]string{
+	DialectMySQL:    "cast(%s as char) like %s",
+	DialectPostgres: "%s::text ilike %s escape '\\'",
+}
+
+var arrayOperatorFormatMap map[Operator]string = map[Operator]string{
+	OperatorEqualAny:      "%s = any(%s)",
+	OperatorNotEqualAll:   "%s != all(%s)",
+	OperatorArrayContains: "%s @> %s",
+	OperatorArrayOverlap:  "%s && %s",
+}
+
+var sampleByHashFormatMap map[Dialect]string = map[Dialect]string{
+	DialectMySQL:    "crc32(concat(%s, %s)) %% 100 < %s",
+	DialectPostgres: "abs(hashtext(concat(%s, %s::text))) %% 100 < %s::numeric",
+}
+
+type SearchMode string
+
+const (
+	SearchModeNatural SearchMode = "natural"
+	SearchModeBoolean SearchMode = "boolean"
+	SearchModePhrase  SearchMode = "phrase"
+)
+
+var postgresSearchQueryFunctionMap map[SearchMode]string = map[SearchMode]string{
+	SearchModeNatural: "plainto_tsquery",
+	SearchModeBoolean: "to_tsquery",
+	SearchModePhrase:  "phraseto_tsquery",
+}
+
+var mysqlSearchModifierMap map[SearchMode]string = map[SearchMode]string{
+	SearchModeNatural: "in natural language mode",
+	SearchModeBoolean: "in boolean mode",
+	SearchModePhrase:  "in boolean mode",
+}
+
+type GeoOperator string
+
+const (
+	GeoOperatorDWithin  GeoOperator = "dwithin"
+	GeoOperatorContains GeoOperator = "contains"
+	GeoOperatorWithin   GeoOperator = "within"
+)
+
+const geoDefaultSRID int = 4326
+
+var geoFilterFormatMap map[Dialect]map[GeoOperator]string = map[Dialect]map[GeoOperator]string{
+	DialectMySQL: {
+		GeoOperatorDWithin:  "st_distance(%s, %s) <= %s",
+		GeoOperatorContains: "st_contains(%s, %s)",
+		GeoOperatorWithin:   "st_within(%s, %s)",
+	},
+	DialectPostgres: {
+		GeoOperatorDWithin:  "st_dwithin(%s, %s, %s)",
+		GeoOperatorContains: "st_contains(%s, %s)",
+		GeoOperatorWithin:   "st_within(%s, %s)",
+	},
+}
+
+const (
+	geometryFormat      string = "st_geomfromtext(%s, %s)"
+	mysqlGeometryFormat string = "st_geomfromtext(%s, %s, 'axis-order=long-lat')"
+)
+
+const (
+	sqlExportHeaderf    string = "// Code generated by goqube export. DO NOT EDIT.\n\npackage %s\n\n"
+	sqlExportArgsf      string = "\t// %s args:\n"
+	sqlExportArgf       string = "\t// %d. %s\n"
+	sqlExportNoArgsf    string = "\t// %s has no args\n"
+	sqlExportConstantf  string = "\t%s string = %q\n"
+	sqlExportParamf     string = "param %s"
+	sqlExportNilArgType string = "nil"
+)
+
+type sqlTokenKind int
+
+const (
+	sqlTokenEOF sqlTokenKind = iota
+	sqlTokenIdentifier
+	sqlTokenQuotedIdentifier
+	sqlTokenNumber
+	sqlTokenString
+	sqlTokenPlaceholder
+	sqlTokenSymbol
+)
+
+var parserReservedWords []string = []string{
+	"all", "and", "as", "asc", "between", "by", "case", "cross", "desc", "distinct", "else", "end", "except",
+	"exists", "false", "fetch", "for", "from", "full", "group", "having", "ilike", "in", "inner", "intersect",
+	"into", "is", "join", "lateral", "left", "like", "limit", "natural", "not", "null", "offset", "on", "or",
+	"order", "outer", "over", "returning", "right", "select", "similar", "then", "true", "union", "using",
+	"when", "where", "window", "with",
+}
+
+var parserComparisonOperatorMap map[string]Operator = map[string]Operator{
+	"=":  OperatorEqual,
+	"!=": OperatorNotEqual,
+	"<>": OperatorNotEqual,
+	">":  OperatorGreaterThan,
+	">=": OperatorGreaterThanOrEqual,
+	"<":  OperatorLessThan,
+	"<=": OperatorLessThanOrEqual,
+}
+
+var parserJoinTypeMap map[string]JoinType = map[string]JoinType{
+	"inner": InnerJoinType,
+	"left":  LeftJoinType,
+	"right": RightJoinType,
+	"full":  FullJoinType,
+	"cross": CrossJoinType,
+}
+
+var booleanFilterMap map[Dialect]map[Operator]string = map[Dialect]map[Operator]string{
+	DialectMySQL: {
+		OperatorTrue:  "1 = 1",
+		OperatorFalse: "1 = 0",
+	},
+	DialectPostgres: {
+		OperatorTrue:  "true",
+		OperatorFalse: "false",
+	},
 }
 
 type SortDirection string
@@ -57,35 +257,468 @@ const (
 	SortDirectionDescending SortDirection = "desc"
 )
 
+type CompoundOperator string
+
+const (
+	CompoundOperatorUnion    CompoundOperator = "union"
+	CompoundOperatorUnionAll CompoundOperator = "union all"
+)
+
+type MissingValuePolicy string
+
+const (
+	MissingValuePolicyReject  MissingValuePolicy = "reject"
+	MissingValuePolicyDefault MissingValuePolicy = "default"
+)
+
+type StructOption string
+
+const (
+	StructOptionOmitZero StructOption = "omit_zero"
+)
+
+const (
+	structTagName          string = "db"
+	structTagOmitEmpty     string = "omitempty"
+	structTagGenerated     string = "generated"
+	structTagIgnoredColumn string = "-"
+)
+
+type Variant string
+
+const (
+	VariantCockroach Variant = "cockroach"
+	VariantMariaDB   Variant = "mariadb"
+)
+
+const (
+	mysqlRowAliasVersion string = "8.0.19"
+	mysqlRowAlias        string = "new"
+)
+
+const (
+	mysqlInsertedValuef        string = "values(%s)"
+	mysqlRowAliasValuef        string = "%s.%s"
+	mysqlOnDuplicateKeyUpdatef string = "on duplicate key update %s"
+	mysqlRowAliasDuplicateKeyf string = "as %s on duplicate key update %s"
+)
+
+const (
+	postgresNextValuef string = "nextval('%s')"
+	mariaDBNextValuef  string = "next value for %s"
+)
+
+const FollowerReadTimestamp string = "follower_read_timestamp()"
+
+type NamedParameterStyle string
+
+const (
+	NamedParameterStyleAt    NamedParameterStyle = "@"
+	NamedParameterStyleColon NamedParameterStyle = ":"
+)
+
+const namedParameterNamef string = "p%d"
+
+type LockMode string
+
+const (
+	LockModeForUpdate LockMode = "for update"
+	LockModeForShare  LockMode = "for share"
+)
+
+type LockWait string
+
+const (
+	LockWaitSkipLocked LockWait = "skip locked"
+	LockWaitNoWait     LockWait = "nowait"
+)
+
+type ExpressionOperator string
+
+const (
+	ExpressionOperatorAdd      ExpressionOperator = "+"
+	ExpressionOperatorSubtract ExpressionOperator = "-"
+	ExpressionOperatorMultiply ExpressionOperator = "*"
+	ExpressionOperatorDivide   ExpressionOperator = "/"
+	ExpressionOperatorModulo   ExpressionOperator = "%"
+)
+
+var expressionOperators []string = []string{
+	string(ExpressionOperatorAdd),
+	string(ExpressionOperatorSubtract),
+	string(ExpressionOperatorMultiply),
+	string(ExpressionOperatorDivide),
+	string(ExpressionOperatorModulo),
+}
+
+type DateTimeFunction string
+
+const (
+	DateTimeFunctionNow      DateTimeFunction = "now"
+	DateTimeFunctionTrunc    DateTimeFunction = "date_trunc"
+	DateTimeFunctionInterval DateTimeFunction = "interval"
+)
+
+type DateTimeUnit string
+
+const (
+	DateTimeUnitSecond DateTimeUnit = "second"
+	DateTimeUnitMinute DateTimeUnit = "minute"
+	DateTimeUnitHour   DateTimeUnit = "hour"
+	DateTimeUnitDay    DateTimeUnit = "day"
+	DateTimeUnitWeek   DateTimeUnit = "week"
+	DateTimeUnitMonth  DateTimeUnit = "month"
+	DateTimeUnitYear   DateTimeUnit = "year"
+)
+
+var mysqlDateTruncFormatMap map[DateTimeUnit]string = map[DateTimeUnit]string{
+	DateTimeUnitSecond: "cast(date_format(%s, '%%Y-%%m-%%d %%H:%%i:%%s') as datetime)",
+	DateTimeUnitMinute: "cast(date_format(%s, '%%Y-%%m-%%d %%H:%%i:00') as datetime)",
+	DateTimeUnitHour:   "cast(date_format(%s, '%%Y-%%m-%%d %%H:00:00') as datetime)",
+	DateTimeUnitDay:    "cast(date_format(%s, '%%Y-%%m-%%d 00:00:00') as datetime)",
+	DateTimeUnitWeek:   "cast(str_to_date(date_format(%s, '%%x%%v Monday'), '%%x%%v %%W') as datetime)",
+	DateTimeUnitMonth:  "cast(date_format(%s, '%%Y-%%m-01 00:00:00') as datetime)",
+	DateTimeUnitYear:   "cast(date_format(%s, '%%Y-01-01 00:00:00') as datetime)",
+}
+
+const (
+	currentTimestampExpression string = "current_timestamp"
+	postgresDateTruncf         string = "date_trunc('%s', %s)"
+	postgresIntervalf          string = "interval '%d %s'"
+	mysqlIntervalf             string = "interval %d %s"
+)
+
+type TrashedMode string
+
+const (
+	TrashedModeWith TrashedMode = "with_trashed"
+	TrashedModeOnly TrashedMode = "only_trashed"
+)
+
+type BulkLoadMode string
+
+const (
+	BulkLoadModeInsert BulkLoadMode = "insert"
+	BulkLoadModeNative BulkLoadMode = "native"
+)
+
+const (
+	postgresCopyFromStdinf string = "copy %s (%s) from stdin"
+	mysqlLoadDataf         string = "load data local infile 'Reader::%s' into table %s fields terminated by '\\t' escaped by '\\\\' lines terminated by '\\n' (%s)"
+)
+
+const savepointNamef string = "goqube_savepoint_%d"
+
+const typedValuef string = "coalesce(%s, (null::%s).%s)"
+
+type ColumnType string
+
+const (
+	ColumnTypeSmallInt  ColumnType = "smallint"
+	ColumnTypeInteger   ColumnType = "integer"
+	ColumnTypeBigInt    ColumnType = "bigint"
+	ColumnTypeText      ColumnType = "text"
+	ColumnTypeBoolean   ColumnType = "boolean"
+	ColumnTypeDate      ColumnType = "date"
+	ColumnTypeTimestamp ColumnType = "timestamp"
+	ColumnTypeJSON      ColumnType = "json"
+	ColumnTypeUUID      ColumnType = "uuid"
+	ColumnTypeBinary    ColumnType = "binary"
+)
+
+var columnTypeMap map[Dialect]map[ColumnType]string = map[Dialect]map[ColumnType]string{
+	DialectMySQL: {
+		ColumnTypeBoolean:   "tinyint(1)",
+		ColumnTypeTimestamp: "datetime",
+		ColumnTypeUUID:      "char(36)",
+		ColumnTypeBinary:    "blob",
+	},
+	DialectPostgres: {
+		ColumnTypeJSON:   "jsonb",
+		ColumnTypeBinary: "bytea",
+	},
+}
+
+const (
+	columnTypeVarcharf string = "varchar(%d)"
+	columnTypeDecimalf string = "decimal(%d, %d)"
+)
+
+type AlterTableActionType string
+
+const (
+	AlterTableActionAddColumn        AlterTableActionType = "add_column"
+	AlterTableActionDropColumn       AlterTableActionType = "drop_column"
+	AlterTableActionRenameColumn     AlterTableActionType = "rename_column"
+	AlterTableActionChangeColumnType AlterTableActionType = "change_column_type"
+	AlterTableActionSetNotNull       AlterTableActionType = "set_not_null"
+	AlterTableActionSetIdentity      AlterTableActionType = "set_identity"
+	AlterTableActionModifyColumn     AlterTableActionType = "modify_column"
+)
+
+type ReferentialAction string
+
+const (
+	ReferentialActionCascade    ReferentialAction = "cascade"
+	ReferentialActionSetNull    ReferentialAction = "set null"
+	ReferentialActionSetDefault ReferentialAction = "set default"
+	ReferentialActionRestrict   ReferentialAction = "restrict"
+	ReferentialActionNoAction   ReferentialAction = "no action"
+)
+
+var referentialActions []string = []string{
+	string(ReferentialActionCascade),
+	string(ReferentialActionSetNull),
+	string(ReferentialActionSetDefault),
+	string(ReferentialActionRestrict),
+	string(ReferentialActionNoAction),
+}
+
+var autoIncrementMap map[Dialect]string = map[Dialect]string{
+	DialectMySQL:    "auto_increment",
+	DialectPostgres: "generated by default as identity",
+}
+
+type IndexHintType string
+
+const (
+	IndexHintUse    IndexHintType = "use"
+	IndexHintForce  IndexHintType = "force"
+	IndexHintIgnore IndexHintType = "ignore"
+)
+
+var dialectDBSystemMap map[Dialect]string = map[Dialect]string{
+	DialectMySQL:    "mysql",
+	DialectPostgres: "postgresql",
+}
+
+type HealthCheck string
+
+const (
+	HealthCheckPing        HealthCheck = "ping"
+	HealthCheckVersion     HealthCheck = "version"
+	HealthCheckDatabase    HealthCheck = "database_name"
+	HealthCheckCurrentTime HealthCheck = "server_time"
+)
+
+var healthCheckExpressionMap map[Dialect]map[HealthCheck]string = map[Dialect]map[HealthCheck]string{
+	DialectMySQL: {
+		HealthCheckPing:        "1",
+		HealthCheckVersion:     "version()",
+		HealthCheckDatabase:    "database()",
+		HealthCheckCurrentTime: "current_timestamp",
+	},
+	DialectPostgres: {
+		HealthCheckPing:        "1",
+		HealthCheckVersion:     "version()",
+		HealthCheckDatabase:    "current_database()",
+		HealthCheckCurrentTime: "current_timestamp",
+	},
+}
+
+type GeneratedColumnPolicy string
+
+const (
+	GeneratedColumnPolicyExclude GeneratedColumnPolicy = "exclude"
+	GeneratedColumnPolicyReject  GeneratedColumnPolicy = "reject"
+)
+
 const (
-	errForOperatorf                     string = "%s for operator %s"
+	errGeneratedColumnf                 string = "%w: %s.%s"
+	errColumnIsNotMappedf               string = "%w: %s"
+	errValidationf                      string = "%s: %s"
+	errPathf                            string = "%s: %s"
+	errUnsupportedElementf              string = "unsupported element type %s"
+	errUnsupportedElementForOperatorf   string = "unsupported element type %s for operator %s"
+	errFieldAliasf                      string = "%w: %s"
+	errSchemaColumnf                    string = "%w: %s"
+	errSortColumnf                      string = "%w: %s"
+	errFilterColumnf                    string = "%w: %s"
+	errQueryParamf                      string = "%w: %s"
+	errArgTypeIsNotAllowedf             string = "%s at %s: %s"
+	errPlaceholderCountf                string = "%w, got %d placeholders and %d args"
+	errRollbackf                        string = "%w, rollback: %v"
+	errSensitiveColumnf                 string = "sensitive column %s: %w"
+	errUnsupportedQueryTypef            string = "unsupported %T query type"
 	errUnsupportedValueTypeForOperatorf string = "unsupported %s value type for operator %s"
 	errUnsupportedValueTypef            string = "unsupported %s value type"
+	errFilterConditionf                 string = "%s: column %s with operator %s"
+	errFilterOperatorf                  string = "%s: operator %s"
+	errFilterConditionColumnf           string = "%s: column %s"
+	errOuterTablef                      string = "%w: %s"
+	errRawNamedArgf                     string = "%w: %s"
+	errGeoDialectf                      string = "%w: %s"
+	errDifferencef                      string = "%s: %v != %v"
+	errParamf                           string = "%w: %s"
+	errParsef                           string = "%s: %q at position %d"
+	errSQLConstantf                     string = "%w: %s"
+	errSQLConstantBuildf                string = "%s: %w"
+	errLimitExceededf                   string = "%w: %d exceeds maximum %d"
+	errTooManyParametersf               string = "%s: %d exceeds maximum %d for dialect %s"
+	errRawKeywordf                      string = "%w: %s"
 )
 
 var (
-	ErrAliasIsRequired                        error = errors.New("alias is required")
-	ErrColumnIsRequired                       error = errors.New("column is required")
-	ErrConflictFieldColumnAndFieldSelectQuery error = errors.New("conflict between field column and field select query")
-	ErrConflictTableNameAndTableSelectQuery   error = errors.New("conflict between table name and table select query")
-	ErrDialectIsRequired                      error = errors.New("dialect is required")
-	ErrFieldIsNil                             error = errors.New("field is nil")
-	ErrFieldIsNotEmpty                        error = errors.New("field is not empty")
-	ErrFieldIsRequired                        error = errors.New("field is required")
-	ErrFieldsIsRequired                       error = errors.New("fields is required")
-	ErrFilterIsRequired                       error = errors.New("filter is required")
-	ErrFilterValueIsNil                       error = errors.New("filter value is nil")
-	ErrFiltersIsRequired                      error = errors.New("filters is required")
-	ErrJoinTypeIsRequired                     error = errors.New("join type is required")
-	ErrLogicIsRequired                        error = errors.New("logic is required")
-	ErrNameIsRequired                         error = errors.New("name is required")
-	ErrOperatorIsNotEmpty                     error = errors.New("operator is not empty")
-	ErrOperatorIsRequired                     error = errors.New("operator is required")
-	ErrTableIsRequired                        error = errors.New("table is required")
-	ErrValueIsNotNil                          error = errors.New("value is not nil")
-	ErrValueIsRequired                        error = errors.New("value is required")
-	ErrValueLengthIsNotEqualToFieldsLength    error = errors.New("value length is not equal to fields length")
-	ErrValuesIsRequired                       error = errors.New("values is required")
+	ErrAliasIsInvalid                           error = errors.New("alias is invalid")
+	ErrAliasIsRequired                          error = errors.New("alias is required")
+	ErrAlterTableActionIsInvalid                error = errors.New("alter table action is invalid")
+	ErrAlterTableActionsIsRequired              error = errors.New("alter table actions is required")
+	ErrAlterTableRequiresStatements             error = errors.New("alter table with rename and other actions must be built as statements")
+	ErrArgTypeIsNotAllowed                      error = errors.New("arg type is not allowed")
+	ErrArgsAreNotAllowedInDDL                   error = errors.New("args are not allowed in ddl")
+	ErrBulkLoadModeIsInvalid                    error = errors.New("bulk load mode is invalid")
+	ErrCaseWhenIsRequired                       error = errors.New("case when is required")
+	ErrCastIsInvalid                            error = errors.New("cast is invalid")
+	ErrChunkSizeIsRequired                      error = errors.New("chunk size is required")
+	ErrCollationIsInvalid                       error = errors.New("collation is invalid")
+	ErrColumnIsNotInSchema                      error = errors.New("column is not in schema")
+	ErrColumnIsNotMapped                        error = errors.New("column is not mapped to destination")
+	ErrColumnIsRequired                         error = errors.New("column is required")
+	ErrColumnTypeIsInvalid                      error = errors.New("column type is invalid")
+	ErrColumnTypeIsRequired                     error = errors.New("column type is required")
+	ErrColumnsIsRequired                        error = errors.New("columns is required")
+	ErrCommentKeyIsRequired                     error = errors.New("comment key is required")
+	ErrComparisonWithNil                        error = errors.New("comparison with nil, use is_null or is_not_null")
+	ErrCompoundOperatorIsInvalid                error = errors.New("compound operator is invalid")
+	ErrConfigIsRequired                         error = errors.New("config is required")
+	ErrConflictExpressionOperatorAndFunction    error = errors.New("expression operator and function cannot be used together")
+	ErrConflictFieldColumnAndFieldSelectQuery   error = errors.New("conflict between field column and field select query")
+	ErrConflictFieldExpression                  error = errors.New("field expression cannot be used with column, table, select query or raw")
+	ErrConflictFieldRaw                         error = errors.New("conflict between field raw and field table, column or select query")
+	ErrConflictFilterValueExpression            error = errors.New("filter value expression cannot be used with value, column, select query or raw")
+	ErrConflictFilterValueOuterTable            error = errors.New("filter value outer table cannot be used with table")
+	ErrConflictFilterValueRaw                   error = errors.New("conflict between filter value raw and filter value column, select query or value")
+	ErrConflictRawFragment                      error = errors.New("raw sql and fragment cannot be used together")
+	ErrConflictRawNamedArgs                     error = errors.New("raw args and named args cannot be used together")
+	ErrConflictTableDatabaseAndTableSelectQuery error = errors.New("conflict between table database and table select query")
+	ErrConflictTableNameAndTableSelectQuery     error = errors.New("conflict between table name and table select query")
+	ErrConflictTableRaw                         error = errors.New("conflict between table raw and table name, database or select query")
+	ErrDBIsRequired                             error = errors.New("db is required")
+	ErrDateTimeFunctionIsInvalid                error = errors.New("date time function is invalid")
+	ErrDateTimeUnitIsInvalid                    error = errors.New("date time unit is invalid")
+	ErrDefaultValueIsNotAllowed                 error = errors.New("default value is not allowed")
+	ErrDestinationIsInvalid                     error = errors.New("destination must be a non-nil pointer")
+	ErrDialectIsRequired                        error = errors.New("dialect is required")
+	ErrExpressionIsNil                          error = errors.New("expression is nil")
+	ErrExpressionOperatorIsInvalid              error = errors.New("expression operator is invalid")
+	ErrFieldAliasIsDuplicated                   error = errors.New("field alias is duplicated")
+	ErrFieldIsDuplicated                        error = errors.New("field is duplicated")
+	ErrFieldIsNil                               error = errors.New("field is nil")
+	ErrFieldIsNotEmpty                          error = errors.New("field is not empty")
+	ErrFieldIsNotInColumns                      error = errors.New("field is not in columns")
+	ErrFieldIsRequired                          error = errors.New("field is required")
+	ErrFieldsIsRequired                         error = errors.New("fields is required")
+	ErrFilterColumnIsNotAllowed                 error = errors.New("filter column is not allowed")
+	ErrFilterDepthLimitIsExceeded               error = errors.New("filter depth limit is exceeded")
+	ErrFilterIsNil                              error = errors.New("filter is nil")
+	ErrFilterIsNotAllowed                       error = errors.New("filter is not allowed")
+	ErrFilterIsRequired                         error = errors.New("filter is required")
+	ErrFilterValueIsNil                         error = errors.New("filter value is nil")
+	ErrFiltersIsRequired                        error = errors.New("filters is required")
+	ErrFragmentIsRequired                       error = errors.New("fragment is required")
+	ErrFunctionIsInvalid                        error = errors.New("function is invalid")
+	ErrGeneratedColumn                          error = errors.New("generated column cannot be written")
+	ErrGeoDialectIsUnsupported                  error = errors.New("geo filter is not supported by dialect")
+	ErrGeoDistanceIsInvalid                     error = errors.New("geo distance must not be negative")
+	ErrGeoOperatorIsInvalid                     error = errors.New("geo operator is invalid")
+	ErrGeometryIsRequired                       error = errors.New("geometry is required")
+	ErrHealthCheckIsInvalid                     error = errors.New("health check is invalid")
+	ErrHintIsInvalid                            error = errors.New("hint is invalid")
+	ErrIdentifierIsInvalid                      error = errors.New("identifier is invalid")
+	ErrInListLimitIsExceeded                    error = errors.New("in list limit is exceeded")
+	ErrIndexHintIsInvalid                       error = errors.New("index hint is invalid")
+	ErrIndexNameIsRequired                      error = errors.New("index name is required")
+	ErrIndexesRequireStatements                 error = errors.New("create table with indexes must be built as statements")
+	ErrIntervalIsInvalid                        error = errors.New("interval is invalid")
+	ErrJSONPathIsInvalid                        error = errors.New("json path is invalid")
+	ErrJSONPathRequiresColumn                   error = errors.New("json path requires column")
+	ErrJoinFilterIsUnreferenced                 error = errors.New("join filter does not reference joined table")
+	ErrJoinLimitIsExceeded                      error = errors.New("join limit is exceeded")
+	ErrJoinTypeIsRequired                       error = errors.New("join type is required")
+	ErrKeyIsNotInColumns                        error = errors.New("key is not in columns")
+	ErrKeyIsNotSortable                         error = errors.New("key is not sortable")
+	ErrKeysIsRequired                           error = errors.New("keys is required")
+	ErrLateralTableIsInvalid                    error = errors.New("lateral join table must be select query or raw")
+	ErrLikePatternIsUnsupported                 error = errors.New("like pattern is unsupported")
+	ErrLimitIsRequired                          error = errors.New("limit is required")
+	ErrLimitOffsetIsNotAllowed                  error = errors.New("limit and offset are not allowed")
+	ErrLockModeIsInvalid                        error = errors.New("lock mode is invalid")
+	ErrLockModeIsRequired                       error = errors.New("lock mode is required")
+	ErrLockWaitIsInvalid                        error = errors.New("lock wait is invalid")
+	ErrLockWithGroupBy                          error = errors.New("lock is not allowed with group by")
+	ErrLogicIsRequired                          error = errors.New("logic is required")
+	ErrMissingValuePolicyIsInvalid              error = errors.New("missing value policy is invalid")
+	ErrNameIsRequired                           error = errors.New("name is required")
+	ErrNamedParameterStyleIsInvalid             error = errors.New("named parameter style is invalid")
+	ErrOperandsIsInvalid                        error = errors.New("operands is invalid")
+	ErrOperandsIsRequired                       error = errors.New("operands is required")
+	ErrOperatorIsNotEmpty                       error = errors.New("operator is not empty")
+	ErrOperatorIsRequired                       error = errors.New("operator is required")
+	ErrOrdinalKeyIsNotReturned                  error = errors.New("ordinal key is not returned")
+	ErrOrdinalKeyIsRequired                     error = errors.New("ordinal key is required")
+	ErrOuterTableIsNotFound                     error = errors.New("outer table is not found in enclosing query")
+	ErrOuterTableRequiresColumn                 error = errors.New("outer table requires column")
+	ErrPackageNameIsInvalid                     error = errors.New("package name is invalid")
+	ErrParamIsNotBound                          error = errors.New("param is not bound")
+	ErrParameterLimitIsExceeded                 error = errors.New("parameter limit is exceeded")
+	ErrPatternValueIsInvalid                    error = errors.New("pattern value must be a string")
+	ErrPlaceholderCountMismatch                 error = errors.New("placeholder count is not equal to args length")
+	ErrQueriesIsRequired                        error = errors.New("queries is required")
+	ErrQueryIsRequired                          error = errors.New("query is required")
+	ErrQueryParamIsInvalid                      error = errors.New("query param is invalid")
+	ErrRawHasComment                            error = errors.New("raw has comment")
+	ErrRawHasMultipleStatements                 error = errors.New("raw has multiple statements")
+	ErrRawHasUnterminatedQuote                  error = errors.New("raw has unterminated quote")
+	ErrRawHasWriteKeyword                       error = errors.New("raw has write keyword")
+	ErrRawIsNotSelect                           error = errors.New("raw is not select")
+	ErrRawNamedArgIsMissing                     error = errors.New("raw named arg is missing")
+	ErrReferencedColumnsIsInvalid               error = errors.New("referenced columns length is not equal to columns length")
+	ErrReferencedTableIsRequired                error = errors.New("referenced table is required")
+	ErrReferentialActionIsInvalid               error = errors.New("referential action is invalid")
+	ErrReturningIsRequired                      error = errors.New("returning is required")
+	ErrRowSourceIsRequired                      error = errors.New("row source is required")
+	ErrRunnerIsRequired                         error = errors.New("runner is required")
+	ErrSQLConstantNameIsDuplicated              error = errors.New("sql constant name is duplicated")
+	ErrSQLConstantNameIsInvalid                 error = errors.New("sql constant name is invalid")
+	ErrSQLIsRequired                            error = errors.New("sql is required")
+	ErrSamplePercentIsInvalid                   error = errors.New("sample percent must be between 0 and 100")
+	ErrSavepointIsRequired                      error = errors.New("savepoint is required")
+	ErrSchemaIsRequired                         error = errors.New("schema is required")
+	ErrSearchFieldsIsRequired                   error = errors.New("search fields is required")
+	ErrSearchLanguageIsInvalid                  error = errors.New("search language is invalid")
+	ErrSearchModeIsInvalid                      error = errors.New("search mode is invalid")
+	ErrSequenceIsInvalid                        error = errors.New("sequence is invalid")
+	ErrSortColumnIsNotAllowed                   error = errors.New("sort column is not allowed")
+	ErrSortsIsRequired                          error = errors.New("sorts is required")
+	ErrTableIsNotInSchema                       error = errors.New("table is not in schema")
+	ErrTableIsRequired                          error = errors.New("table is required")
+	ErrTakeIsRequired                           error = errors.New("take is required")
+	ErrTooManyParameters                        error = errors.New("too many parameters")
+	ErrTransactionFuncIsRequired                error = errors.New("transaction func is required")
+	ErrUnbalancedParentheses                    error = errors.New("unbalanced parentheses")
+	ErrUnexpectedEndOfSQL                       error = errors.New("unexpected end of sql")
+	ErrUnexpectedToken                          error = errors.New("unexpected token")
+	ErrUnsupportedAliasSort                     error = errors.New("sort by alias with cast or collation is not supported by dialect")
+	ErrUnsupportedAlterTableAction              error = errors.New("alter table action is not supported by dialect")
+	ErrUnsupportedArrayOperator                 error = errors.New("array operator is not supported by dialect")
+	ErrUnsupportedAsOfSystemTime                error = errors.New("as of system time is not supported by dialect")
+	ErrUnsupportedBulkLoad                      error = errors.New("unsupported bulk load")
+	ErrUnsupportedIndexHint                     error = errors.New("unsupported index hint")
+	ErrUnsupportedIndexIfExists                 error = errors.New("index if exists is not supported by dialect")
+	ErrUnsupportedMerge                         error = errors.New("merge is not supported by dialect")
+	ErrUnsupportedOnDuplicateKeyUpdate          error = errors.New("on duplicate key update is not supported by dialect")
+	ErrUnsupportedPartialIndex                  error = errors.New("partial index is not supported by dialect")
+	ErrUnsupportedReturning                     error = errors.New("returning is not supported by dialect")
+	ErrUnsupportedSequence                      error = errors.New("sequence is not supported by dialect")
+	ErrUnsupportedUpsert                        error = errors.New("upsert is not supported by dialect")
+	ErrUnsupportedWithTies                      error = errors.New("with ties is not supported by dialect")
+	ErrUpdateFieldIsInvalid                     error = errors.New("update field must be a non-key column")
+	ErrValueIsNotNil                            error = errors.New("value is not nil")
+	ErrValueIsNotStruct                         error = errors.New("value is not a struct")
+	ErrValueIsRequired                          error = errors.New("value is required")
+	ErrValueLengthIsNotEqualToFieldsLength      error = errors.New("value length is not equal to fields length")
+	ErrValuesIsRequired                         error = errors.New("values is required")
+	ErrVariantIsInvalid                         error = errors.New("variant is invalid for dialect")
 )
 
 type JoinType string
@@ -95,4 +728,5 @@ const (
 	LeftJoinType  JoinType = "left join"
 	RightJoinType JoinType = "right join"
 	FullJoinType  JoinType = "full join"
+	CrossJoinType JoinType = "cross join"
 )
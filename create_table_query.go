@@ -0,0 +1,541 @@
+package goqube
+
+import (
+	"fmt"
+	"strings"
+)
+
+func Varchar(length int) ColumnType {
+	return ColumnType(fmt.Sprintf(columnTypeVarcharf, length))
+}
+
+func Decimal(precision, scale int) ColumnType {
+	return ColumnType(fmt.Sprintf(columnTypeDecimalf, precision, scale))
+}
+
+func (t ColumnType) toSQL(dialect Dialect) string {
+	if columnType, ok := columnTypeMap[dialect][t]; ok {
+		return columnType
+	}
+
+	return string(t)
+}
+
+type ColumnDefinition struct {
+	Name          string
+	Type          ColumnType
+	NotNull       bool
+	Default       interface{}
+	HasDefault    bool
+	AutoIncrement bool
+	Unique        bool
+}
+
+func NewColumnDefinition(name string, columnType ColumnType) *ColumnDefinition {
+	return &ColumnDefinition{
+		Name: name,
+		Type: columnType,
+	}
+}
+
+func (c *ColumnDefinition) NotNullable() *ColumnDefinition {
+	c.NotNull = true
+	return c
+}
+
+func (c *ColumnDefinition) DefaultValue(value interface{}) *ColumnDefinition {
+	c.Default = value
+	c.HasDefault = true
+	return c
+}
+
+func (c *ColumnDefinition) AsAutoIncrement() *ColumnDefinition {
+	c.AutoIncrement = true
+	return c
+}
+
+func (c *ColumnDefinition) AsUnique() *ColumnDefinition {
+	c.Unique = true
+	return c
+}
+
+func (c *ColumnDefinition) validate() error {
+	if !isValidIdentifier(c.Name) {
+		return fmt.Errorf(errSchemaColumnf, ErrIdentifierIsInvalid, c.Name)
+	}
+
+	if c.Type == "" {
+		return fmt.Errorf(errSchemaColumnf, ErrColumnTypeIsRequired, c.Name)
+	}
+
+	if !isValidSortModifier(string(c.Type), " (),") {
+		return fmt.Errorf(errSchemaColumnf, ErrColumnTypeIsInvalid, c.Name)
+	}
+
+	return nil
+}
+
+func (c *ColumnDefinition) defaultToSQL(bc *buildContext) (string, error) {
+	if raw, ok := c.Default.(*Raw); ok && raw != nil {
+		var (
+			sql  string
+			args []interface{}
+			err  error
+		)
+
+		sql, args, err = raw.toSQLWithArgs(bc, []interface{}{})
+		if err != nil {
+			return "", err
+		}
+
+		if len(args) > 0 {
+			return "", ErrArgsAreNotAllowedInDDL
+		}
+
+		return sql, nil
+	}
+
+	return debugLiteral(bc.dialect, c.Default)
+}
+
+func (c *ColumnDefinition) toSQL(bc *buildContext, table string) (string, error) {
+	var (
+		parts []string
+		err   error
+	)
+
+	err = c.validate()
+	if err != nil {
+		return "", err
+	}
+
+	parts = []string{bc.tableColumn(table, c.Name), c.Type.toSQL(bc.dialect)}
+
+	if c.AutoIncrement && bc.dialect == DialectPostgres {
+		parts = append(parts, autoIncrementMap[bc.dialect])
+	}
+
+	if c.NotNull {
+		parts = append(parts, "not null")
+	}
+
+	if c.HasDefault {
+		var value string
+
+		value, err = c.defaultToSQL(bc)
+		if err != nil {
+			return "", fmt.Errorf(errSchemaColumnf, err, c.Name)
+		}
+
+		parts = append(parts, "default "+value)
+	}
+
+	if c.AutoIncrement && bc.dialect != DialectPostgres {
+		parts = append(parts, autoIncrementMap[bc.dialect])
+	}
+
+	if c.Unique {
+		parts = append(parts, "unique")
+	}
+
+	return strings.Join(parts, " "), nil
+}
+
+type ForeignKey struct {
+	Name              string
+	Columns           []string
+	ReferencedTable   string
+	ReferencedColumns []string
+	OnDelete          ReferentialAction
+	OnUpdate          ReferentialAction
+}
+
+func NewForeignKey(columns ...string) *ForeignKey {
+	return &ForeignKey{
+		Columns: columns,
+	}
+}
+
+func (f *ForeignKey) As(name string) *ForeignKey {
+	f.Name = name
+	return f
+}
+
+func (f *ForeignKey) References(table string, columns ...string) *ForeignKey {
+	f.ReferencedTable = table
+	f.ReferencedColumns = columns
+	return f
+}
+
+func (f *ForeignKey) OnDeleteAction(action ReferentialAction) *ForeignKey {
+	f.OnDelete = action
+	return f
+}
+
+func (f *ForeignKey) OnUpdateAction(action ReferentialAction) *ForeignKey {
+	f.OnUpdate = action
+	return f
+}
+
+func (f *ForeignKey) validate() error {
+	if f.Name != "" && !isValidIdentifier(f.Name) {
+		return ErrIdentifierIsInvalid
+	}
+
+	if len(f.Columns) == 0 {
+		return ErrColumnsIsRequired
+	}
+
+	if f.ReferencedTable == "" {
+		return ErrReferencedTableIsRequired
+	}
+
+	if !isValidIdentifier(f.ReferencedTable) {
+		return ErrIdentifierIsInvalid
+	}
+
+	if len(f.ReferencedColumns) != len(f.Columns) {
+		return ErrReferencedColumnsIsInvalid
+	}
+
+	for i := range f.ReferencedColumns {
+		if !isValidIdentifier(f.ReferencedColumns[i]) {
+			return ErrIdentifierIsInvalid
+		}
+	}
+
+	if f.OnDelete != "" && !containsString(referentialActions, string(f.OnDelete)) {
+		return ErrReferentialActionIsInvalid
+	}
+
+	if f.OnUpdate != "" && !containsString(referentialActions, string(f.OnUpdate)) {
+		return ErrReferentialActionIsInvalid
+	}
+
+	return nil
+}
+
+func (f *ForeignKey) toSQL(bc *buildContext, table string) string {
+	var (
+		columns           []string = make([]string, len(f.Columns))
+		referencedColumns []string = make([]string, len(f.ReferencedColumns))
+		sql               string
+	)
+
+	for i := range f.Columns {
+		columns[i] = bc.tableColumn(table, f.Columns[i])
+	}
+
+	for i := range f.ReferencedColumns {
+		referencedColumns[i] = bc.tableColumn(f.ReferencedTable, f.ReferencedColumns[i])
+	}
+
+	sql = fmt.Sprintf("foreign key (%s) references %s (%s)", strings.Join(columns, ", "), bc.tableName(f.ReferencedTable), strings.Join(referencedColumns, ", "))
+
+	if f.Name != "" {
+		sql = fmt.Sprintf("constraint %s %s", f.Name, sql)
+	}
+
+	if f.OnDelete != "" {
+		sql = fmt.Sprintf("%s on delete %s", sql, f.OnDelete)
+	}
+
+	if f.OnUpdate != "" {
+		sql = fmt.Sprintf("%s on update %s", sql, f.OnUpdate)
+	}
+
+	return sql
+}
+
+type Index struct {
+	Name    string
+	Columns []string
+	Unique  bool
+}
+
+func NewIndex(name string, columns ...string) *Index {
+	return &Index{
+		Name:    name,
+		Columns: columns,
+	}
+}
+
+func (i *Index) AsUnique() *Index {
+	i.Unique = true
+	return i
+}
+
+func (i *Index) validate() error {
+	if i.Name == "" {
+		return ErrIndexNameIsRequired
+	}
+
+	if !isValidIdentifier(i.Name) {
+		return ErrIdentifierIsInvalid
+	}
+
+	if len(i.Columns) == 0 {
+		return ErrColumnsIsRequired
+	}
+
+	return nil
+}
+
+func (i *Index) toSQL(bc *buildContext, table string) string {
+	var query *CreateIndexQuery = CreateIndex(i.Name, table, i.Columns...)
+
+	query.Unique = i.Unique
+
+	return query.statementSQL(bc)
+}
+
+type CreateTableQuery struct {
+	Table       string
+	IfNotExist  bool
+	Columns     []*ColumnDefinition
+	PrimaryKeys []string
+	ForeignKeys []*ForeignKey
+	Indexes     []*Index
+}
+
+func CreateTable(table string) *CreateTableQuery {
+	return &CreateTableQuery{
+		Table: table,
+	}
+}
+
+func CreateTableFromSchema(table *SchemaTable) *CreateTableQuery {
+	var query *CreateTableQuery = CreateTable(table.Name)
+
+	for i := range table.Columns {
+		if table.Columns[i] != nil {
+			query.Columns = append(query.Columns, table.columnDefinition(table.Columns[i]))
+		}
+	}
+
+	for i := range table.Indexes {
+		if table.Indexes[i] != nil {
+			query.Indexes = append(query.Indexes, table.physicalIndex(table.Indexes[i]))
+		}
+	}
+
+	return query
+}
+
+func (q *CreateTableQuery) IfNotExists() *CreateTableQuery {
+	q.IfNotExist = true
+	return q
+}
+
+func (q *CreateTableQuery) Column(columns ...*ColumnDefinition) *CreateTableQuery {
+	q.Columns = append(q.Columns, columns...)
+	return q
+}
+
+func (q *CreateTableQuery) PrimaryKey(columns ...string) *CreateTableQuery {
+	q.PrimaryKeys = columns
+	return q
+}
+
+func (q *CreateTableQuery) ForeignKey(foreignKeys ...*ForeignKey) *CreateTableQuery {
+	q.ForeignKeys = append(q.ForeignKeys, foreignKeys...)
+	return q
+}
+
+func (q *CreateTableQuery) Index(indexes ...*Index) *CreateTableQuery {
+	q.Indexes = append(q.Indexes, indexes...)
+	return q
+}
+
+func (q *CreateTableQuery) hasColumn(name string) bool {
+	for i := range q.Columns {
+		if q.Columns[i] != nil && q.Columns[i].Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (q *CreateTableQuery) validateKeys(columns []string) error {
+	for i := range columns {
+		if !q.hasColumn(columns[i]) {
+			return fmt.Errorf(errSchemaColumnf, ErrKeyIsNotInColumns, columns[i])
+		}
+	}
+
+	return nil
+}
+
+func (q *CreateTableQuery) validate(dialect Dialect) error {
+	var (
+		names []string
+		err   error
+	)
+
+	if dialect == "" {
+		return ErrDialectIsRequired
+	}
+
+	if q.Table == "" {
+		return ErrTableIsRequired
+	}
+
+	if !isValidIdentifier(q.Table) {
+		return ErrIdentifierIsInvalid
+	}
+
+	if len(q.Columns) == 0 {
+		return ErrColumnsIsRequired
+	}
+
+	for i := range q.Columns {
+		if q.Columns[i] == nil {
+			return wrapPath(fmt.Sprintf("Columns[%d]", i), ErrFieldIsNil)
+		}
+
+		if containsString(names, q.Columns[i].Name) {
+			return wrapPath(fmt.Sprintf("Columns[%d]", i), ErrFieldIsDuplicated)
+		}
+
+		names = append(names, q.Columns[i].Name)
+	}
+
+	err = q.validateKeys(q.PrimaryKeys)
+	if err != nil {
+		return wrapPath("PrimaryKeys", err)
+	}
+
+	for i := range q.ForeignKeys {
+		var path string = fmt.Sprintf("ForeignKeys[%d]", i)
+
+		if q.ForeignKeys[i] == nil {
+			return wrapPath(path, ErrFieldIsNil)
+		}
+
+		err = q.ForeignKeys[i].validate()
+		if err == nil {
+			err = q.validateKeys(q.ForeignKeys[i].Columns)
+		}
+
+		if err != nil {
+			return wrapPath(path, err)
+		}
+	}
+
+	for i := range q.Indexes {
+		var path string = fmt.Sprintf("Indexes[%d]", i)
+
+		if q.Indexes[i] == nil {
+			return wrapPath(path, ErrFieldIsNil)
+		}
+
+		err = q.Indexes[i].validate()
+		if err == nil {
+			err = q.validateKeys(q.Indexes[i].Columns)
+		}
+
+		if err != nil {
+			return wrapPath(path, err)
+		}
+	}
+
+	return nil
+}
+
+func (q *CreateTableQuery) tableToSQL(bc *buildContext) (string, error) {
+	var (
+		definitions []string
+		statement   string = "create table"
+		err         error
+	)
+
+	err = q.validate(bc.dialect)
+	if err != nil {
+		return "", err
+	}
+
+	for i := range q.Columns {
+		var definition string
+
+		definition, err = q.Columns[i].toSQL(bc, q.Table)
+		if err != nil {
+			return "", wrapPath(fmt.Sprintf("Columns[%d]", i), err)
+		}
+
+		definitions = append(definitions, definition)
+	}
+
+	if len(q.PrimaryKeys) > 0 {
+		var columns []string = make([]string, len(q.PrimaryKeys))
+
+		for i := range q.PrimaryKeys {
+			columns[i] = bc.tableColumn(q.Table, q.PrimaryKeys[i])
+		}
+
+		definitions = append(definitions, fmt.Sprintf("primary key (%s)", strings.Join(columns, ", ")))
+	}
+
+	for i := range q.ForeignKeys {
+		definitions = append(definitions, q.ForeignKeys[i].toSQL(bc, q.Table))
+	}
+
+	if q.IfNotExist {
+		statement = "create table if not exists"
+	}
+
+	return fmt.Sprintf("%s %s (%s)", statement, bc.tableName(q.Table), strings.Join(definitions, ", ")), nil
+}
+
+func (q *CreateTableQuery) toSQLWithArgs(bc *buildContext, args []interface{}) (string, []interface{}, error) {
+	var (
+		query string
+		err   error
+	)
+
+	if len(q.Indexes) > 0 {
+		return "", nil, ErrIndexesRequireStatements
+	}
+
+	query, err = q.tableToSQL(bc)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return query, args, nil
+}
+
+func (q *CreateTableQuery) toStatements(bc *buildContext) ([]*Statement, error) {
+	var (
+		query      string
+		statements []*Statement
+		err        error
+	)
+
+	query, err = q.tableToSQL(bc)
+	if err != nil {
+		return nil, err
+	}
+
+	statements = []*Statement{{Query: query, Args: []interface{}{}}}
+	for i := range q.Indexes {
+		statements = append(statements, &Statement{Query: q.Indexes[i].toSQL(bc, q.Table), Args: []interface{}{}})
+	}
+
+	return statements, nil
+}
+
+func (q *CreateTableQuery) ToSQL(dialect Dialect) (string, error) {
+	var (
+		query string
+		err   error
+	)
+
+	query, _, err = q.toSQLWithArgs(newDialectBuildContext(dialect), []interface{}{})
+
+	return query, err
+}
+
+func (q *CreateTableQuery) ToStatements(dialect Dialect) ([]*Statement, error) {
+	return q.toStatements(newDialectBuildContext(dialect))
+}
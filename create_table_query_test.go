@@ -0,0 +1,461 @@
+package goqube
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestCreateTableQuery_ColumnType(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		ColumnType  ColumnType
+		Expectation string
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		ColumnType  ColumnType
+		Expectation string
+	}{
+		{
+			Name:        fmt.Sprintf("boolean with dialect %s", DialectMySQL),
+			Dialect:     DialectMySQL,
+			ColumnType:  ColumnTypeBoolean,
+			Expectation: "tinyint(1)",
+		},
+		{
+			Name:        fmt.Sprintf("boolean with dialect %s", DialectPostgres),
+			Dialect:     DialectPostgres,
+			ColumnType:  ColumnTypeBoolean,
+			Expectation: "boolean",
+		},
+		{
+			Name:        fmt.Sprintf("json with dialect %s", DialectPostgres),
+			Dialect:     DialectPostgres,
+			ColumnType:  ColumnTypeJSON,
+			Expectation: "jsonb",
+		},
+		{
+			Name:        "varchar",
+			Dialect:     DialectMySQL,
+			ColumnType:  Varchar(255),
+			Expectation: "varchar(255)",
+		},
+		{
+			Name:        "decimal",
+			Dialect:     DialectPostgres,
+			ColumnType:  Decimal(12, 2),
+			Expectation: "decimal(12, 2)",
+		},
+		{
+			Name:        "custom type",
+			Dialect:     DialectPostgres,
+			ColumnType:  ColumnType("inet"),
+			Expectation: "inet",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = testCases[i].ColumnType.toSQL(testCases[i].Dialect)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation column type is %s, got %s", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestCreateTableQuery_ToStatements(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Query       *CreateTableQuery
+		Expectation struct {
+			Queries []string
+			Err     error
+		}
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Query       *CreateTableQuery
+		Expectation struct {
+			Queries []string
+			Err     error
+		}
+	}{
+		{
+			Name:    fmt.Sprintf("create table with dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			Query: CreateTable("orders").
+				IfNotExists().
+				Column(
+					NewColumnDefinition("id", ColumnTypeBigInt).AsAutoIncrement(),
+					NewColumnDefinition("user_id", ColumnTypeBigInt).NotNullable(),
+					NewColumnDefinition("code", Varchar(32)).NotNullable().AsUnique(),
+					NewColumnDefinition("status", Varchar(16)).NotNullable().DefaultValue("new"),
+					NewColumnDefinition("paid", ColumnTypeBoolean).DefaultValue(false),
+					NewColumnDefinition("note", ColumnTypeText).DefaultValue(nil),
+					NewColumnDefinition("created_at", ColumnTypeTimestamp).NotNullable().DefaultValue(NewRaw("current_timestamp")),
+				).
+				PrimaryKey("id").
+				ForeignKey(NewForeignKey("user_id").As("fk_orders_user").References("users", "id").OnDeleteAction(ReferentialActionCascade)).
+				Index(NewIndex("idx_orders_user_status", "user_id", "status"), NewIndex("idx_orders_code", "code").AsUnique()),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: []string{
+					"create table if not exists orders (id bigint generated by default as identity, user_id bigint not null, code varchar(32) not null unique, status varchar(16) not null default 'new', paid boolean default false, note text default null, created_at timestamp not null default current_timestamp, primary key (id), constraint fk_orders_user foreign key (user_id) references users (id) on delete cascade)",
+					"create index idx_orders_user_status on orders (user_id, status)",
+					"create unique index idx_orders_code on orders (code)",
+				},
+				Err: nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("create table with dialect %s", DialectMySQL),
+			Dialect: DialectMySQL,
+			Query: CreateTable("order_items").
+				Column(
+					NewColumnDefinition("order_id", ColumnTypeBigInt).NotNullable(),
+					NewColumnDefinition("line", ColumnTypeInteger).NotNullable().AsAutoIncrement(),
+					NewColumnDefinition("price", Decimal(12, 2)).NotNullable().DefaultValue(0),
+					NewColumnDefinition("paid", ColumnTypeBoolean).NotNullable().DefaultValue(true),
+				).
+				PrimaryKey("order_id", "line").
+				ForeignKey(NewForeignKey("order_id").References("orders", "id").OnDeleteAction(ReferentialActionCascade).OnUpdateAction(ReferentialActionRestrict)),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: []string{
+					"create table order_items (order_id bigint not null, line integer not null auto_increment, price decimal(12, 2) not null default 0, paid tinyint(1) not null default true, primary key (order_id, line), foreign key (order_id) references orders (id) on delete cascade on update restrict)",
+				},
+				Err: nil,
+			},
+		},
+		{
+			Name:    "create table from schema",
+			Dialect: DialectPostgres,
+			Query:   CreateTableFromSchema(NewSchemaTable("users").AddColumns(NewSchemaColumn("id").OfType("bigint").AsIdentity(), NewSchemaColumn("email").OfType("varchar(255)").AsNotNull())),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: []string{"create table users (id bigint generated by default as identity, email varchar(255) not null)"},
+				Err:     nil,
+			},
+		},
+		{
+			Name:    "dialect is empty",
+			Dialect: "",
+			Query:   CreateTable("users").Column(NewColumnDefinition("id", ColumnTypeBigInt)),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     ErrDialectIsRequired,
+			},
+		},
+		{
+			Name:    "table is empty",
+			Dialect: DialectPostgres,
+			Query:   CreateTable("").Column(NewColumnDefinition("id", ColumnTypeBigInt)),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     ErrTableIsRequired,
+			},
+		},
+		{
+			Name:    "table is invalid",
+			Dialect: DialectPostgres,
+			Query:   CreateTable("users; drop table users").Column(NewColumnDefinition("id", ColumnTypeBigInt)),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     ErrIdentifierIsInvalid,
+			},
+		},
+		{
+			Name:    "columns is empty",
+			Dialect: DialectPostgres,
+			Query:   CreateTable("users"),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     ErrColumnsIsRequired,
+			},
+		},
+		{
+			Name:    "column is nil",
+			Dialect: DialectPostgres,
+			Query:   CreateTable("users").Column(nil),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     &PathError{Path: "Columns[0]", Err: ErrFieldIsNil},
+			},
+		},
+		{
+			Name:    "column is duplicated",
+			Dialect: DialectPostgres,
+			Query:   CreateTable("users").Column(NewColumnDefinition("id", ColumnTypeBigInt), NewColumnDefinition("id", ColumnTypeText)),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     &PathError{Path: "Columns[1]", Err: ErrFieldIsDuplicated},
+			},
+		},
+		{
+			Name:    "column type is empty",
+			Dialect: DialectPostgres,
+			Query:   CreateTable("users").Column(NewColumnDefinition("id", "")),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     &PathError{Path: "Columns[0]", Err: fmt.Errorf(errSchemaColumnf, ErrColumnTypeIsRequired, "id")},
+			},
+		},
+		{
+			Name:    "column type is invalid",
+			Dialect: DialectPostgres,
+			Query:   CreateTable("users").Column(NewColumnDefinition("id", "bigint; drop table users")),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     &PathError{Path: "Columns[0]", Err: fmt.Errorf(errSchemaColumnf, ErrColumnTypeIsInvalid, "id")},
+			},
+		},
+		{
+			Name:    "column default raw has args",
+			Dialect: DialectPostgres,
+			Query:   CreateTable("users").Column(NewColumnDefinition("status", ColumnTypeText).DefaultValue(NewRaw("lower(?)", "NEW"))),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     &PathError{Path: "Columns[0]", Err: fmt.Errorf(errSchemaColumnf, ErrArgsAreNotAllowedInDDL, "status")},
+			},
+		},
+		{
+			Name:    "primary key is not in columns",
+			Dialect: DialectPostgres,
+			Query:   CreateTable("users").Column(NewColumnDefinition("id", ColumnTypeBigInt)).PrimaryKey("user_id"),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     &PathError{Path: "PrimaryKeys", Err: fmt.Errorf(errSchemaColumnf, ErrKeyIsNotInColumns, "user_id")},
+			},
+		},
+		{
+			Name:    "foreign key referenced table is empty",
+			Dialect: DialectPostgres,
+			Query:   CreateTable("orders").Column(NewColumnDefinition("user_id", ColumnTypeBigInt)).ForeignKey(NewForeignKey("user_id")),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     &PathError{Path: "ForeignKeys[0]", Err: ErrReferencedTableIsRequired},
+			},
+		},
+		{
+			Name:    "foreign key referenced columns length is not equal",
+			Dialect: DialectPostgres,
+			Query:   CreateTable("orders").Column(NewColumnDefinition("user_id", ColumnTypeBigInt)).ForeignKey(NewForeignKey("user_id").References("users")),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     &PathError{Path: "ForeignKeys[0]", Err: ErrReferencedColumnsIsInvalid},
+			},
+		},
+		{
+			Name:    "foreign key referential action is invalid",
+			Dialect: DialectPostgres,
+			Query:   CreateTable("orders").Column(NewColumnDefinition("user_id", ColumnTypeBigInt)).ForeignKey(NewForeignKey("user_id").References("users", "id").OnDeleteAction("drop")),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     &PathError{Path: "ForeignKeys[0]", Err: ErrReferentialActionIsInvalid},
+			},
+		},
+		{
+			Name:    "foreign key column is not in columns",
+			Dialect: DialectPostgres,
+			Query:   CreateTable("orders").Column(NewColumnDefinition("id", ColumnTypeBigInt)).ForeignKey(NewForeignKey("user_id").References("users", "id")),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     &PathError{Path: "ForeignKeys[0]", Err: fmt.Errorf(errSchemaColumnf, ErrKeyIsNotInColumns, "user_id")},
+			},
+		},
+		{
+			Name:    "index name is empty",
+			Dialect: DialectPostgres,
+			Query:   CreateTable("orders").Column(NewColumnDefinition("id", ColumnTypeBigInt)).Index(NewIndex("", "id")),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     &PathError{Path: "Indexes[0]", Err: ErrIndexNameIsRequired},
+			},
+		},
+		{
+			Name:    "index columns is empty",
+			Dialect: DialectPostgres,
+			Query:   CreateTable("orders").Column(NewColumnDefinition("id", ColumnTypeBigInt)).Index(NewIndex("idx_orders")),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     &PathError{Path: "Indexes[0]", Err: ErrColumnsIsRequired},
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualStatements []*Statement
+				actualQueries    []string
+				actualErr        error
+			)
+
+			actualStatements, actualErr = testCases[i].Query.ToStatements(testCases[i].Dialect)
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Errorf("expectation error is nil, got %s", actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Err != nil && (actualErr == nil || testCases[i].Expectation.Err.Error() != actualErr.Error()) {
+				t.Errorf("expectation error is %s, got %v", testCases[i].Expectation.Err.Error(), actualErr)
+			}
+
+			for j := range actualStatements {
+				actualQueries = append(actualQueries, actualStatements[j].Query)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Queries, actualQueries) {
+				t.Errorf("expectation queries is %+v, got %+v", testCases[i].Expectation.Queries, actualQueries)
+			}
+		})
+	}
+}
+
+func TestCreateTableQuery_Build(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Config      *Config
+		Query       *CreateTableQuery
+		Expectation struct {
+			Query string
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Config      *Config
+		Query       *CreateTableQuery
+		Expectation struct {
+			Query string
+			Err   error
+		}
+	}{
+		{
+			Name:   "create table with table prefix",
+			Config: NewConfig(DialectPostgres).SetTablePrefix("app_"),
+			Query: CreateTable("orders").
+				Column(NewColumnDefinition("id", ColumnTypeUUID), NewColumnDefinition("user_id", ColumnTypeUUID)).
+				PrimaryKey("id").
+				ForeignKey(NewForeignKey("user_id").References("users", "id")),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "create table app_orders (id uuid, user_id uuid, primary key (id), foreign key (user_id) references app_users (id))",
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "create table with indexes",
+			Config: NewConfig(DialectPostgres),
+			Query:  CreateTable("orders").Column(NewColumnDefinition("id", ColumnTypeBigInt)).Index(NewIndex("idx_orders_id", "id")),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrIndexesRequireStatements,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Config.Build(testCases[i].Query)
+
+			if !errors.Is(actualErr, testCases[i].Expectation.Err) {
+				t.Errorf("expectation error is %v, got %v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if actualErr == nil && len(actualArgs) != 0 {
+				t.Errorf("expectation args is empty, got %+v", actualArgs)
+			}
+		})
+	}
+
+	t.Run("to sql", func(t *testing.T) {
+		var (
+			actualQuery string
+			actualErr   error
+		)
+
+		actualQuery, actualErr = CreateTable("tags").Column(NewColumnDefinition("name", ColumnTypeText).NotNullable()).ToSQL(DialectMySQL)
+
+		if actualErr != nil {
+			t.Errorf("expectation error is nil, got %s", actualErr.Error())
+		}
+
+		if actualQuery != "create table tags (name text not null)" {
+			t.Errorf("expectation query is %s, got %s", "create table tags (name text not null)", actualQuery)
+		}
+	})
+}
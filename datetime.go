@@ -0,0 +1,116 @@
+package goqube
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type DateTimeExpression struct {
+	Function DateTimeFunction
+	Unit     DateTimeUnit
+	Amount   int64
+}
+
+func Now() *Expression {
+	return &Expression{
+		DateTime: &DateTimeExpression{
+			Function: DateTimeFunctionNow,
+		},
+	}
+}
+
+func DateTrunc(unit DateTimeUnit, operand interface{}) *Expression {
+	return &Expression{
+		DateTime: &DateTimeExpression{
+			Function: DateTimeFunctionTrunc,
+			Unit:     unit,
+		},
+		Operands: []interface{}{operand},
+	}
+}
+
+func Interval(interval string) *Expression {
+	var (
+		dateTime *DateTimeExpression
+		parts    []string
+		amount   int64
+		err      error
+	)
+
+	dateTime = &DateTimeExpression{
+		Function: DateTimeFunctionInterval,
+	}
+
+	parts = strings.Fields(interval)
+	if len(parts) != 2 {
+		return &Expression{DateTime: dateTime}
+	}
+
+	amount, err = strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return &Expression{DateTime: dateTime}
+	}
+
+	dateTime.Amount = amount
+	dateTime.Unit = DateTimeUnit(strings.TrimSuffix(strings.ToLower(parts[1]), "s"))
+
+	return &Expression{DateTime: dateTime}
+}
+
+func isValidDateTimeUnit(unit DateTimeUnit) bool {
+	_, ok := mysqlDateTruncFormatMap[unit]
+	return ok
+}
+
+func (d *DateTimeExpression) validate(operands []interface{}) error {
+	switch d.Function {
+	case DateTimeFunctionNow:
+		if len(operands) > 0 {
+			return ErrOperandsIsInvalid
+		}
+
+	case DateTimeFunctionTrunc:
+		if !isValidDateTimeUnit(d.Unit) {
+			return ErrDateTimeUnitIsInvalid
+		}
+
+		if len(operands) != 1 {
+			return ErrOperandsIsInvalid
+		}
+
+	case DateTimeFunctionInterval:
+		if !isValidDateTimeUnit(d.Unit) {
+			return ErrIntervalIsInvalid
+		}
+
+		if len(operands) > 0 {
+			return ErrOperandsIsInvalid
+		}
+
+	default:
+		return ErrDateTimeFunctionIsInvalid
+	}
+
+	return nil
+}
+
+func (d *DateTimeExpression) toSQL(dialect Dialect, operands []string) string {
+	switch d.Function {
+	case DateTimeFunctionTrunc:
+		if dialect == DialectMySQL {
+			return fmt.Sprintf(mysqlDateTruncFormatMap[d.Unit], operands[0])
+		}
+
+		return fmt.Sprintf(postgresDateTruncf, d.Unit, operands[0])
+
+	case DateTimeFunctionInterval:
+		if dialect == DialectMySQL {
+			return fmt.Sprintf(mysqlIntervalf, d.Amount, d.Unit)
+		}
+
+		return fmt.Sprintf(postgresIntervalf, d.Amount, d.Unit)
+	}
+
+	return currentTimestampExpression
+}
@@ -0,0 +1,318 @@
+package goqube
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestDateTime_Now(t *testing.T) {
+	var (
+		expectation *Expression = &Expression{DateTime: &DateTimeExpression{Function: DateTimeFunctionNow}}
+		actual      *Expression = Now()
+	)
+
+	if !deepEqual(expectation, actual) {
+		t.Errorf("expectation is %+v, got %+v", expectation, actual)
+	}
+}
+
+func TestDateTime_DateTrunc(t *testing.T) {
+	var (
+		field       *Field      = NewField("created_at")
+		expectation *Expression = &Expression{DateTime: &DateTimeExpression{Function: DateTimeFunctionTrunc, Unit: DateTimeUnitDay}, Operands: []interface{}{field}}
+		actual      *Expression = DateTrunc(DateTimeUnitDay, field)
+	)
+
+	if !deepEqual(expectation, actual) {
+		t.Errorf("expectation is %+v, got %+v", expectation, actual)
+	}
+}
+
+func TestDateTime_Interval(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Interval    string
+		Expectation *DateTimeExpression
+	} = []struct {
+		Name        string
+		Interval    string
+		Expectation *DateTimeExpression
+	}{
+		{
+			Name:        "plural unit",
+			Interval:    "7 days",
+			Expectation: &DateTimeExpression{Function: DateTimeFunctionInterval, Unit: DateTimeUnitDay, Amount: 7},
+		},
+		{
+			Name:        "negative amount and uppercase unit",
+			Interval:    "-1 HOUR",
+			Expectation: &DateTimeExpression{Function: DateTimeFunctionInterval, Unit: DateTimeUnitHour, Amount: -1},
+		},
+		{
+			Name:        "amount is not a number",
+			Interval:    "seven days",
+			Expectation: &DateTimeExpression{Function: DateTimeFunctionInterval},
+		},
+		{
+			Name:        "unit is missing",
+			Interval:    "7",
+			Expectation: &DateTimeExpression{Function: DateTimeFunctionInterval},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual *Expression = Interval(testCases[i].Interval)
+
+			if !deepEqual(testCases[i].Expectation, actual.DateTime) {
+				t.Errorf("expectation is %+v, got %+v", testCases[i].Expectation, actual.DateTime)
+			}
+		})
+	}
+}
+
+func TestDateTime_ToSQLWithArgs(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Expression  *Expression
+		Dialect     Dialect
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Expression  *Expression
+		Dialect     Dialect
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:       "date time function is invalid",
+			Expression: &Expression{DateTime: &DateTimeExpression{Function: "strftime"}},
+			Dialect:    DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrDateTimeFunctionIsInvalid,
+			},
+		},
+		{
+			Name:       "date time with operator",
+			Expression: &Expression{Operator: ExpressionOperatorAdd, DateTime: &DateTimeExpression{Function: DateTimeFunctionNow}},
+			Dialect:    DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrConflictExpressionOperatorAndFunction,
+			},
+		},
+		{
+			Name:       "now with operands",
+			Expression: &Expression{DateTime: &DateTimeExpression{Function: DateTimeFunctionNow}, Operands: []interface{}{1}},
+			Dialect:    DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrOperandsIsInvalid,
+			},
+		},
+		{
+			Name:       "date trunc unit is invalid",
+			Expression: DateTrunc("fortnight", NewField("created_at")),
+			Dialect:    DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrDateTimeUnitIsInvalid,
+			},
+		},
+		{
+			Name:       "interval is invalid",
+			Expression: Interval("1 fortnight"),
+			Dialect:    DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrIntervalIsInvalid,
+			},
+		},
+		{
+			Name:       fmt.Sprintf("now with dialect %s", DialectMySQL),
+			Expression: Now(),
+			Dialect:    DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "current_timestamp",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:       fmt.Sprintf("date trunc with dialect %s", DialectPostgres),
+			Expression: DateTrunc(DateTimeUnitMonth, NewField("created_at").FromTable("o")),
+			Dialect:    DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "date_trunc('month', o.created_at)",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:       fmt.Sprintf("date trunc with dialect %s", DialectMySQL),
+			Expression: DateTrunc(DateTimeUnitDay, NewField("created_at")),
+			Dialect:    DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "cast(date_format(created_at, '%Y-%m-%d 00:00:00') as datetime)",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:       fmt.Sprintf("date trunc week with dialect %s", DialectMySQL),
+			Expression: DateTrunc(DateTimeUnitWeek, NewField("created_at")),
+			Dialect:    DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "cast(str_to_date(date_format(created_at, '%x%v Monday'), '%x%v %W') as datetime)",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:       fmt.Sprintf("date trunc week of bound value with dialect %s", DialectMySQL),
+			Expression: DateTrunc(DateTimeUnitWeek, "2024-01-10 12:00:00"),
+			Dialect:    DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "cast(str_to_date(date_format(?, '%x%v Monday'), '%x%v %W') as datetime)",
+				Args:  []interface{}{"2024-01-10 12:00:00"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:       fmt.Sprintf("now minus interval with dialect %s", DialectPostgres),
+			Expression: NewArithmeticExpression(ExpressionOperatorSubtract, Now(), Interval("7 days")),
+			Dialect:    DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "current_timestamp - interval '7 day'",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:       fmt.Sprintf("now minus interval with dialect %s", DialectMySQL),
+			Expression: NewArithmeticExpression(ExpressionOperatorSubtract, Now(), Interval("7 days")),
+			Dialect:    DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "current_timestamp - interval 7 day",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Expression.ToSQLWithArgs(testCases[i].Dialect, []interface{}{})
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Errorf("expectation error is %+v, got nil", testCases[i].Expectation.Err)
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Errorf("expectation error is nil, got %+v", actualErr)
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %+v, got %+v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %+v, got %+v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
+
+func TestDateTime_SelectQuery(t *testing.T) {
+	var (
+		query       *SelectQuery
+		expectation string = "select date_trunc('day', created_at) as bucket, count(*) as total from orders where created_at >= current_timestamp - interval '30 day' group by date_trunc('day', created_at)"
+		actual      string
+		err         error
+	)
+
+	query = Select(NewExpressionField(DateTrunc(DateTimeUnitDay, NewField("created_at"))).As("bucket"), NewField("count(*)").As("total")).
+		From(NewTable("orders")).
+		Where(NewFilter().SetCondition(NewField("created_at"), OperatorGreaterThanOrEqual, NewExpressionFilterValue(NewArithmeticExpression(ExpressionOperatorSubtract, Now(), Interval("30 days"))))).
+		GroupBy(NewExpressionField(DateTrunc(DateTimeUnitDay, NewField("created_at"))))
+
+	actual, _, err = query.ToSQLWithArgs(DialectPostgres, []interface{}{})
+	if err != nil {
+		t.Errorf("expectation error is nil, got %+v", err)
+	}
+
+	if expectation != actual {
+		t.Errorf("expectation query is %s, got %s", expectation, actual)
+	}
+}
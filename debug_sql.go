@@ -0,0 +1,227 @@
+package goqube
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	postgresStringLiteralReplacer *strings.Replacer = strings.NewReplacer(`'`, `''`)
+	mysqlStringLiteralReplacer    *strings.Replacer = strings.NewReplacer(`\`, `\\`, `'`, `''`)
+)
+
+func debugSQL(bc *buildContext, query Query) (string, error) {
+	var (
+		sql  string
+		args []interface{}
+		err  error
+	)
+
+	sql, args, err = query.toSQLWithArgs(bc, []interface{}{})
+	if err != nil {
+		return "", err
+	}
+
+	return inlineArgs(bc.dialect, sql, args)
+}
+
+func inlineArgs(dialect Dialect, query string, args []interface{}) (string, error) {
+	var (
+		builder     strings.Builder
+		inQuote     bool
+		position    int
+		writeIndex  func(index int) error
+		placeholder int
+	)
+
+	writeIndex = func(index int) error {
+		var (
+			literal string
+			err     error
+		)
+
+		placeholder++
+
+		if index < 1 || index > len(args) {
+			return fmt.Errorf(errPlaceholderCountf, ErrPlaceholderCountMismatch, placeholder, len(args))
+		}
+
+		literal, err = debugLiteral(dialect, args[index-1])
+		if err != nil {
+			return err
+		}
+
+		builder.WriteString(literal)
+		return nil
+	}
+
+	for i := 0; i < len(query); i++ {
+		var err error
+
+		if query[i] == '\'' {
+			inQuote = !inQuote
+		}
+
+		if inQuote {
+			builder.WriteByte(query[i])
+			continue
+		}
+
+		switch {
+		case query[i] == '?' && dialect == DialectMySQL:
+			position++
+			err = writeIndex(position)
+
+		case query[i] == '$' && dialect == DialectPostgres && i+1 < len(query) && query[i+1] >= '0' && query[i+1] <= '9':
+			var index int
+
+			for i+1 < len(query) && query[i+1] >= '0' && query[i+1] <= '9' {
+				index = index*10 + int(query[i+1]-'0')
+				i++
+			}
+
+			err = writeIndex(index)
+
+		default:
+			builder.WriteByte(query[i])
+		}
+
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return builder.String(), nil
+}
+
+func debugLiteral(dialect Dialect, value interface{}) (string, error) {
+	var reflectValue reflect.Value
+
+	if value == nil {
+		return "null", nil
+	}
+
+	switch typedValue := normalizeValue(value).(type) {
+	case decimalValue:
+		return string(typedValue), nil
+
+	case driver.Valuer:
+		var (
+			driverValue driver.Value
+			err         error
+		)
+
+		reflectValue = reflect.ValueOf(value)
+		if reflectValue.Kind() == reflect.Ptr && reflectValue.IsNil() {
+			return "null", nil
+		}
+
+		driverValue, err = typedValue.Value()
+		if err != nil {
+			return "", err
+		}
+
+		return debugLiteral(dialect, driverValue)
+
+	case time.Time:
+		if dialect == DialectPostgres {
+			return "'" + typedValue.Format("2006-01-02 15:04:05.999999999Z07:00") + "'", nil
+		}
+
+		return "'" + typedValue.Format("2006-01-02 15:04:05.999999999") + "'", nil
+
+	case []byte:
+		if dialect == DialectPostgres {
+			return `'\x` + hex.EncodeToString(typedValue) + "'", nil
+		}
+
+		return "x'" + hex.EncodeToString(typedValue) + "'", nil
+	}
+
+	reflectValue = reflect.ValueOf(value)
+	switch reflectValue.Kind() {
+	case reflect.Ptr:
+		if reflectValue.IsNil() {
+			return "null", nil
+		}
+
+		return debugLiteral(dialect, reflectValue.Elem().Interface())
+
+	case reflect.String:
+		if dialect == DialectMySQL {
+			return "'" + mysqlStringLiteralReplacer.Replace(reflectValue.String()) + "'", nil
+		}
+
+		return "'" + postgresStringLiteralReplacer.Replace(reflectValue.String()) + "'", nil
+
+	case reflect.Bool:
+		return strconv.FormatBool(reflectValue.Bool()), nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(reflectValue.Int(), 10), nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(reflectValue.Uint(), 10), nil
+
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(reflectValue.Float(), 'g', -1, 64), nil
+	}
+
+	return "", &UnsupportedValueTypeError{Kind: reflectValue.Kind()}
+}
+
+func (c *Config) DebugSQL(query Query) (string, error) {
+	var (
+		sql  string
+		args []interface{}
+		err  error
+	)
+
+	sql, args, err = c.Build(query)
+	if err != nil {
+		return "", err
+	}
+
+	return inlineArgs(c.Dialect, sql, args)
+}
+
+func (s *SelectQuery) DebugSQL(dialect Dialect) (string, error) {
+	return debugSQL(newDialectBuildContext(dialect), s)
+}
+
+func (i *InsertQuery) DebugSQL(dialect Dialect) (string, error) {
+	return debugSQL(newDialectBuildContext(dialect), i)
+}
+
+func (u *UpdateQuery) DebugSQL(dialect Dialect) (string, error) {
+	return debugSQL(newDialectBuildContext(dialect), u)
+}
+
+func (d *DeleteQuery) DebugSQL(dialect Dialect) (string, error) {
+	return debugSQL(newDialectBuildContext(dialect), d)
+}
+
+func (c *CompoundQuery) DebugSQL(dialect Dialect) (string, error) {
+	return debugSQL(newDialectBuildContext(dialect), c)
+}
+
+func (m *MergeQuery) DebugSQL(dialect Dialect) (string, error) {
+	return debugSQL(newDialectBuildContext(dialect), m)
+}
+
+func (r *Raw) DebugSQL(dialect Dialect) (string, error) {
+	return debugSQL(newDialectBuildContext(dialect), r)
+}
+
+func (f *FragmentQuery) DebugSQL(dialect Dialect) (string, error) {
+	return debugSQL(newDialectBuildContext(dialect), f)
+}
+
+func (h *HealthCheckQuery) DebugSQL(dialect Dialect) (string, error) {
+	return debugSQL(newDialectBuildContext(dialect), h)
+}
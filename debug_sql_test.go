@@ -0,0 +1,533 @@
+package goqube
+
+import (
+	"database/sql"
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_debugLiteral(t *testing.T) {
+	var (
+		createdAt time.Time = time.Date(2024, 1, 2, 3, 4, 5, 600000000, time.UTC)
+		nilString *string
+		name      string = "o'neil"
+		testCases []struct {
+			Name        string
+			Dialect     Dialect
+			Value       interface{}
+			Expectation struct {
+				Literal string
+				Err     error
+			}
+		}
+	)
+
+	testCases = []struct {
+		Name        string
+		Dialect     Dialect
+		Value       interface{}
+		Expectation struct {
+			Literal string
+			Err     error
+		}
+	}{
+		{
+			Name:    "nil value",
+			Dialect: DialectPostgres,
+			Value:   nil,
+			Expectation: struct {
+				Literal string
+				Err     error
+			}{
+				Literal: "null",
+				Err:     nil,
+			},
+		},
+		{
+			Name:    "nil pointer",
+			Dialect: DialectPostgres,
+			Value:   nilString,
+			Expectation: struct {
+				Literal string
+				Err     error
+			}{
+				Literal: "null",
+				Err:     nil,
+			},
+		},
+		{
+			Name:    "string pointer with dialect postgres",
+			Dialect: DialectPostgres,
+			Value:   &name,
+			Expectation: struct {
+				Literal string
+				Err     error
+			}{
+				Literal: "'o''neil'",
+				Err:     nil,
+			},
+		},
+		{
+			Name:    "string with backslash with dialect postgres",
+			Dialect: DialectPostgres,
+			Value:   `a\b`,
+			Expectation: struct {
+				Literal string
+				Err     error
+			}{
+				Literal: `'a\b'`,
+				Err:     nil,
+			},
+		},
+		{
+			Name:    "string with backslash with dialect mysql",
+			Dialect: DialectMySQL,
+			Value:   `it's a\b`,
+			Expectation: struct {
+				Literal string
+				Err     error
+			}{
+				Literal: `'it''s a\\b'`,
+				Err:     nil,
+			},
+		},
+		{
+			Name:    "float",
+			Dialect: DialectMySQL,
+			Value:   1.5,
+			Expectation: struct {
+				Literal string
+				Err     error
+			}{
+				Literal: "1.5",
+				Err:     nil,
+			},
+		},
+		{
+			Name:    "bool",
+			Dialect: DialectPostgres,
+			Value:   false,
+			Expectation: struct {
+				Literal string
+				Err     error
+			}{
+				Literal: "false",
+				Err:     nil,
+			},
+		},
+		{
+			Name:    "negative integer",
+			Dialect: DialectMySQL,
+			Value:   int64(-1),
+			Expectation: struct {
+				Literal string
+				Err     error
+			}{
+				Literal: "-1",
+				Err:     nil,
+			},
+		},
+		{
+			Name:    "unsigned integer",
+			Dialect: DialectPostgres,
+			Value:   uint16(7),
+			Expectation: struct {
+				Literal string
+				Err     error
+			}{
+				Literal: "7",
+				Err:     nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("time with dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			Value:   createdAt,
+			Expectation: struct {
+				Literal string
+				Err     error
+			}{
+				Literal: "'2024-01-02 03:04:05.6Z'",
+				Err:     nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("time with dialect %s", DialectMySQL),
+			Dialect: DialectMySQL,
+			Value:   createdAt,
+			Expectation: struct {
+				Literal string
+				Err     error
+			}{
+				Literal: "'2024-01-02 03:04:05.6'",
+				Err:     nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("bytes with dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			Value:   []byte{0xde, 0xad},
+			Expectation: struct {
+				Literal string
+				Err     error
+			}{
+				Literal: `'\xdead'`,
+				Err:     nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("bytes with dialect %s", DialectMySQL),
+			Dialect: DialectMySQL,
+			Value:   []byte{0xde, 0xad},
+			Expectation: struct {
+				Literal string
+				Err     error
+			}{
+				Literal: "x'dead'",
+				Err:     nil,
+			},
+		},
+		{
+			Name:    "valid sql null string",
+			Dialect: DialectPostgres,
+			Value:   sql.NullString{String: "a", Valid: true},
+			Expectation: struct {
+				Literal string
+				Err     error
+			}{
+				Literal: "'a'",
+				Err:     nil,
+			},
+		},
+		{
+			Name:    "invalid sql null int64",
+			Dialect: DialectPostgres,
+			Value:   sql.NullInt64{},
+			Expectation: struct {
+				Literal string
+				Err     error
+			}{
+				Literal: "null",
+				Err:     nil,
+			},
+		},
+		{
+			Name:    "array value",
+			Dialect: DialectPostgres,
+			Value:   Array([]string{"a", "b"}),
+			Expectation: struct {
+				Literal string
+				Err     error
+			}{
+				Literal: `'{"a","b"}'`,
+				Err:     nil,
+			},
+		},
+		{
+			Name:    "unsupported value",
+			Dialect: DialectPostgres,
+			Value:   struct{}{},
+			Expectation: struct {
+				Literal string
+				Err     error
+			}{
+				Literal: "",
+				Err:     &UnsupportedValueTypeError{Kind: reflect.Struct},
+			},
+		},
+		{
+			Name:    "big int",
+			Dialect: DialectMySQL,
+			Value:   new(big.Int).Exp(big.NewInt(10), big.NewInt(20), nil),
+			Expectation: struct {
+				Literal string
+				Err     error
+			}{
+				Literal: "100000000000000000000",
+				Err:     nil,
+			},
+		},
+		{
+			Name:    "big float",
+			Dialect: DialectPostgres,
+			Value:   big.NewFloat(12.5),
+			Expectation: struct {
+				Literal string
+				Err     error
+			}{
+				Literal: "12.5",
+				Err:     nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualLiteral string
+				actualErr     error
+			)
+
+			actualLiteral, actualErr = debugLiteral(testCases[i].Dialect, testCases[i].Value)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Errorf("expectation error is %+v, got nil", testCases[i].Expectation.Err)
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Errorf("expectation error is nil, got %+v", actualErr)
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %+v, got %+v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Literal != actualLiteral {
+				t.Errorf("expectation literal is %s, got %s", testCases[i].Expectation.Literal, actualLiteral)
+			}
+		})
+	}
+}
+
+func Test_inlineArgs(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Query       string
+		Args        []interface{}
+		Expectation struct {
+			Query string
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Query       string
+		Args        []interface{}
+		Expectation struct {
+			Query string
+			Err   error
+		}
+	}{
+		{
+			Name:    fmt.Sprintf("placeholders with dialect %s", DialectMySQL),
+			Dialect: DialectMySQL,
+			Query:   "select * from users where name = ? and note = '?' and id > ?",
+			Args:    []interface{}{"a", 1},
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "select * from users where name = 'a' and note = '?' and id > 1",
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("placeholders with dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			Query:   "select * from users where id = $2 or parent_id = $2 and name = $1 and note = '$1'",
+			Args:    []interface{}{"a", 10},
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "select * from users where id = 10 or parent_id = 10 and name = 'a' and note = '$1'",
+				Err:   nil,
+			},
+		},
+		{
+			Name:    "placeholder without arg",
+			Dialect: DialectMySQL,
+			Query:   "select * from users where id = ? and name = ?",
+			Args:    []interface{}{1},
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   fmt.Errorf(errPlaceholderCountf, ErrPlaceholderCountMismatch, 2, 1),
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualErr   error
+			)
+
+			actualQuery, actualErr = inlineArgs(testCases[i].Dialect, testCases[i].Query, testCases[i].Args)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Errorf("expectation error is %+v, got nil", testCases[i].Expectation.Err)
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Errorf("expectation error is nil, got %+v", actualErr)
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %+v, got %+v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+		})
+	}
+}
+
+func TestSelectQuery_DebugSQL(t *testing.T) {
+	var (
+		query       *SelectQuery
+		expectation string = "select id from users where name = 'o''neil' and created_at > '2024-01-02 03:04:05Z' limit 10"
+		actual      string
+		err         error
+	)
+
+	query = Select(NewField("id")).
+		From(NewTable("users")).
+		Where(NewFilter().
+			SetLogic(LogicAnd).
+			AddFilter(NewField("name"), OperatorEqual, NewFilterValue("o'neil")).
+			AddFilter(NewField("created_at"), OperatorGreaterThan, NewFilterValue(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)))).
+		Limit(10)
+
+	actual, err = query.DebugSQL(DialectPostgres)
+	if err != nil {
+		t.Errorf("expectation error is nil, got %+v", err)
+	}
+
+	if expectation != actual {
+		t.Errorf("expectation query is %s, got %s", expectation, actual)
+	}
+
+	_, err = Select().DebugSQL(DialectPostgres)
+	if err != ErrFieldsIsRequired {
+		t.Errorf("expectation error is %+v, got %+v", ErrFieldsIsRequired, err)
+	}
+}
+
+func TestSelectQuery_DebugSQL_Values(t *testing.T) {
+	var (
+		query       *SelectQuery
+		expectation string = "select id from orders where created_at in ('2024-01-02 03:04:05', '2024-01-03 03:04:05') and coupon in ('spring', null) and total > 10.25 and cast(note as char) like concat('%', cast('gift%' as char), '%') and cast(code as char) like 'spr%'"
+		actual      string
+		err         error
+	)
+
+	query = Select(NewField("id")).
+		From(NewTable("orders")).
+		Where(NewFilter().
+			SetLogic(LogicAnd).
+			AddFilter(NewField("created_at"), OperatorIn, NewFilterValue([]time.Time{time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), time.Date(2024, 1, 3, 3, 4, 5, 0, time.UTC)})).
+			AddFilter(NewField("coupon"), OperatorIn, NewFilterValue([]sql.NullString{{String: "spring", Valid: true}, {}})).
+			AddFilter(NewField("total"), OperatorGreaterThan, NewFilterValue(big.NewFloat(10.25))).
+			AddFilter(NewField("note"), OperatorLike, NewFilterValue(sql.NullString{String: "gift%", Valid: true})).
+			AddFilter(NewField("code"), OperatorStartsWith, NewFilterValue(sql.NullString{String: "spr", Valid: true})))
+
+	actual, err = query.DebugSQL(DialectMySQL)
+	if err != nil {
+		t.Errorf("expectation error is nil, got %+v", err)
+	}
+
+	if expectation != actual {
+		t.Errorf("expectation query is %s, got %s", expectation, actual)
+	}
+}
+
+func TestInsertQuery_DebugSQL(t *testing.T) {
+	var (
+		expectation string = "insert into users(email, name) values ('a@mail.com', 'a')"
+		actual      string
+		err         error
+	)
+
+	actual, err = InsertInto("users").Value("name", "a").Value("email", "a@mail.com").DebugSQL(DialectMySQL)
+	if err != nil {
+		t.Errorf("expectation error is nil, got %+v", err)
+	}
+
+	if expectation != actual {
+		t.Errorf("expectation query is %s, got %s", expectation, actual)
+	}
+}
+
+func TestUpdateQuery_DebugSQL(t *testing.T) {
+	var (
+		expectation string = "update users set active = false where id = 1"
+		actual      string
+		err         error
+	)
+
+	actual, err = Update("users").Set("active", false).Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))).DebugSQL(DialectPostgres)
+	if err != nil {
+		t.Errorf("expectation error is nil, got %+v", err)
+	}
+
+	if expectation != actual {
+		t.Errorf("expectation query is %s, got %s", expectation, actual)
+	}
+}
+
+func TestDeleteQuery_DebugSQL(t *testing.T) {
+	var (
+		expectation string = "delete from users where id in (1, 2)"
+		actual      string
+		err         error
+	)
+
+	actual, err = DeleteFrom("users").Where(NewFilter().SetCondition(NewField("id"), OperatorIn, NewFilterValue([]int{1, 2}))).DebugSQL(DialectMySQL)
+	if err != nil {
+		t.Errorf("expectation error is nil, got %+v", err)
+	}
+
+	if expectation != actual {
+		t.Errorf("expectation query is %s, got %s", expectation, actual)
+	}
+}
+
+func TestRaw_DebugSQL(t *testing.T) {
+	var (
+		expectation string = "select * from users where name = 'a' and note = '?'"
+		actual      string
+		err         error
+	)
+
+	actual, err = NewRaw("select * from users where name = ? and note = '?'", "a").DebugSQL(DialectPostgres)
+	if err != nil {
+		t.Errorf("expectation error is nil, got %+v", err)
+	}
+
+	if expectation != actual {
+		t.Errorf("expectation query is %s, got %s", expectation, actual)
+	}
+}
+
+func TestConfig_DebugSQL(t *testing.T) {
+	var (
+		expectation string = "select id from app_users where id = 1"
+		actual      string
+		err         error
+	)
+
+	actual, err = NewConfig(DialectPostgres).
+		SetTablePrefix("app_").
+		DebugSQL(Select(NewField("id")).From(NewTable("users")).Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))))
+	if err != nil {
+		t.Errorf("expectation error is nil, got %+v", err)
+	}
+
+	if expectation != actual {
+		t.Errorf("expectation query is %s, got %s", expectation, actual)
+	}
+
+	_, err = NewConfig(DialectPostgres).DebugSQL(nil)
+	if err != ErrQueryIsRequired {
+		t.Errorf("expectation error is %+v, got %+v", ErrQueryIsRequired, err)
+	}
+}
@@ -2,36 +2,89 @@ package goqube
 
 import (
 	"fmt"
+	"time"
 )
 
 type DeleteQuery struct {
-	Table  string
-	Filter *Filter
+	Database   string
+	Table      string
+	Filter     *Filter
+	Returnings []*Field
+	Force      bool
+	Comments   map[string]string
 }
 
 func Delete() *DeleteQuery {
 	return &DeleteQuery{}
 }
 
+func DeleteFrom(table string) *DeleteQuery {
+	return Delete().From(table)
+}
+
 func (d *DeleteQuery) From(table string) *DeleteQuery {
 	d.Table = table
 	return d
 }
 
+func (d *DeleteQuery) FromDatabase(database string) *DeleteQuery {
+	d.Database = database
+	return d
+}
+
 func (d *DeleteQuery) Where(filter *Filter) *DeleteQuery {
 	d.Filter = filter
 	return d
 }
 
+func (d *DeleteQuery) Returning(fields ...*Field) *DeleteQuery {
+	d.Returnings = fields
+	return d
+}
+
+func (d *DeleteQuery) ForceDelete() *DeleteQuery {
+	d.Force = true
+	return d
+}
+
 func (d *DeleteQuery) validate(dialect Dialect) error {
+	var err error = d.validateStatement(dialect)
+	if err != nil {
+		return err
+	}
+
+	return validateReturning(dialect, d.Returnings)
+}
+
+func (d *DeleteQuery) validateWithContext(bc *buildContext) error {
+	var err error = d.validateStatement(bc.dialect)
+	if err != nil {
+		return err
+	}
+
+	return bc.validateReturning(d.Returnings)
+}
+
+func (d *DeleteQuery) validateStatement(dialect Dialect) error {
+	var err error
+
 	if dialect == "" {
 		return ErrDialectIsRequired
 	}
 
+	err = validateComments(d.Comments)
+	if err != nil {
+		return err
+	}
+
 	if d.Table == "" {
 		return ErrTableIsRequired
 	}
 
+	if d.Database != "" && !isValidIdentifier(d.Database) {
+		return ErrIdentifierIsInvalid
+	}
+
 	if d.Filter == nil {
 		return ErrFilterIsRequired
 	}
@@ -39,24 +92,28 @@ func (d *DeleteQuery) validate(dialect Dialect) error {
 	return nil
 }
 
-func (d *DeleteQuery) ToSQLWithArgs(dialect Dialect) (string, []interface{}, error) {
+func (d *DeleteQuery) toSQLWithArgs(bc *buildContext, args []interface{}) (string, []interface{}, error) {
 	var (
 		query       string
-		args        []interface{}
 		whereClause string
+		returning   string
 		err         error
 	)
 
-	err = d.validate(dialect)
+	err = d.validateWithContext(bc)
 	if err != nil {
 		return "", nil, err
 	}
 
-	query = fmt.Sprintf("delete from %s", d.Table)
-	args = []interface{}{}
+	bc.pushTableScope(d.Table)
+	defer bc.popScope()
+
+	query = fmt.Sprintf("delete from %s", bc.qualifiedTableName(d.Database, d.Table))
 
 	if d.Filter != nil {
-		whereClause, args, err = d.Filter.ToSQLWithArgs(dialect, args)
+		var traceStart time.Time = bc.startTrace()
+		whereClause, args, err = d.Filter.toRootSQLWithArgs(bc, args)
+		bc.endTrace(buildStageFilter, traceStart)
 		if err != nil {
 			return "", nil, err
 		}
@@ -66,5 +123,16 @@ func (d *DeleteQuery) ToSQLWithArgs(dialect Dialect) (string, []interface{}, err
 		}
 	}
 
+	returning, args, err = returningToSQLWithArgs(bc, d.Returnings, args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	query = fmt.Sprintf("%s%s%s", query, returning, commentSQL(d.Comments))
+
 	return query, args, nil
 }
+
+func (d *DeleteQuery) ToSQLWithArgs(dialect Dialect) (string, []interface{}, error) {
+	return buildStatement(dialect, d, []interface{}{})
+}
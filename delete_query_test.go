@@ -1,6 +1,7 @@
 package goqube
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 )
@@ -25,6 +26,10 @@ func testDeleteQuery_DeleteQueryEquality(t *testing.T, expectation, actual *Dele
 	if !deepEqual(expectation.Filter, actual.Filter) {
 		t.Errorf("expectation filter is %v, got %v", expectation.Filter, actual.Filter)
 	}
+
+	if !deepEqual(expectation.Returnings, actual.Returnings) {
+		t.Errorf("expectation returnings is %v, got %v", expectation.Returnings, actual.Returnings)
+	}
 }
 
 func TestDeleteQuery_Delete(t *testing.T) {
@@ -54,6 +59,20 @@ func TestDeleteQuery_From(t *testing.T) {
 	testDeleteQuery_DeleteQueryEquality(t, expectation, actual)
 }
 
+func TestDeleteQuery_DeleteFrom(t *testing.T) {
+	var (
+		expectation *DeleteQuery
+		actual      *DeleteQuery
+	)
+
+	expectation = &DeleteQuery{
+		Table: "table1",
+	}
+	actual = DeleteFrom("table1")
+
+	testDeleteQuery_DeleteQueryEquality(t, expectation, actual)
+}
+
 func TestDeleteQuery_Where(t *testing.T) {
 	var (
 		expectation *DeleteQuery
@@ -89,6 +108,21 @@ func TestDeleteQuery_Where(t *testing.T) {
 	testDeleteQuery_DeleteQueryEquality(t, expectation, actual)
 }
 
+func TestDeleteQuery_Returning(t *testing.T) {
+	var (
+		expectation *DeleteQuery
+		actual      *DeleteQuery
+	)
+
+	expectation = &DeleteQuery{
+		Table:      "table1",
+		Returnings: []*Field{NewField("id")},
+	}
+	actual = DeleteFrom("table1").Returning(NewField("id"))
+
+	testDeleteQuery_DeleteQueryEquality(t, expectation, actual)
+}
+
 func TestDeleteQuery_validate(t *testing.T) {
 	var testCases []struct {
 		Name        string
@@ -285,3 +319,93 @@ func TestDeleteQuery_ToSQLWithArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteQuery_FromDatabase(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Query       Query
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Query       Query
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:    "database is invalid",
+			Dialect: DialectPostgres,
+			Query:   DeleteFrom("AuditLogs").FromDatabase("archive; drop").Where(Eq("id", 2)),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrIdentifierIsInvalid,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			Query:   DeleteFrom("AuditLogs").FromDatabase("archive").Where(Eq("id", 2)),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "delete from archive.app_audit_logs where id = $1",
+				Args:  []interface{}{2},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s", DialectMySQL),
+			Dialect: DialectMySQL,
+			Query:   DeleteFrom("AuditLogs").FromDatabase("archive").Where(Eq("id", 2)),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "delete from archive.app_audit_logs where id = ?",
+				Args:  []interface{}{2},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				config      *Config = NewConfig(testCases[i].Dialect).SetTablePrefix("app_").SetNameMapper(NewNameMapper().MapTables(SnakeCase))
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = config.Build(testCases[i].Query)
+
+			if !errors.Is(actualErr, testCases[i].Expectation.Err) {
+				t.Errorf("expectation error is %v, got %v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %v, got %v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
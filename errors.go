@@ -0,0 +1,144 @@
+package goqube
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+type UnsupportedValueTypeError struct {
+	Kind     reflect.Kind
+	Operator Operator
+}
+
+func (e *UnsupportedValueTypeError) Error() string {
+	if e.Operator != "" {
+		return fmt.Sprintf(errUnsupportedValueTypeForOperatorf, e.Kind.String(), e.Operator)
+	}
+
+	return fmt.Sprintf(errUnsupportedValueTypef, e.Kind.String())
+}
+
+type UnsupportedElementTypeError struct {
+	Type     reflect.Type
+	Operator Operator
+}
+
+func (e *UnsupportedElementTypeError) Error() string {
+	if e.Operator != "" {
+		return fmt.Sprintf(errUnsupportedElementForOperatorf, e.Type, e.Operator)
+	}
+
+	return fmt.Sprintf(errUnsupportedElementf, e.Type)
+}
+
+type ArgTypeError struct {
+	Path string
+	Type reflect.Type
+}
+
+func (e *ArgTypeError) Error() string {
+	return fmt.Sprintf(errArgTypeIsNotAllowedf, ErrArgTypeIsNotAllowed.Error(), e.Path, e.Type)
+}
+
+func (e *ArgTypeError) Unwrap() error {
+	return ErrArgTypeIsNotAllowed
+}
+
+type TooManyParametersError struct {
+	Dialect Dialect
+	Count   int
+	Max     int
+}
+
+func (e *TooManyParametersError) Error() string {
+	return fmt.Sprintf(errTooManyParametersf, ErrTooManyParameters.Error(), e.Count, e.Max, e.Dialect)
+}
+
+func (e *TooManyParametersError) Unwrap() error {
+	return ErrTooManyParameters
+}
+
+func validateValueKind(value interface{}, operator Operator) error {
+	var reflectValue reflect.Value
+
+	if _, ok := value.(driver.Valuer); ok {
+		return nil
+	}
+
+	reflectValue = reflect.ValueOf(value)
+	switch reflectValue.Kind() {
+	case reflect.Map, reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return &UnsupportedValueTypeError{Kind: reflectValue.Kind(), Operator: operator}
+	}
+
+	return nil
+}
+
+type FilterConditionError struct {
+	Column   string
+	Operator Operator
+	Err      error
+}
+
+func (e *FilterConditionError) Error() string {
+	var valueTypeErr *UnsupportedValueTypeError
+
+	if errors.As(e.Err, &valueTypeErr) && valueTypeErr.Operator != "" {
+		if e.Column == "" {
+			return e.Err.Error()
+		}
+
+		return fmt.Sprintf(errFilterConditionColumnf, e.Err.Error(), e.Column)
+	}
+
+	if e.Column == "" {
+		return fmt.Sprintf(errFilterOperatorf, e.Err.Error(), e.Operator)
+	}
+
+	return fmt.Sprintf(errFilterConditionf, e.Err.Error(), e.Column, e.Operator)
+}
+
+func (e *FilterConditionError) Unwrap() error {
+	return e.Err
+}
+
+type PathError struct {
+	Path string
+	Err  error
+}
+
+func (e *PathError) Error() string {
+	return fmt.Sprintf(errPathf, e.Err.Error(), e.Path)
+}
+
+func (e *PathError) Unwrap() error {
+	return e.Err
+}
+
+func wrapPath(path string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if pathErr, ok := err.(*PathError); ok {
+		return &PathError{Path: validationPath(path, pathErr.Path), Err: pathErr.Err}
+	}
+
+	return &PathError{Path: path, Err: err}
+}
+
+type ParseError struct {
+	Position int
+	Token    string
+	Err      error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf(errParsef, e.Err.Error(), e.Token, e.Position)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
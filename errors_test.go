@@ -0,0 +1,224 @@
+package goqube
+
+import (
+	"database/sql/driver"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type testValuerMap map[string]interface{}
+
+func (m testValuerMap) Value() (driver.Value, error) {
+	return "{}", nil
+}
+
+func TestUnsupportedValueTypeError_Error(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Error       *UnsupportedValueTypeError
+		Expectation string
+	} = []struct {
+		Name        string
+		Error       *UnsupportedValueTypeError
+		Expectation string
+	}{
+		{
+			Name:        "operator is empty",
+			Error:       &UnsupportedValueTypeError{Kind: reflect.Map},
+			Expectation: "unsupported map value type",
+		},
+		{
+			Name:        "operator is not empty",
+			Error:       &UnsupportedValueTypeError{Kind: reflect.Chan, Operator: OperatorIn},
+			Expectation: "unsupported chan value type for operator in",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = testCases[i].Error.Error()
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestArgTypeError_Error(t *testing.T) {
+	var (
+		err    *ArgTypeError
+		actual string
+	)
+
+	err = &ArgTypeError{Path: "where.value", Type: reflect.TypeOf(struct{}{})}
+	actual = err.Error()
+
+	if actual != "arg type is not allowed at where.value: struct {}" {
+		t.Errorf("expectation error is %s, got %s", "arg type is not allowed at where.value: struct {}", actual)
+	}
+
+	if !errors.Is(err, ErrArgTypeIsNotAllowed) {
+		t.Errorf("expectation error is %s, got %s", ErrArgTypeIsNotAllowed.Error(), actual)
+	}
+}
+
+func TestFilterConditionError_Error(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Error       *FilterConditionError
+		Expectation string
+		Is          error
+	} = []struct {
+		Name        string
+		Error       *FilterConditionError
+		Expectation string
+		Is          error
+	}{
+		{
+			Name:        "column and operator",
+			Error:       &FilterConditionError{Column: "users.deleted_at", Operator: OperatorIsNull, Err: ErrValueIsNotNil},
+			Expectation: "value is not nil: column users.deleted_at with operator is_null",
+			Is:          ErrValueIsNotNil,
+		},
+		{
+			Name:        "column is empty",
+			Error:       &FilterConditionError{Operator: OperatorEqual, Err: ErrComparisonWithNil},
+			Expectation: "comparison with nil, use is_null or is_not_null: operator equal",
+			Is:          ErrComparisonWithNil,
+		},
+		{
+			Name:        "unsupported value type with operator",
+			Error:       &FilterConditionError{Column: "age", Operator: OperatorLike, Err: &UnsupportedValueTypeError{Kind: reflect.Int, Operator: OperatorLike}},
+			Expectation: "unsupported int value type for operator like: column age",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = testCases[i].Error.Error()
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation, actual)
+			}
+
+			if testCases[i].Is != nil && !errors.Is(testCases[i].Error, testCases[i].Is) {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Is.Error(), actual)
+			}
+		})
+	}
+}
+
+func Test_validateValueKind(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Value       interface{}
+		Operator    Operator
+		Expectation error
+	} = []struct {
+		Name        string
+		Value       interface{}
+		Operator    Operator
+		Expectation error
+	}{
+		{
+			Name:        "value is nil",
+			Value:       nil,
+			Expectation: nil,
+		},
+		{
+			Name:        "value is string",
+			Value:       "value1",
+			Expectation: nil,
+		},
+		{
+			Name:        "value is map",
+			Value:       map[string]interface{}{},
+			Operator:    OperatorEqual,
+			Expectation: &UnsupportedValueTypeError{Kind: reflect.Map, Operator: OperatorEqual},
+		},
+		{
+			Name:        "value is func",
+			Value:       func() {},
+			Expectation: &UnsupportedValueTypeError{Kind: reflect.Func},
+		},
+		{
+			Name:        "value is map implementing driver valuer",
+			Value:       testValuerMap{},
+			Expectation: nil,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual error = validateValueKind(testCases[i].Value, testCases[i].Operator)
+
+			if testCases[i].Expectation != nil && actual == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation == nil && actual != nil {
+				t.Errorf("expectation error is nil, got %s", actual.Error())
+			}
+
+			if testCases[i].Expectation != nil && actual != nil && testCases[i].Expectation.Error() != actual.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Error(), actual.Error())
+			}
+		})
+	}
+}
+
+func TestPathError_Error(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Error       error
+		Expectation string
+	} = []struct {
+		Name        string
+		Error       error
+		Expectation string
+	}{
+		{
+			Name:        "single path",
+			Error:       wrapPath("Table", ErrNameIsRequired),
+			Expectation: "name is required: Table",
+		},
+		{
+			Name:        "nested path",
+			Error:       wrapPath("Joins[2]", wrapPath("Table", ErrNameIsRequired)),
+			Expectation: "name is required: Joins[2].Table",
+		},
+		{
+			Name:        "select query join table",
+			Error:       buildError(Select(NewField("id")).From(NewTable("users")).Join(InnerJoin(&Table{}).On(Eq("users.id", 1)))),
+			Expectation: "name is required: Joins[0].Table",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = testCases[i].Error.Error()
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation, actual)
+			}
+
+			if !errors.Is(testCases[i].Error, ErrNameIsRequired) {
+				t.Errorf("expectation error is %s, got %s", ErrNameIsRequired.Error(), actual)
+			}
+		})
+	}
+
+	if wrapPath("Table", nil) != nil {
+		t.Error("expectation error is nil, got not nil")
+	}
+}
+
+func buildError(query Query) error {
+	var err error
+
+	_, _, err = NewConfig(DialectPostgres).Build(query)
+
+	return err
+}
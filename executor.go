@@ -0,0 +1,531 @@
+package goqube
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+)
+
+type Executor struct {
+	DB             *sql.DB
+	Config         *Config
+	StmtCache      *StmtCache
+	Tracer         Tracer
+	SpanAttributes SpanAttributesFunc
+	tx             *sql.Tx
+	depth          int
+}
+
+func NewExecutor(db *sql.DB, dialect Dialect) *Executor {
+	return &Executor{
+		DB:     db,
+		Config: NewConfig(dialect),
+	}
+}
+
+func (e *Executor) SetConfig(config *Config) *Executor {
+	e.Config = config
+	return e
+}
+
+func (e *Executor) build(query Query) (string, []interface{}, error) {
+	if e.DB == nil && e.tx == nil {
+		return "", nil, ErrDBIsRequired
+	}
+
+	if e.Config == nil {
+		return "", nil, ErrConfigIsRequired
+	}
+
+	return e.Config.Build(query)
+}
+
+func (e *Executor) exec(ctx context.Context, query Query) (string, sql.Result, error) {
+	var (
+		sqlQuery string
+		args     []interface{}
+		result   sql.Result
+		err      error
+	)
+
+	sqlQuery, args, err = e.build(query)
+	if err != nil {
+		return "", nil, err
+	}
+
+	result, err = e.execContext(ctx, sqlQuery, args)
+
+	return sqlQuery, result, err
+}
+
+func (e *Executor) Exec(ctx context.Context, query Query) (sql.Result, error) {
+	var (
+		span         Span
+		sqlQuery     string
+		result       sql.Result
+		rowsAffected int64 = -1
+		err          error
+	)
+
+	ctx, span = e.startSpan(ctx, query)
+
+	sqlQuery, result, err = e.exec(ctx, query)
+	if span != nil && err == nil {
+		var rowsErr error
+
+		rowsAffected, rowsErr = result.RowsAffected()
+		if rowsErr != nil {
+			rowsAffected = -1
+		}
+	}
+
+	endSpan(span, sqlQuery, "db.rows_affected", rowsAffected, err)
+
+	return result, err
+}
+
+func (e *Executor) ExecInsert(ctx context.Context, insertQuery *InsertQuery) (sql.Result, error) {
+	if insertQuery == nil {
+		return nil, ErrQueryIsRequired
+	}
+
+	return e.Exec(ctx, insertQuery)
+}
+
+func (e *Executor) ExecUpdate(ctx context.Context, updateQuery *UpdateQuery) (sql.Result, error) {
+	if updateQuery == nil {
+		return nil, ErrQueryIsRequired
+	}
+
+	return e.Exec(ctx, updateQuery)
+}
+
+func (e *Executor) ExecDelete(ctx context.Context, deleteQuery *DeleteQuery) (sql.Result, error) {
+	if deleteQuery == nil {
+		return nil, ErrQueryIsRequired
+	}
+
+	return e.Exec(ctx, deleteQuery)
+}
+
+func (e *Executor) query(ctx context.Context, query Query, dest interface{}) (string, error) {
+	var (
+		sqlQuery string
+		args     []interface{}
+		rows     *sql.Rows
+		err      error
+	)
+
+	err = validateScanDestination(dest)
+	if err != nil {
+		return "", err
+	}
+
+	sqlQuery, args, err = e.build(query)
+	if err != nil {
+		return "", err
+	}
+
+	rows, err = e.queryContext(ctx, sqlQuery, args)
+	if err != nil {
+		return sqlQuery, err
+	}
+	defer rows.Close()
+
+	return sqlQuery, scanRows(rows, dest)
+}
+
+func (e *Executor) Query(ctx context.Context, query Query, dest interface{}) error {
+	var (
+		span     Span
+		sqlQuery string
+		rowCount int64 = -1
+		err      error
+	)
+
+	ctx, span = e.startSpan(ctx, query)
+
+	sqlQuery, err = e.query(ctx, query, dest)
+	if span != nil && err == nil {
+		rowCount = scannedRowCount(dest)
+	}
+
+	endSpan(span, sqlQuery, "db.rows", rowCount, err)
+
+	return err
+}
+
+func (e *Executor) QuerySelect(ctx context.Context, selectQuery *SelectQuery, dest interface{}) error {
+	if selectQuery == nil {
+		return ErrQueryIsRequired
+	}
+
+	return e.Query(ctx, selectQuery, dest)
+}
+
+func validateScanDestination(dest interface{}) error {
+	var reflectValue reflect.Value = reflect.ValueOf(dest)
+
+	if reflectValue.Kind() != reflect.Ptr || reflectValue.IsNil() {
+		return ErrDestinationIsInvalid
+	}
+
+	return nil
+}
+
+func isScanStruct(reflectType reflect.Type) bool {
+	var scannerType reflect.Type = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+
+	if reflectType.Kind() != reflect.Struct || reflectType == reflect.TypeOf(time.Time{}) {
+		return false
+	}
+
+	return !reflect.PtrTo(reflectType).Implements(scannerType)
+}
+
+func scanRows(rows *sql.Rows, dest interface{}) error {
+	var (
+		destValue   reflect.Value = reflect.ValueOf(dest).Elem()
+		columns     []string
+		isSlice     bool
+		elementType reflect.Type
+		err         error
+	)
+
+	columns, err = rows.Columns()
+	if err != nil {
+		return err
+	}
+
+	isSlice = destValue.Kind() == reflect.Slice && destValue.Type().Elem().Kind() != reflect.Uint8
+	elementType = destValue.Type()
+	if isSlice {
+		elementType = elementType.Elem()
+		destValue.Set(reflect.MakeSlice(destValue.Type(), 0, 0))
+	}
+
+	for rows.Next() {
+		var element reflect.Value = reflect.New(elementType).Elem()
+
+		err = scanRow(rows, columns, element)
+		if err != nil {
+			return err
+		}
+
+		if !isSlice {
+			destValue.Set(element)
+			return rows.Err()
+		}
+
+		destValue.Set(reflect.Append(destValue, element))
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return err
+	}
+
+	if !isSlice {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func scanRow(rows *sql.Rows, columns []string, element reflect.Value) error {
+	var (
+		target  reflect.Value = element
+		targets []interface{}
+		err     error
+	)
+
+	if target.Kind() == reflect.Ptr && isScanStruct(target.Type().Elem()) {
+		target.Set(reflect.New(target.Type().Elem()))
+		target = target.Elem()
+	}
+
+	if !isScanStruct(target.Type()) {
+		if len(columns) != 1 {
+			return ErrDestinationIsInvalid
+		}
+
+		return rows.Scan(target.Addr().Interface())
+	}
+
+	for _, column := range columns {
+		var field reflect.Value
+
+		field, err = structFieldByColumn(target, column)
+		if err != nil {
+			return err
+		}
+
+		targets = append(targets, field.Addr().Interface())
+	}
+
+	return rows.Scan(targets...)
+}
+
+func structFieldByColumn(reflectValue reflect.Value, column string) (reflect.Value, error) {
+	var reflectType reflect.Type = reflectValue.Type()
+
+	for i := 0; i < reflectType.NumField(); i++ {
+		var (
+			structField reflect.StructField = reflectType.Field(i)
+			fieldValue  reflect.Value       = reflectValue.Field(i)
+			tag         string
+			hasTag      bool
+		)
+
+		tag, hasTag = structField.Tag.Lookup(structTagName)
+
+		if structField.Anonymous && !hasTag {
+			if fieldValue.Kind() == reflect.Ptr && fieldValue.Type().Elem().Kind() == reflect.Struct {
+				if fieldValue.IsNil() {
+					if !fieldValue.CanSet() {
+						continue
+					}
+
+					fieldValue.Set(reflect.New(fieldValue.Type().Elem()))
+				}
+
+				fieldValue = fieldValue.Elem()
+			}
+
+			if fieldValue.Kind() == reflect.Struct {
+				if embeddedField, err := structFieldByColumn(fieldValue, column); err == nil {
+					return embeddedField, nil
+				}
+			}
+
+			continue
+		}
+
+		if structField.PkgPath != "" || !hasTag {
+			continue
+		}
+
+		if strings.Split(tag, ",")[0] == column {
+			return fieldValue, nil
+		}
+	}
+
+	return reflect.Value{}, fmt.Errorf(errColumnIsNotMappedf, ErrColumnIsNotMapped, column)
+}
+
+func (e *Executor) QueryChunked(ctx context.Context, chunkedSelect *ChunkedSelect) (*ChunkedRows, error) {
+	var (
+		statements []*Statement
+		err        error
+	)
+
+	if chunkedSelect == nil {
+		return nil, ErrQueryIsRequired
+	}
+
+	if e.DB == nil && e.tx == nil {
+		return nil, ErrDBIsRequired
+	}
+
+	if e.Config == nil {
+		return nil, ErrConfigIsRequired
+	}
+
+	statements, err = chunkedSelect.toStatements(newBuildContext(e.Config))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChunkedRows{
+		ctx:        ctx,
+		executor:   e,
+		statements: statements,
+	}, nil
+}
+
+func (e *Executor) BulkLoad(ctx context.Context, bulkLoad *BulkLoad, rows RowSource) (int64, error) {
+	var (
+		bc  *buildContext
+		err error
+	)
+
+	if bulkLoad == nil {
+		return 0, ErrQueryIsRequired
+	}
+
+	if e.DB == nil && e.tx == nil {
+		return 0, ErrDBIsRequired
+	}
+
+	if e.Config == nil {
+		return 0, ErrConfigIsRequired
+	}
+
+	if rows == nil {
+		return 0, ErrRowSourceIsRequired
+	}
+
+	bc = newBuildContext(e.Config)
+
+	err = bulkLoad.validate(bc.dialect)
+	if err != nil {
+		return 0, err
+	}
+
+	if bulkLoad.Mode == BulkLoadModeNative {
+		if bc.dialect != DialectPostgres {
+			return 0, ErrUnsupportedBulkLoad
+		}
+
+		return e.copyIn(ctx, bc, bulkLoad, rows)
+	}
+
+	return e.bulkInsert(ctx, bc, bulkLoad, rows)
+}
+
+func (e *Executor) copyIn(ctx context.Context, bc *buildContext, bulkLoad *BulkLoad, rows RowSource) (int64, error) {
+	var (
+		sqlQuery string
+		tx       *sql.Tx
+		count    int64
+		err      error
+	)
+
+	sqlQuery, err = bulkLoad.toSQL(bc)
+	if err != nil {
+		return 0, err
+	}
+
+	if e.tx != nil {
+		return copyInTx(ctx, e.tx, sqlQuery, bulkLoad, rows)
+	}
+
+	tx, err = e.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err = copyInTx(ctx, tx, sqlQuery, bulkLoad, rows)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func copyInTx(ctx context.Context, tx *sql.Tx, sqlQuery string, bulkLoad *BulkLoad, rows RowSource) (int64, error) {
+	var (
+		stmt  *sql.Stmt
+		count int64
+		err   error
+	)
+
+	stmt, err = tx.PrepareContext(ctx, sqlQuery)
+	if err != nil {
+		return 0, err
+	}
+	defer stmt.Close()
+
+	for {
+		var values []interface{}
+
+		values, err = rows()
+		if err == io.EOF {
+			break
+		}
+
+		if err == nil && len(values) != len(bulkLoad.Columns) {
+			err = ErrValueLengthIsNotEqualToFieldsLength
+		}
+
+		if err == nil {
+			_, err = stmt.ExecContext(ctx, values...)
+		}
+
+		if err != nil {
+			return 0, err
+		}
+
+		count++
+	}
+
+	_, err = stmt.ExecContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (e *Executor) execBulkInsert(ctx context.Context, bulkLoad *BulkLoad, batch [][]interface{}) (int64, error) {
+	var (
+		result sql.Result
+		err    error
+	)
+
+	if len(batch) == 0 {
+		return 0, nil
+	}
+
+	result, err = e.Exec(ctx, bulkLoad.insertQuery(batch))
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+func (e *Executor) bulkInsert(ctx context.Context, bc *buildContext, bulkLoad *BulkLoad, rows RowSource) (int64, error) {
+	var (
+		batchSize int = dialectMaxParameters[bc.dialect] / len(bulkLoad.Columns)
+		batch     [][]interface{}
+		count     int64
+		affected  int64
+		err       error
+	)
+
+	if batchSize <= 0 {
+		return 0, ErrParameterLimitIsExceeded
+	}
+
+	for {
+		var values []interface{}
+
+		values, err = rows()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return count, err
+		}
+
+		batch = append(batch, values)
+		if len(batch) < batchSize {
+			continue
+		}
+
+		affected, err = e.execBulkInsert(ctx, bulkLoad, batch)
+		count += affected
+		if err != nil {
+			return count, err
+		}
+
+		batch = batch[:0]
+	}
+
+	affected, err = e.execBulkInsert(ctx, bulkLoad, batch)
+	count += affected
+
+	return count, err
+}
@@ -0,0 +1,737 @@
+package goqube
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+type fakeDriverResult struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+type fakeDriver struct {
+	mu       sync.Mutex
+	queries  []string
+	args     [][]interface{}
+	prepares []string
+	closes   int
+	result   *fakeDriverResult
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) {
+	return &fakeDriverConn{driver: d}, nil
+}
+
+func (d *fakeDriver) record(query string, args []driver.NamedValue) {
+	var values []interface{} = []interface{}{}
+
+	for i := range args {
+		values = append(values, args[i].Value)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.queries = append(d.queries, query)
+	d.args = append(d.args, values)
+}
+
+type fakeDriverConn struct {
+	driver *fakeDriver
+}
+
+func (c *fakeDriverConn) Prepare(query string) (driver.Stmt, error) {
+	c.driver.mu.Lock()
+	c.driver.prepares = append(c.driver.prepares, query)
+	c.driver.mu.Unlock()
+
+	return &fakeDriverStmt{driver: c.driver, query: query}, nil
+}
+
+func (c *fakeDriverConn) Close() error {
+	return nil
+}
+
+func (c *fakeDriverConn) Begin() (driver.Tx, error) {
+	c.driver.record("begin", nil)
+	return &fakeDriverTx{driver: c.driver}, nil
+}
+
+func (c *fakeDriverConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.driver.record(query, args)
+	return driver.RowsAffected(1), nil
+}
+
+func (c *fakeDriverConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.driver.record(query, args)
+	return &fakeDriverRows{result: c.driver.result}, nil
+}
+
+type fakeDriverTx struct {
+	driver *fakeDriver
+}
+
+func (tx *fakeDriverTx) Commit() error {
+	tx.driver.record("commit", nil)
+	return nil
+}
+
+func (tx *fakeDriverTx) Rollback() error {
+	tx.driver.record("rollback", nil)
+	return nil
+}
+
+type fakeDriverStmt struct {
+	driver *fakeDriver
+	query  string
+}
+
+func (s *fakeDriverStmt) Close() error {
+	s.driver.mu.Lock()
+	s.driver.closes++
+	s.driver.mu.Unlock()
+
+	return nil
+}
+
+func (s *fakeDriverStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeDriverStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, errors.New("exec is not supported")
+}
+
+func (s *fakeDriverStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return nil, errors.New("query is not supported")
+}
+
+func (s *fakeDriverStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	s.driver.record(s.query, args)
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeDriverStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	s.driver.record(s.query, args)
+	return &fakeDriverRows{result: s.driver.result}, nil
+}
+
+type fakeDriverRows struct {
+	result *fakeDriverResult
+	index  int
+}
+
+func (r *fakeDriverRows) Columns() []string {
+	return r.result.columns
+}
+
+func (r *fakeDriverRows) Close() error {
+	return nil
+}
+
+func (r *fakeDriverRows) Next(dest []driver.Value) error {
+	if r.index >= len(r.result.rows) {
+		return io.EOF
+	}
+
+	copy(dest, r.result.rows[r.index])
+	r.index++
+
+	return nil
+}
+
+var (
+	fakeDriverOnce     sync.Once
+	fakeDriverInstance *fakeDriver = &fakeDriver{}
+)
+
+func openFakeDB(t *testing.T, result *fakeDriverResult) *sql.DB {
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	fakeDriverOnce.Do(func() {
+		sql.Register("goqube_fake", fakeDriverInstance)
+	})
+
+	fakeDriverInstance.mu.Lock()
+	fakeDriverInstance.queries = nil
+	fakeDriverInstance.args = nil
+	fakeDriverInstance.prepares = nil
+	fakeDriverInstance.closes = 0
+	fakeDriverInstance.result = result
+	fakeDriverInstance.mu.Unlock()
+
+	db, err = sql.Open("goqube_fake", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	return db
+}
+
+type executorTestAudit struct {
+	CreatedBy string `db:"created_by"`
+}
+
+type executorTestUser struct {
+	executorTestAudit
+	ID     int64  `db:"id"`
+	Name   string `db:"name"`
+	Secret string
+}
+
+func TestExecutor_NewExecutor(t *testing.T) {
+	var (
+		db     *sql.DB = &sql.DB{}
+		config *Config = NewConfig(DialectMySQL)
+		actual *Executor
+	)
+
+	actual = NewExecutor(db, DialectPostgres)
+
+	if actual.DB != db {
+		t.Errorf("expectation db is %p, got %p", db, actual.DB)
+	}
+
+	if actual.Config.Dialect != DialectPostgres {
+		t.Errorf("expectation dialect is %s, got %s", DialectPostgres, actual.Config.Dialect)
+	}
+
+	actual.SetConfig(config)
+
+	if actual.Config != config {
+		t.Errorf("expectation config is %+v, got %+v", config, actual.Config)
+	}
+}
+
+func TestExecutor_Exec(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Executor    func(t *testing.T) *Executor
+		Exec        func(e *Executor) (sql.Result, error)
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Executor    func(t *testing.T) *Executor
+		Exec        func(e *Executor) (sql.Result, error)
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name: "db is nil",
+			Executor: func(t *testing.T) *Executor {
+				return NewExecutor(nil, DialectPostgres)
+			},
+			Exec: func(e *Executor) (sql.Result, error) {
+				return e.ExecDelete(context.Background(), DeleteFrom("users").Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))))
+			},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Err: ErrDBIsRequired,
+			},
+		},
+		{
+			Name: "config is nil",
+			Executor: func(t *testing.T) *Executor {
+				return NewExecutor(openFakeDB(t, nil), DialectPostgres).SetConfig(nil)
+			},
+			Exec: func(e *Executor) (sql.Result, error) {
+				return e.Exec(context.Background(), InsertInto("users").Value("name", "name1"))
+			},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Err: ErrConfigIsRequired,
+			},
+		},
+		{
+			Name: "query is nil",
+			Executor: func(t *testing.T) *Executor {
+				return NewExecutor(openFakeDB(t, nil), DialectPostgres)
+			},
+			Exec: func(e *Executor) (sql.Result, error) {
+				return e.ExecUpdate(context.Background(), nil)
+			},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Err: ErrQueryIsRequired,
+			},
+		},
+		{
+			Name: "query is invalid",
+			Executor: func(t *testing.T) *Executor {
+				return NewExecutor(openFakeDB(t, nil), DialectPostgres)
+			},
+			Exec: func(e *Executor) (sql.Result, error) {
+				return e.ExecInsert(context.Background(), InsertInto(""))
+			},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Err: ErrTableIsRequired,
+			},
+		},
+		{
+			Name: "exec insert",
+			Executor: func(t *testing.T) *Executor {
+				return NewExecutor(openFakeDB(t, nil), DialectPostgres)
+			},
+			Exec: func(e *Executor) (sql.Result, error) {
+				return e.ExecInsert(context.Background(), InsertInto("users").Value("name", "name1"))
+			},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into users(name) values ($1)",
+				Args:  []interface{}{"name1"},
+			},
+		},
+		{
+			Name: "exec update",
+			Executor: func(t *testing.T) *Executor {
+				return NewExecutor(openFakeDB(t, nil), DialectMySQL)
+			},
+			Exec: func(e *Executor) (sql.Result, error) {
+				return e.ExecUpdate(context.Background(), Update("users").Set("name", "name1").Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(int64(1)))))
+			},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update users set name = ? where id = ?",
+				Args:  []interface{}{"name1", int64(1)},
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				executor  *Executor
+				actualErr error
+			)
+
+			executor = testCases[i].Executor(t)
+			_, actualErr = testCases[i].Exec(executor)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query == "" {
+				return
+			}
+
+			if len(fakeDriverInstance.queries) != 1 || fakeDriverInstance.queries[0] != testCases[i].Expectation.Query {
+				t.Errorf("expectation query is %s, got %v", testCases[i].Expectation.Query, fakeDriverInstance.queries)
+			}
+
+			if len(fakeDriverInstance.args) != 1 || !reflect.DeepEqual(testCases[i].Expectation.Args, fakeDriverInstance.args[0]) {
+				t.Errorf("expectation args is %v, got %v", testCases[i].Expectation.Args, fakeDriverInstance.args)
+			}
+		})
+	}
+}
+
+func TestExecutor_QuerySelect(t *testing.T) {
+	var (
+		selectQuery *SelectQuery = Select(NewField("id"), NewField("name"), NewField("created_by")).
+				From(NewTable("users")).
+				Where(NewFilter().SetCondition(NewField("name"), OperatorNotEqual, NewFilterValue("")))
+		result *fakeDriverResult = &fakeDriverResult{
+			columns: []string{"id", "name", "created_by"},
+			rows: [][]driver.Value{
+				{int64(1), "name1", "admin"},
+				{int64(2), "name2", "system"},
+			},
+		}
+		testCases []struct {
+			Name        string
+			Result      *fakeDriverResult
+			Query       *SelectQuery
+			Dest        func() interface{}
+			Expectation struct {
+				Dest interface{}
+				Err  error
+			}
+		}
+	)
+
+	testCases = []struct {
+		Name        string
+		Result      *fakeDriverResult
+		Query       *SelectQuery
+		Dest        func() interface{}
+		Expectation struct {
+			Dest interface{}
+			Err  error
+		}
+	}{
+		{
+			Name:   "query is nil",
+			Result: result,
+			Query:  nil,
+			Dest:   func() interface{} { return &[]executorTestUser{} },
+			Expectation: struct {
+				Dest interface{}
+				Err  error
+			}{
+				Dest: &[]executorTestUser{},
+				Err:  ErrQueryIsRequired,
+			},
+		},
+		{
+			Name:   "destination is not a pointer",
+			Result: result,
+			Query:  selectQuery,
+			Dest:   func() interface{} { return []executorTestUser{} },
+			Expectation: struct {
+				Dest interface{}
+				Err  error
+			}{
+				Dest: []executorTestUser{},
+				Err:  ErrDestinationIsInvalid,
+			},
+		},
+		{
+			Name:   "slice of structs",
+			Result: result,
+			Query:  selectQuery,
+			Dest:   func() interface{} { return &[]executorTestUser{} },
+			Expectation: struct {
+				Dest interface{}
+				Err  error
+			}{
+				Dest: &[]executorTestUser{
+					{executorTestAudit: executorTestAudit{CreatedBy: "admin"}, ID: 1, Name: "name1"},
+					{executorTestAudit: executorTestAudit{CreatedBy: "system"}, ID: 2, Name: "name2"},
+				},
+				Err: nil,
+			},
+		},
+		{
+			Name:   "slice of struct pointers",
+			Result: result,
+			Query:  selectQuery,
+			Dest:   func() interface{} { return &[]*executorTestUser{} },
+			Expectation: struct {
+				Dest interface{}
+				Err  error
+			}{
+				Dest: &[]*executorTestUser{
+					{executorTestAudit: executorTestAudit{CreatedBy: "admin"}, ID: 1, Name: "name1"},
+					{executorTestAudit: executorTestAudit{CreatedBy: "system"}, ID: 2, Name: "name2"},
+				},
+				Err: nil,
+			},
+		},
+		{
+			Name:   "single struct",
+			Result: result,
+			Query:  selectQuery,
+			Dest:   func() interface{} { return &executorTestUser{} },
+			Expectation: struct {
+				Dest interface{}
+				Err  error
+			}{
+				Dest: &executorTestUser{executorTestAudit: executorTestAudit{CreatedBy: "admin"}, ID: 1, Name: "name1"},
+				Err:  nil,
+			},
+		},
+		{
+			Name:   "single struct without rows",
+			Result: &fakeDriverResult{columns: result.columns},
+			Query:  selectQuery,
+			Dest:   func() interface{} { return &executorTestUser{} },
+			Expectation: struct {
+				Dest interface{}
+				Err  error
+			}{
+				Dest: &executorTestUser{},
+				Err:  sql.ErrNoRows,
+			},
+		},
+		{
+			Name: "slice of scalars",
+			Result: &fakeDriverResult{
+				columns: []string{"name"},
+				rows:    [][]driver.Value{{"name1"}, {"name2"}},
+			},
+			Query: Select(NewField("name")).From(NewTable("users")),
+			Dest:  func() interface{} { return &[]string{} },
+			Expectation: struct {
+				Dest interface{}
+				Err  error
+			}{
+				Dest: &[]string{"name1", "name2"},
+				Err:  nil,
+			},
+		},
+		{
+			Name:   "scalar with multiple columns",
+			Result: result,
+			Query:  selectQuery,
+			Dest:   func() interface{} { var count int64; return &count },
+			Expectation: struct {
+				Dest interface{}
+				Err  error
+			}{
+				Dest: func() *int64 { var count int64; return &count }(),
+				Err:  ErrDestinationIsInvalid,
+			},
+		},
+		{
+			Name: "column is not mapped",
+			Result: &fakeDriverResult{
+				columns: []string{"id", "email"},
+				rows:    [][]driver.Value{{int64(1), "name1@example.com"}},
+			},
+			Query: Select(NewField("id"), NewField("email")).From(NewTable("users")),
+			Dest:  func() interface{} { return &[]executorTestUser{} },
+			Expectation: struct {
+				Dest interface{}
+				Err  error
+			}{
+				Dest: &[]executorTestUser{},
+				Err:  errors.New("column is not mapped to destination: email"),
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				executor   *Executor
+				actualDest interface{}
+				actualErr  error
+			)
+
+			executor = NewExecutor(openFakeDB(t, testCases[i].Result), DialectPostgres)
+			actualDest = testCases[i].Dest()
+			actualErr = executor.QuerySelect(context.Background(), testCases[i].Query, actualDest)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Err == nil && !reflect.DeepEqual(testCases[i].Expectation.Dest, actualDest) {
+				t.Errorf("expectation dest is %+v, got %+v", testCases[i].Expectation.Dest, actualDest)
+			}
+		})
+	}
+}
+
+func TestExecutor_QueryChunked(t *testing.T) {
+	var (
+		db       *sql.DB
+		executor *Executor
+		rows     *ChunkedRows
+		actual   []executorTestUser
+		err      error
+	)
+
+	db = openFakeDB(t, &fakeDriverResult{
+		columns: []string{"id", "name"},
+		rows:    [][]driver.Value{{int64(1), "name1"}},
+	})
+	executor = NewExecutor(db, DialectPostgres)
+
+	_, err = executor.QueryChunked(context.Background(), nil)
+	if err != ErrQueryIsRequired {
+		t.Errorf("expectation error is %v, got %v", ErrQueryIsRequired, err)
+	}
+
+	rows, err = executor.QueryChunked(context.Background(), NewChunkedSelect(Select(NewField("id"), NewField("name")).From(NewTable("users"))).ChunkBy(NewField("id"), []int{3, 1, 2}, 2))
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %s", err.Error())
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var user executorTestUser
+
+		err = rows.Scan(&user)
+		if err != nil {
+			t.Fatalf("expectation error is nil, got %s", err.Error())
+		}
+
+		actual = append(actual, user)
+	}
+
+	if rows.Err() != nil {
+		t.Errorf("expectation error is nil, got %s", rows.Err().Error())
+	}
+
+	if !deepEqual([]executorTestUser{{ID: 1, Name: "name1"}, {ID: 1, Name: "name1"}}, actual) {
+		t.Errorf("expectation rows is %+v, got %+v", []executorTestUser{{ID: 1, Name: "name1"}, {ID: 1, Name: "name1"}}, actual)
+	}
+
+	if !deepEqual([]string{"select id, name from users where id in ($1, $2) order by id asc", "select id, name from users where id in ($1) order by id asc"}, fakeDriverInstance.queries) {
+		t.Errorf("expectation queries is %+v, got %+v", []string{"select id, name from users where id in ($1, $2) order by id asc", "select id, name from users where id in ($1) order by id asc"}, fakeDriverInstance.queries)
+	}
+}
+
+func TestExecutor_QueryChunkedWithConfigAndTx(t *testing.T) {
+	var (
+		db       *sql.DB
+		tx       *sql.Tx
+		executor *Executor
+		rows     *ChunkedRows
+		err      error
+	)
+
+	db = openFakeDB(t, &fakeDriverResult{
+		columns: []string{"id", "name"},
+		rows:    [][]driver.Value{{int64(1), "name1"}},
+	})
+
+	tx, err = db.Begin()
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %s", err.Error())
+	}
+
+	executor = NewExecutor(db, DialectPostgres).
+		SetConfig(NewConfig(DialectPostgres).SetSoftDelete(NewSoftDelete("deleted_at")).SetLimits(NewLimits().SetMaxInListSize(1))).
+		WithTx(tx)
+
+	rows, err = executor.QueryChunked(context.Background(), NewChunkedSelect(Select(NewField("id"), NewField("name")).From(NewTable("users"))).ChunkBy(NewField("id"), []int{2, 1}, 10))
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %s", err.Error())
+	}
+
+	for rows.Next() {
+	}
+
+	rows.Close()
+	tx.Commit()
+
+	if rows.Err() != nil {
+		t.Errorf("expectation error is nil, got %s", rows.Err().Error())
+	}
+
+	if !deepEqual([]string{
+		"begin",
+		"select id, name from users where id in ($1) and deleted_at is null order by id asc",
+		"select id, name from users where id in ($1) and deleted_at is null order by id asc",
+		"commit",
+	}, fakeDriverInstance.queries) {
+		t.Errorf("expectation queries is chunked in tx with soft delete, got %+v", fakeDriverInstance.queries)
+	}
+}
+
+func TestExecutor_BulkLoad(t *testing.T) {
+	var (
+		db       *sql.DB
+		executor *Executor
+		actual   int64
+		err      error
+	)
+
+	db = openFakeDB(t, nil)
+	executor = NewExecutor(db, DialectMySQL)
+
+	_, err = executor.BulkLoad(context.Background(), nil, RowsOf(nil))
+	if err != ErrQueryIsRequired {
+		t.Errorf("expectation error is %v, got %v", ErrQueryIsRequired, err)
+	}
+
+	_, err = executor.BulkLoad(context.Background(), NewBulkLoad("users", "name"), nil)
+	if err != ErrRowSourceIsRequired {
+		t.Errorf("expectation error is %v, got %v", ErrRowSourceIsRequired, err)
+	}
+
+	_, err = executor.BulkLoad(context.Background(), NewBulkLoad("users", "name").SetMode(BulkLoadModeNative), RowsOf(nil))
+	if err != ErrUnsupportedBulkLoad {
+		t.Errorf("expectation error is %v, got %v", ErrUnsupportedBulkLoad, err)
+	}
+
+	actual, err = executor.BulkLoad(context.Background(), NewBulkLoad("users", "name", "age"), RowsOf([][]interface{}{{"a", 1}, {"b", 2}}))
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %s", err.Error())
+	}
+
+	if actual != 1 {
+		t.Errorf("expectation rows affected is %d, got %d", 1, actual)
+	}
+
+	if !deepEqual([]string{"insert into users(name, age) values (?, ?), (?, ?)"}, fakeDriverInstance.queries) {
+		t.Errorf("expectation queries is %+v, got %+v", []string{"insert into users(name, age) values (?, ?), (?, ?)"}, fakeDriverInstance.queries)
+	}
+
+	db = openFakeDB(t, nil)
+	executor = NewExecutor(db, DialectPostgres)
+
+	actual, err = executor.BulkLoad(context.Background(), NewBulkLoad("users", "name", "age").SetMode(BulkLoadModeNative), RowsOf([][]interface{}{{"a", 1}, {"b", 2}}))
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %s", err.Error())
+	}
+
+	if actual != 2 {
+		t.Errorf("expectation rows loaded is %d, got %d", 2, actual)
+	}
+
+	if !deepEqual([]string{"begin", "copy users (name, age) from stdin", "copy users (name, age) from stdin", "copy users (name, age) from stdin", "commit"}, fakeDriverInstance.queries) {
+		t.Errorf("expectation queries is %+v, got %+v", []string{"begin", "copy users (name, age) from stdin", "copy users (name, age) from stdin", "copy users (name, age) from stdin", "commit"}, fakeDriverInstance.queries)
+	}
+
+	db = openFakeDB(t, nil)
+	executor = NewExecutor(db, DialectPostgres)
+
+	_, err = executor.BulkLoad(context.Background(), NewBulkLoad("users", "name", "age").SetMode(BulkLoadModeNative), RowsOf([][]interface{}{{"a"}}))
+	if err != ErrValueLengthIsNotEqualToFieldsLength {
+		t.Errorf("expectation error is %v, got %v", ErrValueLengthIsNotEqualToFieldsLength, err)
+	}
+
+	if !deepEqual([]string{"begin", "rollback"}, fakeDriverInstance.queries) {
+		t.Errorf("expectation queries is %+v, got %+v", []string{"begin", "rollback"}, fakeDriverInstance.queries)
+	}
+}
@@ -0,0 +1,120 @@
+package goqube
+
+import (
+	"fmt"
+)
+
+type ExportPlan struct {
+	Native   *Statement
+	Fallback *Statement
+	Columns  []string
+}
+
+type Export struct {
+	SelectQuery *SelectQuery
+	Header      bool
+	OutFile     string
+}
+
+func NewExport(selectQuery *SelectQuery) *Export {
+	return &Export{
+		SelectQuery: selectQuery,
+	}
+}
+
+func (e *Export) WithHeader() *Export {
+	e.Header = true
+	return e
+}
+
+func (e *Export) IntoOutFile(path string) *Export {
+	e.OutFile = path
+	return e
+}
+
+func (e *Export) validate(dialect Dialect) error {
+	if dialect == "" {
+		return ErrDialectIsRequired
+	}
+
+	if e.SelectQuery == nil {
+		return ErrQueryIsRequired
+	}
+
+	return nil
+}
+
+func (e *Export) columns() []string {
+	var columns []string = []string{}
+
+	for i := range e.SelectQuery.Fields {
+		if e.SelectQuery.Fields[i] == nil {
+			continue
+		}
+
+		if e.SelectQuery.Fields[i].Alias != "" {
+			columns = append(columns, e.SelectQuery.Fields[i].Alias)
+			continue
+		}
+
+		columns = append(columns, e.SelectQuery.Fields[i].Column)
+	}
+
+	return columns
+}
+
+func (e *Export) nativeQuery(dialect Dialect, query string) string {
+	switch dialect {
+	case DialectPostgres:
+		return fmt.Sprintf("copy (%s) to stdout with (format csv, header %t)", query, e.Header)
+	case DialectMySQL:
+		return fmt.Sprintf(
+			`%s into outfile '%s' fields terminated by ',' optionally enclosed by '"' lines terminated by '\n'`,
+			query,
+			mysqlStringLiteralReplacer.Replace(e.OutFile),
+		)
+	}
+
+	return ""
+}
+
+func (e *Export) canExportNatively(dialect Dialect, args []interface{}) bool {
+	switch dialect {
+	case DialectPostgres:
+		return len(args) == 0
+	case DialectMySQL:
+		return e.OutFile != "" && !e.Header
+	}
+
+	return false
+}
+
+func (e *Export) ToPlan(dialect Dialect) (*ExportPlan, error) {
+	var (
+		query string
+		args  []interface{}
+		plan  *ExportPlan
+		err   error
+	)
+
+	err = e.validate(dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err = e.SelectQuery.ToSQLWithArgs(dialect, []interface{}{})
+	if err != nil {
+		return nil, err
+	}
+
+	plan = &ExportPlan{
+		Fallback: &Statement{Query: query, Args: args},
+		Columns:  e.columns(),
+	}
+
+	if e.canExportNatively(dialect, args) {
+		plan.Native = &Statement{Query: e.nativeQuery(dialect, query), Args: args}
+	}
+
+	return plan, nil
+}
@@ -0,0 +1,244 @@
+package goqube
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestExport_NewExport(t *testing.T) {
+	var (
+		selectQuery *SelectQuery
+		actual      *Export
+	)
+
+	selectQuery = Select(NewField("id")).From(NewTable("table1"))
+	actual = NewExport(selectQuery).WithHeader().IntoOutFile("/tmp/table1.csv")
+
+	if actual.SelectQuery != selectQuery {
+		t.Errorf("expectation select query is %+v, got %+v", selectQuery, actual.SelectQuery)
+	}
+
+	if !actual.Header {
+		t.Errorf("expectation header is %t, got %t", true, actual.Header)
+	}
+
+	if actual.OutFile != "/tmp/table1.csv" {
+		t.Errorf("expectation out file is %s, got %s", "/tmp/table1.csv", actual.OutFile)
+	}
+}
+
+func TestExport_ToPlan(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Export      *Export
+		Dialect     Dialect
+		Expectation struct {
+			Plan *ExportPlan
+			Err  error
+		}
+	} = []struct {
+		Name        string
+		Export      *Export
+		Dialect     Dialect
+		Expectation struct {
+			Plan *ExportPlan
+			Err  error
+		}
+	}{
+		{
+			Name:    "dialect is empty",
+			Export:  NewExport(Select(NewField("id")).From(NewTable("table1"))),
+			Dialect: "",
+			Expectation: struct {
+				Plan *ExportPlan
+				Err  error
+			}{
+				Plan: nil,
+				Err:  ErrDialectIsRequired,
+			},
+		},
+		{
+			Name:    "select query is nil",
+			Export:  NewExport(nil),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Plan *ExportPlan
+				Err  error
+			}{
+				Plan: nil,
+				Err:  ErrQueryIsRequired,
+			},
+		},
+		{
+			Name:    "select query is invalid",
+			Export:  NewExport(Select(NewField("id"))),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Plan *ExportPlan
+				Err  error
+			}{
+				Plan: nil,
+				Err:  ErrTableIsRequired,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s without args", DialectPostgres),
+			Export:  NewExport(Select(NewField("id"), NewField("name").As("customer name")).From(NewTable("customers"))).WithHeader(),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Plan *ExportPlan
+				Err  error
+			}{
+				Plan: &ExportPlan{
+					Native: &Statement{
+						Query: `copy (select id, name as "customer name" from customers) to stdout with (format csv, header true)`,
+						Args:  []interface{}{},
+					},
+					Fallback: &Statement{
+						Query: `select id, name as "customer name" from customers`,
+						Args:  []interface{}{},
+					},
+					Columns: []string{"id", "customer name"},
+				},
+				Err: nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with args", DialectPostgres),
+			Export: NewExport(
+				Select(NewField("id")).
+					From(NewTable("customers")).
+					Where(NewFilter().SetCondition(NewField("status"), OperatorEqual, NewFilterValue("active"))),
+			),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Plan *ExportPlan
+				Err  error
+			}{
+				Plan: &ExportPlan{
+					Native: nil,
+					Fallback: &Statement{
+						Query: "select id from customers where status = $1",
+						Args:  []interface{}{"active"},
+					},
+					Columns: []string{"id"},
+				},
+				Err: nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with out file", DialectMySQL),
+			Export: NewExport(
+				Select(NewField("id")).
+					From(NewTable("customers")).
+					Where(NewFilter().SetCondition(NewField("status"), OperatorEqual, NewFilterValue("active"))),
+			).
+				IntoOutFile("/var/lib/mysql-files/customer's.csv"),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Plan *ExportPlan
+				Err  error
+			}{
+				Plan: &ExportPlan{
+					Native: &Statement{
+						Query: `select id from customers where status = ? into outfile '/var/lib/mysql-files/customer''s.csv' fields terminated by ',' optionally enclosed by '"' lines terminated by '\n'`,
+						Args:  []interface{}{"active"},
+					},
+					Fallback: &Statement{
+						Query: "select id from customers where status = ?",
+						Args:  []interface{}{"active"},
+					},
+					Columns: []string{"id"},
+				},
+				Err: nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s with backslash in out file", DialectMySQL),
+			Export:  NewExport(Select(NewField("id")).From(NewTable("customers"))).IntoOutFile(`/tmp/a\' from x; drop table users; -- .csv`),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Plan *ExportPlan
+				Err  error
+			}{
+				Plan: &ExportPlan{
+					Native: &Statement{
+						Query: `select id from customers into outfile '/tmp/a\\'' from x; drop table users; -- .csv' fields terminated by ',' optionally enclosed by '"' lines terminated by '\n'`,
+						Args:  []interface{}{},
+					},
+					Fallback: &Statement{
+						Query: "select id from customers",
+						Args:  []interface{}{},
+					},
+					Columns: []string{"id"},
+				},
+				Err: nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s with header", DialectMySQL),
+			Export:  NewExport(Select(NewField("id")).From(NewTable("customers"))).IntoOutFile("/tmp/customers.csv").WithHeader(),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Plan *ExportPlan
+				Err  error
+			}{
+				Plan: &ExportPlan{
+					Native: nil,
+					Fallback: &Statement{
+						Query: "select id from customers",
+						Args:  []interface{}{},
+					},
+					Columns: []string{"id"},
+				},
+				Err: nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s without out file", DialectMySQL),
+			Export:  NewExport(Select(NewField("id")).From(NewTable("customers"))),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Plan *ExportPlan
+				Err  error
+			}{
+				Plan: &ExportPlan{
+					Native: nil,
+					Fallback: &Statement{
+						Query: "select id from customers",
+						Args:  []interface{}{},
+					},
+					Columns: []string{"id"},
+				},
+				Err: nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualPlan *ExportPlan
+				actualErr  error
+			)
+
+			actualPlan, actualErr = testCases[i].Export.ToPlan(testCases[i].Dialect)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if !deepEqual(testCases[i].Expectation.Plan, actualPlan) {
+				t.Errorf("expectation plan is %+v, got %+v", testCases[i].Expectation.Plan, actualPlan)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package goqube
+
+import "bytes"
+
+type Expression struct {
+	Operator ExpressionOperator
+	Function string
+	DateTime *DateTimeExpression
+	Case     *CaseExpression
+	Operands []interface{}
+}
+
+func NewArithmeticExpression(operator ExpressionOperator, operands ...interface{}) *Expression {
+	return &Expression{
+		Operator: operator,
+		Operands: operands,
+	}
+}
+
+func NewFunctionExpression(function string, operands ...interface{}) *Expression {
+	return &Expression{
+		Function: function,
+		Operands: operands,
+	}
+}
+
+func NewExpressionField(expression *Expression) *Field {
+	return &Field{
+		Expression: expression,
+	}
+}
+
+func isValidFunctionName(function string) bool {
+	return isValidIdentifier(function)
+}
+
+func (e *Expression) validate(dialect Dialect) error {
+	if dialect == "" {
+		return ErrDialectIsRequired
+	}
+
+	if e.Operator != "" && e.Function != "" || e.DateTime != nil && (e.Operator != "" || e.Function != "") {
+		return ErrConflictExpressionOperatorAndFunction
+	}
+
+	if e.Case != nil && (e.Operator != "" || e.Function != "" || e.DateTime != nil) {
+		return ErrConflictExpressionOperatorAndFunction
+	}
+
+	if e.DateTime != nil {
+		return e.DateTime.validate(e.Operands)
+	}
+
+	if e.Case != nil {
+		return e.Case.validate(e.Operands)
+	}
+
+	if e.Operator == "" && e.Function == "" {
+		return ErrExpressionOperatorIsInvalid
+	}
+
+	if e.Function != "" && !isValidFunctionName(e.Function) {
+		return ErrFunctionIsInvalid
+	}
+
+	if e.Operator != "" {
+		if !containsString(expressionOperators, string(e.Operator)) {
+			return ErrExpressionOperatorIsInvalid
+		}
+
+		if len(e.Operands) < 2 {
+			return ErrOperandsIsRequired
+		}
+	}
+
+	return nil
+}
+
+func (e *Expression) referencesTable(qualifier string) bool {
+	if e.Case != nil && e.Case.referencesTable(qualifier) {
+		return true
+	}
+
+	for i := range e.Operands {
+		switch operand := e.Operands[i].(type) {
+		case *Field:
+			if operand != nil && (operand.Raw != nil || operand.Table == qualifier || operand.Expression != nil && operand.Expression.referencesTable(qualifier)) {
+				return true
+			}
+
+		case *Expression:
+			if operand != nil && operand.referencesTable(qualifier) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (e *Expression) toSQLWithArgs(bc *buildContext, args []interface{}) (string, []interface{}, error) {
+	var (
+		buffer *bytes.Buffer
+		err    error
+	)
+
+	err = e.validate(bc.dialect)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if e.DateTime != nil {
+		return e.dateTimeToSQLWithArgs(bc, args)
+	}
+
+	if e.Case != nil {
+		return e.caseToSQLWithArgs(bc, args)
+	}
+
+	buffer = getBuffer(32 * (len(e.Operands) + 1))
+	defer putBuffer(buffer)
+
+	if e.Function != "" {
+		buffer.WriteString(e.Function)
+		buffer.WriteByte('(')
+	}
+
+	for i := range e.Operands {
+		var operand string
+
+		if i > 0 && e.Function != "" {
+			buffer.WriteString(", ")
+		}
+
+		if i > 0 && e.Operator != "" {
+			buffer.WriteByte(' ')
+			buffer.WriteString(string(e.Operator))
+			buffer.WriteByte(' ')
+		}
+
+		operand, args, err = e.operandToSQLWithArgs(bc, e.Operands[i], args)
+		if err != nil {
+			return "", nil, err
+		}
+
+		buffer.WriteString(operand)
+	}
+
+	if e.Function != "" {
+		buffer.WriteByte(')')
+	}
+
+	return buffer.String(), args, nil
+}
+
+func (e *Expression) dateTimeToSQLWithArgs(bc *buildContext, args []interface{}) (string, []interface{}, error) {
+	var (
+		operands []string = make([]string, len(e.Operands))
+		err      error
+	)
+
+	for i := range e.Operands {
+		operands[i], args, err = e.operandToSQLWithArgs(bc, e.Operands[i], args)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	return e.DateTime.toSQL(bc.dialect, operands), args, nil
+}
+
+func (e *Expression) operandToSQLWithArgs(bc *buildContext, operand interface{}, args []interface{}) (string, []interface{}, error) {
+	var (
+		sql string
+		err error
+	)
+
+	switch typedOperand := operand.(type) {
+	case nil:
+		return "null", args, nil
+
+	case *Field:
+		if typedOperand == nil {
+			return "", nil, ErrFieldIsNil
+		}
+
+		return typedOperand.toSQLWithArgs(bc, args)
+
+	case *Expression:
+		if typedOperand == nil {
+			return "", nil, ErrExpressionIsNil
+		}
+
+		sql, args, err = typedOperand.toSQLWithArgs(bc, args)
+		if err != nil {
+			return "", nil, err
+		}
+
+		if e.Operator != "" && typedOperand.Operator != "" {
+			sql = "(" + sql + ")"
+		}
+
+		return sql, args, nil
+
+	case *Raw:
+		return typedOperand.toSQLWithArgs(bc, args)
+	}
+
+	operand = bc.adaptValue(operand)
+	args = append(args, operand)
+
+	return valuePlaceholder(bc.dialect, operand, len(args)), args, nil
+}
+
+func (e *Expression) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+	return e.toSQLWithArgs(newDialectBuildContext(dialect), args)
+}
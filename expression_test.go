@@ -0,0 +1,277 @@
+package goqube
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestExpression_NewArithmeticExpression(t *testing.T) {
+	var (
+		expectation *Expression = &Expression{Operator: ExpressionOperatorMultiply, Operands: []interface{}{NewField("price"), 2}}
+		actual      *Expression = NewArithmeticExpression(ExpressionOperatorMultiply, NewField("price"), 2)
+	)
+
+	if !deepEqual(expectation, actual) {
+		t.Errorf("expectation is %+v, got %+v", expectation, actual)
+	}
+}
+
+func TestExpression_NewFunctionExpression(t *testing.T) {
+	var (
+		expectation *Expression = &Expression{Function: "coalesce", Operands: []interface{}{NewField("nickname"), NewField("name")}}
+		actual      *Expression = NewFunctionExpression("coalesce", NewField("nickname"), NewField("name"))
+	)
+
+	if !deepEqual(expectation, actual) {
+		t.Errorf("expectation is %+v, got %+v", expectation, actual)
+	}
+}
+
+func TestExpression_NewExpressionField(t *testing.T) {
+	var (
+		expression  *Expression = NewFunctionExpression("now")
+		expectation *Field      = &Field{Expression: expression}
+		actual      *Field      = NewExpressionField(expression)
+	)
+
+	if !deepEqual(expectation, actual) {
+		t.Errorf("expectation is %+v, got %+v", expectation, actual)
+	}
+}
+
+func TestExpression_ToSQLWithArgs(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Expression  *Expression
+		Dialect     Dialect
+		Args        []interface{}
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Expression  *Expression
+		Dialect     Dialect
+		Args        []interface{}
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:       "dialect is empty",
+			Expression: NewFunctionExpression("now"),
+			Dialect:    "",
+			Args:       []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrDialectIsRequired,
+			},
+		},
+		{
+			Name:       "operator and function are both set",
+			Expression: &Expression{Operator: ExpressionOperatorAdd, Function: "sum", Operands: []interface{}{1, 2}},
+			Dialect:    DialectPostgres,
+			Args:       []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrConflictExpressionOperatorAndFunction,
+			},
+		},
+		{
+			Name:       "operator and function are empty",
+			Expression: &Expression{Operands: []interface{}{1}},
+			Dialect:    DialectPostgres,
+			Args:       []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrExpressionOperatorIsInvalid,
+			},
+		},
+		{
+			Name:       "operator is invalid",
+			Expression: NewArithmeticExpression("; drop", 1, 2),
+			Dialect:    DialectPostgres,
+			Args:       []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrExpressionOperatorIsInvalid,
+			},
+		},
+		{
+			Name:       "function is invalid",
+			Expression: NewFunctionExpression("lower(name); drop table users; --"),
+			Dialect:    DialectPostgres,
+			Args:       []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrFunctionIsInvalid,
+			},
+		},
+		{
+			Name:       "arithmetic operands is less than two",
+			Expression: NewArithmeticExpression(ExpressionOperatorAdd, NewField("price")),
+			Dialect:    DialectPostgres,
+			Args:       []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrOperandsIsRequired,
+			},
+		},
+		{
+			Name:       "operand field is nil",
+			Expression: NewFunctionExpression("lower", (*Field)(nil)),
+			Dialect:    DialectPostgres,
+			Args:       []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrFieldIsNil,
+			},
+		},
+		{
+			Name:       "operand expression is nil",
+			Expression: NewFunctionExpression("lower", (*Expression)(nil)),
+			Dialect:    DialectPostgres,
+			Args:       []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrExpressionIsNil,
+			},
+		},
+		{
+			Name:       "function without operands",
+			Expression: NewFunctionExpression("now"),
+			Dialect:    DialectMySQL,
+			Args:       []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "now()",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:       fmt.Sprintf("nested arithmetic with dialect %s", DialectPostgres),
+			Expression: NewArithmeticExpression(ExpressionOperatorMultiply, NewArithmeticExpression(ExpressionOperatorSubtract, NewField("price").FromTable("p"), NewField("discount").FromTable("p")), NewField("quantity")),
+			Dialect:    DialectPostgres,
+			Args:       []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "(p.price - p.discount) * quantity",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:       fmt.Sprintf("function with bound args with dialect %s", DialectPostgres),
+			Expression: NewFunctionExpression("coalesce", NewField("nickname"), NewField("name"), "anonymous"),
+			Dialect:    DialectPostgres,
+			Args:       []interface{}{"active"},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "coalesce(nickname, name, $2)",
+				Args:  []interface{}{"active", "anonymous"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:       fmt.Sprintf("arithmetic with function, raw and null operands with dialect %s", DialectMySQL),
+			Expression: NewArithmeticExpression(ExpressionOperatorAdd, NewFunctionExpression("round", NewArithmeticExpression(ExpressionOperatorDivide, NewField("total"), 100), 2), NewRaw("?", 1), nil),
+			Dialect:    DialectMySQL,
+			Args:       []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "round(total / ?, ?) + ? + null",
+				Args:  []interface{}{100, 2, 1},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Expression.ToSQLWithArgs(testCases[i].Dialect, testCases[i].Args)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Errorf("expectation error is %+v, got nil", testCases[i].Expectation.Err)
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Errorf("expectation error is nil, got %+v", actualErr)
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %+v, got %+v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %+v, got %+v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
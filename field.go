@@ -1,12 +1,18 @@
 package goqube
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 type Field struct {
 	Table       string
 	Column      string
 	SelectQuery *SelectQuery
+	Raw         *Raw
+	Expression  *Expression
 	Alias       string
+	JSONPath    []string
 }
 
 func NewField(column string) *Field {
@@ -21,25 +27,68 @@ func NewSelectQueryField(selectQuery *SelectQuery) *Field {
 	}
 }
 
+func NewRawField(raw *Raw) *Field {
+	return &Field{
+		Raw: raw,
+	}
+}
+
 func (f *Field) FromTable(table string) *Field {
 	f.Table = table
 	return f
 }
 
+func (f *Field) Path(keys ...string) *Field {
+	f.JSONPath = keys
+	return f
+}
+
 func (f *Field) As(alias string) *Field {
 	f.Alias = alias
 	return f
 }
 
+func (f *Field) outputName() string {
+	if f == nil {
+		return ""
+	}
+
+	if f.Alias != "" {
+		return f.Alias
+	}
+
+	if f.SelectQuery != nil || f.Raw != nil || f.Expression != nil || len(f.JSONPath) > 0 || strings.ContainsAny(f.Column, "*() ") {
+		return ""
+	}
+
+	return f.Column
+}
+
+func (f *Field) description() string {
+	if f.Column == "" || f.Table == "" {
+		return f.Column
+	}
+
+	return f.Table + "." + f.Column
+}
+
 func (f *Field) validate(dialect Dialect) error {
 	if dialect == "" {
 		return ErrDialectIsRequired
 	}
 
-	if f.Column == "" && f.SelectQuery == nil {
+	if f.Expression != nil && (f.Column != "" || f.SelectQuery != nil || f.Raw != nil || f.Table != "") {
+		return ErrConflictFieldExpression
+	}
+
+	if f.Column == "" && f.SelectQuery == nil && f.Raw == nil && f.Expression == nil {
 		return ErrColumnIsRequired
 	}
 
+	if f.Raw != nil && (f.Column != "" || f.SelectQuery != nil || f.Table != "") {
+		return ErrConflictFieldRaw
+	}
+
 	if f.Column != "" && f.SelectQuery != nil {
 		return ErrConflictFieldColumnAndFieldSelectQuery
 	}
@@ -48,23 +97,35 @@ func (f *Field) validate(dialect Dialect) error {
 		return ErrAliasIsRequired
 	}
 
+	if !isValidAlias(f.Alias) {
+		return ErrAliasIsInvalid
+	}
+
+	if len(f.JSONPath) > 0 && f.Column == "" {
+		return ErrJSONPathRequiresColumn
+	}
+
+	if !isValidJSONPath(f.JSONPath) {
+		return ErrJSONPathIsInvalid
+	}
+
 	return nil
 }
 
-func (f *Field) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+func (f *Field) toSQLWithArgs(bc *buildContext, args []interface{}) (string, []interface{}, error) {
 	var (
 		field string
 		err   error
 	)
 
-	err = f.validate(dialect)
+	err = f.validate(bc.dialect)
 	if err != nil {
 		return "", nil, err
 	}
 
-	field = f.Column
+	field = bc.physicalColumn(f.Table, f.Column)
 	if f.SelectQuery != nil {
-		field, args, err = f.SelectQuery.ToSQLWithArgsWithAlias(dialect, args)
+		field, args, err = f.SelectQuery.toSQLWithArgsWithAlias(bc, args)
 		if err != nil {
 			return "", nil, err
 		}
@@ -72,27 +133,100 @@ func (f *Field) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []in
 		field = fmt.Sprintf("(%s)", field)
 	}
 
+	if f.Raw != nil {
+		field, args, err = f.Raw.toSQLWithArgs(bc, args)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	if f.Expression != nil {
+		field, args, err = f.Expression.toSQLWithArgs(bc, args)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
 	if f.Table != "" && f.SelectQuery == nil {
-		field = fmt.Sprintf("%s.%s", f.Table, field)
+		field = quoteQualifier(bc.dialect, bc.qualifierName(f.Table)) + "." + field
+	}
+
+	if len(f.JSONPath) > 0 {
+		field = jsonPathExpression(bc.dialect, field, f.JSONPath)
 	}
 
 	return field, args, nil
 }
 
-func (f *Field) ToSQLWithArgsWithAlias(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+func (f *Field) toSQLWithArgsWithAlias(bc *buildContext, args []interface{}) (string, []interface{}, error) {
 	var (
 		fieldWithAlias string
 		err            error
 	)
 
-	fieldWithAlias, args, err = f.ToSQLWithArgs(dialect, args)
+	fieldWithAlias, args, err = f.toSQLWithArgs(bc, args)
 	if err != nil {
 		return "", nil, err
 	}
 
 	if f.Alias != "" {
-		fieldWithAlias = fmt.Sprintf("%s as %s", fieldWithAlias, f.Alias)
+		fieldWithAlias = fieldWithAlias + " as " + quoteAlias(bc.dialect, f.Alias)
 	}
 
 	return fieldWithAlias, args, nil
 }
+
+func (f *Field) toSelectSQLWithArgs(bc *buildContext, args []interface{}) (string, []interface{}, error) {
+	var (
+		sensitivity *Sensitivity
+		field       string
+		alias       string
+		err         error
+	)
+
+	if f.SelectQuery == nil && f.Raw == nil && f.Expression == nil {
+		sensitivity = bc.sensitivity(f.Table, f.Column)
+	}
+
+	alias = f.Alias
+	if alias == "" && f.SelectQuery == nil && f.Raw == nil && f.Expression == nil && bc.physicalColumn(f.Table, f.Column) != f.Column {
+		alias = f.Column
+	}
+
+	if (sensitivity == nil || sensitivity.DecryptFormat == "") && alias == f.Alias {
+		return f.toSQLWithArgsWithAlias(bc, args)
+	}
+
+	field, args, err = f.toSQLWithArgs(bc, args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if alias == "" {
+		alias = f.Column
+	}
+
+	if sensitivity != nil && sensitivity.DecryptFormat != "" {
+		field = sensitivity.decryptColumn(field)
+	}
+
+	field = fmt.Sprintf("%s as %s", field, quoteAlias(bc.dialect, alias))
+
+	return field, args, nil
+}
+
+func (f *Field) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+	return f.toSQLWithArgs(newDialectBuildContext(dialect), args)
+}
+
+func (f *Field) ToSQLWithArgsWithAlias(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+	return f.toSQLWithArgsWithAlias(newDialectBuildContext(dialect), args)
+}
+
+func (f *Field) isPlainColumn() bool {
+	return f.Column != "" && f.Table == "" && f.Alias == "" && f.SelectQuery == nil && f.Raw == nil && f.Expression == nil && len(f.JSONPath) == 0
+}
+
+func (f *Field) isBareSelectQuery() bool {
+	return f.SelectQuery != nil && f.Column == "" && f.Table == "" && f.Alias == "" && f.Raw == nil && f.Expression == nil && len(f.JSONPath) == 0
+}
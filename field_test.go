@@ -1,6 +1,9 @@
 package goqube
 
-import "testing"
+import (
+	"fmt"
+	"testing"
+)
 
 func testField_FieldEquality(t *testing.T, expectation, actual *Field) {
 	if expectation == nil && actual == nil {
@@ -31,6 +34,10 @@ func testField_FieldEquality(t *testing.T, expectation, actual *Field) {
 		t.Errorf("expectation select query is %+v, got %+v", expectation.SelectQuery, actual.SelectQuery)
 	}
 
+	if !deepEqual(expectation.Raw, actual.Raw) {
+		t.Errorf("expectation raw is %+v, got %+v", expectation.Raw, actual.Raw)
+	}
+
 	if expectation.Table != actual.Table {
 		t.Errorf("expectation field is %s, got %s", expectation.Table, actual.Table)
 	}
@@ -66,6 +73,18 @@ func TestField_NewSelectQueryField(t *testing.T) {
 	)
 }
 
+func TestField_NewRawField(t *testing.T) {
+	testField_FieldEquality(
+		t,
+		&Field{
+			Raw: &Raw{
+				SQL: "lower(email)",
+			},
+		},
+		NewRawField(NewRaw("lower(email)")),
+	)
+}
+
 func TestField_FromTable(t *testing.T) {
 	testField_FieldEquality(t, &Field{Column: "field1", Table: "table1"}, NewField("field1").FromTable("table1"))
 }
@@ -107,6 +126,33 @@ func TestField_validate(t *testing.T) {
 			Dialect:     DialectPostgres,
 			Expectation: ErrConflictFieldColumnAndFieldSelectQuery,
 		},
+		{
+			Name: "raw is not nil and column is not empty",
+			Field: &Field{
+				Column: "field1",
+				Raw:    NewRaw("lower(field1)"),
+			},
+			Dialect:     DialectPostgres,
+			Expectation: ErrConflictFieldRaw,
+		},
+		{
+			Name: "raw is not nil and table is not empty",
+			Field: &Field{
+				Table: "table1",
+				Raw:   NewRaw("lower(field1)"),
+			},
+			Dialect:     DialectPostgres,
+			Expectation: ErrConflictFieldRaw,
+		},
+		{
+			Name: "expression is not nil and column is not empty",
+			Field: &Field{
+				Column:     "field1",
+				Expression: NewFunctionExpression("lower", NewField("field1")),
+			},
+			Dialect:     DialectPostgres,
+			Expectation: ErrConflictFieldExpression,
+		},
 		{
 			Name: "alias is empty and select query is not nil",
 			Field: &Field{
@@ -232,6 +278,32 @@ func TestField_ToSQLWithArgs(t *testing.T) {
 				Err:   nil,
 			},
 		},
+		{
+			Name:  "raw is not nil",
+			Field: NewRawField(NewRaw("coalesce(nickname, ?)", "anonymous")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "coalesce(nickname, $1)",
+				Args:  []interface{}{"anonymous"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:  "raw is not nil and to sql with args is error",
+			Field: NewRawField(NewRaw("coalesce(nickname, ?)")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errPlaceholderCountf, ErrPlaceholderCountMismatch, 1, 0),
+			},
+		},
 	}
 
 	for i := range testCases {
@@ -341,6 +413,19 @@ func TestField_ToSQLWithArgsWithAlias(t *testing.T) {
 				Query: "(select field1 from table1) as alias1",
 			},
 		},
+		{
+			Name:  "alias is not empty and expression is not nil",
+			Field: NewExpressionField(NewArithmeticExpression(ExpressionOperatorMultiply, NewField("price"), NewField("quantity"))).As("total"),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "price * quantity as total",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
 	}
 
 	for i := range testCases {
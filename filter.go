@@ -1,6 +1,9 @@
 package goqube
 
 import (
+	"bytes"
+	"database/sql/driver"
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
@@ -18,6 +21,29 @@ func NewFilter() *Filter {
 	return &Filter{}
 }
 
+func FilterTrue() *Filter {
+	return &Filter{Operator: OperatorTrue}
+}
+
+func FilterFalse() *Filter {
+	return &Filter{Operator: OperatorFalse}
+}
+
+var likePatternReplacer *strings.Replacer = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+type HashSample struct {
+	Percent float64
+	Seed    string
+}
+
+func SampleByHash(field *Field, percent float64, seed string) *Filter {
+	return &Filter{
+		Field:    field,
+		Operator: OperatorSampleByHash,
+		Value:    NewFilterValue(&HashSample{Percent: percent, Seed: seed}),
+	}
+}
+
 func (f *Filter) SetLogic(logic Logic) *Filter {
 	f.Logic = logic
 	return f
@@ -40,14 +66,48 @@ func (f *Filter) AddFilters(filters ...*Filter) *Filter {
 	return f
 }
 
-func (f *Filter) validate(dialect Dialect) error {
+func (f *Filter) referencesTable(qualifier string) bool {
+	if f == nil {
+		return false
+	}
+
+	if f.Field != nil && (f.Field.Raw != nil || f.Field.Column != "" && f.Field.Table == qualifier) {
+		return true
+	}
+
+	if f.Field != nil && f.Field.Expression != nil && f.Field.Expression.referencesTable(qualifier) {
+		return true
+	}
+
+	if f.Value != nil && (f.Value.Raw != nil || f.Value.Column != "" && f.Value.Table == qualifier) {
+		return true
+	}
+
+	if f.Value != nil && f.Value.Expression != nil && f.Value.Expression.referencesTable(qualifier) {
+		return true
+	}
+
+	if f.Field != nil && f.Field.Table == "" && f.Field.Column != "" && f.Value != nil && f.Value.Table == "" && f.Value.Column != "" {
+		return true
+	}
+
+	for i := range f.Filters {
+		if f.Filters[i].referencesTable(qualifier) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (f *Filter) validateCondition(dialect Dialect) error {
 	var reflectValue reflect.Value
 
 	if dialect == "" {
 		return ErrDialectIsRequired
 	}
 
-	if f.Value != nil && f.Value.Column == "" && f.Value.SelectQuery == nil {
+	if f.Value != nil && !f.Value.isExpression() {
 		reflectValue = reflect.ValueOf(f.Value.Value)
 	}
 
@@ -60,7 +120,7 @@ func (f *Filter) validate(dialect Dialect) error {
 	}
 
 	if f.Logic != "" && f.Value != nil &&
-		(f.Value.Column != "" || f.Value.SelectQuery != nil ||
+		(f.Value.isExpression() ||
 			(f.Value.SelectQuery == nil && (f.Value.Value != nil || reflectValue.Kind() != reflect.Invalid))) {
 		return ErrValueIsNotNil
 	}
@@ -73,6 +133,85 @@ func (f *Filter) validate(dialect Dialect) error {
 		return ErrLogicIsRequired
 	}
 
+	if f.Logic == "" && len(f.Filters) == 0 && (f.Operator == OperatorTrue || f.Operator == OperatorFalse) {
+		if f.Field != nil {
+			return ErrFieldIsNotEmpty
+		}
+
+		if f.Value != nil {
+			return ErrValueIsNotNil
+		}
+
+		return nil
+	}
+
+	if f.Logic == "" && len(f.Filters) == 0 && f.Operator == OperatorSearch {
+		var (
+			search  *SearchFilter
+			isValid bool
+		)
+
+		if f.Field != nil {
+			return ErrFieldIsNotEmpty
+		}
+
+		if f.Value != nil {
+			search, isValid = f.Value.Value.(*SearchFilter)
+		}
+
+		if !isValid || search == nil {
+			return ErrValueIsRequired
+		}
+
+		return search.validate()
+	}
+
+	if f.Logic == "" && len(f.Filters) == 0 && f.Operator == OperatorGeo {
+		var (
+			geo     *GeoFilter
+			isValid bool
+		)
+
+		if f.Field == nil {
+			return ErrFieldIsRequired
+		}
+
+		if f.Value != nil {
+			geo, isValid = f.Value.Value.(*GeoFilter)
+		}
+
+		if !isValid || geo == nil {
+			return ErrValueIsRequired
+		}
+
+		return geo.validate(dialect)
+	}
+
+	if f.Logic == "" && len(f.Filters) == 0 && f.Operator == OperatorSampleByHash {
+		var (
+			sample  *HashSample
+			isValid bool
+		)
+
+		if f.Field == nil {
+			return ErrFieldIsRequired
+		}
+
+		if f.Value != nil {
+			sample, isValid = f.Value.Value.(*HashSample)
+		}
+
+		if !isValid || sample == nil {
+			return ErrValueIsRequired
+		}
+
+		if sample.Percent < 0 || sample.Percent > 100 {
+			return ErrSamplePercentIsInvalid
+		}
+
+		return nil
+	}
+
 	if f.Logic == "" && len(f.Filters) == 0 {
 		if f.Field == nil {
 			return ErrFieldIsRequired
@@ -82,47 +221,230 @@ func (f *Filter) validate(dialect Dialect) error {
 			return ErrOperatorIsRequired
 		}
 
-		if f.Operator != OperatorIsNull && f.Operator != OperatorIsNotNull &&
-			(f.Value == nil ||
-				(f.Value != nil && f.Value.Column == "" && f.Value.SelectQuery == nil && f.Value.Value == nil && reflectValue.Kind() == reflect.Invalid)) {
-			return ErrValueIsRequired
+		var err error = f.validateConditionValue(dialect, reflectValue)
+		if err != nil {
+			return &FilterConditionError{Column: f.Field.description(), Operator: f.Operator, Err: err}
 		}
+	}
 
-		if (f.Operator == OperatorIsNull || f.Operator == OperatorIsNotNull) &&
-			f.Value != nil &&
-			(f.Value.Column != "" && f.Value.SelectQuery != nil ||
-				(f.Value.SelectQuery == nil && (f.Value.Value != nil || reflectValue.Kind() != reflect.Invalid))) {
-			return ErrValueIsNotNil
-		}
+	return nil
+}
+
+func (f *Filter) validateConditionValue(dialect Dialect, reflectValue reflect.Value) error {
+	if isComparisonOperator(f.Operator) && f.Value != nil && !f.Value.isExpression() && isNilValue(f.Value.Value) {
+		return ErrComparisonWithNil
+	}
+
+	if f.Operator != OperatorIsNull && f.Operator != OperatorIsNotNull &&
+		(f.Value == nil ||
+			(f.Value != nil && !f.Value.isExpression() && f.Value.Value == nil && reflectValue.Kind() == reflect.Invalid)) {
+		return ErrValueIsRequired
+	}
+
+	if (f.Operator == OperatorIsNull || f.Operator == OperatorIsNotNull) &&
+		f.Value != nil &&
+		(f.Value.Raw != nil || f.Value.Expression != nil || f.Value.Column != "" && f.Value.SelectQuery != nil ||
+			(f.Value.SelectQuery == nil && (f.Value.Value != nil || reflectValue.Kind() != reflect.Invalid))) {
+		return ErrValueIsNotNil
+	}
 
-		if f.Operator != OperatorIn && f.Operator != OperatorNotIn &&
-			f.Value != nil &&
-			(f.Value.Column == "" && f.Value.SelectQuery == nil && (reflectValue.Kind() == reflect.Slice || reflectValue.Kind() == reflect.Array)) {
-			return fmt.Errorf(errUnsupportedValueTypeForOperatorf, reflectValue.Kind().String(), f.Operator)
+	if _, isArray := arrayOperatorFormatMap[f.Operator]; isArray {
+		if dialect != DialectPostgres {
+			return ErrUnsupportedArrayOperator
 		}
 
-		if (f.Operator == OperatorIn || f.Operator == OperatorNotIn) && f.Value != nil && f.Value.Column == "" && f.Value.SelectQuery == nil {
-			if reflectValue.Kind() != reflect.Slice && reflectValue.Kind() != reflect.Array {
-				return fmt.Errorf(errUnsupportedValueTypeForOperatorf, reflectValue.Kind().String(), f.Operator)
+		if f.Value != nil && !f.Value.isExpression() {
+			if _, isValuer := f.Value.Value.(driver.Valuer); !isValuer && reflectValue.Kind() != reflect.Slice && reflectValue.Kind() != reflect.Array {
+				return &UnsupportedValueTypeError{Kind: reflectValue.Kind(), Operator: f.Operator}
 			}
+		}
 
-			if reflectValue.Len() == 0 {
-				return ErrValueIsRequired
-			}
+		return nil
+	}
+
+	if f.Operator != OperatorIn && f.Operator != OperatorNotIn &&
+		f.Value != nil &&
+		(!f.Value.isExpression() && !isValuer(f.Value.Value) && (reflectValue.Kind() == reflect.Slice || reflectValue.Kind() == reflect.Array)) {
+		return &UnsupportedValueTypeError{Kind: reflectValue.Kind(), Operator: f.Operator}
+	}
+
+	if f.Value != nil && !f.Value.isExpression() {
+		var err error = validateValueKind(f.Value.Value, f.Operator)
+		if err != nil {
+			return err
 		}
 	}
 
+	if isLikeOperator(f.Operator) && f.Value != nil && !f.Value.isExpression() && !isStringValue(f.Value.Value) {
+		return &UnsupportedValueTypeError{Kind: reflect.Indirect(reflectValue).Kind(), Operator: f.Operator}
+	}
+
+	if _, isPattern := likePatternFormatMap[f.Operator]; isPattern {
+		if f.Value == nil || f.Value.isExpression() {
+			return ErrPatternValueIsInvalid
+		}
+
+		if _, isParam := f.Value.Value.(ParamValue); isParam {
+			return nil
+		}
+
+		if _, ok := patternValue(f.Value.Value); !ok {
+			return ErrPatternValueIsInvalid
+		}
+	}
+
+	if (f.Operator == OperatorIn || f.Operator == OperatorNotIn) && f.Value != nil && !f.Value.isExpression() {
+		if reflectValue.Kind() != reflect.Slice && reflectValue.Kind() != reflect.Array {
+			return &UnsupportedValueTypeError{Kind: reflectValue.Kind(), Operator: f.Operator}
+		}
+
+		if reflectValue.Len() == 0 {
+			return ErrValueIsRequired
+		}
+	}
+
+	return nil
+}
+
+func isComparisonOperator(operator Operator) bool {
+	switch operator {
+	case OperatorEqual, OperatorNotEqual, OperatorGreaterThan, OperatorGreaterThanOrEqual, OperatorLessThan, OperatorLessThanOrEqual:
+		return true
+	}
+
+	return false
+}
+
+func isLikeOperator(operator Operator) bool {
+	switch operator {
+	case OperatorLike, OperatorNotLike, OperatorLikePattern, OperatorNotLikePattern:
+		return true
+	}
+
+	return false
+}
+
+func isNilValue(value interface{}) bool {
+	var reflectValue reflect.Value = reflect.ValueOf(value)
+
+	if value == nil || reflectValue.Kind() == reflect.Ptr && reflectValue.IsNil() {
+		return true
+	}
+
+	if valuer, isValuer := value.(driver.Valuer); isValuer {
+		var (
+			driverValue driver.Value
+			err         error
+		)
+
+		driverValue, err = valuer.Value()
+		return err == nil && driverValue == nil
+	}
+
+	return false
+}
+
+func likePattern(operator Operator, value interface{}) (interface{}, error) {
+	var (
+		pattern string
+		ok      bool
+	)
+
+	pattern, ok = patternValue(value)
+	if !ok {
+		return nil, ErrPatternValueIsInvalid
+	}
+
+	return fmt.Sprintf(likePatternFormatMap[operator], likePatternReplacer.Replace(pattern)), nil
+}
+
+func arrayValue(value interface{}) interface{} {
+	if _, isValuer := value.(driver.Valuer); isValuer {
+		return value
+	}
+
+	return Array(value)
+}
+
+func isStringValue(value interface{}) bool {
+	if _, isValuer := value.(driver.Valuer); isValuer {
+		return true
+	}
+
+	return reflect.Indirect(reflect.ValueOf(value)).Kind() == reflect.String
+}
+
+func (f *Filter) validateBoundValue(value interface{}) error {
+	var (
+		reflectValue reflect.Value = reflect.ValueOf(value)
+		err          error
+	)
+
+	switch {
+	case isComparisonOperator(f.Operator) && isNilValue(value):
+		err = ErrComparisonWithNil
+	case !isValuer(value) && (reflectValue.Kind() == reflect.Slice || reflectValue.Kind() == reflect.Array):
+		err = &UnsupportedValueTypeError{Kind: reflectValue.Kind(), Operator: f.Operator}
+	case isLikeOperator(f.Operator) && !isStringValue(value):
+		err = &UnsupportedValueTypeError{Kind: reflect.Indirect(reflectValue).Kind(), Operator: f.Operator}
+	default:
+		err = validateValueKind(value, f.Operator)
+	}
+
+	if err != nil {
+		return &FilterConditionError{Column: f.Field.description(), Operator: f.Operator, Err: err}
+	}
+
+	return nil
+}
+
+func (f *Filter) boundValue() *FilterValue {
+	var (
+		param ParamValue
+		value FilterValue
+		ok    bool
+	)
+
+	if f.Value == nil || f.Value.isExpression() {
+		return f.Value
+	}
+
+	param, ok = f.Value.Value.(ParamValue)
+	if !ok {
+		return f.Value
+	}
+
+	value = *f.Value
+	value.Value = param.with(func(value interface{}) (interface{}, error) {
+		return value, f.validateBoundValue(value)
+	})
+
+	return &value
+}
+
+func (f *Filter) validate(dialect Dialect) error {
+	var err error
+
+	err = f.validateCondition(dialect)
+	if err != nil {
+		return err
+	}
+
 	for i := range f.Filters {
-		var err error = f.Filters[i].validate(dialect)
+		if f.Filters[i] == nil {
+			return wrapPath(fmt.Sprintf("Filters[%d]", i), ErrFilterIsNil)
+		}
+
+		err = f.Filters[i].validate(dialect)
 		if err != nil {
-			return err
+			return wrapPath(fmt.Sprintf("Filters[%d]", i), err)
 		}
 	}
 
 	return nil
 }
 
-func (f *Filter) toSQLWithArgs(dialect Dialect, args []interface{}, isRoot bool) (string, []interface{}, error) {
+func (f *Filter) toSQLWithArgs(bc *buildContext, args []interface{}, isRoot bool) (string, []interface{}, error) {
 	var (
 		field                string
 		queryValue           string
@@ -133,82 +455,101 @@ func (f *Filter) toSQLWithArgs(dialect Dialect, args []interface{}, isRoot bool)
 		placeholder          string
 		conditionQuery       string
 		conditionQueries     []string
-		whereClause          string
+		buffer               *bytes.Buffer
 		err                  error
 	)
 
+	if f.Operator == OperatorTrue || f.Operator == OperatorFalse {
+		return booleanFilterMap[bc.dialect][f.Operator], args, nil
+	}
+
+	if f.Operator == OperatorSearch {
+		var writer *FragmentWriter = newFragmentWriter(bc, args).WriteFragment(f.Value.Value.(*SearchFilter))
+
+		return writer.SQL(), writer.Args(), writer.Err()
+	}
+
 	if f.Operator != "" {
-		field, args, err = f.Field.ToSQLWithArgsWithAlias(dialect, args)
+		field, args, err = f.Field.toSQLWithArgsWithAlias(bc, args)
 		if err != nil {
 			return "", nil, err
 		}
 	}
 
 	switch f.Operator {
-	case OperatorEqual, OperatorNotEqual, OperatorGreaterThan, OperatorGreaterThanOrEqual, OperatorLessThan, OperatorLessThanOrEqual:
-		queryValue, args, err = f.Value.ToSQLWithArgs(dialect, args)
+	case OperatorEqual, OperatorNotEqual, OperatorGreaterThan, OperatorGreaterThanOrEqual, OperatorLessThan, OperatorLessThanOrEqual, OperatorLikePattern, OperatorNotLikePattern:
+		queryValue, args, err = f.boundValue().toSQLWithArgs(bc, args)
 		if err != nil {
 			return "", nil, err
 		}
 
-		conditionQueryFormat = "%s %s %s"
 		filterOperator = filterOperatorMap[f.Operator]
-		conditionQuery = fmt.Sprintf(conditionQueryFormat, field, filterOperator, queryValue)
 
 		if queryValue == "" {
 			placeholderStartIdx = len(args)
 			placeholderEndIdx = len(args)
-			placeholder = getPlaceholder(dialect, placeholderStartIdx, placeholderEndIdx)
-			conditionQuery = fmt.Sprintf(conditionQueryFormat, field, filterOperator, placeholder)
+			queryValue = getPlaceholder(bc.dialect, placeholderStartIdx, placeholderEndIdx)
 		}
 
-		return conditionQuery, args, nil
+		return field + " " + filterOperator + " " + queryValue, args, nil
 
 	case OperatorIsNull, OperatorIsNotNull:
-		conditionQueryFormat = "%s %s"
 		filterOperator = filterOperatorMap[f.Operator]
-		conditionQuery = fmt.Sprintf(conditionQueryFormat, field, filterOperator)
 
-		return conditionQuery, args, nil
+		return field + " " + filterOperator, args, nil
 
 	case OperatorIn, OperatorNotIn:
 		filterOperator = filterOperatorMap[f.Operator]
 
-		if f.Value.SelectQuery == nil {
+		if !f.Value.isExpression() {
 			var interfaceSlice []interface{}
 
-			conditionQueryFormat = "%s %s (%s)"
-
-			interfaceSlice, err = typedSliceToInterfaceSlice(f.Value.Value)
+			interfaceSlice, err = typedSliceToInterfaceSlice(bc.adaptSliceValue(f.Value.Value))
 			if err != nil {
-				err = fmt.Errorf(errForOperatorf, err.Error(), f.Operator)
+				var (
+					unsupportedValueTypeErr   *UnsupportedValueTypeError
+					unsupportedElementTypeErr *UnsupportedElementTypeError
+				)
+
+				if errors.As(err, &unsupportedValueTypeErr) {
+					unsupportedValueTypeErr.Operator = f.Operator
+				}
+
+				if errors.As(err, &unsupportedElementTypeErr) {
+					unsupportedElementTypeErr.Operator = f.Operator
+				}
+
 				return "", nil, err
 			}
 
 			args = append(args, interfaceSlice...)
 			placeholderStartIdx = len(args) - (len(interfaceSlice) - 1)
 			placeholderEndIdx = len(args)
-			placeholder = getPlaceholder(dialect, placeholderStartIdx, placeholderEndIdx)
-			conditionQuery = fmt.Sprintf(conditionQueryFormat, field, filterOperator, placeholder)
+			placeholder = getPlaceholder(bc.dialect, placeholderStartIdx, placeholderEndIdx)
+			conditionQuery = field + " " + filterOperator + " (" + placeholder + ")"
 		} else {
-			queryValue, args, err = f.Value.ToSQLWithArgs(dialect, args)
+			queryValue, args, err = f.Value.toSQLWithArgs(bc, args)
 			if err != nil {
 				return "", nil, err
 			}
 
 			conditionQueryFormat = "%s %s %s"
+			if f.Value.Raw != nil || f.Value.Expression != nil {
+				conditionQueryFormat = "%s %s (%s)"
+			}
+
 			conditionQuery = fmt.Sprintf(conditionQueryFormat, field, filterOperator, queryValue)
 		}
 
 		return conditionQuery, args, nil
 
 	case OperatorLike, OperatorNotLike:
-		queryValue, args, err = f.Value.ToSQLWithArgs(dialect, args)
+		queryValue, args, err = f.boundValue().toSQLWithArgs(bc, args)
 		if err != nil {
 			return "", nil, err
 		}
 
-		switch dialect {
+		switch bc.dialect {
 		case DialectMySQL:
 			conditionQueryFormat = "cast(%s as char) %s concat('%%', cast(%s as char), '%%')"
 			filterOperator = filterOperatorMap[f.Operator]
@@ -225,10 +566,72 @@ func (f *Filter) toSQLWithArgs(dialect Dialect, args []interface{}, isRoot bool)
 		if queryValue == "" {
 			placeholderStartIdx = len(args)
 			placeholderEndIdx = len(args)
-			placeholder = getPlaceholder(dialect, placeholderStartIdx, placeholderEndIdx)
+			placeholder = getPlaceholder(bc.dialect, placeholderStartIdx, placeholderEndIdx)
 			conditionQuery = fmt.Sprintf(conditionQueryFormat, field, filterOperator, placeholder)
 		}
 
+		return conditionQuery, args, nil
+
+	case OperatorStartsWith, OperatorEndsWith, OperatorContains:
+		if param, ok := f.Value.Value.(ParamValue); ok {
+			args = append(args, param.with(func(value interface{}) (interface{}, error) {
+				return likePattern(f.Operator, value)
+			}))
+		} else {
+			var pattern interface{}
+
+			pattern, _ = likePattern(f.Operator, f.Value.Value)
+			args = append(args, pattern)
+		}
+
+		placeholder = getPlaceholder(bc.dialect, len(args), len(args))
+
+		return fmt.Sprintf(likePatternConditionFormatMap[bc.dialect], field, placeholder), args, nil
+
+	case OperatorEqualAny, OperatorNotEqualAll, OperatorArrayContains, OperatorArrayOverlap:
+		if !f.Value.isExpression() {
+			var value interface{} = f.Value.Value
+
+			if param, ok := value.(ParamValue); ok {
+				value = param.with(func(value interface{}) (interface{}, error) {
+					return arrayValue(value), nil
+				})
+			} else {
+				value = arrayValue(value)
+			}
+
+			args = append(args, value)
+			queryValue = getPlaceholder(bc.dialect, len(args), len(args))
+		} else {
+			queryValue, args, err = f.Value.toSQLWithArgs(bc, args)
+			if err != nil {
+				return "", nil, err
+			}
+
+			if f.Value.SelectQuery != nil && (f.Operator == OperatorEqualAny || f.Operator == OperatorNotEqualAll) {
+				queryValue = queryValue[1 : len(queryValue)-1]
+			}
+		}
+
+		return fmt.Sprintf(arrayOperatorFormatMap[f.Operator], field, queryValue), args, nil
+
+	case OperatorSampleByHash:
+		var (
+			sample          *HashSample = f.Value.Value.(*HashSample)
+			seedPlaceholder string
+		)
+
+		args = append(args, sample.Seed)
+		seedPlaceholder = getPlaceholder(bc.dialect, len(args), len(args))
+		args = append(args, sample.Percent)
+		placeholder = getPlaceholder(bc.dialect, len(args), len(args))
+		conditionQuery = fmt.Sprintf(sampleByHashFormatMap[bc.dialect], seedPlaceholder, field, placeholder)
+
+		return conditionQuery, args, nil
+
+	case OperatorGeo:
+		conditionQuery, args = f.Value.Value.(*GeoFilter).toSQLWithArgs(bc, field, args)
+
 		return conditionQuery, args, nil
 	}
 
@@ -246,9 +649,9 @@ func (f *Filter) toSQLWithArgs(dialect Dialect, args []interface{}, isRoot bool)
 			return "", args, nil
 		}
 
-		subConditionQuery, subArgs, err = f.Filters[i].toSQLWithArgs(dialect, args, false)
+		subConditionQuery, subArgs, err = f.Filters[i].toSQLWithArgs(bc, args, false)
 		if err != nil {
-			return "", nil, err
+			return "", nil, wrapPath(fmt.Sprintf("Filters[%d]", i), err)
 		}
 
 		if subConditionQuery != "" {
@@ -262,19 +665,63 @@ func (f *Filter) toSQLWithArgs(dialect Dialect, args []interface{}, isRoot bool)
 		return "", args, nil
 	}
 
-	whereClause = fmt.Sprintf("(%s)", strings.Join(conditionQueries, fmt.Sprintf(" %s ", f.Logic)))
-	if isRoot {
-		whereClause = strings.Join(conditionQueries, fmt.Sprintf(" %s ", f.Logic))
+	buffer = getBuffer(len(conditionQueries) * 32)
+	defer putBuffer(buffer)
+
+	if !isRoot {
+		buffer.WriteByte('(')
+	}
+	for i := range conditionQueries {
+		if i > 0 {
+			buffer.WriteByte(' ')
+			buffer.WriteString(string(f.Logic))
+			buffer.WriteByte(' ')
+		}
+		buffer.WriteString(conditionQueries[i])
+	}
+	if !isRoot {
+		buffer.WriteByte(')')
 	}
 
-	return whereClause, args, nil
+	return buffer.String(), args, nil
 }
 
-func (f *Filter) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
-	var err error = f.validate(dialect)
+func (f *Filter) nilAsNull() *Filter {
+	var filter Filter = *f
+
+	if (f.Operator == OperatorEqual || f.Operator == OperatorNotEqual) &&
+		(f.Value == nil || !f.Value.isExpression() && isNilValue(f.Value.Value)) {
+		filter.Operator = nullOperatorMap[f.Operator]
+		filter.Value = nil
+	}
+
+	if f.Filters != nil {
+		filter.Filters = make([]*Filter, len(f.Filters))
+		for i := range f.Filters {
+			if f.Filters[i] != nil {
+				filter.Filters[i] = f.Filters[i].nilAsNull()
+			}
+		}
+	}
+
+	return &filter
+}
+
+func (f *Filter) toRootSQLWithArgs(bc *buildContext, args []interface{}) (string, []interface{}, error) {
+	var err error
+
+	if bc.config.NilAsNull {
+		f = f.nilAsNull()
+	}
+
+	err = f.validate(bc.dialect)
 	if err != nil {
 		return "", nil, err
 	}
 
-	return f.toSQLWithArgs(dialect, args, true)
+	return f.toSQLWithArgs(bc, args, true)
+}
+
+func (f *Filter) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+	return f.toRootSQLWithArgs(newDialectBuildContext(dialect), args)
 }
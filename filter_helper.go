@@ -0,0 +1,113 @@
+package goqube
+
+func filterValueOf(value interface{}) *FilterValue {
+	switch typedValue := value.(type) {
+	case *FilterValue:
+		return typedValue
+
+	case *Field:
+		if typedValue == nil {
+			return NewFilterValue(nil)
+		}
+
+		return &FilterValue{
+			Table:       typedValue.Table,
+			Column:      typedValue.Column,
+			SelectQuery: typedValue.SelectQuery,
+			Raw:         typedValue.Raw,
+			Expression:  typedValue.Expression,
+			JSONPath:    typedValue.JSONPath,
+		}
+
+	case *SelectQuery:
+		return NewSelectQueryFilterValue(typedValue)
+
+	case *Raw:
+		return NewRawFilterValue(typedValue)
+
+	case *Expression:
+		return NewExpressionFilterValue(typedValue)
+	}
+
+	return NewFilterValue(value)
+}
+
+func newConditionFilter(column string, operator Operator, value interface{}) *Filter {
+	return NewFilter().SetCondition(fieldFromColumn(column), operator, filterValueOf(value))
+}
+
+func Eq(column string, value interface{}) *Filter {
+	return newConditionFilter(column, OperatorEqual, value)
+}
+
+func Ne(column string, value interface{}) *Filter {
+	return newConditionFilter(column, OperatorNotEqual, value)
+}
+
+func Gt(column string, value interface{}) *Filter {
+	return newConditionFilter(column, OperatorGreaterThan, value)
+}
+
+func Gte(column string, value interface{}) *Filter {
+	return newConditionFilter(column, OperatorGreaterThanOrEqual, value)
+}
+
+func Lt(column string, value interface{}) *Filter {
+	return newConditionFilter(column, OperatorLessThan, value)
+}
+
+func Lte(column string, value interface{}) *Filter {
+	return newConditionFilter(column, OperatorLessThanOrEqual, value)
+}
+
+func In(column string, values interface{}) *Filter {
+	return newConditionFilter(column, OperatorIn, values)
+}
+
+func NotIn(column string, values interface{}) *Filter {
+	return newConditionFilter(column, OperatorNotIn, values)
+}
+
+func Like(column string, value interface{}) *Filter {
+	return newConditionFilter(column, OperatorLike, value)
+}
+
+func NotLike(column string, value interface{}) *Filter {
+	return newConditionFilter(column, OperatorNotLike, value)
+}
+
+func LikePattern(column string, value interface{}) *Filter {
+	return newConditionFilter(column, OperatorLikePattern, value)
+}
+
+func NotLikePattern(column string, value interface{}) *Filter {
+	return newConditionFilter(column, OperatorNotLikePattern, value)
+}
+
+func IsNull(column string) *Filter {
+	return NewFilter().SetCondition(fieldFromColumn(column), OperatorIsNull, nil)
+}
+
+func IsNotNull(column string) *Filter {
+	return NewFilter().SetCondition(fieldFromColumn(column), OperatorIsNotNull, nil)
+}
+
+func newLogicFilter(logic Logic, filters []*Filter) *Filter {
+	var filter *Filter = NewFilter().SetLogic(logic)
+
+	for i := range filters {
+		if filters[i] != nil {
+			filter.AddFilters(filters[i])
+		}
+	}
+
+	return filter
+}
+
+func And(filters ...*Filter) *Filter {
+	return newLogicFilter(LogicAnd, filters)
+}
+
+func Or(filters ...*Filter) *Filter {
+	return newLogicFilter(LogicOr, filters)
+}
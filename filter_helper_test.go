@@ -0,0 +1,168 @@
+package goqube
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestFilterHelper_Eq(t *testing.T) {
+	var (
+		expectation *Filter = NewFilter().SetCondition(NewField("status").FromTable("u"), OperatorEqual, NewFilterValue("active"))
+		actual      *Filter = Eq("u.status", "active")
+	)
+
+	if !deepEqual(expectation, actual) {
+		t.Errorf("expectation is %+v, got %+v", expectation, actual)
+	}
+}
+
+func TestFilterHelper_And(t *testing.T) {
+	var (
+		expectation *Filter = NewFilter().SetLogic(LogicAnd).AddFilters(Eq("a", 1), Eq("b", 2))
+		actual      *Filter = And(Eq("a", 1), nil, Eq("b", 2))
+	)
+
+	if !deepEqual(expectation, actual) {
+		t.Errorf("expectation is %+v, got %+v", expectation, actual)
+	}
+}
+
+func TestFilterHelper_ToSQLWithArgs(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Filter      *Filter
+		Dialect     Dialect
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Filter      *Filter
+		Dialect     Dialect
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:    fmt.Sprintf("comparison helpers with dialect %s", DialectPostgres),
+			Filter:  And(Eq("status", "active"), Ne("role", "guest"), Gt("age", 17), Gte("score", 50), Lt("attempts", 3), Lte("balance", 100)),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "status = $1 and role != $2 and age > $3 and score >= $4 and attempts < $5 and balance <= $6",
+				Args:  []interface{}{"active", "guest", 17, 50, 3, 100},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("nested helpers with dialect %s", DialectMySQL),
+			Filter:  Or(And(In("role", []string{"admin", "owner"}), IsNull("deleted_at")), And(NotIn("id", []int{1, 2}), IsNotNull("verified_at")), Like("email", "@mail.com"), NotLike("name", "test")),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "(role in (?, ?) and deleted_at is null) or (id not in (?, ?) and verified_at is not null) or cast(email as char) like concat('%', cast(? as char), '%') or cast(name as char) not like concat('%', cast(? as char), '%')",
+				Args:  []interface{}{"admin", "owner", 1, 2, "@mail.com", "test"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("like pattern helpers with dialect %s", DialectPostgres),
+			Filter:  And(LikePattern("name", "Jo%"), NotLikePattern("bio", "%Spam%")),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "name like $1 and bio not like $2",
+				Args:  []interface{}{"Jo%", "%Spam%"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    "like pattern is not a string",
+			Filter:  LikePattern("name", 1),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &FilterConditionError{Column: "name", Operator: OperatorLikePattern, Err: &UnsupportedValueTypeError{Kind: reflect.Int, Operator: OperatorLikePattern}},
+			},
+		},
+		{
+			Name:    "column, select query and expression values",
+			Filter:  And(Eq("o.user_id", NewField("id").FromTable("u")), In("u.id", Select(NewField("user_id")).From(NewTable("bans"))), Lt("expires_at", Now())),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "o.user_id = u.id and u.id in (select user_id from bans) and expires_at < current_timestamp",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    "comparison with nil",
+			Filter:  Eq("status", nil),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &FilterConditionError{Column: "status", Operator: OperatorEqual, Err: ErrComparisonWithNil},
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Filter.ToSQLWithArgs(testCases[i].Dialect, []interface{}{})
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Errorf("expectation error is %+v, got nil", testCases[i].Expectation.Err)
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Errorf("expectation error is nil, got %+v", actualErr)
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %+v, got %+v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %+v, got %+v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
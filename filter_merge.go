@@ -0,0 +1,136 @@
+package goqube
+
+func (f *Filter) isEmpty() bool {
+	return f.Logic == "" && f.Field == nil && f.Operator == "" && f.Value == nil && len(f.Filters) == 0
+}
+
+func (f *Filter) isIdentity(logic Logic) bool {
+	return f.Logic == "" && f.Field == nil && len(f.Filters) == 0 &&
+		(logic == LogicAnd && f.Operator == OperatorTrue || logic == LogicOr && f.Operator == OperatorFalse)
+}
+
+func (f *Filter) addWithLogic(logic Logic, filter *Filter) *Filter {
+	var current Filter
+
+	if filter == nil {
+		return f
+	}
+
+	if f.isEmpty() || f.isIdentity(logic) {
+		*f = Filter{
+			Logic:   logic,
+			Filters: []*Filter{filter},
+		}
+		return f
+	}
+
+	if f.Logic == logic && f.Field == nil && f.Operator == "" {
+		f.Filters = append(f.Filters, filter)
+		return f
+	}
+
+	current = *f
+	*f = Filter{
+		Logic:   logic,
+		Filters: []*Filter{&current, filter},
+	}
+
+	return f
+}
+
+func (f *Filter) AddAnd(filter *Filter) *Filter {
+	return f.addWithLogic(LogicAnd, filter)
+}
+
+func (f *Filter) AddOr(filter *Filter) *Filter {
+	return f.addWithLogic(LogicOr, filter)
+}
+
+func (f *Filter) matchesColumn(field *Field) bool {
+	return f.Field != nil && f.Field.Table == field.Table && f.Field.Column == field.Column
+}
+
+func (f *Filter) removeField(field *Field) bool {
+	var filters []*Filter
+
+	if f.matchesColumn(field) {
+		return true
+	}
+
+	if len(f.Filters) == 0 {
+		return false
+	}
+
+	filters = make([]*Filter, 0, len(f.Filters))
+	for i := range f.Filters {
+		if f.Filters[i] != nil && !f.Filters[i].removeField(field) {
+			filters = append(filters, f.Filters[i])
+		}
+	}
+
+	f.Filters = filters
+
+	return len(f.Filters) == 0 && f.Field == nil && f.Operator == ""
+}
+
+func (f *Filter) RemoveByField(column string) *Filter {
+	if f.removeField(fieldFromColumn(column)) {
+		*f = *FilterTrue()
+	}
+
+	return f
+}
+
+func (f *Filter) Clone() *Filter {
+	var clone *Filter
+
+	if f == nil {
+		return nil
+	}
+
+	clone = &Filter{
+		Logic:    f.Logic,
+		Field:    f.Field.clone(),
+		Operator: f.Operator,
+		Value:    f.Value.clone(),
+	}
+
+	if f.Filters != nil {
+		clone.Filters = make([]*Filter, len(f.Filters))
+		for i := range f.Filters {
+			clone.Filters[i] = f.Filters[i].Clone()
+		}
+	}
+
+	return clone
+}
+
+func (f *Field) clone() *Field {
+	var clone Field
+
+	if f == nil {
+		return nil
+	}
+
+	clone = *f
+	if f.JSONPath != nil {
+		clone.JSONPath = append([]string{}, f.JSONPath...)
+	}
+
+	return &clone
+}
+
+func (v *FilterValue) clone() *FilterValue {
+	var clone FilterValue
+
+	if v == nil {
+		return nil
+	}
+
+	clone = *v
+	if v.JSONPath != nil {
+		clone.JSONPath = append([]string{}, v.JSONPath...)
+	}
+
+	return &clone
+}
@@ -0,0 +1,215 @@
+package goqube
+
+import "testing"
+
+func TestFilter_AddAnd(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Filter      *Filter
+		Added       *Filter
+		Expectation *Filter
+	} = []struct {
+		Name        string
+		Filter      *Filter
+		Added       *Filter
+		Expectation *Filter
+	}{
+		{
+			Name:        "added filter is nil",
+			Filter:      Eq("status", "active"),
+			Added:       nil,
+			Expectation: Eq("status", "active"),
+		},
+		{
+			Name:        "filter is empty",
+			Filter:      NewFilter(),
+			Added:       Eq("tenant_id", 1),
+			Expectation: &Filter{Logic: LogicAnd, Filters: []*Filter{Eq("tenant_id", 1)}},
+		},
+		{
+			Name:        "filter is true",
+			Filter:      FilterTrue(),
+			Added:       Eq("tenant_id", 1),
+			Expectation: &Filter{Logic: LogicAnd, Filters: []*Filter{Eq("tenant_id", 1)}},
+		},
+		{
+			Name:        "filter is and group",
+			Filter:      And(Eq("status", "active")),
+			Added:       Eq("tenant_id", 1),
+			Expectation: &Filter{Logic: LogicAnd, Filters: []*Filter{Eq("status", "active"), Eq("tenant_id", 1)}},
+		},
+		{
+			Name:        "filter is or group",
+			Filter:      Or(Eq("status", "active"), Eq("status", "pending")),
+			Added:       Eq("tenant_id", 1),
+			Expectation: &Filter{Logic: LogicAnd, Filters: []*Filter{Or(Eq("status", "active"), Eq("status", "pending")), Eq("tenant_id", 1)}},
+		},
+		{
+			Name:        "filter is condition",
+			Filter:      Eq("status", "active"),
+			Added:       Eq("tenant_id", 1),
+			Expectation: &Filter{Logic: LogicAnd, Filters: []*Filter{Eq("status", "active"), Eq("tenant_id", 1)}},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual *Filter = testCases[i].Filter.AddAnd(testCases[i].Added)
+
+			if actual != testCases[i].Filter {
+				t.Errorf("expectation filter is mutated in place, got %p", actual)
+			}
+
+			if !deepEqual(testCases[i].Expectation, actual) {
+				t.Errorf("expectation is %+v, got %+v", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestFilter_AddOr(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Filter      *Filter
+		Added       *Filter
+		Expectation *Filter
+	} = []struct {
+		Name        string
+		Filter      *Filter
+		Added       *Filter
+		Expectation *Filter
+	}{
+		{
+			Name:        "filter is false",
+			Filter:      FilterFalse(),
+			Added:       Eq("role", "admin"),
+			Expectation: &Filter{Logic: LogicOr, Filters: []*Filter{Eq("role", "admin")}},
+		},
+		{
+			Name:        "filter is or group",
+			Filter:      Or(Eq("role", "owner")),
+			Added:       Eq("role", "admin"),
+			Expectation: &Filter{Logic: LogicOr, Filters: []*Filter{Eq("role", "owner"), Eq("role", "admin")}},
+		},
+		{
+			Name:        "filter is and group",
+			Filter:      And(Eq("status", "active"), Eq("tenant_id", 1)),
+			Added:       Eq("role", "admin"),
+			Expectation: &Filter{Logic: LogicOr, Filters: []*Filter{And(Eq("status", "active"), Eq("tenant_id", 1)), Eq("role", "admin")}},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual *Filter = testCases[i].Filter.AddOr(testCases[i].Added)
+
+			if !deepEqual(testCases[i].Expectation, actual) {
+				t.Errorf("expectation is %+v, got %+v", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestFilter_RemoveByField(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Filter      *Filter
+		Column      string
+		Expectation *Filter
+	} = []struct {
+		Name        string
+		Filter      *Filter
+		Column      string
+		Expectation *Filter
+	}{
+		{
+			Name:        "column is not found",
+			Filter:      And(Eq("status", "active"), Eq("tenant_id", 1)),
+			Column:      "role",
+			Expectation: And(Eq("status", "active"), Eq("tenant_id", 1)),
+		},
+		{
+			Name:        "nested conditions are removed",
+			Filter:      And(Eq("tenant_id", 1), Or(Eq("tenant_id", 2), Eq("status", "active")), Or(Eq("tenant_id", 3))),
+			Column:      "tenant_id",
+			Expectation: And(Or(Eq("status", "active"))),
+		},
+		{
+			Name:        "qualified column only matches same table",
+			Filter:      And(Eq("u.tenant_id", 1), Eq("o.tenant_id", 1), Eq("tenant_id", 1)),
+			Column:      "u.tenant_id",
+			Expectation: And(Eq("o.tenant_id", 1), Eq("tenant_id", 1)),
+		},
+		{
+			Name:        "all conditions are removed",
+			Filter:      And(Eq("tenant_id", 1), Or(Eq("tenant_id", 2))),
+			Column:      "tenant_id",
+			Expectation: FilterTrue(),
+		},
+		{
+			Name:        "root condition is removed",
+			Filter:      Eq("tenant_id", 1),
+			Column:      "tenant_id",
+			Expectation: FilterTrue(),
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual *Filter = testCases[i].Filter.RemoveByField(testCases[i].Column)
+
+			if !deepEqual(testCases[i].Expectation, actual) {
+				t.Errorf("expectation is %+v, got %+v", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestFilter_Clone(t *testing.T) {
+	var (
+		filter *Filter
+		clone  *Filter
+	)
+
+	if (*Filter)(nil).Clone() != nil {
+		t.Error("expectation clone of nil filter is nil")
+	}
+
+	filter = And(Eq("status", "active"), Or(Eq("u.role", "admin"), NewFilter().SetCondition(NewField("payload").Path("type"), OperatorEqual, NewColumnFilterValue("event").Path("type"))))
+	clone = filter.Clone()
+
+	if !deepEqual(filter, clone) {
+		t.Errorf("expectation clone is %+v, got %+v", filter, clone)
+	}
+
+	clone.AddAnd(Eq("tenant_id", 1))
+	clone.Filters[1].Filters[0].Field.Table = "r"
+	clone.Filters[1].Filters[1].Field.JSONPath[0] = "kind"
+	clone.Filters[1].Filters[1].Value.JSONPath[0] = "kind"
+	clone.Filters[0].Value.Value = "inactive"
+
+	if !deepEqual(And(Eq("status", "active"), Or(Eq("u.role", "admin"), NewFilter().SetCondition(NewField("payload").Path("type"), OperatorEqual, NewColumnFilterValue("event").Path("type")))), filter) {
+		t.Errorf("expectation original filter is unchanged, got %+v", filter)
+	}
+}
+
+func TestFilter_AddAnd_ToSQLWithArgs(t *testing.T) {
+	var (
+		userFilter  *Filter = Or(Eq("status", "active"), Eq("status", "pending"))
+		expectation string  = "select id from orders where (status = $1 or status = $2) and tenant_id = $3"
+		actual      string
+		err         error
+	)
+
+	actual, _, err = Select(NewField("id")).
+		From(NewTable("orders")).
+		Where(userFilter.Clone().RemoveByField("tenant_id").AddAnd(Eq("tenant_id", 7))).
+		ToSQLWithArgs(DialectPostgres, []interface{}{})
+	if err != nil {
+		t.Errorf("expectation error is nil, got %+v", err)
+	}
+
+	if expectation != actual {
+		t.Errorf("expectation query is %s, got %s", expectation, actual)
+	}
+}
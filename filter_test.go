@@ -1,6 +1,7 @@
 package goqube
 
 import (
+	"database/sql"
 	"fmt"
 	"reflect"
 	"testing"
@@ -68,6 +69,22 @@ func TestFilter_NewFilter(t *testing.T) {
 	testFilter_FilterEquality(t, &Filter{}, NewFilter())
 }
 
+func TestFilter_FilterTrue(t *testing.T) {
+	testFilter_FilterEquality(t, &Filter{Operator: OperatorTrue}, FilterTrue())
+}
+
+func TestFilter_FilterFalse(t *testing.T) {
+	testFilter_FilterEquality(t, &Filter{Operator: OperatorFalse}, FilterFalse())
+}
+
+func TestFilter_SampleByHash(t *testing.T) {
+	testFilter_FilterEquality(
+		t,
+		&Filter{Field: NewField("id"), Operator: OperatorSampleByHash, Value: NewFilterValue(&HashSample{Percent: 10, Seed: "experiment1"})},
+		SampleByHash(NewField("id"), 10, "experiment1"),
+	)
+}
+
 func TestFilter_SetLogic(t *testing.T) {
 	var testCases []struct {
 		Name        string
@@ -909,6 +926,24 @@ func TestFilter_validate(t *testing.T) {
 			Filter:      &Filter{},
 			Expectation: ErrDialectIsRequired,
 		},
+		{
+			Name:        "boolean filter with field",
+			Dialect:     DialectPostgres,
+			Filter:      &Filter{Field: NewField("field1"), Operator: OperatorTrue},
+			Expectation: ErrFieldIsNotEmpty,
+		},
+		{
+			Name:        "boolean filter with value",
+			Dialect:     DialectPostgres,
+			Filter:      &Filter{Operator: OperatorFalse, Value: NewFilterValue(1)},
+			Expectation: ErrValueIsNotNil,
+		},
+		{
+			Name:        "boolean filter is valid",
+			Dialect:     DialectPostgres,
+			Filter:      FilterTrue(),
+			Expectation: nil,
+		},
 		{
 			Name:    "logic is not empty and field is not nil",
 			Dialect: DialectPostgres,
@@ -999,7 +1034,7 @@ func TestFilter_validate(t *testing.T) {
 				Operator: OperatorEqual,
 				Value:    nil,
 			},
-			Expectation: ErrValueIsRequired,
+			Expectation: &FilterConditionError{Column: "field1", Operator: OperatorEqual, Err: ErrValueIsRequired},
 		},
 		{
 			Name:    fmt.Sprintf("logic is empty and filters length is zero and operator is %s and value is not nil or value kind is not %s", OperatorIsNull, reflect.Invalid.String()),
@@ -1013,7 +1048,7 @@ func TestFilter_validate(t *testing.T) {
 					Value: "value1",
 				},
 			},
-			Expectation: ErrValueIsNotNil,
+			Expectation: &FilterConditionError{Column: "field1", Operator: OperatorIsNull, Err: ErrValueIsNotNil},
 		},
 		{
 			Name:    fmt.Sprintf("logic is empty and filters length is zero and operator is not %s and operator is not %s and value kind is %s", OperatorIn, OperatorNotIn, reflect.Slice.String()),
@@ -1027,7 +1062,7 @@ func TestFilter_validate(t *testing.T) {
 					Value: []int64{1, 2, 3},
 				},
 			},
-			Expectation: fmt.Errorf(errUnsupportedValueTypeForOperatorf, reflect.Slice.String(), OperatorEqual),
+			Expectation: &FilterConditionError{Column: "field1", Operator: OperatorEqual, Err: &UnsupportedValueTypeError{Kind: reflect.Slice, Operator: OperatorEqual}},
 		},
 		{
 			Name:    fmt.Sprintf("logic is empty and filters length is zero and operator is %s and value kind is not %s and %s", OperatorIn, reflect.Slice.String(), reflect.Array.String()),
@@ -1041,7 +1076,7 @@ func TestFilter_validate(t *testing.T) {
 					Value: int64(123),
 				},
 			},
-			Expectation: fmt.Errorf(errUnsupportedValueTypeForOperatorf, reflect.Int64.String(), OperatorIn),
+			Expectation: &FilterConditionError{Column: "field1", Operator: OperatorIn, Err: &UnsupportedValueTypeError{Kind: reflect.Int64, Operator: OperatorIn}},
 		},
 		{
 			Name:    fmt.Sprintf("logic is empty and filters length is zero and operator is %s and value length is zero", OperatorIn),
@@ -1055,7 +1090,7 @@ func TestFilter_validate(t *testing.T) {
 					Value: []int64{},
 				},
 			},
-			Expectation: ErrValueIsRequired,
+			Expectation: &FilterConditionError{Column: "field1", Operator: OperatorIn, Err: ErrValueIsRequired},
 		},
 		{
 			Name:    "filter is valid",
@@ -1111,7 +1146,56 @@ func TestFilter_validate(t *testing.T) {
 					},
 				},
 			},
-			Expectation: fmt.Errorf(errUnsupportedValueTypeForOperatorf, reflect.Slice.String(), OperatorEqual),
+			Expectation: &PathError{Path: "Filters[1]", Err: &FilterConditionError{Column: "field2", Operator: OperatorEqual, Err: &UnsupportedValueTypeError{Kind: reflect.Slice, Operator: OperatorEqual}}},
+		},
+		{
+			Name:    "value kind is unsupported",
+			Dialect: DialectPostgres,
+			Filter: &Filter{
+				Field: &Field{
+					Column: "field1",
+				},
+				Operator: OperatorEqual,
+				Value: &FilterValue{
+					Value: make(chan int),
+				},
+			},
+			Expectation: &FilterConditionError{Column: "field1", Operator: OperatorEqual, Err: &UnsupportedValueTypeError{Kind: reflect.Chan, Operator: OperatorEqual}},
+		},
+		{
+			Name:        "like value is not a string",
+			Dialect:     DialectPostgres,
+			Filter:      NewFilter().SetCondition(NewField("age"), OperatorLike, NewFilterValue(10)),
+			Expectation: &FilterConditionError{Column: "age", Operator: OperatorLike, Err: &UnsupportedValueTypeError{Kind: reflect.Int, Operator: OperatorLike}},
+		},
+		{
+			Name:        "like value is a column",
+			Dialect:     DialectPostgres,
+			Filter:      NewFilter().SetCondition(NewField("name"), OperatorLike, NewColumnFilterValue("pattern")),
+			Expectation: nil,
+		},
+		{
+			Name:        "equal value is a nil pointer",
+			Dialect:     DialectPostgres,
+			Filter:      NewFilter().SetCondition(NewField("name").FromTable("users"), OperatorEqual, NewFilterValue((*string)(nil))),
+			Expectation: &FilterConditionError{Column: "users.name", Operator: OperatorEqual, Err: ErrComparisonWithNil},
+		},
+		{
+			Name:        "not equal value is a null valuer",
+			Dialect:     DialectPostgres,
+			Filter:      NewFilter().SetCondition(NewField("name"), OperatorNotEqual, NewFilterValue(sql.NullString{})),
+			Expectation: &FilterConditionError{Column: "name", Operator: OperatorNotEqual, Err: ErrComparisonWithNil},
+		},
+		{
+			Name:    "element filters is nil",
+			Dialect: DialectPostgres,
+			Filter: &Filter{
+				Logic: LogicAnd,
+				Filters: []*Filter{
+					nil,
+				},
+			},
+			Expectation: &PathError{Path: "Filters[0]", Err: ErrFilterIsNil},
 		},
 	}
 
@@ -1524,7 +1608,7 @@ func TestFilter_toSQLWithArgs(t *testing.T) {
 			}{
 				Query: "",
 				Args:  nil,
-				Err:   fmt.Errorf(errUnsupportedValueTypeForOperatorf, reflect.String.String(), OperatorIn),
+				Err:   &PathError{Path: "Filters[0]", Err: fmt.Errorf(errUnsupportedValueTypeForOperatorf, reflect.String.String(), OperatorIn)},
 			},
 		},
 		{
@@ -1954,7 +2038,7 @@ func TestFilter_toSQLWithArgs(t *testing.T) {
 			}{
 				Query: "",
 				Args:  nil,
-				Err:   fmt.Errorf(errUnsupportedValueTypeForOperatorf, reflect.String.String(), OperatorIn),
+				Err:   &PathError{Path: "Filters[0]", Err: fmt.Errorf(errUnsupportedValueTypeForOperatorf, reflect.String.String(), OperatorIn)},
 			},
 		},
 		{
@@ -2026,7 +2110,7 @@ func TestFilter_toSQLWithArgs(t *testing.T) {
 				actualErr   error
 			)
 
-			actualQuery, actualArgs, actualErr = testCases[i].Filter.toSQLWithArgs(testCases[i].Dialect, testCases[i].Args, testCases[i].IsRoot)
+			actualQuery, actualArgs, actualErr = testCases[i].Filter.toSQLWithArgs(newDialectBuildContext(testCases[i].Dialect), testCases[i].Args, testCases[i].IsRoot)
 
 			if testCases[i].Expectation.Err != nil && actualErr == nil {
 				t.Error("expectation error is not nil, got nil")
@@ -2081,6 +2165,291 @@ func TestFilter_ToSQLWithArgs(t *testing.T) {
 			Err   error
 		}
 	}{
+		{
+			Name:    fmt.Sprintf("boolean filters with dialect %s", DialectMySQL),
+			Filter:  NewFilter().SetLogic(LogicOr).AddFilters(FilterFalse(), NewFilter().SetLogic(LogicAnd).AddFilters(FilterTrue(), NewFilter().SetCondition(NewField("field1"), OperatorEqual, NewFilterValue(1)))),
+			Dialect: DialectMySQL,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "1 = 0 or (1 = 1 and field1 = ?)",
+				Args:  []interface{}{1},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("boolean filters with dialect %s", DialectPostgres),
+			Filter:  NewFilter().SetLogic(LogicAnd).AddFilters(FilterTrue(), NewFilter().SetCondition(NewField("field1"), OperatorEqual, NewFilterValue(1)), FilterFalse()),
+			Dialect: DialectPostgres,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "true and field1 = $1 and false",
+				Args:  []interface{}{1},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("sample by hash with dialect %s", DialectMySQL),
+			Filter:  NewFilter().SetLogic(LogicAnd).AddFilters(NewFilter().SetCondition(NewField("status"), OperatorEqual, NewFilterValue("active")), SampleByHash(NewField("id"), 12.5, "experiment1")),
+			Dialect: DialectMySQL,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "status = ? and crc32(concat(?, id)) % 100 < ?",
+				Args:  []interface{}{"active", "experiment1", 12.5},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("sample by hash with dialect %s", DialectPostgres),
+			Filter:  NewFilter().SetLogic(LogicAnd).AddFilters(NewFilter().SetCondition(NewField("status"), OperatorEqual, NewFilterValue("active")), SampleByHash(NewField("id").FromTable("u"), 12.5, "experiment1")),
+			Dialect: DialectPostgres,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "status = $1 and abs(hashtext(concat($2, u.id::text))) % 100 < $3::numeric",
+				Args:  []interface{}{"active", "experiment1", 12.5},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("pattern operators with dialect %s", DialectMySQL),
+			Filter:  NewFilter().SetLogic(LogicAnd).AddFilter(NewField("name"), OperatorStartsWith, NewFilterValue("50%_off")).AddFilter(NewField("email"), OperatorEndsWith, NewFilterValue("@mail.com")).AddFilter(NewField("path"), OperatorContains, NewFilterValue(`a\b`)),
+			Dialect: DialectMySQL,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "cast(name as char) like ? and cast(email as char) like ? and cast(path as char) like ?",
+				Args:  []interface{}{`50\%\_off%`, "%@mail.com", `%a\\b%`},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("pattern operators with dialect %s", DialectPostgres),
+			Filter:  NewFilter().SetLogic(LogicOr).AddFilter(NewField("name").FromTable("u"), OperatorStartsWith, NewFilterValue("jo")).AddFilter(NewField("name").FromTable("u"), OperatorContains, NewFilterValue("100%")),
+			Dialect: DialectPostgres,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: `u.name::text ilike $1 escape '\' or u.name::text ilike $2 escape '\'`,
+				Args:  []interface{}{"jo%", `%100\%%`},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    "pattern operator value is not a string",
+			Filter:  NewFilter().SetCondition(NewField("id"), OperatorStartsWith, NewFilterValue(1)),
+			Dialect: DialectPostgres,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &FilterConditionError{Column: "id", Operator: OperatorStartsWith, Err: ErrPatternValueIsInvalid},
+			},
+		},
+		{
+			Name:    "pattern operator value is a column",
+			Filter:  NewFilter().SetCondition(NewField("name"), OperatorEndsWith, NewColumnFilterValue("suffix")),
+			Dialect: DialectPostgres,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &FilterConditionError{Column: "name", Operator: OperatorEndsWith, Err: ErrPatternValueIsInvalid},
+			},
+		},
+		{
+			Name:    "array operators with dialect postgres",
+			Filter:  NewFilter().SetLogic(LogicAnd).AddFilter(NewField("id"), OperatorEqualAny, NewFilterValue([]int64{1, 2})).AddFilter(NewField("status"), OperatorNotEqualAll, NewFilterValue([]string{"deleted"})).AddFilter(NewField("tags"), OperatorArrayContains, NewFilterValue([]string{"go"})).AddFilter(NewField("tags"), OperatorArrayOverlap, NewFilterValue(Array([]string{"sql", "db"}))),
+			Dialect: DialectPostgres,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "id = any($1) and status != all($2) and tags @> $3 and tags && $4",
+				Args:  []interface{}{Array([]int64{1, 2}), Array([]string{"deleted"}), Array([]string{"go"}), Array([]string{"sql", "db"})},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    "array operator with select query value",
+			Filter:  NewFilter().SetCondition(NewField("id"), OperatorEqualAny, NewSelectQueryFilterValue(Select(NewField("user_id")).From(NewTable("orders")))),
+			Dialect: DialectPostgres,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "id = any(select user_id from orders)",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    "array operator with column value",
+			Filter:  NewFilter().SetCondition(NewField("tags"), OperatorArrayContains, NewColumnFilterValue("required_tags")),
+			Dialect: DialectPostgres,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "tags @> required_tags",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    "array operator with dialect mysql",
+			Filter:  NewFilter().SetCondition(NewField("id"), OperatorEqualAny, NewFilterValue([]int64{1, 2})),
+			Dialect: DialectMySQL,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &FilterConditionError{Column: "id", Operator: OperatorEqualAny, Err: ErrUnsupportedArrayOperator},
+			},
+		},
+		{
+			Name:    "array operator value is not a slice",
+			Filter:  NewFilter().SetCondition(NewField("id"), OperatorEqualAny, NewFilterValue(1)),
+			Dialect: DialectPostgres,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &FilterConditionError{Column: "id", Operator: OperatorEqualAny, Err: &UnsupportedValueTypeError{Kind: reflect.Int, Operator: OperatorEqualAny}},
+			},
+		},
+		{
+			Name:    "equal value is a valuer array",
+			Filter:  NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(testUUID{1, 2})),
+			Dialect: DialectPostgres,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "id = $1",
+				Args:  []interface{}{testUUID{1, 2}},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    "in value is a slice of valuer arrays",
+			Filter:  NewFilter().SetCondition(NewField("id"), OperatorIn, NewFilterValue([]testUUID{{1, 2}, {3, 4}})),
+			Dialect: DialectPostgres,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "id in ($1, $2)",
+				Args:  []interface{}{testUUID{1, 2}, testUUID{3, 4}},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    "in value element type is unsupported",
+			Filter:  NewFilter().SetCondition(NewField("id"), OperatorIn, NewFilterValue([]struct{ ID int }{{ID: 1}})),
+			Dialect: DialectPostgres,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &UnsupportedElementTypeError{Type: reflect.TypeOf(struct{ ID int }{}), Operator: OperatorIn},
+			},
+		},
+		{
+			Name:    "sample by hash field is nil",
+			Filter:  SampleByHash(nil, 10, "experiment1"),
+			Dialect: DialectPostgres,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrFieldIsRequired,
+			},
+		},
+		{
+			Name:    "sample by hash value is not a hash sample",
+			Filter:  NewFilter().SetCondition(NewField("id"), OperatorSampleByHash, NewFilterValue(10)),
+			Dialect: DialectPostgres,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrValueIsRequired,
+			},
+		},
+		{
+			Name:    "sample by hash percent is out of range",
+			Filter:  SampleByHash(NewField("id"), 101, "experiment1"),
+			Dialect: DialectPostgres,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrSamplePercentIsInvalid,
+			},
+		},
 		{
 			Name: "invalid validation",
 			Filter: &Filter{
@@ -2106,7 +2475,7 @@ func TestFilter_ToSQLWithArgs(t *testing.T) {
 			}{
 				Query: "",
 				Args:  []interface{}{},
-				Err:   fmt.Errorf(errUnsupportedValueTypeForOperatorf, reflect.Slice.String(), OperatorEqual),
+				Err:   &PathError{Path: "Filters[0]", Err: &FilterConditionError{Column: "field1", Operator: OperatorEqual, Err: &UnsupportedValueTypeError{Kind: reflect.Slice, Operator: OperatorEqual}}},
 			},
 		},
 		{
@@ -2137,6 +2506,41 @@ func TestFilter_ToSQLWithArgs(t *testing.T) {
 				Err:   nil,
 			},
 		},
+		{
+			Name: fmt.Sprintf("expression filters with dialect %s", DialectPostgres),
+			Filter: NewFilter().
+				SetLogic(LogicAnd).
+				AddFilter(NewRawField(NewRaw("lower(u.email)")), OperatorEqual, NewRawFilterValue(NewRaw("lower(o.email)"))).
+				AddFilter(NewField("created_at"), OperatorGreaterThan, NewRawFilterValue(NewRaw("updated_at + ?::interval", "1 day"))).
+				AddFilter(NewRawField(NewRaw("extract(year from created_at)")), OperatorIn, NewRawFilterValue(NewRaw("select year from fiscal_years where active = ?", true))).
+				AddFilter(NewField("status"), OperatorEqual, NewFilterValue("active")),
+			Dialect: DialectPostgres,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "lower(u.email) = lower(o.email) and created_at > updated_at + $1::interval and extract(year from created_at) in (select year from fiscal_years where active = $2) and status = $3",
+				Args:  []interface{}{"1 day", true, "active"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("expression filter with dialect %s", DialectMySQL),
+			Filter:  NewFilter().SetCondition(NewRawField(NewRaw("date(created_at)")), OperatorEqual, NewRawFilterValue(NewRaw("date(?)", "2024-01-01"))),
+			Dialect: DialectMySQL,
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "date(created_at) = date(?)",
+				Args:  []interface{}{"2024-01-01"},
+				Err:   nil,
+			},
+		},
 	}
 
 	for i := range testCases {
@@ -2179,3 +2583,147 @@ func TestFilter_ToSQLWithArgs(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkFilter_ToSQLWithArgs(b *testing.B) {
+	var filter *Filter = NewFilter().SetLogic(LogicAnd)
+
+	for i := 0; i < 16; i++ {
+		filter.AddFilters(
+			NewFilter().SetLogic(LogicOr).AddFilters(
+				NewFilter().SetCondition(NewField("status").FromTable("t"), OperatorEqual, NewFilterValue("active")),
+				NewFilter().SetCondition(NewField("id").FromTable("t"), OperatorIn, NewFilterValue([]int{1, 2, 3})),
+			),
+		)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _, err := filter.ToSQLWithArgs(DialectPostgres, []interface{}{})
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestFilter_nilAsNull(t *testing.T) {
+	var (
+		nilString *string
+		testCases []struct {
+			Name        string
+			NilAsNull   bool
+			Filter      *Filter
+			Expectation struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}
+		}
+	)
+
+	testCases = []struct {
+		Name        string
+		NilAsNull   bool
+		Filter      *Filter
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:      "equal nil without option",
+			NilAsNull: false,
+			Filter:    Eq("deleted_at", nil),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &PathError{Path: "Filter", Err: &FilterConditionError{Column: "deleted_at", Operator: OperatorEqual, Err: ErrComparisonWithNil}},
+			},
+		},
+		{
+			Name:      "equal nil",
+			NilAsNull: true,
+			Filter:    Eq("deleted_at", nil),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from users where deleted_at is null",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:      "nested not equal nil pointer and null valuer",
+			NilAsNull: true,
+			Filter: NewFilter().
+				SetLogic(LogicAnd).
+				AddFilter(NewField("status"), OperatorEqual, NewFilterValue("active")).
+				AddFilter(NewField("manager_id"), OperatorNotEqual, NewFilterValue(nilString)).
+				AddFilter(NewField("nickname"), OperatorEqual, NewFilterValue(sql.NullString{})),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from users where status = $1 and manager_id is not null and nickname is null",
+				Args:  []interface{}{"active"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:      "greater than nil is still rejected",
+			NilAsNull: true,
+			Filter:    NewFilter().SetCondition(NewField("age"), OperatorGreaterThan, NewFilterValue(nil)),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &PathError{Path: "Filter", Err: &FilterConditionError{Column: "age", Operator: OperatorGreaterThan, Err: ErrComparisonWithNil}},
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				query       *SelectQuery = Select(NewField("id")).From(NewTable("users")).Where(testCases[i].Filter)
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = NewConfig(DialectPostgres).SetNilAsNull(testCases[i].NilAsNull).Build(query)
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Errorf("expectation error is nil, got %s", actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Err != nil && (actualErr == nil || testCases[i].Expectation.Err.Error() != actualErr.Error()) {
+				t.Errorf("expectation error is %s, got %v", testCases[i].Expectation.Err.Error(), actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %+v, got %+v", testCases[i].Expectation.Args, actualArgs)
+			}
+
+			if testCases[i].Filter.Operator == OperatorIsNull || testCases[i].Filter.Operator == OperatorIsNotNull {
+				t.Errorf("expectation filter operator is not rewritten, got %s", testCases[i].Filter.Operator)
+			}
+		})
+	}
+}
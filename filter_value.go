@@ -6,7 +6,11 @@ type FilterValue struct {
 	Value       interface{}
 	Table       string
 	Column      string
+	OuterTable  string
 	SelectQuery *SelectQuery
+	Raw         *Raw
+	Expression  *Expression
+	JSONPath    []string
 }
 
 func NewFilterValue(value interface{}) *FilterValue {
@@ -21,39 +25,99 @@ func NewColumnFilterValue(column string) *FilterValue {
 	}
 }
 
+func NewOuterColumnFilterValue(outerTable, column string) *FilterValue {
+	return &FilterValue{
+		OuterTable: outerTable,
+		Column:     column,
+	}
+}
+
 func NewSelectQueryFilterValue(selectQuery *SelectQuery) *FilterValue {
 	return &FilterValue{
 		SelectQuery: selectQuery,
 	}
 }
 
+func NewRawFilterValue(raw *Raw) *FilterValue {
+	return &FilterValue{
+		Raw: raw,
+	}
+}
+
+func NewExpressionFilterValue(expression *Expression) *FilterValue {
+	return &FilterValue{
+		Expression: expression,
+	}
+}
+
 func (v *FilterValue) FromTable(table string) *FilterValue {
 	v.Table = table
 
 	return v
 }
 
+func (v *FilterValue) FromOuterTable(outerTable string) *FilterValue {
+	v.OuterTable = outerTable
+
+	return v
+}
+
+func (v *FilterValue) Path(keys ...string) *FilterValue {
+	v.JSONPath = keys
+
+	return v
+}
+
 func (v *FilterValue) validate(dialect Dialect) error {
 	if dialect == "" {
 		return ErrDialectIsRequired
 	}
 
+	if v.Raw != nil && (v.Column != "" || v.SelectQuery != nil || v.Value != nil) {
+		return ErrConflictFilterValueRaw
+	}
+
+	if v.Expression != nil && (v.Column != "" || v.SelectQuery != nil || v.Raw != nil || v.Value != nil) {
+		return ErrConflictFilterValueExpression
+	}
+
+	if v.OuterTable != "" && v.Table != "" {
+		return ErrConflictFilterValueOuterTable
+	}
+
+	if v.OuterTable != "" && v.Column == "" {
+		return ErrOuterTableRequiresColumn
+	}
+
+	if len(v.JSONPath) > 0 && v.Column == "" {
+		return ErrJSONPathRequiresColumn
+	}
+
+	if !isValidJSONPath(v.JSONPath) {
+		return ErrJSONPathIsInvalid
+	}
+
 	return nil
 }
 
-func (v *FilterValue) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+func (v *FilterValue) isExpression() bool {
+	return v.Column != "" || v.SelectQuery != nil || v.Raw != nil || v.Expression != nil
+}
+
+func (v *FilterValue) toSQLWithArgs(bc *buildContext, args []interface{}) (string, []interface{}, error) {
 	var (
 		query string
+		value interface{}
 		err   error
 	)
 
-	err = v.validate(dialect)
+	err = v.validate(bc.dialect)
 	if err != nil {
 		return "", nil, err
 	}
 
 	if v.SelectQuery != nil {
-		query, args, err = v.SelectQuery.ToSQLWithArgsWithAlias(dialect, args)
+		query, args, err = v.SelectQuery.toSQLWithArgsWithAlias(bc, args)
 		if err != nil {
 			return "", nil, err
 		}
@@ -63,17 +127,58 @@ func (v *FilterValue) ToSQLWithArgs(dialect Dialect, args []interface{}) (string
 		return query, args, nil
 	}
 
+	if v.Raw != nil {
+		return v.Raw.toSQLWithArgs(bc, args)
+	}
+
+	if v.Expression != nil {
+		return v.Expression.toSQLWithArgs(bc, args)
+	}
+
+	if v.OuterTable != "" {
+		return v.outerColumnToSQLWithArgs(bc, args)
+	}
+
 	if v.SelectQuery == nil && v.Column != "" {
-		query = v.Column
+		query = bc.physicalColumn(v.Table, v.Column)
 
 		if v.Table != "" {
-			query = fmt.Sprintf("%s.%s", v.Table, query)
+			query = fmt.Sprintf("%s.%s", quoteQualifier(bc.dialect, bc.qualifierName(v.Table)), query)
 		}
 
-		return query, args, nil
+		return jsonPathExpression(bc.dialect, query, v.JSONPath), args, nil
 	}
 
-	args = append(args, v.Value)
+	value = bc.adaptValue(v.Value)
+	args = append(args, value)
+
+	if _, ok := value.(UUIDValue); ok {
+		return valuePlaceholder(bc.dialect, value, len(args)), args, nil
+	}
 
 	return "", args, nil
 }
+
+func (v *FilterValue) outerColumnToSQLWithArgs(bc *buildContext, args []interface{}) (string, []interface{}, error) {
+	var (
+		scope *referenceScope = bc.scope.outer(v.OuterTable)
+		query string
+	)
+
+	if scope == nil {
+		return "", nil, fmt.Errorf(errOuterTablef, ErrOuterTableIsNotFound, v.OuterTable)
+	}
+
+	query = bc.mapColumnName(v.Column)
+	if bc.config.Schema != nil {
+		query = bc.tableColumn(scope.table(v.OuterTable), v.Column)
+	}
+
+	query = fmt.Sprintf("%s.%s", quoteQualifier(bc.dialect, bc.qualifierName(v.OuterTable)), query)
+
+	return jsonPathExpression(bc.dialect, query, v.JSONPath), args, nil
+}
+
+func (v *FilterValue) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+	return v.toSQLWithArgs(newDialectBuildContext(dialect), args)
+}
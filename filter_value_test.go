@@ -1,6 +1,9 @@
 package goqube
 
-import "testing"
+import (
+	"errors"
+	"testing"
+)
 
 func testFilterValue_FilterValueEquality(t *testing.T, expectation, actual *FilterValue) {
 	if expectation == nil && actual == nil {
@@ -27,6 +30,14 @@ func testFilterValue_FilterValueEquality(t *testing.T, expectation, actual *Filt
 		t.Errorf("expectation column is %s, got %s", expectation.Column, actual.Column)
 	}
 
+	if !deepEqual(expectation.Raw, actual.Raw) {
+		t.Errorf("expectation raw is %+v, got %+v", expectation.Raw, actual.Raw)
+	}
+
+	if !deepEqual(expectation.Expression, actual.Expression) {
+		t.Errorf("expectation expression is %+v, got %+v", expectation.Expression, actual.Expression)
+	}
+
 	if expectation.SelectQuery == nil && actual.SelectQuery != nil {
 		t.Errorf("expectation select query is nil, got %+v", actual.SelectQuery)
 	}
@@ -70,6 +81,22 @@ func TestFilterValue_NewColumnFilterValue(t *testing.T) {
 	testFilterValue_FilterValueEquality(t, expectation, actual)
 }
 
+func TestFilterValue_NewOuterColumnFilterValue(t *testing.T) {
+	var (
+		expectation *FilterValue
+		actual      *FilterValue
+	)
+
+	expectation = &FilterValue{
+		OuterTable: "p",
+		Column:     "id",
+	}
+
+	actual = NewOuterColumnFilterValue("p", "id")
+
+	testFilterValue_FilterValueEquality(t, expectation, actual)
+}
+
 func TestFilterValue_NewSelectQueryFilterValue(t *testing.T) {
 	var (
 		expectation *FilterValue
@@ -97,6 +124,28 @@ func TestFilterValue_NewSelectQueryFilterValue(t *testing.T) {
 	testFilterValue_FilterValueEquality(t, expectation, actual)
 }
 
+func TestFilterValue_NewRawFilterValue(t *testing.T) {
+	testFilterValue_FilterValueEquality(
+		t,
+		&FilterValue{
+			Raw: &Raw{
+				SQL: "lower(email)",
+			},
+		},
+		NewRawFilterValue(NewRaw("lower(email)")),
+	)
+}
+
+func TestFilterValue_NewExpressionFilterValue(t *testing.T) {
+	testFilterValue_FilterValueEquality(
+		t,
+		&FilterValue{
+			Expression: Now(),
+		},
+		NewExpressionFilterValue(Now()),
+	)
+}
+
 func TestFilterValue_FromTable(t *testing.T) {
 	var (
 		expectation *FilterValue
@@ -114,6 +163,23 @@ func TestFilterValue_FromTable(t *testing.T) {
 	testFilterValue_FilterValueEquality(t, expectation, actual)
 }
 
+func TestFilterValue_FromOuterTable(t *testing.T) {
+	var (
+		expectation *FilterValue
+		actual      *FilterValue
+	)
+
+	expectation = &FilterValue{
+		OuterTable: "p",
+		Column:     "id",
+	}
+
+	actual = NewColumnFilterValue("id").
+		FromOuterTable("p")
+
+	testFilterValue_FilterValueEquality(t, expectation, actual)
+}
+
 func TestFilterValue_validate(t *testing.T) {
 	var testCases []struct {
 		Name        string
@@ -132,6 +198,42 @@ func TestFilterValue_validate(t *testing.T) {
 			FilterValue: &FilterValue{},
 			Expectation: ErrDialectIsRequired,
 		},
+		{
+			Name:    "raw is not nil and value is not nil",
+			Dialect: DialectPostgres,
+			FilterValue: &FilterValue{
+				Value: "value1",
+				Raw:   NewRaw("lower(email)"),
+			},
+			Expectation: ErrConflictFilterValueRaw,
+		},
+		{
+			Name:    "expression is not nil and column is not empty",
+			Dialect: DialectPostgres,
+			FilterValue: &FilterValue{
+				Column:     "updated_at",
+				Expression: Now(),
+			},
+			Expectation: ErrConflictFilterValueExpression,
+		},
+		{
+			Name:    "outer table is not empty and table is not empty",
+			Dialect: DialectPostgres,
+			FilterValue: &FilterValue{
+				Table:      "c",
+				OuterTable: "p",
+				Column:     "id",
+			},
+			Expectation: ErrConflictFilterValueOuterTable,
+		},
+		{
+			Name:    "outer table is not empty and column is empty",
+			Dialect: DialectPostgres,
+			FilterValue: &FilterValue{
+				OuterTable: "p",
+			},
+			Expectation: ErrOuterTableRequiresColumn,
+		},
 		{
 			Name:    "filter value is valid",
 			Dialect: DialectPostgres,
@@ -323,3 +425,126 @@ func TestFilterValue_ToSQLWithArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestFilterValue_ToSQLWithArgs_OuterTable(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Config      *Config
+		Query       *SelectQuery
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Config      *Config
+		Query       *SelectQuery
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:   "outer table alias",
+			Config: NewConfig(DialectPostgres),
+			Query: Select(
+				NewField("id").FromTable("p"),
+				NewSelectQueryField(Select(NewRawField(NewRaw("count(*)"))).
+					From(NewTable("comments").As("c")).
+					Where(NewFilter().
+						SetLogic(LogicAnd).
+						AddFilter(NewField("post_id").FromTable("c"), OperatorEqual, NewOuterColumnFilterValue("p", "id")).
+						AddFilter(NewField("status").FromTable("c"), OperatorEqual, NewFilterValue("approved")))).As("comment_count"),
+			).
+				From(NewTable("posts").As("p")).
+				Where(NewFilter().SetCondition(NewField("published").FromTable("p"), OperatorEqual, NewFilterValue(true))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select p.id, (select count(*) from comments as c where c.post_id = p.id and c.status = $1) as comment_count from posts as p where p.published = $2",
+				Args:  []interface{}{"approved", true},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "outer table alias is shadowed by inner alias",
+			Config: NewConfig(DialectPostgres).SetSchema(NewSchema().AddTables(NewSchemaTable("users").RenameColumn("id", "user_id"))),
+			Query: Select(NewField("id").FromTable("t")).
+				From(NewTable("users").As("t")).
+				Where(NewFilter().SetCondition(NewField("id").FromTable("t"), OperatorIn, NewSelectQueryFilterValue(Select(NewField("owner_id").FromTable("t")).
+					From(NewTable("orders").As("t")).
+					Where(NewFilter().SetCondition(NewField("id").FromTable("t"), OperatorEqual, NewOuterColumnFilterValue("t", "id")))))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select t.user_id as id from users as t where t.user_id in (select t.owner_id from orders as t where t.id = t.user_id)",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "outer table without alias",
+			Config: NewConfig(DialectMySQL),
+			Query: Select(NewField("id")).
+				From(NewTable("posts")).
+				Where(NewFilter().SetCondition(NewField("id"), OperatorIn, NewSelectQueryFilterValue(Select(NewField("post_id")).
+					From(NewTable("comments")).
+					Where(NewFilter().SetCondition(NewField("author_id"), OperatorEqual, NewOuterColumnFilterValue("posts", "author_id")))))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from posts where id in (select post_id from comments where author_id = posts.author_id)",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "outer table is not found",
+			Config: NewConfig(DialectPostgres),
+			Query: Select(NewField("id")).
+				From(NewTable("posts").As("p")).
+				Where(NewFilter().SetCondition(NewField("id").FromTable("p"), OperatorEqual, NewOuterColumnFilterValue("p", "id"))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrOuterTableIsNotFound,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Config.Build(testCases[i].Query)
+
+			if !errors.Is(actualErr, testCases[i].Expectation.Err) {
+				t.Errorf("expectation error is %v, got %v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %+v, got %+v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
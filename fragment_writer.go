@@ -0,0 +1,188 @@
+package goqube
+
+import "strings"
+
+type Fragment interface {
+	WriteFragment(w *FragmentWriter) error
+}
+
+type FragmentWriter struct {
+	bc      *buildContext
+	builder strings.Builder
+	args    []interface{}
+	err     error
+}
+
+func NewFragmentWriter(dialect Dialect, args []interface{}) *FragmentWriter {
+	return newFragmentWriter(newDialectBuildContext(dialect), args)
+}
+
+func newFragmentWriter(bc *buildContext, args []interface{}) *FragmentWriter {
+	return &FragmentWriter{
+		bc:   bc,
+		args: args,
+	}
+}
+
+func (w *FragmentWriter) Dialect() Dialect {
+	return w.bc.dialect
+}
+
+func (w *FragmentWriter) WriteSQL(sql string) *FragmentWriter {
+	if w.err != nil {
+		return w
+	}
+
+	w.builder.WriteString(sql)
+	return w
+}
+
+func (w *FragmentWriter) WriteIdentifier(identifier string) *FragmentWriter {
+	var parts []string
+
+	if w.err != nil {
+		return w
+	}
+
+	if identifier == "" {
+		w.err = ErrColumnIsRequired
+		return w
+	}
+
+	if !isValidAlias(identifier) {
+		w.err = ErrAliasIsInvalid
+		return w
+	}
+
+	parts = strings.Split(identifier, ".")
+	for i := range parts {
+		parts[i] = quoteAlias(w.bc.dialect, parts[i])
+	}
+
+	w.builder.WriteString(strings.Join(parts, "."))
+	return w
+}
+
+func (w *FragmentWriter) WriteArg(value interface{}) *FragmentWriter {
+	if w.err != nil {
+		return w
+	}
+
+	w.args = append(w.args, value)
+	w.builder.WriteString(getPlaceholder(w.bc.dialect, len(w.args), len(w.args)))
+	return w
+}
+
+func (w *FragmentWriter) WriteArgs(values ...interface{}) *FragmentWriter {
+	if w.err != nil {
+		return w
+	}
+
+	if len(values) == 0 {
+		w.err = ErrValuesIsRequired
+		return w
+	}
+
+	w.args = append(w.args, values...)
+	w.builder.WriteString(getPlaceholder(w.bc.dialect, len(w.args)-len(values)+1, len(w.args)))
+	return w
+}
+
+func (w *FragmentWriter) WriteQuery(query Query) *FragmentWriter {
+	var sql string
+
+	if w.err != nil {
+		return w
+	}
+
+	if query == nil {
+		w.err = ErrQueryIsRequired
+		return w
+	}
+
+	sql, w.args, w.err = query.toSQLWithArgs(w.bc, w.args)
+	if w.err != nil {
+		return w
+	}
+
+	w.builder.WriteString("(")
+	w.builder.WriteString(sql)
+	w.builder.WriteString(")")
+	return w
+}
+
+func (w *FragmentWriter) WriteRaw(raw *Raw) *FragmentWriter {
+	var sql string
+
+	if w.err != nil {
+		return w
+	}
+
+	if raw == nil {
+		w.err = ErrSQLIsRequired
+		return w
+	}
+
+	sql, w.args, w.err = raw.toSQLWithArgs(w.bc, w.args)
+	if w.err != nil {
+		return w
+	}
+
+	w.builder.WriteString(sql)
+	return w
+}
+
+func (w *FragmentWriter) WriteFragment(fragment Fragment) *FragmentWriter {
+	if w.err != nil {
+		return w
+	}
+
+	if fragment == nil {
+		w.err = ErrFragmentIsRequired
+		return w
+	}
+
+	w.err = fragment.WriteFragment(w)
+	return w
+}
+
+func (w *FragmentWriter) SQL() string {
+	return w.builder.String()
+}
+
+func (w *FragmentWriter) Args() []interface{} {
+	return w.args
+}
+
+func (w *FragmentWriter) Err() error {
+	return w.err
+}
+
+type FragmentQuery struct {
+	Fragment Fragment
+}
+
+func NewFragmentQuery(fragment Fragment) *FragmentQuery {
+	return &FragmentQuery{
+		Fragment: fragment,
+	}
+}
+
+func (f *FragmentQuery) toSQLWithArgs(bc *buildContext, args []interface{}) (string, []interface{}, error) {
+	var writer *FragmentWriter
+
+	if bc.dialect == "" {
+		return "", nil, ErrDialectIsRequired
+	}
+
+	writer = newFragmentWriter(bc, args).WriteFragment(f.Fragment)
+	if writer.Err() != nil {
+		return "", nil, writer.Err()
+	}
+
+	return writer.SQL(), writer.Args(), nil
+}
+
+func (f *FragmentQuery) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+	return f.toSQLWithArgs(newDialectBuildContext(dialect), args)
+}
@@ -0,0 +1,364 @@
+package goqube
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+type fragmentWriterTestMatch struct {
+	Columns []string
+	Search  string
+}
+
+func (m *fragmentWriterTestMatch) WriteFragment(w *FragmentWriter) error {
+	if w.Dialect() != DialectMySQL {
+		return errors.New("match against is only supported by mysql")
+	}
+
+	w.WriteSQL("match (")
+	for i := range m.Columns {
+		if i > 0 {
+			w.WriteSQL(", ")
+		}
+		w.WriteIdentifier(m.Columns[i])
+	}
+
+	return w.WriteSQL(") against (").WriteArg(m.Search).WriteSQL(" in boolean mode)").Err()
+}
+
+func TestFragmentWriter_NewFragmentWriter(t *testing.T) {
+	var actual *FragmentWriter = NewFragmentWriter(DialectPostgres, []interface{}{1})
+
+	if actual.Dialect() != DialectPostgres {
+		t.Errorf("expectation dialect is %s, got %s", DialectPostgres, actual.Dialect())
+	}
+
+	if !deepEqual([]interface{}{1}, actual.Args()) {
+		t.Errorf("expectation args is %+v, got %+v", []interface{}{1}, actual.Args())
+	}
+
+	if actual.SQL() != "" {
+		t.Errorf("expectation sql is empty, got %s", actual.SQL())
+	}
+}
+
+func TestFragmentWriter_Write(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Write       func(w *FragmentWriter) *FragmentWriter
+		Expectation struct {
+			SQL  string
+			Args []interface{}
+			Err  error
+		}
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Write       func(w *FragmentWriter) *FragmentWriter
+		Expectation struct {
+			SQL  string
+			Args []interface{}
+			Err  error
+		}
+	}{
+		{
+			Name:    "identifier is empty",
+			Dialect: DialectPostgres,
+			Write: func(w *FragmentWriter) *FragmentWriter {
+				return w.WriteIdentifier("").WriteArg(1)
+			},
+			Expectation: struct {
+				SQL  string
+				Args []interface{}
+				Err  error
+			}{
+				SQL:  "",
+				Args: []interface{}{},
+				Err:  ErrColumnIsRequired,
+			},
+		},
+		{
+			Name:    "identifier is invalid",
+			Dialect: DialectPostgres,
+			Write: func(w *FragmentWriter) *FragmentWriter {
+				return w.WriteIdentifier("a\nb")
+			},
+			Expectation: struct {
+				SQL  string
+				Args []interface{}
+				Err  error
+			}{
+				SQL:  "",
+				Args: []interface{}{},
+				Err:  ErrAliasIsInvalid,
+			},
+		},
+		{
+			Name:    "args is empty",
+			Dialect: DialectPostgres,
+			Write: func(w *FragmentWriter) *FragmentWriter {
+				return w.WriteArgs()
+			},
+			Expectation: struct {
+				SQL  string
+				Args []interface{}
+				Err  error
+			}{
+				SQL:  "",
+				Args: []interface{}{},
+				Err:  ErrValuesIsRequired,
+			},
+		},
+		{
+			Name:    "query is nil",
+			Dialect: DialectPostgres,
+			Write: func(w *FragmentWriter) *FragmentWriter {
+				return w.WriteQuery(nil)
+			},
+			Expectation: struct {
+				SQL  string
+				Args []interface{}
+				Err  error
+			}{
+				SQL:  "",
+				Args: []interface{}{},
+				Err:  ErrQueryIsRequired,
+			},
+		},
+		{
+			Name:    "raw is nil",
+			Dialect: DialectPostgres,
+			Write: func(w *FragmentWriter) *FragmentWriter {
+				return w.WriteRaw(nil)
+			},
+			Expectation: struct {
+				SQL  string
+				Args []interface{}
+				Err  error
+			}{
+				SQL:  "",
+				Args: []interface{}{},
+				Err:  ErrSQLIsRequired,
+			},
+		},
+		{
+			Name:    "fragment is nil",
+			Dialect: DialectPostgres,
+			Write: func(w *FragmentWriter) *FragmentWriter {
+				return w.WriteFragment(nil)
+			},
+			Expectation: struct {
+				SQL  string
+				Args []interface{}
+				Err  error
+			}{
+				SQL:  "",
+				Args: []interface{}{},
+				Err:  ErrFragmentIsRequired,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("write with dialect %s", DialectMySQL),
+			Dialect: DialectMySQL,
+			Write: func(w *FragmentWriter) *FragmentWriter {
+				return w.WriteIdentifier("u.order").
+					WriteSQL(" in (").
+					WriteArgs(1, 2).
+					WriteSQL(") or ").
+					WriteIdentifier("id").
+					WriteSQL(" in ").
+					WriteQuery(Select(NewField("user_id")).From(NewTable("orders")).Where(NewFilter().SetCondition(NewField("status"), OperatorEqual, NewFilterValue("paid"))))
+			},
+			Expectation: struct {
+				SQL  string
+				Args []interface{}
+				Err  error
+			}{
+				SQL:  "u.`order` in (?, ?) or id in (select user_id from orders where status = ?)",
+				Args: []interface{}{1, 2, "paid"},
+				Err:  nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("write with dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			Write: func(w *FragmentWriter) *FragmentWriter {
+				return w.WriteIdentifier("u.order").
+					WriteSQL(" = ").
+					WriteArg(1).
+					WriteSQL(" and ").
+					WriteRaw(NewRaw("coalesce(name, ?)", "unknown")).
+					WriteSQL(" in ").
+					WriteQuery(Select(NewField("name")).From(NewTable("users")).Where(NewFilter().SetCondition(NewField("status"), OperatorEqual, NewFilterValue("active"))))
+			},
+			Expectation: struct {
+				SQL  string
+				Args []interface{}
+				Err  error
+			}{
+				SQL:  `u."order" = $1 and coalesce(name, $2) in (select name from users where status = $3)`,
+				Args: []interface{}{1, "unknown", "active"},
+				Err:  nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual *FragmentWriter = testCases[i].Write(NewFragmentWriter(testCases[i].Dialect, []interface{}{}))
+
+			if testCases[i].Expectation.Err != nil && actual.Err() == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actual.Err() != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actual.Err() != nil && testCases[i].Expectation.Err.Error() != actual.Err().Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actual.Err().Error())
+			}
+
+			if testCases[i].Expectation.SQL != actual.SQL() {
+				t.Errorf("expectation sql is %s, got %s", testCases[i].Expectation.SQL, actual.SQL())
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actual.Args()) {
+				t.Errorf("expectation args is %+v, got %+v", testCases[i].Expectation.Args, actual.Args())
+			}
+		})
+	}
+}
+
+func TestFragmentWriter_NewFragmentRaw(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		SelectQuery *SelectQuery
+		Dialect     Dialect
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		SelectQuery *SelectQuery
+		Dialect     Dialect
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name: "fragment returns error",
+			SelectQuery: Select(NewField("id")).
+				From(NewTable("articles")).
+				Where(NewFilter().SetCondition(&Field{Raw: NewFragmentRaw(&fragmentWriterTestMatch{Columns: []string{"title"}, Search: "go"})}, OperatorGreaterThan, NewFilterValue(0))),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &PathError{Path: "Filter", Err: errors.New("match against is only supported by mysql")},
+			},
+		},
+		{
+			Name: "raw sql and fragment",
+			SelectQuery: Select(&Field{Raw: &Raw{SQL: "1", Fragment: &fragmentWriterTestMatch{}}, Alias: "score"}).
+				From(NewTable("articles")),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &PathError{Path: "Fields[0]", Err: ErrConflictRawFragment},
+			},
+		},
+		{
+			Name: fmt.Sprintf("fragment with dialect %s", DialectMySQL),
+			SelectQuery: Select(NewField("id")).
+				From(NewTable("articles")).
+				Where(NewFilter().SetLogic(LogicAnd).AddFilters(
+					NewFilter().SetCondition(NewField("status"), OperatorEqual, NewFilterValue("published")),
+					NewFilter().SetCondition(&Field{Raw: NewFragmentRaw(&fragmentWriterTestMatch{Columns: []string{"title", "body"}, Search: "+go -java"})}, OperatorGreaterThan, NewFilterValue(0)),
+				)),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from articles where status = ? and match (title, body) against (? in boolean mode) > ?",
+				Args:  []interface{}{"published", "+go -java", 0},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].SelectQuery.ToSQLWithArgs(testCases[i].Dialect, []interface{}{})
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %+v, got %+v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
+
+func TestFragmentWriter_NewFragmentQuery(t *testing.T) {
+	var (
+		query string
+		args  []interface{}
+		err   error
+	)
+
+	_, _, err = NewFragmentQuery(&fragmentWriterTestMatch{}).ToSQLWithArgs("", []interface{}{})
+	if err != ErrDialectIsRequired {
+		t.Errorf("expectation error is %v, got %v", ErrDialectIsRequired, err)
+	}
+
+	query, args, err = NewConfig(DialectMySQL).Build(NewFragmentQuery(&fragmentWriterTestMatch{Columns: []string{"title"}, Search: "go"}))
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %s", err.Error())
+	}
+
+	if query != "match (title) against (? in boolean mode)" {
+		t.Errorf("expectation query is %s, got %s", "match (title) against (? in boolean mode)", query)
+	}
+
+	if !deepEqual([]interface{}{"go"}, args) {
+		t.Errorf("expectation args is %+v, got %+v", []interface{}{"go"}, args)
+	}
+}
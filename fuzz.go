@@ -0,0 +1,251 @@
+package goqube
+
+const (
+	fuzzMaxDepth    int = 3
+	fuzzMaxChildren int = 4
+)
+
+var (
+	fuzzDialects    []Dialect  = []Dialect{DialectMySQL, DialectPostgres, ""}
+	fuzzIdentifiers []string   = []string{"", "id", "name", "status", "t1", "t2", "created_at"}
+	fuzzLogics      []Logic    = []Logic{"", LogicAnd, LogicOr}
+	fuzzOperators   []Operator = []Operator{
+		"",
+		"unknown",
+		OperatorEqual,
+		OperatorNotEqual,
+		OperatorGreaterThan,
+		OperatorGreaterThanOrEqual,
+		OperatorLessThan,
+		OperatorLessThanOrEqual,
+		OperatorIsNull,
+		OperatorIsNotNull,
+		OperatorIn,
+		OperatorNotIn,
+		OperatorLike,
+		OperatorNotLike,
+		OperatorLikePattern,
+		OperatorNotLikePattern,
+		OperatorStartsWith,
+		OperatorEndsWith,
+		OperatorContains,
+		OperatorEqualAny,
+		OperatorNotEqualAll,
+		OperatorArrayContains,
+		OperatorArrayOverlap,
+	}
+	fuzzDirections []SortDirection = []SortDirection{"", SortDirectionAscending, SortDirectionDescending}
+	fuzzJoinTypes  []JoinType      = []JoinType{"", InnerJoinType, LeftJoinType, RightJoinType, FullJoinType}
+)
+
+type fuzzReader struct {
+	data  []byte
+	index int
+}
+
+func (r *fuzzReader) next() byte {
+	var b byte
+
+	if r.index >= len(r.data) {
+		return 0
+	}
+
+	b = r.data[r.index]
+	r.index++
+
+	return b
+}
+
+func (r *fuzzReader) intn(n int) int {
+	return int(r.next()) % n
+}
+
+func (r *fuzzReader) identifier() string {
+	return fuzzIdentifiers[r.intn(len(fuzzIdentifiers))]
+}
+
+func (r *fuzzReader) field(depth int) *Field {
+	switch r.intn(8) {
+	case 0:
+		return nil
+	case 1:
+		if depth < fuzzMaxDepth {
+			return NewSelectQueryField(r.selectQuery(depth + 1)).As(r.identifier())
+		}
+	}
+
+	return NewField(r.identifier()).FromTable(r.identifier()).As(r.identifier())
+}
+
+func (r *fuzzReader) table(depth int) *Table {
+	switch r.intn(8) {
+	case 0:
+		return nil
+	case 1:
+		if depth < fuzzMaxDepth {
+			return NewSelectQueryTable(r.selectQuery(depth + 1)).As(r.identifier())
+		}
+	}
+
+	return NewTable(r.identifier()).FromDatabase(r.identifier()).As(r.identifier())
+}
+
+func (r *fuzzReader) value() interface{} {
+	switch r.intn(10) {
+	case 0:
+		return nil
+	case 1:
+		return int(r.next())
+	case 2:
+		return r.identifier()
+	case 3:
+		return []int{}
+	case 4:
+		var values []string = []string{}
+		for i := r.intn(fuzzMaxChildren); i >= 0; i-- {
+			values = append(values, r.identifier())
+		}
+		return values
+	case 5:
+		return [2]int{int(r.next()), int(r.next())}
+	case 6:
+		return map[string]int{r.identifier(): int(r.next())}
+	case 7:
+		return []interface{}{nil, r.identifier(), int(r.next())}
+	case 8:
+		return true
+	}
+
+	return float64(r.next()) / 3
+}
+
+func (r *fuzzReader) filterValue(depth int) *FilterValue {
+	switch r.intn(6) {
+	case 0:
+		return nil
+	case 1:
+		return NewColumnFilterValue(r.identifier()).FromTable(r.identifier())
+	case 2:
+		if depth < fuzzMaxDepth {
+			return NewSelectQueryFilterValue(r.selectQuery(depth + 1))
+		}
+	}
+
+	return NewFilterValue(r.value())
+}
+
+func (r *fuzzReader) filter(depth int) *Filter {
+	var filter *Filter
+
+	switch r.intn(5) {
+	case 0:
+		return nil
+	case 1, 2:
+		return NewFilter().SetCondition(r.field(depth), fuzzOperators[r.intn(len(fuzzOperators))], r.filterValue(depth))
+	}
+
+	filter = NewFilter().SetLogic(fuzzLogics[r.intn(len(fuzzLogics))])
+	if depth >= fuzzMaxDepth {
+		return filter
+	}
+
+	for i := r.intn(fuzzMaxChildren); i > 0; i-- {
+		filter.AddFilters(r.filter(depth + 1))
+	}
+
+	return filter
+}
+
+func (r *fuzzReader) selectQuery(depth int) *SelectQuery {
+	var selectQuery *SelectQuery = Select()
+
+	for i := r.intn(fuzzMaxChildren); i > 0; i-- {
+		selectQuery.Fields = append(selectQuery.Fields, r.field(depth))
+	}
+
+	selectQuery.From(r.table(depth))
+
+	for i := r.intn(fuzzMaxChildren); i > 0; i-- {
+		var join *Join
+
+		if r.intn(8) == 0 {
+			selectQuery.Join(nil)
+			continue
+		}
+
+		join = &Join{
+			Type:   fuzzJoinTypes[r.intn(len(fuzzJoinTypes))],
+			Table:  r.table(depth),
+			Filter: r.filter(depth),
+		}
+		selectQuery.Join(join)
+	}
+
+	selectQuery.Where(r.filter(depth))
+
+	for i := r.intn(fuzzMaxChildren); i > 0; i-- {
+		selectQuery.GroupByFields = append(selectQuery.GroupByFields, r.field(depth))
+	}
+
+	for i := r.intn(fuzzMaxChildren); i > 0; i-- {
+		if r.intn(8) == 0 {
+			selectQuery.Sorts = append(selectQuery.Sorts, nil)
+			continue
+		}
+
+		selectQuery.Sorts = append(selectQuery.Sorts, NewSort(r.field(depth), fuzzDirections[r.intn(len(fuzzDirections))]))
+	}
+
+	return selectQuery.
+		Limit(uint64(r.next())).
+		Offset(uint64(r.next())).
+		As(r.identifier())
+}
+
+func (r *fuzzReader) query() Query {
+	switch r.intn(4) {
+	case 0:
+		var insertQuery *InsertQuery = Insert().Into(r.identifier())
+		for i := r.intn(fuzzMaxChildren * 2); i > 0; i-- {
+			insertQuery.Value(r.identifier(), r.value())
+		}
+		return insertQuery
+
+	case 1:
+		var updateQuery *UpdateQuery = Update(r.identifier())
+		for i := r.intn(fuzzMaxChildren); i > 0; i-- {
+			updateQuery.Set(r.identifier(), r.value())
+		}
+		return updateQuery.Where(r.filter(0))
+
+	case 2:
+		return Delete().From(r.identifier()).Where(r.filter(0))
+	}
+
+	return r.selectQuery(0)
+}
+
+func BuildAnyQuery(data []byte) (string, []interface{}, error) {
+	var (
+		reader  *fuzzReader
+		dialect Dialect
+		query   string
+		args    []interface{}
+		err     error
+	)
+
+	reader = &fuzzReader{data: data}
+	dialect = fuzzDialects[reader.intn(len(fuzzDialects))]
+
+	query, args, err = NewConfig(dialect).Build(reader.query())
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = checkInvariants(dialect, query, args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return query, args, nil
+}
@@ -0,0 +1,100 @@
+package goqube
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFuzz_BuildAnyQuery(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Data        []byte
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Data        []byte
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name: "data is empty",
+			Data: []byte{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrTableIsRequired,
+			},
+		},
+		{
+			Name: "data is delete query",
+			Data: []byte{1, 2, 1, 1, 2, 1, 1, 0, 2, 3, 1, 4},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "delete from id where id.id = $1",
+				Args:  []interface{}{int(4)},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = BuildAnyQuery(testCases[i].Data)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Errorf("expectation error is nil, got %s", actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %v, got %v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
+
+func FuzzBuildAnyQuery(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{1, 2, 1, 1, 2, 1, 1, 0, 2, 3, 1, 4})
+	f.Add([]byte{1, 3, 2, 2, 3, 1, 2, 5, 4, 3, 2, 1, 0, 9, 8, 7})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var err error
+
+		_, _, err = BuildAnyQuery(data)
+		if errors.Is(err, ErrUnbalancedParentheses) || errors.Is(err, ErrPlaceholderCountMismatch) {
+			t.Fatalf("invariant is violated for data %v: %s", data, err.Error())
+		}
+	})
+}
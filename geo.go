@@ -0,0 +1,95 @@
+package goqube
+
+import (
+	"fmt"
+	"strconv"
+)
+
+type Geometry struct {
+	WKT  string
+	SRID int
+}
+
+func NewGeometry(wkt string, srid int) *Geometry {
+	return &Geometry{
+		WKT:  wkt,
+		SRID: srid,
+	}
+}
+
+func GeoPoint(longitude, latitude float64) *Geometry {
+	return NewGeometry(
+		"POINT("+strconv.FormatFloat(longitude, 'f', -1, 64)+" "+strconv.FormatFloat(latitude, 'f', -1, 64)+")",
+		geoDefaultSRID,
+	)
+}
+
+type GeoFilter struct {
+	Operator GeoOperator
+	Geometry *Geometry
+	Distance float64
+}
+
+func newGeoFilter(field *Field, geo *GeoFilter) *Filter {
+	return &Filter{
+		Field:    field,
+		Operator: OperatorGeo,
+		Value:    NewFilterValue(geo),
+	}
+}
+
+func GeoDWithin(field *Field, geometry *Geometry, distance float64) *Filter {
+	return newGeoFilter(field, &GeoFilter{Operator: GeoOperatorDWithin, Geometry: geometry, Distance: distance})
+}
+
+func GeoContains(field *Field, geometry *Geometry) *Filter {
+	return newGeoFilter(field, &GeoFilter{Operator: GeoOperatorContains, Geometry: geometry})
+}
+
+func GeoWithin(field *Field, geometry *Geometry) *Filter {
+	return newGeoFilter(field, &GeoFilter{Operator: GeoOperatorWithin, Geometry: geometry})
+}
+
+func (g *GeoFilter) validate(dialect Dialect) error {
+	if _, ok := geoFilterFormatMap[dialect]; !ok {
+		return fmt.Errorf(errGeoDialectf, ErrGeoDialectIsUnsupported, dialect)
+	}
+
+	if _, ok := geoFilterFormatMap[dialect][g.Operator]; !ok {
+		return ErrGeoOperatorIsInvalid
+	}
+
+	if g.Geometry == nil || g.Geometry.WKT == "" {
+		return ErrGeometryIsRequired
+	}
+
+	if g.Operator == GeoOperatorDWithin && g.Distance < 0 {
+		return ErrGeoDistanceIsInvalid
+	}
+
+	return nil
+}
+
+func (g *GeoFilter) toSQLWithArgs(bc *buildContext, field string, args []interface{}) (string, []interface{}) {
+	var (
+		format              string = geometryFormat
+		geometry            string
+		distancePlaceholder string
+	)
+
+	if bc.dialect == DialectMySQL && !bc.config.isMariaDB() {
+		format = mysqlGeometryFormat
+	}
+
+	args = append(args, g.Geometry.WKT, g.Geometry.SRID)
+	geometry = fmt.Sprintf(format, getPlaceholder(bc.dialect, len(args)-1, len(args)-1), getPlaceholder(bc.dialect, len(args), len(args)))
+
+	if g.Operator != GeoOperatorDWithin {
+		return fmt.Sprintf(geoFilterFormatMap[bc.dialect][g.Operator], field, geometry), args
+	}
+
+	args = append(args, g.Distance)
+	distancePlaceholder = getPlaceholder(bc.dialect, len(args), len(args))
+
+	return fmt.Sprintf(geoFilterFormatMap[bc.dialect][g.Operator], field, geometry, distancePlaceholder), args
+}
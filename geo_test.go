@@ -0,0 +1,221 @@
+package goqube
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestGeo_GeoPoint(t *testing.T) {
+	var (
+		expectation *Geometry = &Geometry{WKT: "POINT(106.8456 -6.2088)", SRID: 4326}
+		actual      *Geometry = GeoPoint(106.8456, -6.2088)
+	)
+
+	if !deepEqual(expectation, actual) {
+		t.Errorf("expectation geometry is %+v, got %+v", expectation, actual)
+	}
+}
+
+func TestGeo_GeoDWithin(t *testing.T) {
+	var (
+		expectation *Filter
+		actual      *Filter
+	)
+
+	expectation = &Filter{
+		Field:    NewField("location"),
+		Operator: OperatorGeo,
+		Value:    NewFilterValue(&GeoFilter{Operator: GeoOperatorDWithin, Geometry: GeoPoint(1, 2), Distance: 500}),
+	}
+	actual = GeoDWithin(NewField("location"), GeoPoint(1, 2), 500)
+
+	if !deepEqual(expectation, actual) {
+		t.Errorf("expectation filter is %+v, got %+v", expectation, actual)
+	}
+}
+
+func TestGeo_ToSQLWithArgs(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Config      *Config
+		Filter      *Filter
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Config      *Config
+		Filter      *Filter
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:   "dialect is unsupported",
+			Config: NewConfig("sqlite"),
+			Filter: GeoContains(NewField("area"), GeoPoint(1, 2)),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrGeoDialectIsUnsupported,
+			},
+		},
+		{
+			Name:   "field is nil",
+			Config: NewConfig(DialectPostgres),
+			Filter: GeoContains(nil, GeoPoint(1, 2)),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrFieldIsRequired,
+			},
+		},
+		{
+			Name:   "geo operator is invalid",
+			Config: NewConfig(DialectPostgres),
+			Filter: newGeoFilter(NewField("area"), &GeoFilter{Operator: "touches", Geometry: GeoPoint(1, 2)}),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrGeoOperatorIsInvalid,
+			},
+		},
+		{
+			Name:   "geometry is nil",
+			Config: NewConfig(DialectMySQL),
+			Filter: GeoWithin(NewField("location"), nil),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrGeometryIsRequired,
+			},
+		},
+		{
+			Name:   "distance is negative",
+			Config: NewConfig(DialectPostgres),
+			Filter: GeoDWithin(NewField("location"), GeoPoint(1, 2), -1),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrGeoDistanceIsInvalid,
+			},
+		},
+		{
+			Name:   "dialect postgres dwithin",
+			Config: NewConfig(DialectPostgres),
+			Filter: GeoDWithin(NewField("location"), GeoPoint(106.8, -6.2), 1000),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from shops where st_dwithin(location, st_geomfromtext($1, $2), $3) limit $4",
+				Args:  []interface{}{"POINT(106.8 -6.2)", 4326, float64(1000), 10},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "dialect postgres contains",
+			Config: NewConfig(DialectPostgres),
+			Filter: GeoContains(NewField("area"), NewGeometry("POINT(1 2)", 3857)),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from shops where st_contains(area, st_geomfromtext($1, $2)) limit $3",
+				Args:  []interface{}{"POINT(1 2)", 3857, 10},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "dialect mysql dwithin",
+			Config: NewConfig(DialectMySQL),
+			Filter: GeoDWithin(NewField("location"), GeoPoint(106.8, -6.2), 1000),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from shops where st_distance(location, st_geomfromtext(?, ?, 'axis-order=long-lat')) <= ? limit ?",
+				Args:  []interface{}{"POINT(106.8 -6.2)", 4326, float64(1000), 10},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "dialect mysql within with variant mariadb",
+			Config: NewConfig(DialectMySQL).SetVariant(VariantMariaDB),
+			Filter: GeoWithin(NewField("location"), NewGeometry("POLYGON((0 0,1 0,1 1,0 0))", 4326)),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from shops where st_within(location, st_geomfromtext(?, ?)) limit ?",
+				Args:  []interface{}{"POLYGON((0 0,1 0,1 1,0 0))", 4326, 10},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Config.Build(
+				Select(NewField("id")).From(NewTable("shops")).Where(testCases[i].Filter).Limit(10),
+			)
+
+			if !errors.Is(actualErr, testCases[i].Expectation.Err) {
+				t.Errorf("expectation error is %v, got %v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %v, got %v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
+
+func TestGeo_validate(t *testing.T) {
+	var (
+		expectation string = "geo filter is not supported by dialect: sqlite"
+		actual      error  = (&GeoFilter{Operator: GeoOperatorWithin, Geometry: GeoPoint(1, 2)}).validate("sqlite")
+	)
+
+	if actual == nil || expectation != actual.Error() {
+		t.Errorf("expectation error is %s, got %v", expectation, actual)
+	}
+}
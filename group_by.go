@@ -0,0 +1,69 @@
+package goqube
+
+import (
+	"fmt"
+	"strconv"
+)
+
+func (s *SelectQuery) GroupByOrdinals() *SelectQuery {
+	s.GroupByOrdinal = true
+	return s
+}
+
+func (s *SelectQuery) fieldPosition(field *Field) int {
+	for i := range s.Fields {
+		if s.Fields[i] == nil {
+			continue
+		}
+
+		if s.Fields[i] == field || field.isPlainColumn() && s.Fields[i].outputName() == field.Column {
+			return i + 1
+		}
+	}
+
+	return 0
+}
+
+func (s *SelectQuery) isSelectAlias(field *Field) bool {
+	if !field.isPlainColumn() {
+		return false
+	}
+
+	for i := range s.Fields {
+		if s.Fields[i] != nil && s.Fields[i].Alias != "" && s.Fields[i].Alias == field.Column {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *SelectQuery) groupByFieldToSQLWithArgs(bc *buildContext, field *Field, args []interface{}) (string, []interface{}, error) {
+	var (
+		query string
+		err   error
+	)
+
+	if s.GroupByOrdinal {
+		var position int = s.fieldPosition(field)
+
+		if position > 0 {
+			return strconv.Itoa(position), args, nil
+		}
+	}
+
+	if s.isSelectAlias(field) {
+		return quoteAlias(bc.dialect, field.Column), args, nil
+	}
+
+	if field.isBareSelectQuery() {
+		query, args, err = field.SelectQuery.toSQLWithArgsWithAlias(bc, args)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return fmt.Sprintf("(%s)", query), args, nil
+	}
+
+	return field.toSQLWithArgs(bc, args)
+}
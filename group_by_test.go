@@ -0,0 +1,167 @@
+package goqube
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSelectQuery_GroupByOrdinals(t *testing.T) {
+	var actual *SelectQuery = Select(NewField("field1")).From(NewTable("table1")).GroupByOrdinals()
+
+	if !actual.GroupByOrdinal {
+		t.Errorf("expectation group by ordinal is %t, got %t", true, actual.GroupByOrdinal)
+	}
+}
+
+func TestSelectQuery_ToSQLWithArgs_GroupBy(t *testing.T) {
+	var (
+		bucket    *Field = NewExpressionField(NewFunctionExpression("date_bin", "1 hour", NewField("created_at"))).As("bucket")
+		testCases []struct {
+			Name        string
+			Config      *Config
+			Query       *SelectQuery
+			Expectation struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}
+		}
+	)
+
+	testCases = []struct {
+		Name        string
+		Config      *Config
+		Query       *SelectQuery
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:   "group by expression",
+			Config: NewConfig(DialectMySQL),
+			Query: Select(NewExpressionField(NewFunctionExpression("date", NewField("created_at"))).As("day"), NewRawField(NewRaw("count(*)")).As("total")).
+				From(NewTable("orders")).
+				GroupBy(NewExpressionField(NewFunctionExpression("date", NewField("created_at")))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select date(created_at) as day, count(*) as total from orders group by date(created_at)",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "group by select alias skips name mapper",
+			Config: NewConfig(DialectPostgres).SetNameMapper(NewNameMapper().MapColumns(SnakeCase)),
+			Query: Select(NewField("createdAt").As("createdDay"), NewRawField(NewRaw("count(*)")).As("total")).
+				From(NewTable("orders")).
+				GroupBy(NewField("createdDay")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select created_at as createdDay, count(*) as total from orders group by createdDay",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "group by subquery without alias",
+			Config: NewConfig(DialectPostgres),
+			Query: Select(NewRawField(NewRaw("count(*)")).As("total")).
+				From(NewTable("orders").As("o")).
+				GroupBy(NewSelectQueryField(Select(NewField("region")).From(NewTable("customers")).Where(Eq("customers.id", NewColumnFilterValue("o.customer_id"))))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select count(*) as total from orders as o group by (select region from customers where customers.id = o.customer_id)",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "group by ordinals",
+			Config: NewConfig(DialectPostgres),
+			Query: Select(bucket, NewField("status"), NewRawField(NewRaw("count(*)")).As("total")).
+				From(NewTable("orders")).
+				GroupBy(bucket, NewField("status"), NewField("region")).
+				GroupByOrdinals(),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select date_bin($1, created_at) as bucket, status, count(*) as total from orders group by 1, 2, region",
+				Args:  []interface{}{"1 hour"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "group by without ordinals repeats expression args",
+			Config: NewConfig(DialectPostgres),
+			Query: Select(bucket).
+				From(NewTable("orders")).
+				GroupBy(bucket),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select date_bin($1, created_at) as bucket from orders group by date_bin($2, created_at)",
+				Args:  []interface{}{"1 hour", "1 hour"},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Config.Build(testCases[i].Query)
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Errorf("expectation error is nil, got %s", actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Err != nil && (actualErr == nil || testCases[i].Expectation.Err.Error() != actualErr.Error()) {
+				t.Errorf("expectation error is %s, got %v", testCases[i].Expectation.Err.Error(), actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %+v, got %+v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
+
+func TestSelectQuery_ValidateAll_GroupBy(t *testing.T) {
+	var (
+		query *SelectQuery
+		err   error
+	)
+
+	query = Select(NewRawField(NewRaw("count(*)")).As("total")).
+		From(NewTable("orders")).
+		GroupBy(NewSelectQueryField(Select().From(NewTable("customers"))))
+
+	err = query.ValidateAll(DialectPostgres)
+	if err == nil || err.Error() != fmt.Sprintf("GroupByFields[0].SelectQuery: %s", ErrFieldsIsRequired.Error()) {
+		t.Errorf("expectation error is GroupByFields[0].SelectQuery: %s, got %v", ErrFieldsIsRequired.Error(), err)
+	}
+}
@@ -0,0 +1,62 @@
+package goqube
+
+import "strings"
+
+type HealthCheckQuery struct {
+	Checks []HealthCheck
+}
+
+func NewHealthCheck(checks ...HealthCheck) *HealthCheckQuery {
+	if len(checks) == 0 {
+		checks = []HealthCheck{HealthCheckPing}
+	}
+
+	return &HealthCheckQuery{
+		Checks: checks,
+	}
+}
+
+func SmokeTest() *HealthCheckQuery {
+	return NewHealthCheck(HealthCheckPing, HealthCheckVersion, HealthCheckDatabase, HealthCheckCurrentTime)
+}
+
+func (h *HealthCheckQuery) validate(dialect Dialect) error {
+	if dialect == "" {
+		return ErrDialectIsRequired
+	}
+
+	if len(h.Checks) == 0 {
+		return ErrFieldsIsRequired
+	}
+
+	for i := range h.Checks {
+		if _, isValid := healthCheckExpressionMap[dialect][h.Checks[i]]; !isValid {
+			return ErrHealthCheckIsInvalid
+		}
+	}
+
+	return nil
+}
+
+func (h *HealthCheckQuery) toSQLWithArgs(bc *buildContext, args []interface{}) (string, []interface{}, error) {
+	var (
+		expressions []string
+		err         error
+	)
+
+	err = h.validate(bc.dialect)
+	if err != nil {
+		return "", nil, err
+	}
+
+	expressions = []string{}
+	for i := range h.Checks {
+		expressions = append(expressions, healthCheckExpressionMap[bc.dialect][h.Checks[i]]+" as "+string(h.Checks[i]))
+	}
+
+	return "select " + strings.Join(expressions, ", "), args, nil
+}
+
+func (h *HealthCheckQuery) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+	return h.toSQLWithArgs(newDialectBuildContext(dialect), args)
+}
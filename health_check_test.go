@@ -0,0 +1,202 @@
+package goqube
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestHealthCheck_NewHealthCheck(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Actual      *HealthCheckQuery
+		Expectation *HealthCheckQuery
+	} = []struct {
+		Name        string
+		Actual      *HealthCheckQuery
+		Expectation *HealthCheckQuery
+	}{
+		{
+			Name:        "default ping",
+			Actual:      NewHealthCheck(),
+			Expectation: &HealthCheckQuery{Checks: []HealthCheck{HealthCheckPing}},
+		},
+		{
+			Name:        "selected checks",
+			Actual:      NewHealthCheck(HealthCheckVersion, HealthCheckDatabase),
+			Expectation: &HealthCheckQuery{Checks: []HealthCheck{HealthCheckVersion, HealthCheckDatabase}},
+		},
+		{
+			Name:        "smoke test",
+			Actual:      SmokeTest(),
+			Expectation: &HealthCheckQuery{Checks: []HealthCheck{HealthCheckPing, HealthCheckVersion, HealthCheckDatabase, HealthCheckCurrentTime}},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			if !deepEqual(testCases[i].Expectation, testCases[i].Actual) {
+				t.Errorf("expectation health check is %+v, got %+v", testCases[i].Expectation, testCases[i].Actual)
+			}
+		})
+	}
+}
+
+func TestHealthCheck_ToSQLWithArgs(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		HealthCheck *HealthCheckQuery
+		Dialect     Dialect
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		HealthCheck *HealthCheckQuery
+		Dialect     Dialect
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:        "dialect is empty",
+			HealthCheck: NewHealthCheck(),
+			Dialect:     "",
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrDialectIsRequired,
+			},
+		},
+		{
+			Name:        "checks is empty",
+			HealthCheck: &HealthCheckQuery{},
+			Dialect:     DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrFieldsIsRequired,
+			},
+		},
+		{
+			Name:        "check is invalid",
+			HealthCheck: NewHealthCheck("uptime"),
+			Dialect:     DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrHealthCheckIsInvalid,
+			},
+		},
+		{
+			Name:        fmt.Sprintf("ping with dialect %s", DialectMySQL),
+			HealthCheck: NewHealthCheck(),
+			Dialect:     DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select 1 as ping",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:        fmt.Sprintf("smoke test with dialect %s", DialectMySQL),
+			HealthCheck: SmokeTest(),
+			Dialect:     DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select 1 as ping, version() as version, database() as database_name, current_timestamp as server_time",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:        fmt.Sprintf("smoke test with dialect %s", DialectPostgres),
+			HealthCheck: SmokeTest(),
+			Dialect:     DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select 1 as ping, version() as version, current_database() as database_name, current_timestamp as server_time",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].HealthCheck.ToSQLWithArgs(testCases[i].Dialect, []interface{}{})
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %+v, got %+v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
+
+func TestHealthCheck_Build(t *testing.T) {
+	var (
+		query string
+		args  []interface{}
+		err   error
+	)
+
+	query, args, err = NewConfig(DialectPostgres).Build(NewHealthCheck(HealthCheckPing, HealthCheckVersion))
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %s", err.Error())
+	}
+
+	if query != "select 1 as ping, version() as version" {
+		t.Errorf("expectation query is %s, got %s", "select 1 as ping, version() as version", query)
+	}
+
+	if !deepEqual([]interface{}{}, args) {
+		t.Errorf("expectation args is %+v, got %+v", []interface{}{}, args)
+	}
+}
@@ -1,10 +1,10 @@
 package goqube
 
 import (
+	"database/sql/driver"
 	"encoding/json"
-	"fmt"
 	"reflect"
-	"strings"
+	"strconv"
 )
 
 func typedSliceToInterfaceSlice(value interface{}) ([]interface{}, error) {
@@ -15,19 +15,60 @@ func typedSliceToInterfaceSlice(value interface{}) ([]interface{}, error) {
 
 	reflectValue = reflect.ValueOf(value)
 	if reflectValue.Kind() != reflect.Slice && reflectValue.Kind() != reflect.Array {
-		return nil, fmt.Errorf(errUnsupportedValueTypef, reflectValue.Kind().String())
+		return nil, &UnsupportedValueTypeError{Kind: reflectValue.Kind()}
 	}
 
 	interfaceSlice = []interface{}{}
 	for i := 0; i < reflectValue.Len(); i++ {
-		interfaceSlice = append(interfaceSlice, reflectValue.Index(i).Interface())
+		var (
+			element interface{}
+			err     error
+		)
+
+		element, err = sliceElement(reflectValue.Index(i))
+		if err != nil {
+			return nil, err
+		}
+
+		interfaceSlice = append(interfaceSlice, element)
 	}
 
 	return interfaceSlice, nil
 }
 
+func sliceElement(reflectValue reflect.Value) (interface{}, error) {
+	var (
+		element interface{} = reflectValue.Interface()
+		err     error
+	)
+
+	if _, isValuer := element.(driver.Valuer); !isValuer && reflectValue.CanAddr() && reflectValue.Addr().Type().Implements(valuerType) {
+		return reflectValue.Addr().Interface(), nil
+	}
+
+	err = validateValueKind(element, "")
+	if err != nil {
+		return nil, err
+	}
+
+	element = normalizeValue(element)
+	if _, isValuer := element.(driver.Valuer); isValuer {
+		return element, nil
+	}
+
+	_, err = driver.DefaultParameterConverter.ConvertValue(element)
+	if err != nil {
+		return nil, &UnsupportedElementTypeError{Type: reflect.TypeOf(element)}
+	}
+
+	return element, nil
+}
+
 func getPlaceholder(dialect Dialect, startIdx, endIdx int) string {
-	var placeholders []string = []string{}
+	var (
+		placeholders []byte
+		placeholder  string = placeholderMap[dialect]
+	)
 
 	if startIdx <= 0 || endIdx <= 0 || endIdx < startIdx {
 		return ""
@@ -36,21 +77,32 @@ func getPlaceholder(dialect Dialect, startIdx, endIdx int) string {
 	switch dialect {
 	case DialectMySQL:
 		if startIdx == endIdx {
-			return placeholderMap[dialect]
+			return placeholder
 		}
+
+		placeholders = make([]byte, 0, (endIdx-startIdx+1)*3)
 		for i := startIdx; i <= endIdx; i++ {
-			placeholders = append(placeholders, placeholderMap[dialect])
+			if i > startIdx {
+				placeholders = append(placeholders, ", "...)
+			}
+			placeholders = append(placeholders, placeholder...)
 		}
-		return strings.Join(placeholders, ", ")
+		return string(placeholders)
 
 	case DialectPostgres:
 		if startIdx == endIdx {
-			return fmt.Sprintf("%s%d", placeholderMap[dialect], endIdx)
+			return placeholder + strconv.Itoa(endIdx)
 		}
+
+		placeholders = make([]byte, 0, (endIdx-startIdx+1)*(len(strconv.Itoa(endIdx))+3))
 		for i := startIdx; i <= endIdx; i++ {
-			placeholders = append(placeholders, fmt.Sprintf("%s%d", placeholderMap[dialect], i))
+			if i > startIdx {
+				placeholders = append(placeholders, ", "...)
+			}
+			placeholders = append(placeholders, placeholder...)
+			placeholders = strconv.AppendInt(placeholders, int64(i), 10)
 		}
-		return strings.Join(placeholders, ", ")
+		return string(placeholders)
 
 	default:
 		return ""
@@ -76,3 +128,13 @@ func deepEqual(value1 interface{}, value2 interface{}) bool {
 
 	return reflect.DeepEqual(val1, val2)
 }
+
+func containsString(values []string, value string) bool {
+	for i := range values {
+		if values[i] == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -1,11 +1,28 @@
 package goqube
 
 import (
+	"database/sql/driver"
 	"fmt"
 	"reflect"
 	"testing"
 )
 
+type testUUID [2]byte
+
+func (u testUUID) Value() (driver.Value, error) {
+	return fmt.Sprintf("%x", u[:]), nil
+}
+
+type testCustomID int64
+
+type testPointerValuer struct {
+	ID int64
+}
+
+func (v *testPointerValuer) Value() (driver.Value, error) {
+	return v.ID, nil
+}
+
 func Test_typedSliceToInterfaceSlice(t *testing.T) {
 	var testCases []struct {
 		Name        string
@@ -33,6 +50,72 @@ func Test_typedSliceToInterfaceSlice(t *testing.T) {
 				Error:  fmt.Errorf("unsupported %s value type", reflect.String.String()),
 			},
 		},
+		{
+			Name:  "element kind is unsupported",
+			Value: []interface{}{"value1", map[string]string{}},
+			Expectation: struct {
+				Values []interface{}
+				Error  error
+			}{
+				Values: nil,
+				Error:  &UnsupportedValueTypeError{Kind: reflect.Map},
+			},
+		},
+		{
+			Name:  "element type is unsupported",
+			Value: []struct{ ID int }{{ID: 1}},
+			Expectation: struct {
+				Values []interface{}
+				Error  error
+			}{
+				Values: nil,
+				Error:  &UnsupportedElementTypeError{Type: reflect.TypeOf(struct{ ID int }{})},
+			},
+		},
+		{
+			Name:  "slice of interface",
+			Value: []interface{}{1, "value1", nil, testUUID{1, 2}},
+			Expectation: struct {
+				Values []interface{}
+				Error  error
+			}{
+				Values: []interface{}{1, "value1", nil, testUUID{1, 2}},
+				Error:  nil,
+			},
+		},
+		{
+			Name:  "slice of valuer array",
+			Value: []testUUID{{1, 2}, {3, 4}},
+			Expectation: struct {
+				Values []interface{}
+				Error  error
+			}{
+				Values: []interface{}{testUUID{1, 2}, testUUID{3, 4}},
+				Error:  nil,
+			},
+		},
+		{
+			Name:  "slice of convertible named type",
+			Value: []testCustomID{1, 2},
+			Expectation: struct {
+				Values []interface{}
+				Error  error
+			}{
+				Values: []interface{}{testCustomID(1), testCustomID(2)},
+				Error:  nil,
+			},
+		},
+		{
+			Name:  "slice of pointer receiver valuer",
+			Value: []testPointerValuer{{ID: 7}},
+			Expectation: struct {
+				Values []interface{}
+				Error  error
+			}{
+				Values: []interface{}{&testPointerValuer{ID: 7}},
+				Error:  nil,
+			},
+		},
 		{
 			Name:  "slice of string to slice of interface",
 			Value: []string{"value1", "value2", "value3"},
@@ -163,3 +246,54 @@ func Test_getPlaceholder(t *testing.T) {
 		})
 	}
 }
+
+func Test_containsString(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Values      []string
+		Value       string
+		Expectation bool
+	} = []struct {
+		Name        string
+		Values      []string
+		Value       string
+		Expectation bool
+	}{
+		{
+			Name:        "values is empty",
+			Values:      nil,
+			Value:       "value1",
+			Expectation: false,
+		},
+		{
+			Name:        "value is not found",
+			Values:      []string{"value1", "value2"},
+			Value:       "value3",
+			Expectation: false,
+		},
+		{
+			Name:        "value is found",
+			Values:      []string{"value1", "value2"},
+			Value:       "value2",
+			Expectation: true,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual bool = containsString(testCases[i].Values, testCases[i].Value)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %t, got %t", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func BenchmarkGetPlaceholder(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		getPlaceholder(DialectPostgres, 1, 100)
+	}
+}
@@ -0,0 +1,102 @@
+package goqube
+
+import (
+	"fmt"
+	"strings"
+)
+
+type IndexHint struct {
+	Type    IndexHintType
+	Indexes []string
+}
+
+func (t *Table) withIndexHint(hintType IndexHintType, indexes []string) *Table {
+	t.IndexHints = append(t.IndexHints, &IndexHint{Type: hintType, Indexes: indexes})
+	return t
+}
+
+func (t *Table) UseIndex(indexes ...string) *Table {
+	return t.withIndexHint(IndexHintUse, indexes)
+}
+
+func (t *Table) ForceIndex(indexes ...string) *Table {
+	return t.withIndexHint(IndexHintForce, indexes)
+}
+
+func (t *Table) IgnoreIndex(indexes ...string) *Table {
+	return t.withIndexHint(IndexHintIgnore, indexes)
+}
+
+func (h *IndexHint) validate() error {
+	if h.Type != IndexHintUse && h.Type != IndexHintForce && h.Type != IndexHintIgnore {
+		return ErrIndexHintIsInvalid
+	}
+
+	if len(h.Indexes) == 0 && h.Type != IndexHintUse {
+		return ErrIndexHintIsInvalid
+	}
+
+	for i := range h.Indexes {
+		if !isPlainIdentifier(h.Indexes[i]) {
+			return ErrIndexHintIsInvalid
+		}
+	}
+
+	return nil
+}
+
+func (t *Table) validateIndexHints(dialect Dialect) error {
+	if len(t.IndexHints) == 0 {
+		return nil
+	}
+
+	if dialect != DialectMySQL || t.Name == "" {
+		return ErrUnsupportedIndexHint
+	}
+
+	for i := range t.IndexHints {
+		if t.IndexHints[i] == nil {
+			return ErrIndexHintIsInvalid
+		}
+
+		var err error = t.IndexHints[i].validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t *Table) indexHintsSQL() string {
+	var builder strings.Builder
+
+	for i := range t.IndexHints {
+		fmt.Fprintf(&builder, " %s index (%s)", t.IndexHints[i].Type, strings.Join(t.IndexHints[i].Indexes, ", "))
+	}
+
+	return builder.String()
+}
+
+func (s *SelectQuery) Hint(hints ...string) *SelectQuery {
+	s.Hints = append(s.Hints, hints...)
+	return s
+}
+
+func (s *SelectQuery) validateHints() error {
+	for i := range s.Hints {
+		if strings.TrimSpace(s.Hints[i]) == "" || strings.Contains(s.Hints[i], "*/") || strings.Contains(s.Hints[i], "/*") {
+			return ErrHintIsInvalid
+		}
+	}
+
+	return nil
+}
+
+func (s *SelectQuery) hintsSQL() string {
+	if len(s.Hints) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("/*+ %s */ ", strings.Join(s.Hints, " "))
+}
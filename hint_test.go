@@ -0,0 +1,213 @@
+package goqube
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestTable_IndexHint(t *testing.T) {
+	var (
+		expectation []*IndexHint = []*IndexHint{
+			{Type: IndexHintUse, Indexes: []string{"idx_a"}},
+			{Type: IndexHintForce, Indexes: []string{"idx_b", "idx_c"}},
+			{Type: IndexHintIgnore, Indexes: []string{"idx_d"}},
+		}
+		actual *Table = NewTable("users").UseIndex("idx_a").ForceIndex("idx_b", "idx_c").IgnoreIndex("idx_d")
+	)
+
+	if !deepEqual(expectation, actual.IndexHints) {
+		t.Errorf("expectation index hints is %+v, got %+v", expectation, actual.IndexHints)
+	}
+}
+
+func TestSelectQuery_Hint(t *testing.T) {
+	var actual *SelectQuery = Select(NewField("id")).From(NewTable("users")).Hint("SeqScan(users)").Hint("Leading(users)")
+
+	if !deepEqual([]string{"SeqScan(users)", "Leading(users)"}, actual.Hints) {
+		t.Errorf("expectation hints is %+v, got %+v", []string{"SeqScan(users)", "Leading(users)"}, actual.Hints)
+	}
+}
+
+func TestSelectQuery_ToSQLWithArgs_Hints(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Query       *SelectQuery
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Query       *SelectQuery
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:    "hint is empty",
+			Dialect: DialectPostgres,
+			Query:   Select(NewField("id")).From(NewTable("users")).Hint(" "),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrHintIsInvalid,
+			},
+		},
+		{
+			Name:    "hint closes comment",
+			Dialect: DialectPostgres,
+			Query:   Select(NewField("id")).From(NewTable("users")).Hint("SeqScan(users) */ drop table users; /*"),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrHintIsInvalid,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("index hint with dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			Query:   Select(NewField("id")).From(NewTable("users").UseIndex("idx_users_email")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &PathError{Path: "Table", Err: ErrUnsupportedIndexHint},
+			},
+		},
+		{
+			Name:    "index hint on subquery table",
+			Dialect: DialectMySQL,
+			Query:   Select(NewField("id")).From(SelectAs(Select(NewField("id")).From(NewTable("users")), "u").UseIndex("idx_users_email")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &PathError{Path: "Table", Err: ErrUnsupportedIndexHint},
+			},
+		},
+		{
+			Name:    "index hint type is invalid",
+			Dialect: DialectMySQL,
+			Query:   Select(NewField("id")).From(&Table{Name: "users", IndexHints: []*IndexHint{{Type: "prefer", Indexes: []string{"idx"}}}}),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &PathError{Path: "Table", Err: ErrIndexHintIsInvalid},
+			},
+		},
+		{
+			Name:    "force index without indexes",
+			Dialect: DialectMySQL,
+			Query:   Select(NewField("id")).From(NewTable("users").ForceIndex()),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &PathError{Path: "Table", Err: ErrIndexHintIsInvalid},
+			},
+		},
+		{
+			Name:    "index name is invalid",
+			Dialect: DialectMySQL,
+			Query:   Select(NewField("id")).From(NewTable("users").UseIndex("idx) where 1=1 --")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &PathError{Path: "Table", Err: ErrIndexHintIsInvalid},
+			},
+		},
+		{
+			Name:    fmt.Sprintf("optimizer hints with dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			Query:   Select(NewField("id")).From(NewTable("users")).Where(Eq("status", "active")).Hint("IndexScan(users idx_users_status)", "Rows(users #100)"),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select /*+ IndexScan(users idx_users_status) Rows(users #100) */ id from users where status = $1",
+				Args:  []interface{}{"active"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("index hints with dialect %s", DialectMySQL),
+			Dialect: DialectMySQL,
+			Query: Select(NewField("id").FromTable("u")).
+				From(NewTable("users").As("u").ForceIndex("idx_users_email").IgnoreIndex("idx_users_name", "idx_users_age")).
+				Join(InnerJoin(NewTable("orders").As("o").UseIndex()).On(NewFilter().SetCondition(NewField("user_id").FromTable("o"), OperatorEqual, NewColumnFilterValue("id").FromTable("u")))).
+				Hint("MAX_EXECUTION_TIME(1000)"),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select /*+ MAX_EXECUTION_TIME(1000) */ u.id from users as u force index (idx_users_email) ignore index (idx_users_name, idx_users_age) inner join orders as o use index () on o.user_id = u.id",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Query.ToSQLWithArgs(testCases[i].Dialect, []interface{}{})
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Errorf("expectation error is %s, got nil", testCases[i].Expectation.Err.Error())
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Errorf("expectation error is nil, got %s", actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %+v, got %+v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
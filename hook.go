@@ -0,0 +1,120 @@
+package goqube
+
+type BeforeBuildHook func(query Query) error
+
+type FilterRewriter func(filter *Filter) *Filter
+
+func (c *Config) BeforeBuild(hooks ...BeforeBuildHook) *Config {
+	c.BeforeBuildHooks = append(c.BeforeBuildHooks, hooks...)
+	return c
+}
+
+func (c *Config) RewriteFilter(rewriters ...FilterRewriter) *Config {
+	c.FilterRewriters = append(c.FilterRewriters, rewriters...)
+	return c
+}
+
+func (c *Config) runHooks(query Query) (Query, error) {
+	for i := range c.BeforeBuildHooks {
+		if c.BeforeBuildHooks[i] == nil {
+			continue
+		}
+
+		var err error = c.BeforeBuildHooks[i](query)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(c.FilterRewriters) == 0 {
+		return query, nil
+	}
+
+	return c.rewriteQuery(query), nil
+}
+
+func (c *Config) rewriteFilter(filter *Filter) *Filter {
+	for i := range c.FilterRewriters {
+		if c.FilterRewriters[i] != nil {
+			filter = c.FilterRewriters[i](filter)
+		}
+	}
+
+	return filter
+}
+
+func (c *Config) rewriteQuery(query Query) Query {
+	switch q := query.(type) {
+	case *SelectQuery:
+		return c.rewriteSelectQuery(q)
+	case *UpdateQuery:
+		var rewritten UpdateQuery = *q
+
+		rewritten.Filter = c.rewriteFilter(q.Filter)
+		return &rewritten
+	case *DeleteQuery:
+		var rewritten DeleteQuery = *q
+
+		rewritten.Filter = c.rewriteFilter(q.Filter)
+		return &rewritten
+	case *CompoundQuery:
+		var rewritten CompoundQuery = *q
+
+		rewritten.Branches = make([]*CompoundBranch, len(q.Branches))
+		for i := range q.Branches {
+			if q.Branches[i] == nil {
+				continue
+			}
+
+			rewritten.Branches[i] = &CompoundBranch{
+				Operator: q.Branches[i].Operator,
+				Query:    c.rewriteQuery(q.Branches[i].Query),
+			}
+		}
+
+		return &rewritten
+	}
+
+	return query
+}
+
+func (c *Config) rewriteSelectQuery(selectQuery *SelectQuery) *SelectQuery {
+	var rewritten SelectQuery
+
+	if selectQuery == nil {
+		return nil
+	}
+
+	rewritten = *selectQuery
+	rewritten.Table = c.rewriteTable(selectQuery.Table)
+	rewritten.Filter = c.rewriteFilter(selectQuery.Filter)
+
+	if len(selectQuery.Joins) > 0 {
+		rewritten.Joins = make([]*Join, len(selectQuery.Joins))
+		for i := range selectQuery.Joins {
+			if selectQuery.Joins[i] == nil {
+				continue
+			}
+
+			var join Join = *selectQuery.Joins[i]
+
+			join.Table = c.rewriteTable(selectQuery.Joins[i].Table)
+			rewritten.Joins[i] = &join
+		}
+	}
+
+	return &rewritten
+}
+
+func (c *Config) rewriteTable(table *Table) *Table {
+	var rewritten Table
+
+	if table == nil || table.SelectQuery == nil {
+		return table
+	}
+
+	rewritten = *table
+	rewritten.SelectQuery = c.rewriteSelectQuery(table.SelectQuery)
+
+	return &rewritten
+}
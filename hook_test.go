@@ -0,0 +1,181 @@
+package goqube
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestConfig_BuildWithHooks(t *testing.T) {
+	var (
+		errForbidden error = errors.New("forbidden")
+		tenantPolicy FilterRewriter
+		renameColumn FilterRewriter
+		testCases    []struct {
+			Name        string
+			Config      *Config
+			Query       Query
+			Expectation struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}
+		}
+	)
+
+	tenantPolicy = func(filter *Filter) *Filter {
+		return And(filter, NewFilter().SetCondition(NewField("tenant_id"), OperatorEqual, NewFilterValue(7)))
+	}
+
+	renameColumn = func(filter *Filter) *Filter {
+		if filter != nil && filter.Field != nil && filter.Field.Column == "mail" {
+			var rewritten Filter = *filter
+
+			rewritten.Field = NewField("email")
+			return &rewritten
+		}
+
+		return filter
+	}
+
+	testCases = []struct {
+		Name        string
+		Config      *Config
+		Query       Query
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:   "rewrite filter of select query and derived table",
+			Config: NewConfig(DialectPostgres).RewriteFilter(tenantPolicy),
+			Query: Select(NewField("id")).
+				From(SelectAs(Select(NewField("id")).From(NewTable("users")), "u")).
+				Where(NewFilter().SetCondition(NewField("id"), OperatorGreaterThan, NewFilterValue(1))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from (select id from users where tenant_id = $1) as u where id > $2 and tenant_id = $3",
+				Args:  []interface{}{7, 1, 7},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "rewrite filter of update and delete query",
+			Config: NewConfig(DialectMySQL).RewriteFilter(renameColumn, tenantPolicy),
+			Query:  Delete().From("users").Where(NewFilter().SetCondition(NewField("mail"), OperatorEqual, NewFilterValue("john@mail.com"))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "delete from users where email = ? and tenant_id = ?",
+				Args:  []interface{}{"john@mail.com", 7},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "rewrite filter of compound query",
+			Config: NewConfig(DialectMySQL).RewriteFilter(tenantPolicy),
+			Query:  Union(Select(NewField("id")).From(NewTable("users")), Select(NewField("id")).From(NewTable("admins"))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "(select id from users where tenant_id = ?) union (select id from admins where tenant_id = ?)",
+				Args:  []interface{}{7, 7},
+				Err:   nil,
+			},
+		},
+		{
+			Name: "before build hook rejects query",
+			Config: NewConfig(DialectPostgres).BeforeBuild(nil, func(query Query) error {
+				if _, ok := query.(*DeleteQuery); ok {
+					return errForbidden
+				}
+
+				return nil
+			}),
+			Query: Delete().From("users"),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   errForbidden,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Config.Build(testCases[i].Query)
+
+			if !errors.Is(actualErr, testCases[i].Expectation.Err) {
+				t.Errorf("expectation error is %v, got %v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %+v, got %+v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+
+	t.Run("rewrite does not modify query", func(t *testing.T) {
+		var (
+			query  *SelectQuery = Select(NewField("id")).From(NewTable("users"))
+			config *Config      = NewConfig(DialectPostgres).RewriteFilter(tenantPolicy)
+			err    error
+		)
+
+		_, _, err = config.Build(query)
+		if err != nil {
+			t.Errorf("expectation error is nil, got %s", err.Error())
+		}
+
+		if query.Filter != nil {
+			t.Errorf("expectation filter is nil, got %+v", query.Filter)
+		}
+	})
+
+	t.Run("builder options", func(t *testing.T) {
+		var (
+			calls   int
+			builder *Builder = NewBuilder(DialectPostgres, WithBeforeBuild(func(query Query) error {
+				calls++
+				return nil
+			}), WithRewriteFilter(tenantPolicy))
+			actualQuery string
+			err         error
+		)
+
+		actualQuery, _, err = builder.With(WithRewriteFilter(renameColumn)).Build(Select(NewField("id")).From(NewTable("users")))
+		if err != nil {
+			t.Errorf("expectation error is nil, got %s", err.Error())
+		}
+
+		if actualQuery != "select id from users where tenant_id = $1" || calls != 1 {
+			t.Errorf("expectation query is rewritten and hook is called once, got %s and %d", actualQuery, calls)
+		}
+
+		if len(builder.Config().FilterRewriters) != 1 {
+			t.Errorf("expectation builder filter rewriters length is %d, got %d", 1, len(builder.Config().FilterRewriters))
+		}
+	})
+}
@@ -0,0 +1,91 @@
+package goqube
+
+import "strings"
+
+var reservedIdentifiers []string = []string{
+	"all", "and", "as", "asc", "between", "by", "case", "check", "column", "constraint",
+	"create", "cross", "default", "delete", "desc", "distinct", "drop", "else", "end", "exists",
+	"false", "for", "foreign", "from", "full", "group", "having", "in", "index", "inner",
+	"insert", "into", "is", "join", "key", "left", "like", "limit", "not", "null",
+	"offset", "on", "or", "order", "outer", "primary", "references", "right", "select", "set",
+	"table", "then", "to", "true", "union", "unique", "update", "user", "using", "values",
+	"when", "where", "with",
+}
+
+var identifierQuoteMap map[Dialect]string = map[Dialect]string{
+	DialectMySQL:    "`",
+	DialectPostgres: `"`,
+}
+
+func isPlainIdentifier(identifier string) bool {
+	if identifier == "" {
+		return false
+	}
+
+	for i, r := range identifier {
+		if r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') {
+			continue
+		}
+
+		if i > 0 && r >= '0' && r <= '9' {
+			continue
+		}
+
+		return false
+	}
+
+	return !containsString(reservedIdentifiers, strings.ToLower(identifier))
+}
+
+func isValidIdentifier(identifier string) bool {
+	if identifier == "" {
+		return false
+	}
+
+	for i, r := range identifier {
+		if r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') {
+			continue
+		}
+
+		if i > 0 && (r == '.' || (r >= '0' && r <= '9')) {
+			continue
+		}
+
+		return false
+	}
+
+	return true
+}
+
+func quoteQualifier(dialect Dialect, qualifier string) string {
+	if strings.Contains(qualifier, ".") {
+		return qualifier
+	}
+
+	return quoteAlias(dialect, qualifier)
+}
+
+func isValidAlias(alias string) bool {
+	for _, r := range alias {
+		if r < 0x20 || r == 0x7f {
+			return false
+		}
+	}
+
+	return true
+}
+
+func quoteAlias(dialect Dialect, alias string) string {
+	var quote string
+
+	if isPlainIdentifier(alias) {
+		return alias
+	}
+
+	quote = identifierQuoteMap[dialect]
+	if quote == "" {
+		return alias
+	}
+
+	return quote + strings.ReplaceAll(alias, quote, quote+quote) + quote
+}
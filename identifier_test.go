@@ -0,0 +1,292 @@
+package goqube
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestIdentifier_isPlainIdentifier(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Identifier  string
+		Expectation bool
+	} = []struct {
+		Name        string
+		Identifier  string
+		Expectation bool
+	}{
+		{
+			Name:        "identifier is empty",
+			Identifier:  "",
+			Expectation: false,
+		},
+		{
+			Name:        "identifier is plain",
+			Identifier:  "total_count1",
+			Expectation: true,
+		},
+		{
+			Name:        "identifier starts with digit",
+			Identifier:  "1total",
+			Expectation: false,
+		},
+		{
+			Name:        "identifier contains space",
+			Identifier:  "total count",
+			Expectation: false,
+		},
+		{
+			Name:        "identifier is reserved",
+			Identifier:  "Order",
+			Expectation: false,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual bool = isPlainIdentifier(testCases[i].Identifier)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation plain identifier is %t, got %t", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestIdentifier_quoteQualifier(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Qualifier   string
+		Expectation string
+	} = []struct {
+		Name        string
+		Qualifier   string
+		Expectation string
+	}{
+		{
+			Name:        "qualifier is plain",
+			Qualifier:   "t1",
+			Expectation: "t1",
+		},
+		{
+			Name:        "qualifier is reserved",
+			Qualifier:   "order",
+			Expectation: "\"order\"",
+		},
+		{
+			Name:        "qualifier contains database",
+			Qualifier:   "database1.table1",
+			Expectation: "database1.table1",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = quoteQualifier(DialectPostgres, testCases[i].Qualifier)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation qualifier is %s, got %s", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestIdentifier_isValidAlias(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Alias       string
+		Expectation bool
+	} = []struct {
+		Name        string
+		Alias       string
+		Expectation bool
+	}{
+		{
+			Name:        "alias is empty",
+			Alias:       "",
+			Expectation: true,
+		},
+		{
+			Name:        "alias contains space",
+			Alias:       "total count",
+			Expectation: true,
+		},
+		{
+			Name:        "alias contains new line",
+			Alias:       "total\ncount",
+			Expectation: false,
+		},
+		{
+			Name:        "alias contains delete character",
+			Alias:       "total\x7f",
+			Expectation: false,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual bool = isValidAlias(testCases[i].Alias)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation valid alias is %t, got %t", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestIdentifier_quoteAlias(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Alias       string
+		Expectation string
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Alias       string
+		Expectation string
+	}{
+		{
+			Name:        "alias is plain",
+			Dialect:     DialectPostgres,
+			Alias:       "total",
+			Expectation: "total",
+		},
+		{
+			Name:        "dialect is empty",
+			Dialect:     "",
+			Alias:       "total count",
+			Expectation: "total count",
+		},
+		{
+			Name:        fmt.Sprintf("alias contains space with dialect %s", DialectPostgres),
+			Dialect:     DialectPostgres,
+			Alias:       "total \"count\"",
+			Expectation: "\"total \"\"count\"\"\"",
+		},
+		{
+			Name:        fmt.Sprintf("alias is reserved with dialect %s", DialectMySQL),
+			Dialect:     DialectMySQL,
+			Alias:       "order",
+			Expectation: "`order`",
+		},
+		{
+			Name:        fmt.Sprintf("alias contains backtick with dialect %s", DialectMySQL),
+			Dialect:     DialectMySQL,
+			Alias:       "total `count`",
+			Expectation: "`total ``count```",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = quoteAlias(testCases[i].Dialect, testCases[i].Alias)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation alias is %s, got %s", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestIdentifier_Build(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Query       Query
+		Expectation struct {
+			Query string
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Query       Query
+		Expectation struct {
+			Query string
+			Err   error
+		}
+	}{
+		{
+			Name:    "field alias is invalid",
+			Dialect: DialectPostgres,
+			Query:   Select(NewField("id").As("id\n--")).From(NewTable("table1")),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrAliasIsInvalid,
+			},
+		},
+		{
+			Name:    "table alias is invalid",
+			Dialect: DialectPostgres,
+			Query:   Select(NewField("id")).From(NewTable("table1").As("t\x00")),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrAliasIsInvalid,
+			},
+		},
+		{
+			Name:    "select query alias is invalid",
+			Dialect: DialectPostgres,
+			Query:   Select(NewSelectQueryField(Select(NewField("id")).From(NewTable("table1")).As("s\t")).As("s")).From(NewTable("table1")),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrAliasIsInvalid,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("aliases are quoted with dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			Query: Select(NewField("id").FromTable("order").As("total count"), NewField("name").As("user")).
+				From(NewTable("orders").As("order")),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "select \"order\".id as \"total count\", name as \"user\" from orders as \"order\"",
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("aliases are quoted with dialect %s", DialectMySQL),
+			Dialect: DialectMySQL,
+			Query:   Select(NewField("id").As("Total Count")).From(NewTable("orders").As("o")),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "select id as `Total Count` from orders as o",
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualErr   error
+			)
+
+			actualQuery, _, actualErr = NewConfig(testCases[i].Dialect).Build(testCases[i].Query)
+
+			if !errors.Is(actualErr, testCases[i].Expectation.Err) {
+				t.Errorf("expectation error is %v, got %v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package goqube
+
+import (
+	"fmt"
+	"strings"
+)
+
+type CreateIndexQuery struct {
+	Name       string
+	Table      string
+	Columns    []string
+	Unique     bool
+	IfNotExist bool
+	Filter     *Filter
+}
+
+func CreateIndex(name, table string, columns ...string) *CreateIndexQuery {
+	return &CreateIndexQuery{
+		Name:    name,
+		Table:   table,
+		Columns: columns,
+	}
+}
+
+func (q *CreateIndexQuery) AsUnique() *CreateIndexQuery {
+	q.Unique = true
+	return q
+}
+
+func (q *CreateIndexQuery) IfNotExists() *CreateIndexQuery {
+	q.IfNotExist = true
+	return q
+}
+
+func (q *CreateIndexQuery) Where(filter *Filter) *CreateIndexQuery {
+	q.Filter = filter
+	return q
+}
+
+func (q *CreateIndexQuery) validate(dialect Dialect) error {
+	if dialect == "" {
+		return ErrDialectIsRequired
+	}
+
+	if q.Name == "" {
+		return ErrIndexNameIsRequired
+	}
+
+	if !isValidIdentifier(q.Name) {
+		return ErrIdentifierIsInvalid
+	}
+
+	if q.Table == "" {
+		return ErrTableIsRequired
+	}
+
+	if !isValidIdentifier(q.Table) {
+		return ErrIdentifierIsInvalid
+	}
+
+	if len(q.Columns) == 0 {
+		return ErrColumnsIsRequired
+	}
+
+	for i := range q.Columns {
+		if !isValidIdentifier(q.Columns[i]) {
+			return fmt.Errorf(errSchemaColumnf, ErrIdentifierIsInvalid, q.Columns[i])
+		}
+	}
+
+	if q.Filter != nil && dialect != DialectPostgres {
+		return ErrUnsupportedPartialIndex
+	}
+
+	return nil
+}
+
+func (q *CreateIndexQuery) statementSQL(bc *buildContext) string {
+	var (
+		columns   []string = make([]string, len(q.Columns))
+		statement string   = "create index"
+	)
+
+	for i := range q.Columns {
+		columns[i] = bc.tableColumn(q.Table, q.Columns[i])
+	}
+
+	if q.Unique {
+		statement = "create unique index"
+	}
+
+	if q.IfNotExist {
+		statement = fmt.Sprintf("%s if not exists", statement)
+	}
+
+	return fmt.Sprintf("%s %s on %s (%s)", statement, q.Name, bc.tableName(q.Table), strings.Join(columns, ", "))
+}
+
+func (q *CreateIndexQuery) toSQLWithArgs(bc *buildContext, args []interface{}) (string, []interface{}, error) {
+	var (
+		query string
+		err   error
+	)
+
+	err = q.validate(bc.dialect)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if q.IfNotExist && bc.dialect != DialectPostgres && !bc.config.isMariaDB() {
+		return "", nil, ErrUnsupportedIndexIfExists
+	}
+
+	query = q.statementSQL(bc)
+
+	if q.Filter != nil {
+		var (
+			whereClause string
+			whereArgs   []interface{}
+		)
+
+		bc.pushTableScope(q.Table)
+		defer bc.popScope()
+
+		whereClause, whereArgs, err = q.Filter.toRootSQLWithArgs(bc, []interface{}{})
+		if err != nil {
+			return "", nil, wrapPath("Filter", err)
+		}
+
+		whereClause, err = inlineArgs(bc.dialect, whereClause, whereArgs)
+		if err != nil {
+			return "", nil, wrapPath("Filter", err)
+		}
+
+		if whereClause != "" {
+			query = fmt.Sprintf("%s where %s", query, whereClause)
+		}
+	}
+
+	return query, args, nil
+}
+
+func (q *CreateIndexQuery) ToSQL(dialect Dialect) (string, error) {
+	var (
+		query string
+		err   error
+	)
+
+	query, _, err = q.toSQLWithArgs(newDialectBuildContext(dialect), []interface{}{})
+
+	return query, err
+}
+
+type DropIndexQuery struct {
+	Name    string
+	Table   string
+	IfExist bool
+}
+
+func DropIndex(name string) *DropIndexQuery {
+	return &DropIndexQuery{
+		Name: name,
+	}
+}
+
+func (q *DropIndexQuery) On(table string) *DropIndexQuery {
+	q.Table = table
+	return q
+}
+
+func (q *DropIndexQuery) IfExists() *DropIndexQuery {
+	q.IfExist = true
+	return q
+}
+
+func (q *DropIndexQuery) validate(dialect Dialect) error {
+	if dialect == "" {
+		return ErrDialectIsRequired
+	}
+
+	if q.Name == "" {
+		return ErrIndexNameIsRequired
+	}
+
+	if !isValidIdentifier(q.Name) {
+		return ErrIdentifierIsInvalid
+	}
+
+	if q.Table != "" && !isValidIdentifier(q.Table) {
+		return ErrIdentifierIsInvalid
+	}
+
+	if q.Table == "" && dialect == DialectMySQL {
+		return ErrTableIsRequired
+	}
+
+	return nil
+}
+
+func (q *DropIndexQuery) toSQLWithArgs(bc *buildContext, args []interface{}) (string, []interface{}, error) {
+	var (
+		statement string = "drop index"
+		err       error
+	)
+
+	err = q.validate(bc.dialect)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if q.IfExist && bc.dialect != DialectPostgres && !bc.config.isMariaDB() {
+		return "", nil, ErrUnsupportedIndexIfExists
+	}
+
+	if q.IfExist {
+		statement = "drop index if exists"
+	}
+
+	if bc.dialect == DialectMySQL {
+		return fmt.Sprintf("%s %s on %s", statement, q.Name, bc.tableName(q.Table)), args, nil
+	}
+
+	return fmt.Sprintf("%s %s", statement, q.Name), args, nil
+}
+
+func (q *DropIndexQuery) ToSQL(dialect Dialect) (string, error) {
+	var (
+		query string
+		err   error
+	)
+
+	query, _, err = q.toSQLWithArgs(newDialectBuildContext(dialect), []interface{}{})
+
+	return query, err
+}
@@ -0,0 +1,358 @@
+package goqube
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestCreateIndexQuery_Build(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Config      *Config
+		Query       *CreateIndexQuery
+		Expectation struct {
+			Query string
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Config      *Config
+		Query       *CreateIndexQuery
+		Expectation struct {
+			Query string
+			Err   error
+		}
+	}{
+		{
+			Name:   fmt.Sprintf("create index with dialect %s", DialectMySQL),
+			Config: NewConfig(DialectMySQL),
+			Query:  CreateIndex("idx_users_email", "users", "email"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "create index idx_users_email on users (email)",
+				Err:   nil,
+			},
+		},
+		{
+			Name:   fmt.Sprintf("create unique index if not exists with dialect %s", DialectPostgres),
+			Config: NewConfig(DialectPostgres),
+			Query:  CreateIndex("idx_users_tenant_email", "users", "tenant_id", "email").AsUnique().IfNotExists(),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "create unique index if not exists idx_users_tenant_email on users (tenant_id, email)",
+				Err:   nil,
+			},
+		},
+		{
+			Name:   fmt.Sprintf("create partial unique index with dialect %s", DialectPostgres),
+			Config: NewConfig(DialectPostgres),
+			Query: CreateIndex("idx_users_email_active", "users", "email").
+				AsUnique().
+				Where(NewFilter().SetLogic(LogicAnd).
+					AddFilter(NewField("deleted_at"), OperatorIsNull, nil).
+					AddFilter(NewField("status"), OperatorEqual, NewFilterValue("active"))),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "create unique index idx_users_email_active on users (email) where deleted_at is null and status = 'active'",
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "create index if not exists with variant mariadb",
+			Config: NewConfig(DialectMySQL).SetVariant(VariantMariaDB),
+			Query:  CreateIndex("idx_users_email", "users", "email").IfNotExists(),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "create index if not exists idx_users_email on users (email)",
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "create index with table prefix",
+			Config: NewConfig(DialectPostgres).SetTablePrefix("app_"),
+			Query:  CreateIndex("idx_users_email", "users", "email"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "create index idx_users_email on app_users (email)",
+				Err:   nil,
+			},
+		},
+		{
+			Name:   fmt.Sprintf("create index if not exists with dialect %s", DialectMySQL),
+			Config: NewConfig(DialectMySQL),
+			Query:  CreateIndex("idx_users_email", "users", "email").IfNotExists(),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrUnsupportedIndexIfExists,
+			},
+		},
+		{
+			Name:   fmt.Sprintf("create partial index with dialect %s", DialectMySQL),
+			Config: NewConfig(DialectMySQL),
+			Query:  CreateIndex("idx_users_email", "users", "email").Where(NewFilter().SetCondition(NewField("deleted_at"), OperatorIsNull, nil)),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrUnsupportedPartialIndex,
+			},
+		},
+		{
+			Name:   "index name is empty",
+			Config: NewConfig(DialectPostgres),
+			Query:  CreateIndex("", "users", "email"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrIndexNameIsRequired,
+			},
+		},
+		{
+			Name:   "index name is invalid",
+			Config: NewConfig(DialectPostgres),
+			Query:  CreateIndex("idx; drop table users", "users", "email"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrIdentifierIsInvalid,
+			},
+		},
+		{
+			Name:   "table is empty",
+			Config: NewConfig(DialectPostgres),
+			Query:  CreateIndex("idx_users_email", "", "email"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrTableIsRequired,
+			},
+		},
+		{
+			Name:   "columns is empty",
+			Config: NewConfig(DialectPostgres),
+			Query:  CreateIndex("idx_users_email", "users"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrColumnsIsRequired,
+			},
+		},
+		{
+			Name:   "column is invalid",
+			Config: NewConfig(DialectPostgres),
+			Query:  CreateIndex("idx_users_email", "users", "lower(email)"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrIdentifierIsInvalid,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Config.Build(testCases[i].Query)
+
+			if !errors.Is(actualErr, testCases[i].Expectation.Err) {
+				t.Errorf("expectation error is %v, got %v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if actualErr == nil && len(actualArgs) != 0 {
+				t.Errorf("expectation args is empty, got %+v", actualArgs)
+			}
+		})
+	}
+
+	t.Run("to sql", func(t *testing.T) {
+		var (
+			actualQuery string
+			actualErr   error
+		)
+
+		actualQuery, actualErr = CreateIndex("idx_users_email", "users", "email").AsUnique().ToSQL(DialectMySQL)
+
+		if actualErr != nil {
+			t.Errorf("expectation error is nil, got %s", actualErr.Error())
+		}
+
+		if actualQuery != "create unique index idx_users_email on users (email)" {
+			t.Errorf("expectation query is %s, got %s", "create unique index idx_users_email on users (email)", actualQuery)
+		}
+	})
+}
+
+func TestDropIndexQuery_Build(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Config      *Config
+		Query       *DropIndexQuery
+		Expectation struct {
+			Query string
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Config      *Config
+		Query       *DropIndexQuery
+		Expectation struct {
+			Query string
+			Err   error
+		}
+	}{
+		{
+			Name:   fmt.Sprintf("drop index with dialect %s", DialectMySQL),
+			Config: NewConfig(DialectMySQL),
+			Query:  DropIndex("idx_users_email").On("users"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "drop index idx_users_email on users",
+				Err:   nil,
+			},
+		},
+		{
+			Name:   fmt.Sprintf("drop index if exists with dialect %s", DialectPostgres),
+			Config: NewConfig(DialectPostgres),
+			Query:  DropIndex("idx_users_email").On("users").IfExists(),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "drop index if exists idx_users_email",
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "drop index if exists with variant mariadb",
+			Config: NewConfig(DialectMySQL).SetVariant(VariantMariaDB),
+			Query:  DropIndex("idx_users_email").On("users").IfExists(),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "drop index if exists idx_users_email on users",
+				Err:   nil,
+			},
+		},
+		{
+			Name:   fmt.Sprintf("drop index if exists with dialect %s", DialectMySQL),
+			Config: NewConfig(DialectMySQL),
+			Query:  DropIndex("idx_users_email").On("users").IfExists(),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrUnsupportedIndexIfExists,
+			},
+		},
+		{
+			Name:   fmt.Sprintf("drop index without table with dialect %s", DialectMySQL),
+			Config: NewConfig(DialectMySQL),
+			Query:  DropIndex("idx_users_email"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrTableIsRequired,
+			},
+		},
+		{
+			Name:   "index name is empty",
+			Config: NewConfig(DialectPostgres),
+			Query:  DropIndex(""),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrIndexNameIsRequired,
+			},
+		},
+		{
+			Name:   "table is invalid",
+			Config: NewConfig(DialectPostgres),
+			Query:  DropIndex("idx_users_email").On("users; drop table users"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrIdentifierIsInvalid,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualErr   error
+			)
+
+			actualQuery, _, actualErr = testCases[i].Config.Build(testCases[i].Query)
+
+			if !errors.Is(actualErr, testCases[i].Expectation.Err) {
+				t.Errorf("expectation error is %v, got %v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+		})
+	}
+
+	t.Run("to sql", func(t *testing.T) {
+		var (
+			actualQuery string
+			actualErr   error
+		)
+
+		actualQuery, actualErr = DropIndex("idx_users_email").ToSQL(DialectPostgres)
+
+		if actualErr != nil {
+			t.Errorf("expectation error is nil, got %s", actualErr.Error())
+		}
+
+		if actualQuery != "drop index idx_users_email" {
+			t.Errorf("expectation query is %s, got %s", "drop index idx_users_email", actualQuery)
+		}
+	})
+}
@@ -6,9 +6,39 @@ import (
 	"strings"
 )
 
+type defaultValue struct{}
+
+type nullValue struct{}
+
+var (
+	DefaultValue interface{} = defaultValue{}
+	NullValue    interface{} = nullValue{}
+)
+
+func literalValue(value interface{}) (string, bool) {
+	switch value.(type) {
+	case defaultValue:
+		return "default", true
+	case nullValue:
+		return "null", true
+	}
+
+	return "", false
+}
+
 type InsertQuery struct {
-	Table        string
-	FieldsValues map[string][]interface{}
+	Database            string
+	Table               string
+	Fields              []string
+	FieldsValues        map[string][]interface{}
+	Returnings          []*Field
+	Ordinal             string
+	OrdinalKeys         []string
+	MissingValuePolicy  MissingValuePolicy
+	Upsert              bool
+	DuplicateKeyUpdates []string
+	Comments            map[string]string
+	valuesErr           error
 }
 
 func Insert() *InsertQuery {
@@ -17,16 +47,85 @@ func Insert() *InsertQuery {
 	}
 }
 
+func InsertInto(table string) *InsertQuery {
+	return Insert().Into(table)
+}
+
 func (i *InsertQuery) Into(table string) *InsertQuery {
 	i.Table = table
 	return i
 }
 
+func (i *InsertQuery) FromDatabase(database string) *InsertQuery {
+	i.Database = database
+	return i
+}
+
 func (i *InsertQuery) Value(field string, value interface{}) *InsertQuery {
 	i.FieldsValues[field] = append(i.FieldsValues[field], value)
 	return i
 }
 
+func (i *InsertQuery) Columns(fields ...string) *InsertQuery {
+	i.Fields = fields
+	return i
+}
+
+func (i *InsertQuery) Values(values ...interface{}) *InsertQuery {
+	if len(i.Fields) == 0 {
+		i.valuesErr = ErrFieldsIsRequired
+		return i
+	}
+
+	if len(values) != len(i.Fields) {
+		i.valuesErr = ErrValueLengthIsNotEqualToFieldsLength
+		return i
+	}
+
+	for fieldIndex := range i.Fields {
+		i.Value(i.Fields[fieldIndex], values[fieldIndex])
+	}
+
+	return i
+}
+
+func (i *InsertQuery) Returning(fields ...*Field) *InsertQuery {
+	i.Returnings = fields
+	return i
+}
+
+func (i *InsertQuery) WithOrdinal(column string, keys ...string) *InsertQuery {
+	i.Ordinal = column
+	i.OrdinalKeys = keys
+	return i
+}
+
+func (i *InsertQuery) ordinalKeyReturning(key string) *Field {
+	for _, field := range i.Returnings {
+		if field != nil && field.Column == key && (field.Table == "" || field.Table == i.Table) &&
+			field.SelectQuery == nil && field.Raw == nil && field.Expression == nil && len(field.JSONPath) == 0 {
+			return field
+		}
+	}
+
+	return nil
+}
+
+func (i *InsertQuery) AsUpsert() *InsertQuery {
+	i.Upsert = true
+	return i
+}
+
+func (i *InsertQuery) OnDuplicateKeyUpdate(fields ...string) *InsertQuery {
+	i.DuplicateKeyUpdates = fields
+	return i
+}
+
+func (i *InsertQuery) OnMissingValue(policy MissingValuePolicy) *InsertQuery {
+	i.MissingValuePolicy = policy
+	return i
+}
+
 func (i *InsertQuery) getColumnsAndRowsValues() ([]string, [][]interface{}) {
 	var (
 		columns    []string
@@ -36,7 +135,10 @@ func (i *InsertQuery) getColumnsAndRowsValues() ([]string, [][]interface{}) {
 
 	columns = []string{}
 	for field, value := range i.FieldsValues {
-		columns = append(columns, field)
+		if !containsString(i.Fields, field) {
+			columns = append(columns, field)
+		}
+
 		if rowCount < len(value) {
 			rowCount = len(value)
 		}
@@ -46,12 +148,20 @@ func (i *InsertQuery) getColumnsAndRowsValues() ([]string, [][]interface{}) {
 		return columns[i] < columns[j]
 	})
 
+	if len(i.Fields) > 0 {
+		columns = append(append([]string{}, i.Fields...), columns...)
+	}
+
 	rowsValues = [][]interface{}{}
 	for rowIndex := 0; rowIndex < rowCount; rowIndex++ {
 		var rowValues []interface{} = []interface{}{}
 
 		for columnIndex := 0; columnIndex < len(columns); columnIndex++ {
 			if rowIndex >= len(i.FieldsValues[columns[columnIndex]]) {
+				if i.MissingValuePolicy == MissingValuePolicyDefault {
+					rowValues = append(rowValues, defaultValue{})
+				}
+
 				continue
 			}
 
@@ -65,19 +175,61 @@ func (i *InsertQuery) getColumnsAndRowsValues() ([]string, [][]interface{}) {
 }
 
 func (i *InsertQuery) validate(dialect Dialect) error {
+	var err error = i.validateStatement(dialect)
+	if err != nil {
+		return err
+	}
+
+	return validateReturning(dialect, i.Returnings)
+}
+
+func (i *InsertQuery) validateWithContext(bc *buildContext) error {
+	var err error = i.validateStatement(bc.dialect)
+	if err != nil {
+		return err
+	}
+
+	return bc.validateReturning(i.Returnings)
+}
+
+func (i *InsertQuery) validateStatement(dialect Dialect) error {
 	var (
 		columns    []string
 		rowsValues [][]interface{}
+		err        error
 	)
 
 	if dialect == "" {
 		return ErrDialectIsRequired
 	}
 
+	err = validateComments(i.Comments)
+	if err != nil {
+		return err
+	}
+
 	if i.Table == "" {
 		return ErrTableIsRequired
 	}
 
+	if i.Database != "" && !isValidIdentifier(i.Database) {
+		return ErrIdentifierIsInvalid
+	}
+
+	if i.valuesErr != nil {
+		return i.valuesErr
+	}
+
+	if i.MissingValuePolicy != "" && i.MissingValuePolicy != MissingValuePolicyReject && i.MissingValuePolicy != MissingValuePolicyDefault {
+		return ErrMissingValuePolicyIsInvalid
+	}
+
+	for fieldIndex := range i.Fields {
+		if containsString(i.Fields[:fieldIndex], i.Fields[fieldIndex]) {
+			return ErrFieldIsDuplicated
+		}
+	}
+
 	columns, rowsValues = i.getColumnsAndRowsValues()
 
 	if len(columns) == 0 {
@@ -100,44 +252,224 @@ func (i *InsertQuery) validate(dialect Dialect) error {
 		if len(rowValues) != len(columns) {
 			return ErrValueLengthIsNotEqualToFieldsLength
 		}
+
+		for columnIndex := 0; columnIndex < len(rowValues); columnIndex++ {
+			var err error = validateValueKind(rowValues[columnIndex], "")
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if i.Ordinal != "" {
+		if i.MissingValuePolicy == MissingValuePolicyDefault {
+			return ErrMissingValuePolicyIsInvalid
+		}
+
+		for rowIndex := range rowsValues {
+			for columnIndex := range rowsValues[rowIndex] {
+				if _, ok := rowsValues[rowIndex][columnIndex].(defaultValue); ok {
+					return ErrDefaultValueIsNotAllowed
+				}
+			}
+		}
+
+		if len(i.Returnings) == 0 {
+			return ErrReturningIsRequired
+		}
+
+		if !isValidIdentifier(i.Ordinal) {
+			return ErrIdentifierIsInvalid
+		}
+
+		if containsString(columns, i.Ordinal) {
+			return ErrFieldIsDuplicated
+		}
+
+		if len(i.OrdinalKeys) == 0 {
+			return ErrOrdinalKeyIsRequired
+		}
+
+		for _, key := range i.OrdinalKeys {
+			if !containsString(columns, key) {
+				return fmt.Errorf(errSchemaColumnf, ErrKeyIsNotInColumns, key)
+			}
+
+			if i.ordinalKeyReturning(key) == nil {
+				return fmt.Errorf(errSchemaColumnf, ErrOrdinalKeyIsNotReturned, key)
+			}
+		}
+	}
+
+	for _, field := range i.DuplicateKeyUpdates {
+		if !containsString(columns, field) {
+			return fmt.Errorf(errSchemaColumnf, ErrFieldIsNotInColumns, field)
+		}
 	}
 
 	return nil
 }
 
-func (i *InsertQuery) ToSQLWithArgs(dialect Dialect) (string, []interface{}, error) {
+func (i *InsertQuery) toSQLWithArgs(bc *buildContext, args []interface{}) (string, []interface{}, error) {
 	var (
-		columns      []string
-		rowsValues   [][]interface{}
-		query        string
-		args         []interface{}
-		placeholders []string
-		err          error
+		columns         []string
+		columnIndexes   []int
+		writableColumns []string
+		rowsValues      [][]interface{}
+		statement       string = "insert"
+		query           string
+		placeholders    []string
+		returning       string
+		err             error
 	)
 
-	err = i.validate(dialect)
+	err = i.validateWithContext(bc)
 	if err != nil {
 		return "", nil, err
 	}
 
+	if i.Upsert {
+		if bc.config.Variant != VariantCockroach {
+			return "", nil, ErrUnsupportedUpsert
+		}
+
+		statement = "upsert"
+	}
+
+	if len(i.DuplicateKeyUpdates) > 0 && bc.dialect != DialectMySQL {
+		return "", nil, ErrUnsupportedOnDuplicateKeyUpdate
+	}
+
+	bc.pushTableScope(i.Table)
+	defer bc.popScope()
+
 	columns, rowsValues = i.getColumnsAndRowsValues()
-	args = []interface{}{}
+
+	columnIndexes = []int{}
+	for columnIndex := 0; columnIndex < len(columns); columnIndex++ {
+		var skip bool
+
+		skip, err = bc.skipGeneratedColumn(i.Table, columns[columnIndex])
+		if err != nil {
+			return "", nil, err
+		}
+
+		if !skip {
+			columnIndexes = append(columnIndexes, columnIndex)
+			writableColumns = append(writableColumns, bc.tableColumn(i.Table, columns[columnIndex]))
+		}
+	}
+
+	if len(writableColumns) == 0 {
+		return "", nil, ErrFieldsIsRequired
+	}
 
 	for rowIndex := 0; rowIndex < len(rowsValues); rowIndex++ {
+		var rowPlaceholders []string = []string{}
+
+		for _, columnIndex := range columnIndexes {
+			var placeholder string
+
+			if sequence, ok := rowsValues[rowIndex][columnIndex].(sequenceValue); ok {
+				placeholder, err = bc.nextValueSQL(sequence.sequence)
+				if err != nil {
+					return "", nil, err
+				}
+
+				rowPlaceholders = append(rowPlaceholders, placeholder)
+				continue
+			}
+
+			if literal, ok := literalValue(rowsValues[rowIndex][columnIndex]); ok {
+				rowPlaceholders = append(rowPlaceholders, literal)
+				continue
+			}
+
+			placeholder, args, err = bc.sensitiveValueWithPlaceholder(i.Table, columns[columnIndex], rowsValues[rowIndex][columnIndex], args)
+			if err != nil {
+				return "", nil, err
+			}
+
+			rowPlaceholders = append(rowPlaceholders, placeholder)
+		}
+
+		if i.Ordinal != "" && rowIndex == 0 {
+			for k := range rowPlaceholders {
+				rowPlaceholders[k] = fmt.Sprintf(typedValuef, rowPlaceholders[k], bc.qualifiedTableName(i.Database, i.Table), writableColumns[k])
+			}
+		}
+
+		if i.Ordinal != "" {
+			rowPlaceholders = append(rowPlaceholders, fmt.Sprintf("%d", rowIndex+1))
+		}
+
+		placeholders = append(placeholders, fmt.Sprintf("(%s)", strings.Join(rowPlaceholders, ", ")))
+	}
+
+	returning, args, err = returningToSQLWithArgs(bc, i.Returnings, args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	query = fmt.Sprintf("%s into %s(%s) values %s%s%s", statement, bc.qualifiedTableName(i.Database, i.Table), strings.Join(writableColumns, ", "), strings.Join(placeholders, ", "), i.duplicateKeyUpdateSQL(bc), returning)
+	if i.Ordinal != "" {
+		query = fmt.Sprintf(
+			"with input_rows(%s, %s) as (values %s), inserted_rows as (%s into %s(%s) select %s from input_rows order by %s%s) select inserted_rows.*, input_rows.%s from inserted_rows inner join input_rows on %s order by input_rows.%s",
+			strings.Join(writableColumns, ", "),
+			i.Ordinal,
+			strings.Join(placeholders, ", "),
+			statement,
+			bc.qualifiedTableName(i.Database, i.Table),
+			strings.Join(writableColumns, ", "),
+			strings.Join(writableColumns, ", "),
+			i.Ordinal,
+			returning,
+			i.Ordinal,
+			i.ordinalJoinSQL(bc),
+			i.Ordinal,
+		)
+	}
+
+	query = fmt.Sprintf("%s%s", query, commentSQL(i.Comments))
+
+	return query, args, nil
+}
+
+func (i *InsertQuery) duplicateKeyUpdateSQL(bc *buildContext) string {
+	var assignments []string = make([]string, len(i.DuplicateKeyUpdates))
+
+	if len(i.DuplicateKeyUpdates) == 0 {
+		return ""
+	}
+
+	for k, field := range i.DuplicateKeyUpdates {
+		var column string = bc.tableColumn(i.Table, field)
+
+		assignments[k] = fmt.Sprintf("%s = %s", column, bc.insertedValueSQL(column))
+	}
+
+	return " " + bc.onDuplicateKeyUpdateSQL(assignments)
+}
+
+func (i *InsertQuery) ordinalJoinSQL(bc *buildContext) string {
+	var conditions []string = make([]string, len(i.OrdinalKeys))
+
+	for k, key := range i.OrdinalKeys {
 		var (
-			placeholderStartIdx int
-			placeholderEndIdx   int
-			placeholder         string
+			field    *Field = i.ordinalKeyReturning(key)
+			returned string = bc.tableColumn(i.Table, key)
 		)
 
-		args = append(args, rowsValues[rowIndex]...)
-		placeholderStartIdx = len(args) - (len(rowsValues[rowIndex]) - 1)
-		placeholderEndIdx = len(args)
-		placeholder = fmt.Sprintf("(%s)", getPlaceholder(dialect, placeholderStartIdx, placeholderEndIdx))
-		placeholders = append(placeholders, placeholder)
+		if field.Alias != "" {
+			returned = quoteAlias(bc.dialect, field.Alias)
+		}
+
+		conditions[k] = fmt.Sprintf("input_rows.%s = inserted_rows.%s", bc.tableColumn(i.Table, key), returned)
 	}
 
-	query = fmt.Sprintf("insert into %s(%s) values %s", i.Table, strings.Join(columns, ", "), strings.Join(placeholders, ", "))
+	return strings.Join(conditions, " and ")
+}
 
-	return query, args, nil
+func (i *InsertQuery) ToSQLWithArgs(dialect Dialect) (string, []interface{}, error) {
+	return buildStatement(dialect, i, []interface{}{})
 }
@@ -1,7 +1,9 @@
 package goqube
 
 import (
+	"errors"
 	"fmt"
+	"reflect"
 	"testing"
 )
 
@@ -22,6 +24,10 @@ func testInsertQuery_InsertQueryEquality(t *testing.T, expectation, actual *Inse
 		t.Errorf("expectation table is %s, got %s", expectation.Table, actual.Table)
 	}
 
+	if !deepEqual(expectation.Fields, actual.Fields) {
+		t.Errorf("expectation fields is %v, got %v", expectation.Fields, actual.Fields)
+	}
+
 	if len(expectation.FieldsValues) != len(actual.FieldsValues) {
 		t.Errorf("expectation length of field values is %d, got %d", len(expectation.FieldsValues), len(actual.FieldsValues))
 	}
@@ -36,6 +42,10 @@ func testInsertQuery_InsertQueryEquality(t *testing.T, expectation, actual *Inse
 			}
 		}
 	}
+
+	if !deepEqual(expectation.Returnings, actual.Returnings) {
+		t.Errorf("expectation returnings is %v, got %v", expectation.Returnings, actual.Returnings)
+	}
 }
 
 func TestInsertQuery_Insert(t *testing.T) {
@@ -95,6 +105,182 @@ func TestInsertQuery_Value(t *testing.T) {
 	testInsertQuery_InsertQueryEquality(t, expectation, actual)
 }
 
+func TestInsertQuery_InsertInto(t *testing.T) {
+	var (
+		expectation *InsertQuery
+		actual      *InsertQuery
+	)
+
+	expectation = &InsertQuery{
+		FieldsValues: map[string][]interface{}{},
+		Table:        "table1",
+	}
+	actual = InsertInto("table1")
+
+	testInsertQuery_InsertQueryEquality(t, expectation, actual)
+}
+
+func TestInsertQuery_Returning(t *testing.T) {
+	var (
+		expectation *InsertQuery
+		actual      *InsertQuery
+	)
+
+	expectation = &InsertQuery{
+		FieldsValues: map[string][]interface{}{},
+		Table:        "table1",
+		Returnings:   []*Field{NewField("id")},
+	}
+	actual = InsertInto("table1").Returning(NewField("id"))
+
+	testInsertQuery_InsertQueryEquality(t, expectation, actual)
+}
+
+func TestInsertQuery_WithOrdinal(t *testing.T) {
+	var actual *InsertQuery = InsertInto("table1").WithOrdinal("ordinal", "email")
+
+	if actual.Ordinal != "ordinal" {
+		t.Errorf("expectation ordinal is %s, got %s", "ordinal", actual.Ordinal)
+	}
+
+	if !reflect.DeepEqual(actual.OrdinalKeys, []string{"email"}) {
+		t.Errorf("expectation ordinal keys is %+v, got %+v", []string{"email"}, actual.OrdinalKeys)
+	}
+}
+
+func TestInsertQuery_OnDuplicateKeyUpdate(t *testing.T) {
+	var actual *InsertQuery = InsertInto("table1").OnDuplicateKeyUpdate("field1", "field2")
+
+	if !reflect.DeepEqual(actual.DuplicateKeyUpdates, []string{"field1", "field2"}) {
+		t.Errorf("expectation duplicate key updates is %+v, got %+v", []string{"field1", "field2"}, actual.DuplicateKeyUpdates)
+	}
+}
+
+func TestInsertQuery_AsUpsert(t *testing.T) {
+	var actual *InsertQuery = InsertInto("table1").AsUpsert()
+
+	if !actual.Upsert {
+		t.Errorf("expectation upsert is %t, got %t", true, actual.Upsert)
+	}
+}
+
+func TestInsertQuery_OnMissingValue(t *testing.T) {
+	var actual *InsertQuery = InsertInto("table1").OnMissingValue(MissingValuePolicyDefault)
+
+	if actual.MissingValuePolicy != MissingValuePolicyDefault {
+		t.Errorf("expectation missing value policy is %s, got %s", MissingValuePolicyDefault, actual.MissingValuePolicy)
+	}
+}
+
+func TestInsertQuery_Columns(t *testing.T) {
+	var (
+		expectation *InsertQuery
+		actual      *InsertQuery
+	)
+
+	expectation = &InsertQuery{
+		Table:        "table1",
+		Fields:       []string{"field2", "field1"},
+		FieldsValues: map[string][]interface{}{},
+	}
+	actual = InsertInto("table1").
+		Columns("field2", "field1")
+
+	testInsertQuery_InsertQueryEquality(t, expectation, actual)
+}
+
+func TestInsertQuery_Values(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		InsertQuery *InsertQuery
+		Expectation struct {
+			InsertQuery *InsertQuery
+			Err         error
+		}
+	} = []struct {
+		Name        string
+		InsertQuery *InsertQuery
+		Expectation struct {
+			InsertQuery *InsertQuery
+			Err         error
+		}
+	}{
+		{
+			Name: "columns is empty",
+			InsertQuery: InsertInto("table1").
+				Values("value1"),
+			Expectation: struct {
+				InsertQuery *InsertQuery
+				Err         error
+			}{
+				InsertQuery: &InsertQuery{
+					Table:        "table1",
+					FieldsValues: map[string][]interface{}{},
+				},
+				Err: ErrFieldsIsRequired,
+			},
+		},
+		{
+			Name: "values length is not equal to columns length",
+			InsertQuery: InsertInto("table1").
+				Columns("field1", "field2").
+				Values("value1"),
+			Expectation: struct {
+				InsertQuery *InsertQuery
+				Err         error
+			}{
+				InsertQuery: &InsertQuery{
+					Table:        "table1",
+					Fields:       []string{"field1", "field2"},
+					FieldsValues: map[string][]interface{}{},
+				},
+				Err: ErrValueLengthIsNotEqualToFieldsLength,
+			},
+		},
+		{
+			Name: "values length is equal to columns length",
+			InsertQuery: InsertInto("table1").
+				Columns("field2", "field1").
+				Values(1, "value1").
+				Values(2, "value2"),
+			Expectation: struct {
+				InsertQuery *InsertQuery
+				Err         error
+			}{
+				InsertQuery: &InsertQuery{
+					Table:  "table1",
+					Fields: []string{"field2", "field1"},
+					FieldsValues: map[string][]interface{}{
+						"field1": {"value1", "value2"},
+						"field2": {1, 2},
+					},
+				},
+				Err: nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actualErr error = testCases[i].InsertQuery.validate(DialectPostgres)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			testInsertQuery_InsertQueryEquality(t, testCases[i].Expectation.InsertQuery, testCases[i].InsertQuery)
+		})
+	}
+}
+
 func TestInsertQuery_getColumnsAndRowsValues(t *testing.T) {
 	var testCases []struct {
 		Name                 string
@@ -125,6 +311,38 @@ func TestInsertQuery_getColumnsAndRowsValues(t *testing.T) {
 				{"value4"},
 			},
 		},
+		{
+			Name: "missing value policy is default",
+			InsertQuery: &InsertQuery{
+				Table: "table1",
+				FieldsValues: map[string][]interface{}{
+					"field1": {"value1", "value2"},
+					"field2": {1},
+				},
+				MissingValuePolicy: MissingValuePolicyDefault,
+			},
+			ExpectationColumns: []string{"field1", "field2"},
+			ExpectationRowValues: [][]interface{}{
+				{"value1", 1},
+				{"value2", defaultValue{}},
+			},
+		},
+		{
+			Name: "fields is not empty",
+			InsertQuery: &InsertQuery{
+				Table:  "table1",
+				Fields: []string{"field3", "field1"},
+				FieldsValues: map[string][]interface{}{
+					"field1": {"value1"},
+					"field2": {1},
+					"field3": {true},
+				},
+			},
+			ExpectationColumns: []string{"field3", "field1", "field2"},
+			ExpectationRowValues: [][]interface{}{
+				{true, "value1", 1},
+			},
+		},
 		{
 			Name: "insert query is valid",
 			InsertQuery: &InsertQuery{
@@ -251,6 +469,81 @@ func TestInsertQuery_validate(t *testing.T) {
 			},
 			Expectation: ErrValueLengthIsNotEqualToFieldsLength,
 		},
+		{
+			Name:    "fields is duplicated",
+			Dialect: DialectPostgres,
+			InsertQuery: &InsertQuery{
+				Table:  "table1",
+				Fields: []string{"field1", "field1"},
+				FieldsValues: map[string][]interface{}{
+					"field1": {"value1", "value2"},
+				},
+			},
+			Expectation: ErrFieldIsDuplicated,
+		},
+		{
+			Name:    "value kind is unsupported",
+			Dialect: DialectPostgres,
+			InsertQuery: &InsertQuery{
+				Table: "table1",
+				FieldsValues: map[string][]interface{}{
+					"field1": {map[string]interface{}{"key1": "value1"}},
+				},
+			},
+			Expectation: &UnsupportedValueTypeError{Kind: reflect.Map},
+		},
+		{
+			Name:    "ordinal without returning",
+			Dialect: DialectPostgres,
+			InsertQuery: &InsertQuery{
+				Table: "table1",
+				FieldsValues: map[string][]interface{}{
+					"field1": {"value1"},
+				},
+				Ordinal: "ordinal",
+			},
+			Expectation: ErrReturningIsRequired,
+		},
+		{
+			Name:    "ordinal is duplicated with field",
+			Dialect: DialectPostgres,
+			InsertQuery: &InsertQuery{
+				Table: "table1",
+				FieldsValues: map[string][]interface{}{
+					"field1": {"value1"},
+				},
+				Returnings: []*Field{NewField("id")},
+				Ordinal:    "field1",
+			},
+			Expectation: ErrFieldIsDuplicated,
+		},
+		{
+			Name:    "ordinal without key",
+			Dialect: DialectPostgres,
+			InsertQuery: InsertInto("table1").
+				Value("field1", "value1").
+				WithOrdinal("ordinal").
+				Returning(NewField("id")),
+			Expectation: ErrOrdinalKeyIsRequired,
+		},
+		{
+			Name:    "ordinal key is not in columns",
+			Dialect: DialectPostgres,
+			InsertQuery: InsertInto("table1").
+				Value("field1", "value1").
+				WithOrdinal("ordinal", "field2").
+				Returning(NewField("id"), NewField("field2")),
+			Expectation: fmt.Errorf(errSchemaColumnf, ErrKeyIsNotInColumns, "field2"),
+		},
+		{
+			Name:    "ordinal key is not returned",
+			Dialect: DialectPostgres,
+			InsertQuery: InsertInto("table1").
+				Value("field1", "value1").
+				WithOrdinal("ordinal", "field1").
+				Returning(NewField("id")),
+			Expectation: fmt.Errorf(errSchemaColumnf, ErrOrdinalKeyIsNotReturned, "field1"),
+		},
 		{
 			Name:    "insert query is valid",
 			Dialect: DialectPostgres,
@@ -263,6 +556,37 @@ func TestInsertQuery_validate(t *testing.T) {
 			},
 			Expectation: nil,
 		},
+		{
+			Name:        "missing value policy is invalid",
+			Dialect:     DialectPostgres,
+			InsertQuery: InsertInto("table1").Value("field1", "value1").OnMissingValue("ignore"),
+			Expectation: ErrMissingValuePolicyIsInvalid,
+		},
+		{
+			Name:        "missing value policy is reject",
+			Dialect:     DialectPostgres,
+			InsertQuery: InsertInto("table1").Value("field1", "value1").Value("field1", "value2").Value("field2", 1).OnMissingValue(MissingValuePolicyReject),
+			Expectation: ErrValueLengthIsNotEqualToFieldsLength,
+		},
+		{
+			Name:    "missing value policy default with ordinal",
+			Dialect: DialectPostgres,
+			InsertQuery: InsertInto("table1").
+				Value("field1", "value1").
+				OnMissingValue(MissingValuePolicyDefault).
+				WithOrdinal("ordinal").
+				Returning(NewField("id")),
+			Expectation: ErrMissingValuePolicyIsInvalid,
+		},
+		{
+			Name:    "default value with ordinal",
+			Dialect: DialectPostgres,
+			InsertQuery: InsertInto("table1").
+				Value("field1", DefaultValue).
+				WithOrdinal("ordinal").
+				Returning(NewField("id")),
+			Expectation: ErrDefaultValueIsNotAllowed,
+		},
 	}
 
 	for i := range testCases {
@@ -358,6 +682,58 @@ func TestInsertQuery_ToSQLWithArgs(t *testing.T) {
 				Err:   nil,
 			},
 		},
+		{
+			Name: fmt.Sprintf("insert query with columns and dialect %s", DialectPostgres),
+			InsertQuery: InsertInto("table1").
+				Columns("field2", "field1").
+				Values(1, "value1").
+				Values(2, "value2"),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into table1(field2, field1) values ($1, $2), ($3, $4)",
+				Args:  []interface{}{1, "value1", 2, "value2"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("insert with dialect %s and missing value policy default", DialectPostgres),
+			InsertQuery: InsertInto("table1").
+				Value("field1", "value1").
+				Value("field1", "value2").
+				Value("field2", 1).
+				OnMissingValue(MissingValuePolicyDefault),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into table1(field1, field2) values ($1, $2), ($3, default)",
+				Args:  []interface{}{"value1", 1, "value2"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("insert with dialect %s and explicit null and default values", DialectMySQL),
+			InsertQuery: InsertInto("table1").
+				Columns("field1", "field2", "field3").
+				Values("value1", NullValue, nil).
+				Values(DefaultValue, 1, NullValue),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into table1(field1, field2, field3) values (?, null, ?), (default, ?, null)",
+				Args:  []interface{}{"value1", nil, 1},
+				Err:   nil,
+			},
+		},
 	}
 
 	for i := range testCases {
@@ -400,3 +776,93 @@ func TestInsertQuery_ToSQLWithArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestInsertQuery_FromDatabase(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Query       Query
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Query       Query
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:    "database is invalid",
+			Dialect: DialectPostgres,
+			Query:   InsertInto("AuditLogs").FromDatabase("archive; drop").Value("user_id", 1),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrIdentifierIsInvalid,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			Query:   InsertInto("AuditLogs").FromDatabase("archive").Value("user_id", 1),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into archive.app_audit_logs(user_id) values ($1)",
+				Args:  []interface{}{1},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s", DialectMySQL),
+			Dialect: DialectMySQL,
+			Query:   InsertInto("AuditLogs").FromDatabase("archive").Value("user_id", 1),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into archive.app_audit_logs(user_id) values (?)",
+				Args:  []interface{}{1},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				config      *Config = NewConfig(testCases[i].Dialect).SetTablePrefix("app_").SetNameMapper(NewNameMapper().MapTables(SnakeCase))
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = config.Build(testCases[i].Query)
+
+			if !errors.Is(actualErr, testCases[i].Expectation.Err) {
+				t.Errorf("expectation error is %v, got %v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %v, got %v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
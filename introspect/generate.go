@@ -0,0 +1,183 @@
+package introspect
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/fikri240794/goqube"
+)
+
+const (
+	codeColumnConstf string = "\t%sColumn%s string = %q\n"
+	codeFieldf       string = "\t%s %s `db:\"%s\"`\n"
+	codeHeaderf      string = "// Code generated by goqube introspect. DO NOT EDIT.\n\npackage %s\n\n"
+	codeImportTime   string = "import \"time\"\n\n"
+	codeStructf      string = "type %s struct {\n"
+	codeTableConstf  string = "\t%sTable string = %q\n"
+	identifierPrefix string = "X"
+	tagGenerated     string = ",generated"
+)
+
+var (
+	initialisms []string = []string{"api", "http", "id", "ip", "json", "sql", "url", "uuid"}
+
+	goTypes []struct {
+		prefixes []string
+		goType   string
+	} = []struct {
+		prefixes []string
+		goType   string
+	}{
+		{prefixes: []string{"interval"}, goType: "string"},
+		{prefixes: []string{"tinyint(1)", "bool"}, goType: "bool"},
+		{prefixes: []string{"tinyint", "smallint", "mediumint", "int", "bigint", "serial", "bigserial"}, goType: "int64"},
+		{prefixes: []string{"decimal", "numeric", "real", "float", "double"}, goType: "float64"},
+		{prefixes: []string{"date", "time"}, goType: "time.Time"},
+		{prefixes: []string{"json", "bytea", "blob", "binary", "varbinary", "tinyblob", "mediumblob", "longblob"}, goType: "[]byte"},
+	}
+)
+
+func GenerateStructs(schema *goqube.Schema, packageName string) ([]byte, error) {
+	var (
+		tables   []string
+		body     bytes.Buffer
+		code     bytes.Buffer
+		useTime  bool
+		constant bytes.Buffer
+	)
+
+	if schema == nil {
+		return nil, ErrSchemaIsRequired
+	}
+
+	for table := range schema.Tables {
+		tables = append(tables, table)
+	}
+
+	sort.Strings(tables)
+
+	for _, table := range tables {
+		var (
+			schemaTable *goqube.SchemaTable = schema.Tables[table]
+			structName  string              = goName(table)
+		)
+
+		if schemaTable == nil {
+			continue
+		}
+
+		fmt.Fprintf(&constant, codeTableConstf, structName, table)
+		fmt.Fprintf(&body, codeStructf, structName)
+
+		for i := range schemaTable.Columns {
+			var (
+				column *goqube.SchemaColumn = schemaTable.Columns[i]
+				goType string
+				tag    string
+			)
+
+			if column == nil {
+				continue
+			}
+
+			goType = columnGoType(column)
+			if strings.HasSuffix(goType, "time.Time") {
+				useTime = true
+			}
+
+			tag = column.Name
+			if column.Generated || column.Identity {
+				tag += tagGenerated
+			}
+
+			fmt.Fprintf(&constant, codeColumnConstf, structName, goName(column.Name), column.Name)
+			fmt.Fprintf(&body, codeFieldf, goName(column.Name), goType, tag)
+		}
+
+		body.WriteString("}\n\n")
+	}
+
+	fmt.Fprintf(&code, codeHeaderf, packageName)
+	if useTime {
+		code.WriteString(codeImportTime)
+	}
+
+	if constant.Len() > 0 {
+		fmt.Fprintf(&code, "const (\n%s)\n\n", constant.String())
+	}
+
+	code.Write(body.Bytes())
+
+	return format.Source(code.Bytes())
+}
+
+func columnGoType(column *goqube.SchemaColumn) string {
+	var (
+		columnType string = strings.ToLower(strings.TrimSpace(column.Type))
+		goType     string = "string"
+	)
+
+	for i := range goTypes {
+		var matched bool
+
+		for j := range goTypes[i].prefixes {
+			if strings.HasPrefix(columnType, goTypes[i].prefixes[j]) {
+				matched = true
+				break
+			}
+		}
+
+		if matched {
+			goType = goTypes[i].goType
+			break
+		}
+	}
+
+	if !column.NotNull && goType != "[]byte" {
+		return "*" + goType
+	}
+
+	return goType
+}
+
+func goName(name string) string {
+	var (
+		words []string
+		out   strings.Builder
+	)
+
+	words = strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	for i := range words {
+		var word string = strings.ToLower(words[i])
+
+		if containsString(initialisms, word) {
+			out.WriteString(strings.ToUpper(word))
+			continue
+		}
+
+		out.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+
+	if out.Len() == 0 || unicode.IsDigit(rune(out.String()[0])) {
+		return identifierPrefix + out.String()
+	}
+
+	return out.String()
+}
+
+func containsString(values []string, value string) bool {
+	for i := range values {
+		if values[i] == value {
+			return true
+		}
+	}
+
+	return false
+}
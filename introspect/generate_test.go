@@ -0,0 +1,109 @@
+package introspect
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/fikri240794/goqube"
+)
+
+func TestGenerateStructs(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Schema      *goqube.Schema
+		Expectation struct {
+			Code string
+			Err  error
+		}
+	} = []struct {
+		Name        string
+		Schema      *goqube.Schema
+		Expectation struct {
+			Code string
+			Err  error
+		}
+	}{
+		{
+			Name: "generate structs",
+			Schema: goqube.NewSchema().AddTables(
+				goqube.NewSchemaTable("users").AddColumns(
+					goqube.NewSchemaColumn("id").OfType("bigint").AsNotNull().AsIdentity(),
+					goqube.NewSchemaColumn("email").OfType("varchar(255)").AsNotNull(),
+					goqube.NewSchemaColumn("active").OfType("tinyint(1)").AsNotNull(),
+					goqube.NewSchemaColumn("balance").OfType("decimal(12,2)"),
+					goqube.NewSchemaColumn("created_at").OfType("timestamp with time zone").AsNotNull(),
+					goqube.NewSchemaColumn("profile_json").OfType("jsonb"),
+					nil,
+				),
+				goqube.NewSchemaTable("order_items").AddColumns(goqube.NewSchemaColumn("2fa_code").OfType("interval")),
+			),
+			Expectation: struct {
+				Code string
+				Err  error
+			}{
+				Code: `// Code generated by goqube introspect. DO NOT EDIT.
+
+package models
+
+import "time"
+
+const (
+	OrderItemsTable          string = "order_items"
+	OrderItemsColumnX2faCode string = "2fa_code"
+	UsersTable               string = "users"
+	UsersColumnID            string = "id"
+	UsersColumnEmail         string = "email"
+	UsersColumnActive        string = "active"
+	UsersColumnBalance       string = "balance"
+	UsersColumnCreatedAt     string = "created_at"
+	UsersColumnProfileJSON   string = "profile_json"
+)
+
+type OrderItems struct {
+	X2faCode *string ` + "`db:\"2fa_code\"`" + `
+}
+
+type Users struct {
+	ID          int64     ` + "`db:\"id,generated\"`" + `
+	Email       string    ` + "`db:\"email\"`" + `
+	Active      bool      ` + "`db:\"active\"`" + `
+	Balance     *float64  ` + "`db:\"balance\"`" + `
+	CreatedAt   time.Time ` + "`db:\"created_at\"`" + `
+	ProfileJSON []byte    ` + "`db:\"profile_json\"`" + `
+}
+`,
+				Err: nil,
+			},
+		},
+		{
+			Name:   "schema is nil",
+			Schema: nil,
+			Expectation: struct {
+				Code string
+				Err  error
+			}{
+				Code: "",
+				Err:  ErrSchemaIsRequired,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualCode []byte
+				actualErr  error
+			)
+
+			actualCode, actualErr = GenerateStructs(testCases[i].Schema, "models")
+
+			if !errors.Is(actualErr, testCases[i].Expectation.Err) {
+				t.Errorf("expectation error is %v, got %v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Code != string(actualCode) {
+				t.Errorf("expectation code is\n%s\ngot\n%s", testCases[i].Expectation.Code, string(actualCode))
+			}
+		})
+	}
+}
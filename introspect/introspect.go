@@ -0,0 +1,217 @@
+package introspect
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/fikri240794/goqube"
+)
+
+const (
+	columnsTable            string = "information_schema.columns"
+	mysqlAutoIncrement      string = "auto_increment"
+	mysqlCurrentSchema      string = "database()"
+	mysqlStoredGenerated    string = "stored generated"
+	mysqlVirtualGenerated   string = "virtual generated"
+	postgresCurrentSchema   string = "current_schema()"
+	postgresGeneratedAlways string = "always"
+	valueYes                string = "yes"
+)
+
+var (
+	ErrDialectIsInvalid error = errors.New("dialect must be mysql or postgres")
+	ErrSchemaIsRequired error = errors.New("schema is required")
+)
+
+type Column struct {
+	Table       string `db:"table_name"`
+	Name        string `db:"column_name"`
+	Type        string `db:"column_type"`
+	IsNullable  string `db:"is_nullable"`
+	IsIdentity  string `db:"is_identity"`
+	IsGenerated string `db:"is_generated"`
+	Extra       string `db:"extra"`
+}
+
+func (c *Column) notNull() bool {
+	return !strings.EqualFold(c.IsNullable, valueYes)
+}
+
+func (c *Column) identity() bool {
+	return strings.EqualFold(c.IsIdentity, valueYes) || strings.Contains(strings.ToLower(c.Extra), mysqlAutoIncrement)
+}
+
+func (c *Column) generated() bool {
+	var extra string = strings.ToLower(c.Extra)
+
+	return strings.EqualFold(c.IsGenerated, postgresGeneratedAlways) || strings.Contains(extra, mysqlVirtualGenerated) || strings.Contains(extra, mysqlStoredGenerated)
+}
+
+func (c *Column) toSchemaColumn() *goqube.SchemaColumn {
+	var column *goqube.SchemaColumn = goqube.NewSchemaColumn(c.Name).OfType(strings.ToLower(c.Type))
+
+	if c.notNull() {
+		column.AsNotNull()
+	}
+
+	if c.identity() {
+		column.AsIdentity()
+	}
+
+	if c.generated() {
+		column.AsGenerated()
+	}
+
+	return column
+}
+
+type Introspector struct {
+	Executor   *goqube.Executor
+	SchemaName string
+	Tables     []string
+}
+
+func NewIntrospector(db *sql.DB, dialect goqube.Dialect) *Introspector {
+	return &Introspector{
+		Executor: goqube.NewExecutor(db, dialect),
+	}
+}
+
+func (i *Introspector) InSchema(schemaName string) *Introspector {
+	i.SchemaName = schemaName
+	return i
+}
+
+func (i *Introspector) OnlyTables(tables ...string) *Introspector {
+	i.Tables = append(i.Tables, tables...)
+	return i
+}
+
+func (i *Introspector) columnsQuery() (*goqube.SelectQuery, error) {
+	var (
+		fields       []*goqube.Field
+		schemaFilter *goqube.FilterValue
+		filter       *goqube.Filter
+	)
+
+	switch i.Executor.Config.Dialect {
+	case goqube.DialectMySQL:
+		fields = []*goqube.Field{
+			goqube.NewField("column_type").As("column_type"),
+			goqube.NewField("extra").As("extra"),
+		}
+		schemaFilter = goqube.NewRawFilterValue(goqube.NewRaw(mysqlCurrentSchema))
+	case goqube.DialectPostgres:
+		fields = []*goqube.Field{
+			goqube.NewField("data_type").As("column_type"),
+			goqube.NewField("is_identity").As("is_identity"),
+			goqube.NewField("is_generated").As("is_generated"),
+		}
+		schemaFilter = goqube.NewRawFilterValue(goqube.NewRaw(postgresCurrentSchema))
+	default:
+		return nil, ErrDialectIsInvalid
+	}
+
+	if i.SchemaName != "" {
+		schemaFilter = goqube.NewFilterValue(i.SchemaName)
+	}
+
+	fields = append([]*goqube.Field{
+		goqube.NewField("table_name").As("table_name"),
+		goqube.NewField("column_name").As("column_name"),
+		goqube.NewField("is_nullable").As("is_nullable"),
+	}, fields...)
+
+	filter = goqube.NewFilter().
+		SetLogic(goqube.LogicAnd).
+		AddFilter(goqube.NewField("table_schema"), goqube.OperatorEqual, schemaFilter)
+
+	if len(i.Tables) > 0 {
+		filter.AddFilter(goqube.NewField("table_name"), goqube.OperatorIn, goqube.NewFilterValue(i.Tables))
+	}
+
+	return goqube.Select(fields...).
+		From(goqube.NewTable(columnsTable)).
+		Where(filter).
+		OrderBy(
+			goqube.NewSort(goqube.NewField("table_name"), goqube.SortDirectionAscending),
+			goqube.NewSort(goqube.NewField("ordinal_position"), goqube.SortDirectionAscending),
+		).
+		NoLimit(), nil
+}
+
+func (i *Introspector) Columns(ctx context.Context) ([]*Column, error) {
+	var (
+		query   *goqube.SelectQuery
+		columns []*Column
+		err     error
+	)
+
+	if i.Executor == nil || i.Executor.Config == nil {
+		return nil, goqube.ErrConfigIsRequired
+	}
+
+	query, err = i.columnsQuery()
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.Executor.QuerySelect(ctx, query, &columns)
+	if err != nil {
+		return nil, err
+	}
+
+	return columns, nil
+}
+
+func (i *Introspector) Schema(ctx context.Context) (*goqube.Schema, error) {
+	var (
+		columns []*Column
+		err     error
+	)
+
+	columns, err = i.Columns(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSchema(columns), nil
+}
+
+func NewSchema(columns []*Column) *goqube.Schema {
+	var schema *goqube.Schema = goqube.NewSchema()
+
+	for i := range columns {
+		if columns[i] == nil {
+			continue
+		}
+
+		var table *goqube.SchemaTable = schema.Tables[columns[i].Table]
+		if table == nil {
+			table = goqube.NewSchemaTable(columns[i].Table)
+			schema.AddTables(table)
+		}
+
+		table.AddColumns(columns[i].toSchemaColumn())
+	}
+
+	return schema
+}
+
+func Fields(table *goqube.SchemaTable) []*goqube.Field {
+	var fields []*goqube.Field = []*goqube.Field{}
+
+	if table == nil {
+		return fields
+	}
+
+	for i := range table.Columns {
+		if table.Columns[i] != nil {
+			fields = append(fields, goqube.NewField(table.Columns[i].Name).FromTable(table.Name))
+		}
+	}
+
+	return fields
+}
@@ -0,0 +1,289 @@
+package introspect
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/fikri240794/goqube"
+)
+
+type fakeDriver struct {
+	mu      sync.Mutex
+	query   string
+	args    []interface{}
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) {
+	return &fakeDriverConn{driver: d}, nil
+}
+
+type fakeDriverConn struct {
+	driver *fakeDriver
+}
+
+func (c *fakeDriverConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("prepare is not supported")
+}
+
+func (c *fakeDriverConn) Close() error {
+	return nil
+}
+
+func (c *fakeDriverConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("begin is not supported")
+}
+
+func (c *fakeDriverConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.driver.mu.Lock()
+	defer c.driver.mu.Unlock()
+
+	c.driver.query = query
+	c.driver.args = []interface{}{}
+	for i := range args {
+		c.driver.args = append(c.driver.args, args[i].Value)
+	}
+
+	return &fakeDriverRows{columns: c.driver.columns, rows: c.driver.rows}, nil
+}
+
+type fakeDriverRows struct {
+	columns []string
+	rows    [][]driver.Value
+	index   int
+}
+
+func (r *fakeDriverRows) Columns() []string {
+	return r.columns
+}
+
+func (r *fakeDriverRows) Close() error {
+	return nil
+}
+
+func (r *fakeDriverRows) Next(dest []driver.Value) error {
+	if r.index >= len(r.rows) {
+		return io.EOF
+	}
+
+	copy(dest, r.rows[r.index])
+	r.index++
+
+	return nil
+}
+
+var (
+	fakeDriverOnce     sync.Once
+	fakeDriverInstance *fakeDriver = &fakeDriver{}
+)
+
+func openFakeDB(t *testing.T, columns []string, rows [][]driver.Value) *sql.DB {
+	var (
+		db  *sql.DB
+		err error
+	)
+
+	fakeDriverOnce.Do(func() {
+		sql.Register("goqube_introspect_fake", fakeDriverInstance)
+	})
+
+	fakeDriverInstance.mu.Lock()
+	fakeDriverInstance.query = ""
+	fakeDriverInstance.args = nil
+	fakeDriverInstance.columns = columns
+	fakeDriverInstance.rows = rows
+	fakeDriverInstance.mu.Unlock()
+
+	db, err = sql.Open("goqube_introspect_fake", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	return db
+}
+
+func TestIntrospector_Schema(t *testing.T) {
+	var testCases []struct {
+		Name         string
+		Dialect      goqube.Dialect
+		Introspector func(db *sql.DB) *Introspector
+		Columns      []string
+		Rows         [][]driver.Value
+		Expectation  struct {
+			Query  string
+			Args   []interface{}
+			Schema *goqube.Schema
+			Err    error
+		}
+	} = []struct {
+		Name         string
+		Dialect      goqube.Dialect
+		Introspector func(db *sql.DB) *Introspector
+		Columns      []string
+		Rows         [][]driver.Value
+		Expectation  struct {
+			Query  string
+			Args   []interface{}
+			Schema *goqube.Schema
+			Err    error
+		}
+	}{
+		{
+			Name: "introspect with dialect postgres",
+			Introspector: func(db *sql.DB) *Introspector {
+				return NewIntrospector(db, goqube.DialectPostgres)
+			},
+			Columns: []string{"table_name", "column_name", "is_nullable", "column_type", "is_identity", "is_generated"},
+			Rows: [][]driver.Value{
+				{"users", "id", "NO", "bigint", "YES", "NEVER"},
+				{"users", "email", "YES", "character varying", "NO", "NEVER"},
+				{"users", "search", "YES", "tsvector", "NO", "ALWAYS"},
+				{"roles", "name", "NO", "text", "NO", "NEVER"},
+			},
+			Expectation: struct {
+				Query  string
+				Args   []interface{}
+				Schema *goqube.Schema
+				Err    error
+			}{
+				Query: "select table_name as table_name, column_name as column_name, is_nullable as is_nullable, data_type as column_type, is_identity as is_identity, is_generated as is_generated from information_schema.columns where table_schema = current_schema() order by table_name asc, ordinal_position asc",
+				Args:  []interface{}{},
+				Schema: goqube.NewSchema().AddTables(
+					goqube.NewSchemaTable("users").AddColumns(
+						goqube.NewSchemaColumn("id").OfType("bigint").AsNotNull().AsIdentity(),
+						goqube.NewSchemaColumn("email").OfType("character varying"),
+						goqube.NewSchemaColumn("search").OfType("tsvector").AsGenerated(),
+					),
+					goqube.NewSchemaTable("roles").AddColumns(goqube.NewSchemaColumn("name").OfType("text").AsNotNull()),
+				),
+				Err: nil,
+			},
+		},
+		{
+			Name: "introspect with dialect mysql, schema name and tables",
+			Introspector: func(db *sql.DB) *Introspector {
+				return NewIntrospector(db, goqube.DialectMySQL).InSchema("app").OnlyTables("users")
+			},
+			Columns: []string{"table_name", "column_name", "is_nullable", "column_type", "extra"},
+			Rows: [][]driver.Value{
+				{"users", "id", "NO", "BIGINT", "auto_increment"},
+				{"users", "full_name", "YES", "varchar(255)", "VIRTUAL GENERATED"},
+				{"users", "search_key", "YES", "varchar(255)", "STORED GENERATED"},
+				{"users", "updated_at", "NO", "timestamp", "DEFAULT_GENERATED on update CURRENT_TIMESTAMP"},
+			},
+			Expectation: struct {
+				Query  string
+				Args   []interface{}
+				Schema *goqube.Schema
+				Err    error
+			}{
+				Query: "select table_name as table_name, column_name as column_name, is_nullable as is_nullable, column_type as column_type, extra as extra from information_schema.columns where table_schema = ? and table_name in (?) order by table_name asc, ordinal_position asc",
+				Args:  []interface{}{"app", "users"},
+				Schema: goqube.NewSchema().AddTables(
+					goqube.NewSchemaTable("users").AddColumns(
+						goqube.NewSchemaColumn("id").OfType("bigint").AsNotNull().AsIdentity(),
+						goqube.NewSchemaColumn("full_name").OfType("varchar(255)").AsGenerated(),
+						goqube.NewSchemaColumn("search_key").OfType("varchar(255)").AsGenerated(),
+						goqube.NewSchemaColumn("updated_at").OfType("timestamp").AsNotNull(),
+					),
+				),
+				Err: nil,
+			},
+		},
+		{
+			Name: "dialect is invalid",
+			Introspector: func(db *sql.DB) *Introspector {
+				return NewIntrospector(db, "sqlite")
+			},
+			Expectation: struct {
+				Query  string
+				Args   []interface{}
+				Schema *goqube.Schema
+				Err    error
+			}{
+				Query:  "",
+				Args:   nil,
+				Schema: nil,
+				Err:    ErrDialectIsInvalid,
+			},
+		},
+		{
+			Name: "config is nil",
+			Introspector: func(db *sql.DB) *Introspector {
+				return &Introspector{Executor: &goqube.Executor{DB: db}}
+			},
+			Expectation: struct {
+				Query  string
+				Args   []interface{}
+				Schema *goqube.Schema
+				Err    error
+			}{
+				Query:  "",
+				Args:   nil,
+				Schema: nil,
+				Err:    goqube.ErrConfigIsRequired,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				db           *sql.DB = openFakeDB(t, testCases[i].Columns, testCases[i].Rows)
+				actualSchema *goqube.Schema
+				actualErr    error
+			)
+
+			actualSchema, actualErr = testCases[i].Introspector(db).Schema(context.Background())
+
+			if !errors.Is(actualErr, testCases[i].Expectation.Err) {
+				t.Errorf("expectation error is %v, got %v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if !reflect.DeepEqual(testCases[i].Expectation.Schema, actualSchema) {
+				t.Errorf("expectation schema is %+v, got %+v", testCases[i].Expectation.Schema, actualSchema)
+			}
+
+			if testCases[i].Expectation.Query != fakeDriverInstance.query {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, fakeDriverInstance.query)
+			}
+
+			if !reflect.DeepEqual(testCases[i].Expectation.Args, fakeDriverInstance.args) {
+				t.Errorf("expectation args is %+v, got %+v", testCases[i].Expectation.Args, fakeDriverInstance.args)
+			}
+		})
+	}
+}
+
+func TestFields(t *testing.T) {
+	var (
+		expectation []*goqube.Field = []*goqube.Field{
+			goqube.NewField("id").FromTable("users"),
+			goqube.NewField("email").FromTable("users"),
+		}
+		actual []*goqube.Field
+	)
+
+	actual = Fields(goqube.NewSchemaTable("users").AddColumns(goqube.NewSchemaColumn("id"), nil, goqube.NewSchemaColumn("email")))
+
+	if !reflect.DeepEqual(expectation, actual) {
+		t.Errorf("expectation fields is %+v, got %+v", expectation, actual)
+	}
+
+	actual = Fields(nil)
+
+	if len(actual) != 0 {
+		t.Errorf("expectation fields is empty, got %+v", actual)
+	}
+}
@@ -0,0 +1,69 @@
+package goqube
+
+import "fmt"
+
+func checkInvariants(dialect Dialect, query string, args []interface{}) error {
+	var (
+		depth            int
+		inQuote          bool
+		placeholderCount int
+		maxIndex         int
+	)
+
+	for i := 0; i < len(query); i++ {
+		if query[i] == '\'' {
+			inQuote = !inQuote
+			continue
+		}
+
+		if inQuote {
+			continue
+		}
+
+		switch query[i] {
+		case '(':
+			depth++
+
+		case ')':
+			depth--
+			if depth < 0 {
+				return ErrUnbalancedParentheses
+			}
+
+		case '?':
+			if dialect == DialectMySQL {
+				placeholderCount++
+			}
+
+		case '$':
+			var index int
+
+			if dialect != DialectPostgres {
+				continue
+			}
+
+			for i+1 < len(query) && query[i+1] >= '0' && query[i+1] <= '9' {
+				index = index*10 + int(query[i+1]-'0')
+				i++
+			}
+
+			if index > maxIndex {
+				maxIndex = index
+			}
+		}
+	}
+
+	if depth != 0 || inQuote {
+		return ErrUnbalancedParentheses
+	}
+
+	if dialect == DialectPostgres {
+		placeholderCount = maxIndex
+	}
+
+	if placeholderCount != len(args) {
+		return fmt.Errorf(errPlaceholderCountf, ErrPlaceholderCountMismatch, placeholderCount, len(args))
+	}
+
+	return nil
+}
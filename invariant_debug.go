@@ -0,0 +1,5 @@
+//go:build goqube_debug
+
+package goqube
+
+const debugInvariants bool = true
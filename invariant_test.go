@@ -0,0 +1,90 @@
+package goqube
+
+import (
+	"fmt"
+	"testing"
+)
+
+func Test_checkInvariants(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Query       string
+		Args        []interface{}
+		Expectation error
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Query       string
+		Args        []interface{}
+		Expectation error
+	}{
+		{
+			Name:        "parentheses is not closed",
+			Dialect:     DialectPostgres,
+			Query:       "select field1 from (select field1 from table1 as t1",
+			Args:        []interface{}{},
+			Expectation: ErrUnbalancedParentheses,
+		},
+		{
+			Name:        "parentheses is closed before opened",
+			Dialect:     DialectPostgres,
+			Query:       "select field1) from (table1",
+			Args:        []interface{}{},
+			Expectation: ErrUnbalancedParentheses,
+		},
+		{
+			Name:        "quote is not closed",
+			Dialect:     DialectPostgres,
+			Query:       "select 'field1 from table1",
+			Args:        []interface{}{},
+			Expectation: ErrUnbalancedParentheses,
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s placeholder count is not equal to args length", DialectMySQL),
+			Dialect:     DialectMySQL,
+			Query:       "select field1 from table1 where field1 = ? and field2 = ?",
+			Args:        []interface{}{"value1"},
+			Expectation: fmt.Errorf(errPlaceholderCountf, ErrPlaceholderCountMismatch, 2, 1),
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s placeholder index is not equal to args length", DialectPostgres),
+			Dialect:     DialectPostgres,
+			Query:       "select field1 from table1 where field1 = $1 and field2 = $12",
+			Args:        []interface{}{"value1", "value2"},
+			Expectation: fmt.Errorf(errPlaceholderCountf, ErrPlaceholderCountMismatch, 12, 2),
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s with parentheses and placeholders inside quote", DialectMySQL),
+			Dialect:     DialectMySQL,
+			Query:       "select field1 from table1 where cast(field1 as char) like concat('%(?', cast(? as char), '%')",
+			Args:        []interface{}{"value1"},
+			Expectation: nil,
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s query is valid", DialectPostgres),
+			Dialect:     DialectPostgres,
+			Query:       "select field1 from table1 where field1 in ($1, $2) limit $3",
+			Args:        []interface{}{"value1", "value2", 10},
+			Expectation: nil,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual error = checkInvariants(testCases[i].Dialect, testCases[i].Query, testCases[i].Args)
+
+			if testCases[i].Expectation != nil && actual == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation == nil && actual != nil {
+				t.Errorf("expectation error is nil, got %s", actual.Error())
+			}
+
+			if testCases[i].Expectation != nil && actual != nil && testCases[i].Expectation.Error() != actual.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Error(), actual.Error())
+			}
+		})
+	}
+}
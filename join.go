@@ -3,9 +3,11 @@ package goqube
 import "fmt"
 
 type Join struct {
-	Type   JoinType
-	Table  *Table
-	Filter *Filter
+	Type          JoinType
+	Table         *Table
+	Filter        *Filter
+	Lateral       bool
+	Unconstrained bool
 }
 
 func InnerJoin(table *Table) *Join {
@@ -36,6 +38,23 @@ func FullJoin(table *Table) *Join {
 	}
 }
 
+func CrossJoin(table *Table) *Join {
+	return &Join{
+		Type:  CrossJoinType,
+		Table: table,
+	}
+}
+
+func (j *Join) AsLateral() *Join {
+	j.Lateral = true
+	return j
+}
+
+func (j *Join) AsUnconstrained() *Join {
+	j.Unconstrained = true
+	return j
+}
+
 func (j *Join) On(filter *Filter) *Join {
 	j.Filter = filter
 
@@ -55,14 +74,34 @@ func (j *Join) validate(dialect Dialect) error {
 		return ErrTableIsRequired
 	}
 
-	if j.Filter == nil {
+	if j.Lateral && j.Table.SelectQuery == nil && j.Table.Raw == nil {
+		return ErrLateralTableIsInvalid
+	}
+
+	if j.Type == CrossJoinType && j.Filter != nil {
+		return ErrFilterIsNotAllowed
+	}
+
+	if j.Type != CrossJoinType && !j.Lateral && j.Filter == nil {
 		return ErrFilterIsRequired
 	}
 
 	return nil
 }
 
-func (j *Join) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+func (j *Join) validateFilterReference() error {
+	if j.Type == CrossJoinType || j.Lateral || j.Unconstrained || j.Filter == nil {
+		return nil
+	}
+
+	if !j.Filter.referencesTable(j.Table.qualifier()) {
+		return ErrJoinFilterIsUnreferenced
+	}
+
+	return nil
+}
+
+func (j *Join) toSQLWithArgs(bc *buildContext, args []interface{}) (string, []interface{}, error) {
 	var (
 		tableQuery  string
 		filterQuery string
@@ -70,22 +109,42 @@ func (j *Join) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []int
 		err         error
 	)
 
-	err = j.validate(dialect)
+	err = j.validate(bc.dialect)
 	if err != nil {
 		return "", nil, err
 	}
 
-	tableQuery, args, err = j.Table.ToSQLWithArgsWithAlias(dialect, args)
+	tableQuery, args, err = j.Table.toSQLWithArgsWithAlias(bc, args)
 	if err != nil {
-		return "", nil, err
+		return "", nil, wrapPath("Table", err)
 	}
 
-	filterQuery, args, err = j.Filter.ToSQLWithArgs(dialect, args)
-	if err != nil {
-		return "", nil, err
+	if j.Lateral {
+		tableQuery = fmt.Sprintf("lateral %s", tableQuery)
+	}
+
+	if j.Type == CrossJoinType {
+		return string(j.Type) + " " + tableQuery, args, nil
+	}
+
+	filterQuery = "true"
+	if j.Filter != nil {
+		filterQuery, args, err = j.Filter.toRootSQLWithArgs(bc, args)
+		if err != nil {
+			return "", nil, wrapPath("Filter", err)
+		}
+
+		err = j.validateFilterReference()
+		if err != nil {
+			return "", nil, err
+		}
 	}
 
-	query = fmt.Sprintf("%s %s on %s", j.Type, tableQuery, filterQuery)
+	query = string(j.Type) + " " + tableQuery + " on " + filterQuery
 
 	return query, args, nil
 }
+
+func (j *Join) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+	return j.toSQLWithArgs(newDialectBuildContext(dialect), args)
+}
@@ -1,6 +1,9 @@
 package goqube
 
-import "testing"
+import (
+	"fmt"
+	"testing"
+)
 
 func testJoin_JoinEquality(t *testing.T, expectation, actual *Join) {
 	if expectation == nil && actual == nil {
@@ -42,6 +45,14 @@ func testJoin_JoinEquality(t *testing.T, expectation, actual *Join) {
 	if expectation.Filter != nil && actual.Filter != nil && !deepEqual(*expectation.Filter, *actual.Filter) {
 		t.Errorf("expectation filter is %+v, got %+v", expectation.Filter, actual.Filter)
 	}
+
+	if expectation.Lateral != actual.Lateral {
+		t.Errorf("expectation lateral is %t, got %t", expectation.Lateral, actual.Lateral)
+	}
+
+	if expectation.Unconstrained != actual.Unconstrained {
+		t.Errorf("expectation unconstrained is %t, got %t", expectation.Unconstrained, actual.Unconstrained)
+	}
 }
 
 func TestJoin_InnerJoin(t *testing.T) {
@@ -161,6 +172,58 @@ func TestJoin_On(t *testing.T) {
 	testJoin_JoinEquality(t, expectation, actual)
 }
 
+func TestJoin_CrossJoin(t *testing.T) {
+	var (
+		expectation *Join
+		actual      *Join
+	)
+
+	expectation = &Join{
+		Type: CrossJoinType,
+		Table: &Table{
+			Name: "table2",
+		},
+	}
+
+	actual = CrossJoin(NewTable("table2"))
+
+	testJoin_JoinEquality(t, expectation, actual)
+}
+
+func TestJoin_AsLateral(t *testing.T) {
+	var (
+		expectation *Join
+		actual      *Join
+	)
+
+	expectation = &Join{
+		Type:    LeftJoinType,
+		Table:   RawAs(NewRaw("select 1"), "t2"),
+		Lateral: true,
+	}
+
+	actual = LeftJoin(RawAs(NewRaw("select 1"), "t2")).AsLateral()
+
+	testJoin_JoinEquality(t, expectation, actual)
+}
+
+func TestJoin_AsUnconstrained(t *testing.T) {
+	var (
+		expectation *Join
+		actual      *Join
+	)
+
+	expectation = &Join{
+		Type:          InnerJoinType,
+		Table:         NewTable("table2"),
+		Unconstrained: true,
+	}
+
+	actual = InnerJoin(NewTable("table2")).AsUnconstrained()
+
+	testJoin_JoinEquality(t, expectation, actual)
+}
+
 func TestJoin_vaidate(t *testing.T) {
 	var testCases []struct {
 		Name        string
@@ -204,6 +267,30 @@ func TestJoin_vaidate(t *testing.T) {
 			},
 			Expectation: ErrFilterIsRequired,
 		},
+		{
+			Name:        "lateral table is not subquery",
+			Dialect:     DialectPostgres,
+			Join:        LeftJoin(NewTable("table2")).AsLateral(),
+			Expectation: ErrLateralTableIsInvalid,
+		},
+		{
+			Name:        "cross join with filter",
+			Dialect:     DialectPostgres,
+			Join:        CrossJoin(NewTable("table2")).On(FilterTrue()),
+			Expectation: ErrFilterIsNotAllowed,
+		},
+		{
+			Name:        "cross join without filter is valid",
+			Dialect:     DialectPostgres,
+			Join:        CrossJoin(NewTable("table2")),
+			Expectation: nil,
+		},
+		{
+			Name:        "lateral join without filter is valid",
+			Dialect:     DialectPostgres,
+			Join:        LeftJoin(RawAs(NewRaw("select 1"), "t2")).AsLateral(),
+			Expectation: nil,
+		},
 		{
 			Name:    "join is valid",
 			Dialect: DialectPostgres,
@@ -301,7 +388,7 @@ func TestJoin_ToSQLWithArgs(t *testing.T) {
 			}{
 				Query: "",
 				Args:  nil,
-				Err:   ErrNameIsRequired,
+				Err:   &PathError{Path: "Table", Err: ErrNameIsRequired},
 			},
 		},
 		{
@@ -324,7 +411,7 @@ func TestJoin_ToSQLWithArgs(t *testing.T) {
 			}{
 				Query: "",
 				Args:  nil,
-				Err:   ErrFiltersIsRequired,
+				Err:   &PathError{Path: "Filter", Err: ErrFiltersIsRequired},
 			},
 		},
 		{
@@ -362,6 +449,160 @@ func TestJoin_ToSQLWithArgs(t *testing.T) {
 				Err:   nil,
 			},
 		},
+		{
+			Name:    fmt.Sprintf("cross join with dialect %s", DialectMySQL),
+			Dialect: DialectMySQL,
+			Join:    CrossJoin(NewTable("table2")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "cross join table2",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("cross lateral join with dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			Join:    CrossJoin(RawAs(NewRaw("select id from table2 where table2.table1_id = table1.id limit ?", 3), "t2")).AsLateral(),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "cross join lateral (select id from table2 where table2.table1_id = table1.id limit $1) as t2",
+				Args:  []interface{}{3},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("left lateral join without filter with dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			Join:    LeftJoin(SelectAs(Select(NewField("id")).From(NewTable("table2")), "t2")).AsLateral(),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "left join lateral (select id from table2) as t2 on true",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    "lateral join filter is invalid",
+			Dialect: DialectPostgres,
+			Join:    LeftJoin(SelectAs(Select(NewField("id")).From(NewTable("table2")), "t2")).AsLateral().On(NewFilter()),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &PathError{Path: "Filter", Err: ErrFieldIsRequired},
+			},
+		},
+		{
+			Name:    "join filter is constant only",
+			Dialect: DialectPostgres,
+			Join:    InnerJoin(NewTable("roles")).On(NewFilter().SetCondition(NewField("role_id"), OperatorEqual, NewFilterValue(1))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrJoinFilterIsUnreferenced,
+			},
+		},
+		{
+			Name:    "join filter references other table only",
+			Dialect: DialectPostgres,
+			Join:    InnerJoin(NewTable("roles").As("r")).On(NewFilter().SetCondition(NewField("role_id").FromTable("u"), OperatorEqual, NewFilterValue(1))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrJoinFilterIsUnreferenced,
+			},
+		},
+		{
+			Name:    "join filter is true",
+			Dialect: DialectPostgres,
+			Join:    InnerJoin(NewTable("roles")).On(FilterTrue()),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrJoinFilterIsUnreferenced,
+			},
+		},
+		{
+			Name:    "unconstrained join filter is constant only",
+			Dialect: DialectPostgres,
+			Join:    InnerJoin(NewTable("roles")).On(NewFilter().SetCondition(NewField("role_id"), OperatorEqual, NewFilterValue(1))).AsUnconstrained(),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "inner join roles on role_id = $1",
+				Args:  []interface{}{1},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    "join filter references joined table in nested filter",
+			Dialect: DialectPostgres,
+			Join:    LeftJoin(NewTable("roles").As("r")).On(NewFilter().SetLogic(LogicAnd).AddFilter(NewField("active"), OperatorEqual, NewFilterValue(true)).AddFilter(NewField("id").FromTable("r"), OperatorEqual, NewColumnFilterValue("role_id").FromTable("u"))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "left join roles as r on active = $1 and r.id = u.role_id",
+				Args:  []interface{}{true},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    "join filter compares unqualified columns",
+			Dialect: DialectPostgres,
+			Join:    InnerJoin(NewTable("roles")).On(NewFilter().SetCondition(NewField("role_id"), OperatorEqual, NewColumnFilterValue("id"))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "inner join roles on role_id = id",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    "join filter is raw expression",
+			Dialect: DialectPostgres,
+			Join:    InnerJoin(NewTable("roles")).On(NewFilter().SetCondition(NewRawField(NewRaw("lower(roles.code)")), OperatorEqual, NewFilterValue("admin"))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "inner join roles on lower(roles.code) = $1",
+				Args:  []interface{}{"admin"},
+				Err:   nil,
+			},
+		},
 	}
 
 	for i := range testCases {
@@ -0,0 +1,70 @@
+package goqube
+
+import (
+	"strings"
+)
+
+func isValidJSONPath(path []string) bool {
+	for i := range path {
+		if path[i] == "" || strings.ContainsAny(path[i], `'"\`) || !isValidAlias(path[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isJSONPathIndex(key string) bool {
+	for _, r := range key {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func jsonPathExpression(dialect Dialect, column string, path []string) string {
+	var builder strings.Builder
+
+	if len(path) == 0 {
+		return column
+	}
+
+	switch dialect {
+	case DialectMySQL:
+		builder.WriteString("json_unquote(json_extract(")
+		builder.WriteString(column)
+		builder.WriteString(", '$")
+		for i := range path {
+			if isJSONPathIndex(path[i]) {
+				builder.WriteString("[" + path[i] + "]")
+				continue
+			}
+
+			builder.WriteString(`."` + path[i] + `"`)
+		}
+		builder.WriteString("'))")
+
+	case DialectPostgres:
+		builder.WriteString(column)
+		for i := range path {
+			builder.WriteString("->")
+			if i == len(path)-1 {
+				builder.WriteString(">")
+			}
+
+			if isJSONPathIndex(path[i]) {
+				builder.WriteString(path[i])
+				continue
+			}
+
+			builder.WriteString("'" + path[i] + "'")
+		}
+
+	default:
+		return column
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,207 @@
+package goqube
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestJSONPath_jsonPathExpression(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Column      string
+		Path        []string
+		Expectation string
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Column      string
+		Path        []string
+		Expectation string
+	}{
+		{
+			Name:        "path is empty",
+			Dialect:     DialectPostgres,
+			Column:      "payload",
+			Path:        nil,
+			Expectation: "payload",
+		},
+		{
+			Name:        "dialect is empty",
+			Dialect:     "",
+			Column:      "payload",
+			Path:        []string{"user"},
+			Expectation: "payload",
+		},
+		{
+			Name:        fmt.Sprintf("nested keys with dialect %s", DialectMySQL),
+			Dialect:     DialectMySQL,
+			Column:      "e.payload",
+			Path:        []string{"user", "id"},
+			Expectation: `json_unquote(json_extract(e.payload, '$."user"."id"'))`,
+		},
+		{
+			Name:        fmt.Sprintf("array index with dialect %s", DialectMySQL),
+			Dialect:     DialectMySQL,
+			Column:      "payload",
+			Path:        []string{"items", "0", "sku"},
+			Expectation: `json_unquote(json_extract(payload, '$."items"[0]."sku"'))`,
+		},
+		{
+			Name:        fmt.Sprintf("nested keys with dialect %s", DialectPostgres),
+			Dialect:     DialectPostgres,
+			Column:      "e.payload",
+			Path:        []string{"user", "id"},
+			Expectation: "e.payload->'user'->>'id'",
+		},
+		{
+			Name:        fmt.Sprintf("array index with dialect %s", DialectPostgres),
+			Dialect:     DialectPostgres,
+			Column:      "payload",
+			Path:        []string{"items", "0"},
+			Expectation: "payload->'items'->>0",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = jsonPathExpression(testCases[i].Dialect, testCases[i].Column, testCases[i].Path)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation expression is %s, got %s", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestJSONPath_ToSQLWithArgs(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		SelectQuery *SelectQuery
+		Dialect     Dialect
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		SelectQuery *SelectQuery
+		Dialect     Dialect
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:        "field path without column",
+			SelectQuery: Select(NewRawField(NewRaw("payload")).Path("user")).From(NewTable("events")),
+			Dialect:     DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &PathError{Path: "Fields[0]", Err: ErrJSONPathRequiresColumn},
+			},
+		},
+		{
+			Name:        "field path key is invalid",
+			SelectQuery: Select(NewField("payload").Path("user'); drop table events; --")).From(NewTable("events")),
+			Dialect:     DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &PathError{Path: "Fields[0]", Err: ErrJSONPathIsInvalid},
+			},
+		},
+		{
+			Name: "filter value path key is empty",
+			SelectQuery: Select(NewField("id")).
+				From(NewTable("events")).
+				Where(NewFilter().SetCondition(NewField("user_id"), OperatorEqual, NewColumnFilterValue("payload").Path("user", ""))),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &PathError{Path: "Filter", Err: ErrJSONPathIsInvalid},
+			},
+		},
+		{
+			Name: fmt.Sprintf("json path with dialect %s", DialectMySQL),
+			SelectQuery: Select(NewField("id"), NewField("payload").FromTable("e").Path("user", "id").As("user_id")).
+				From(NewTable("events").As("e")).
+				Where(NewFilter().SetCondition(NewField("payload").FromTable("e").Path("type"), OperatorEqual, NewFilterValue("signup"))),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: `select id, json_unquote(json_extract(e.payload, '$."user"."id"')) as user_id from events as e where json_unquote(json_extract(e.payload, '$."type"')) = ?`,
+				Args:  []interface{}{"signup"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("json path with dialect %s", DialectPostgres),
+			SelectQuery: Select(NewField("id"), NewField("payload").FromTable("e").Path("user", "id").As("user_id")).
+				From(NewTable("events").As("e")).
+				Join(InnerJoin(NewTable("users").As("u")).On(NewFilter().SetCondition(NewField("id").FromTable("u"), OperatorEqual, NewColumnFilterValue("payload").FromTable("e").Path("user", "id")))).
+				Where(NewFilter().SetCondition(NewField("payload").FromTable("e").Path("type"), OperatorEqual, NewFilterValue("signup"))),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id, e.payload->'user'->>'id' as user_id from events as e inner join users as u on u.id = e.payload->'user'->>'id' where e.payload->>'type' = $1",
+				Args:  []interface{}{"signup"},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].SelectQuery.ToSQLWithArgs(testCases[i].Dialect, []interface{}{})
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %+v, got %+v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package goqube
+
+import (
+	"fmt"
+	"reflect"
+)
+
+type Limits struct {
+	MaxJoins       int
+	MaxFilterDepth int
+	MaxInListSize  int
+	RequireLimit   bool
+}
+
+func NewLimits() *Limits {
+	return &Limits{}
+}
+
+func (l *Limits) SetMaxJoins(maxJoins int) *Limits {
+	l.MaxJoins = maxJoins
+	return l
+}
+
+func (l *Limits) SetMaxFilterDepth(maxFilterDepth int) *Limits {
+	l.MaxFilterDepth = maxFilterDepth
+	return l
+}
+
+func (l *Limits) SetMaxInListSize(maxInListSize int) *Limits {
+	l.MaxInListSize = maxInListSize
+	return l
+}
+
+func (l *Limits) SetRequireLimit(requireLimit bool) *Limits {
+	l.RequireLimit = requireLimit
+	return l
+}
+
+func (l *Limits) check(query Query) error {
+	switch q := query.(type) {
+	case *SelectQuery:
+		if l.RequireLimit && !q.TakeIsSet && q.Take == 0 {
+			return ErrLimitIsRequired
+		}
+
+		return l.checkSelectQuery(q)
+	case *CompoundQuery:
+		if l.RequireLimit && !q.TakeIsSet && q.Take == 0 {
+			return ErrLimitIsRequired
+		}
+
+		return l.checkCompoundQuery(q)
+	case *UpdateQuery:
+		return l.checkFilter(q.Filter, 1)
+	case *DeleteQuery:
+		return l.checkFilter(q.Filter, 1)
+	}
+
+	return nil
+}
+
+func (l *Limits) checkCompoundQuery(compoundQuery *CompoundQuery) error {
+	for i := range compoundQuery.Branches {
+		if compoundQuery.Branches[i] == nil {
+			continue
+		}
+
+		var err error
+
+		switch q := compoundQuery.Branches[i].Query.(type) {
+		case *SelectQuery:
+			err = l.checkSelectQuery(q)
+		case *CompoundQuery:
+			err = l.checkCompoundQuery(q)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (l *Limits) checkSelectQuery(selectQuery *SelectQuery) error {
+	var err error
+
+	if selectQuery == nil {
+		return nil
+	}
+
+	if l.MaxJoins > 0 && len(selectQuery.Joins) > l.MaxJoins {
+		return fmt.Errorf(errLimitExceededf, ErrJoinLimitIsExceeded, len(selectQuery.Joins), l.MaxJoins)
+	}
+
+	err = l.checkTable(selectQuery.Table)
+	if err != nil {
+		return err
+	}
+
+	for i := range selectQuery.Joins {
+		if selectQuery.Joins[i] == nil {
+			continue
+		}
+
+		err = l.checkTable(selectQuery.Joins[i].Table)
+		if err != nil {
+			return err
+		}
+
+		err = l.checkFilter(selectQuery.Joins[i].Filter, 1)
+		if err != nil {
+			return err
+		}
+	}
+
+	for i := range selectQuery.Fields {
+		if selectQuery.Fields[i] == nil {
+			continue
+		}
+
+		err = l.checkSelectQuery(selectQuery.Fields[i].SelectQuery)
+		if err != nil {
+			return err
+		}
+	}
+
+	return l.checkFilter(selectQuery.Filter, 1)
+}
+
+func (l *Limits) checkTable(table *Table) error {
+	if table == nil {
+		return nil
+	}
+
+	return l.checkSelectQuery(table.SelectQuery)
+}
+
+func (l *Limits) checkFilter(filter *Filter, depth int) error {
+	var err error
+
+	if filter == nil {
+		return nil
+	}
+
+	if l.MaxFilterDepth > 0 && depth > l.MaxFilterDepth {
+		return fmt.Errorf(errLimitExceededf, ErrFilterDepthLimitIsExceeded, depth, l.MaxFilterDepth)
+	}
+
+	if filter.Field != nil {
+		err = l.checkSelectQuery(filter.Field.SelectQuery)
+		if err != nil {
+			return err
+		}
+	}
+
+	if filter.Value != nil {
+		err = l.checkSelectQuery(filter.Value.SelectQuery)
+		if err != nil {
+			return err
+		}
+
+		if l.MaxInListSize > 0 && (filter.Operator == OperatorIn || filter.Operator == OperatorNotIn) && !filter.Value.isExpression() {
+			var reflectValue reflect.Value = reflect.ValueOf(filter.Value.Value)
+
+			if (reflectValue.Kind() == reflect.Slice || reflectValue.Kind() == reflect.Array) && reflectValue.Len() > l.MaxInListSize {
+				return fmt.Errorf(errLimitExceededf, ErrInListLimitIsExceeded, reflectValue.Len(), l.MaxInListSize)
+			}
+		}
+	}
+
+	for i := range filter.Filters {
+		err = l.checkFilter(filter.Filters[i], depth+1)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package goqube
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLimits_NewLimits(t *testing.T) {
+	var (
+		expectation *Limits = &Limits{MaxJoins: 2, MaxFilterDepth: 3, MaxInListSize: 100, RequireLimit: true}
+		actual      *Limits = NewLimits().SetMaxJoins(2).SetMaxFilterDepth(3).SetMaxInListSize(100).SetRequireLimit(true)
+	)
+
+	if !deepEqual(expectation, actual) {
+		t.Errorf("expectation limits is %+v, got %+v", expectation, actual)
+	}
+}
+
+func TestLimits_Build(t *testing.T) {
+	var (
+		joinUsers func() *Join
+		testCases []struct {
+			Name        string
+			Limits      *Limits
+			Query       Query
+			Expectation struct {
+				Query string
+				Err   error
+			}
+		}
+	)
+
+	joinUsers = func() *Join {
+		return InnerJoin(NewTable("users")).On(NewFilter().SetCondition(NewField("id").FromTable("users"), OperatorEqual, NewColumnFilterValue("user_id").FromTable("orders")))
+	}
+
+	testCases = []struct {
+		Name        string
+		Limits      *Limits
+		Query       Query
+		Expectation struct {
+			Query string
+			Err   error
+		}
+	}{
+		{
+			Name:   "select query within limits",
+			Limits: NewLimits().SetMaxJoins(1).SetMaxFilterDepth(2).SetMaxInListSize(3).SetRequireLimit(true),
+			Query: Select(NewField("id").FromTable("orders")).
+				From(NewTable("orders")).
+				Join(joinUsers()).
+				Where(NewFilter().SetLogic(LogicAnd).AddFilter(NewField("status").FromTable("orders"), OperatorIn, NewFilterValue([]string{"new", "paid", "sent"}))).
+				Limit(10),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "select orders.id from orders inner join users on users.id = orders.user_id where orders.status in (?, ?, ?) limit ?",
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "too many joins",
+			Limits: NewLimits().SetMaxJoins(1),
+			Query:  Select(NewField("id").FromTable("orders")).From(NewTable("orders")).Join(joinUsers()).Join(joinUsers()),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrJoinLimitIsExceeded,
+			},
+		},
+		{
+			Name:   "too many joins in subquery",
+			Limits: NewLimits().SetMaxJoins(1),
+			Query: Select(NewField("id")).
+				From(NewTable("orders")).
+				Where(NewFilter().SetCondition(NewField("user_id"), OperatorIn, NewSelectQueryFilterValue(Select(NewField("id").FromTable("orders")).From(NewTable("orders")).Join(joinUsers()).Join(joinUsers())))),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrJoinLimitIsExceeded,
+			},
+		},
+		{
+			Name:   "filter is too deep",
+			Limits: NewLimits().SetMaxFilterDepth(2),
+			Query: Delete().From("orders").Where(
+				NewFilter().SetLogic(LogicOr).AddFilters(
+					NewFilter().SetLogic(LogicAnd).AddFilter(NewField("id"), OperatorEqual, NewFilterValue(1)),
+				),
+			),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrFilterDepthLimitIsExceeded,
+			},
+		},
+		{
+			Name:   "in list is too large",
+			Limits: NewLimits().SetMaxInListSize(2),
+			Query:  Update("orders").Set("status", "sent").Where(NewFilter().SetCondition(NewField("id"), OperatorNotIn, NewFilterValue([]int{1, 2, 3}))),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrInListLimitIsExceeded,
+			},
+		},
+		{
+			Name:   "select query without limit",
+			Limits: NewLimits().SetRequireLimit(true),
+			Query:  Select(NewField("id")).From(NewTable("orders")),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrLimitIsRequired,
+			},
+		},
+		{
+			Name:   "compound query without limit",
+			Limits: NewLimits().SetRequireLimit(true),
+			Query:  Union(Select(NewField("id")).From(NewTable("orders")), Select(NewField("id")).From(NewTable("archived_orders"))),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrLimitIsRequired,
+			},
+		},
+		{
+			Name:   "insert query is not limited",
+			Limits: NewLimits().SetRequireLimit(true),
+			Query:  InsertInto("orders").Value("status", "new"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "insert into orders(status) values (?)",
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualErr   error
+			)
+
+			actualQuery, _, actualErr = NewConfig(DialectMySQL).SetLimits(testCases[i].Limits).Build(testCases[i].Query)
+
+			if !errors.Is(actualErr, testCases[i].Expectation.Err) {
+				t.Errorf("expectation error is %v, got %v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+		})
+	}
+
+	t.Run("error message", func(t *testing.T) {
+		var actualErr error
+
+		_, _, actualErr = NewBuilder(DialectPostgres, WithLimits(NewLimits().SetMaxInListSize(2))).
+			Build(Select(NewField("id")).From(NewTable("orders")).Where(NewFilter().SetCondition(NewField("id"), OperatorIn, NewFilterValue([]int{1, 2, 3}))))
+
+		if actualErr == nil || actualErr.Error() != "in list limit is exceeded: 3 exceeds maximum 2" {
+			t.Errorf("expectation error is %s, got %v", "in list limit is exceeded: 3 exceeds maximum 2", actualErr)
+		}
+	})
+}
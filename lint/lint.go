@@ -0,0 +1,362 @@
+package lint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fikri240794/goqube"
+)
+
+const (
+	RuleExplicitFields       string = "explicit_fields"
+	RuleIndexedFilterColumns string = "indexed_filter_columns"
+	RuleKnownColumns         string = "known_columns"
+	RuleNoLeadingWildcard    string = "no_leading_wildcard"
+	RulePaginationRequired   string = "pagination_required"
+)
+
+const (
+	msgLeadingWildcardf   string = "leading wildcard %s on large table %s column %s"
+	msgNotIndexedf        string = "filter column %s.%s is not indexed"
+	msgNotInSchemaf       string = "column %s.%s is not in schema"
+	msgPaginationRequired string = "select query requires limit"
+	msgSelectAllFieldsf   string = "select query uses %s instead of explicit fields"
+	msgViolationf         string = "%s: %s"
+	selectAllFieldsColumn string = "*"
+)
+
+type Violation struct {
+	Rule    string
+	Message string
+}
+
+func (v *Violation) Error() string {
+	return fmt.Sprintf(msgViolationf, v.Rule, v.Message)
+}
+
+type Rule struct {
+	Name  string
+	Check func(query goqube.Query) []string
+}
+
+type Linter struct {
+	Rules []*Rule
+}
+
+func NewLinter(rules ...*Rule) *Linter {
+	return &Linter{
+		Rules: rules,
+	}
+}
+
+func (l *Linter) AddRules(rules ...*Rule) *Linter {
+	l.Rules = append(l.Rules, rules...)
+	return l
+}
+
+func (l *Linter) Lint(query goqube.Query) []*Violation {
+	var violations []*Violation = []*Violation{}
+
+	for i := range l.Rules {
+		if l.Rules[i] == nil || l.Rules[i].Check == nil {
+			continue
+		}
+
+		var messages []string = l.Rules[i].Check(query)
+		for j := range messages {
+			violations = append(violations, &Violation{Rule: l.Rules[i].Name, Message: messages[j]})
+		}
+	}
+
+	return violations
+}
+
+func ExplicitFields() *Rule {
+	return &Rule{
+		Name: RuleExplicitFields,
+		Check: func(query goqube.Query) []string {
+			var (
+				selectQuery *goqube.SelectQuery
+				ok          bool
+				messages    []string
+			)
+
+			selectQuery, ok = query.(*goqube.SelectQuery)
+			if !ok {
+				return nil
+			}
+
+			for i := range selectQuery.Fields {
+				if selectQuery.Fields[i] == nil || selectQuery.Fields[i].Column != selectAllFieldsColumn {
+					continue
+				}
+
+				var field string = selectAllFieldsColumn
+				if selectQuery.Fields[i].Table != "" {
+					field = fmt.Sprintf("%s.%s", selectQuery.Fields[i].Table, selectAllFieldsColumn)
+				}
+
+				messages = append(messages, fmt.Sprintf(msgSelectAllFieldsf, field))
+			}
+
+			return messages
+		},
+	}
+}
+
+func PaginationRequired() *Rule {
+	return &Rule{
+		Name: RulePaginationRequired,
+		Check: func(query goqube.Query) []string {
+			var (
+				selectQuery *goqube.SelectQuery
+				ok          bool
+			)
+
+			selectQuery, ok = query.(*goqube.SelectQuery)
+			if !ok || selectQuery.Take > 0 || selectQuery.TakeIsSet {
+				return nil
+			}
+
+			return []string{msgPaginationRequired}
+		},
+	}
+}
+
+func NoLeadingWildcard(largeTables ...string) *Rule {
+	return &Rule{
+		Name: RuleNoLeadingWildcard,
+		Check: func(query goqube.Query) []string {
+			var (
+				tables   map[string]string
+				messages []string
+			)
+
+			tables = queryTables(query)
+			walkConditions(queryFilter(query), func(filter *goqube.Filter) {
+				var table string
+
+				if filter.Operator != goqube.OperatorLike && filter.Operator != goqube.OperatorNotLike &&
+					filter.Operator != goqube.OperatorEndsWith && filter.Operator != goqube.OperatorContains &&
+					!hasLeadingWildcardPattern(filter) {
+					return
+				}
+
+				table = resolveTable(tables, filter.Field)
+				if !containsString(largeTables, table) {
+					return
+				}
+
+				messages = append(messages, fmt.Sprintf(msgLeadingWildcardf, filter.Operator, table, filter.Field.Column))
+			})
+
+			return messages
+		},
+	}
+}
+
+func IndexedFilterColumns(indexes map[string][]string) *Rule {
+	return &Rule{
+		Name: RuleIndexedFilterColumns,
+		Check: func(query goqube.Query) []string {
+			var (
+				tables   map[string]string
+				messages []string
+			)
+
+			tables = queryTables(query)
+			walkConditions(queryFilter(query), func(filter *goqube.Filter) {
+				var (
+					table   string
+					columns []string
+					ok      bool
+				)
+
+				if filter.Field.SelectQuery != nil {
+					return
+				}
+
+				table = resolveTable(tables, filter.Field)
+				columns, ok = indexes[table]
+				if !ok || containsString(columns, filter.Field.Column) {
+					return
+				}
+
+				messages = append(messages, fmt.Sprintf(msgNotIndexedf, table, filter.Field.Column))
+			})
+
+			return messages
+		},
+	}
+}
+
+func KnownColumns(schema *goqube.Schema) *Rule {
+	return &Rule{
+		Name: RuleKnownColumns,
+		Check: func(query goqube.Query) []string {
+			var (
+				tables   map[string]string
+				columns  []string
+				messages []string
+				check    func(table, column string)
+			)
+
+			if schema == nil {
+				return nil
+			}
+
+			tables = queryTables(query)
+			check = func(table, column string) {
+				if schema.Tables[table] == nil || schema.Column(table, column) != nil {
+					return
+				}
+
+				messages = append(messages, fmt.Sprintf(msgNotInSchemaf, table, column))
+			}
+
+			switch q := query.(type) {
+			case *goqube.SelectQuery:
+				for i := range q.Fields {
+					if q.Fields[i] == nil || q.Fields[i].Column == "" || q.Fields[i].Column == selectAllFieldsColumn {
+						continue
+					}
+
+					check(resolveTable(tables, q.Fields[i]), q.Fields[i].Column)
+				}
+			case *goqube.InsertQuery:
+				for column := range q.FieldsValues {
+					columns = append(columns, column)
+				}
+
+				sort.Strings(columns)
+				for i := range columns {
+					check(q.Table, columns[i])
+				}
+			case *goqube.UpdateQuery:
+				for column := range q.FieldsValue {
+					columns = append(columns, column)
+				}
+
+				sort.Strings(columns)
+				for i := range columns {
+					check(q.Table, columns[i])
+				}
+			}
+
+			walkConditions(queryFilter(query), func(filter *goqube.Filter) {
+				if filter.Field.Column == "" {
+					return
+				}
+
+				check(resolveTable(tables, filter.Field), filter.Field.Column)
+			})
+
+			return messages
+		},
+	}
+}
+
+func queryFilter(query goqube.Query) *goqube.Filter {
+	switch q := query.(type) {
+	case *goqube.SelectQuery:
+		return q.Filter
+	case *goqube.UpdateQuery:
+		return q.Filter
+	case *goqube.DeleteQuery:
+		return q.Filter
+	}
+
+	return nil
+}
+
+func queryTables(query goqube.Query) map[string]string {
+	var tables map[string]string = map[string]string{}
+
+	switch q := query.(type) {
+	case *goqube.SelectQuery:
+		addTable(tables, q.Table, true)
+		for i := range q.Joins {
+			if q.Joins[i] != nil {
+				addTable(tables, q.Joins[i].Table, false)
+			}
+		}
+	case *goqube.UpdateQuery:
+		tables[""] = q.Table
+		tables[q.Table] = q.Table
+	case *goqube.DeleteQuery:
+		tables[""] = q.Table
+		tables[q.Table] = q.Table
+	}
+
+	return tables
+}
+
+func addTable(tables map[string]string, table *goqube.Table, isMain bool) {
+	if table == nil || table.Name == "" {
+		return
+	}
+
+	tables[table.Name] = table.Name
+	if table.Alias != "" {
+		tables[table.Alias] = table.Name
+	}
+
+	if isMain {
+		tables[""] = table.Name
+	}
+}
+
+func resolveTable(tables map[string]string, field *goqube.Field) string {
+	var (
+		table string
+		ok    bool
+	)
+
+	table, ok = tables[field.Table]
+	if !ok {
+		return field.Table
+	}
+
+	return table
+}
+
+func walkConditions(filter *goqube.Filter, visit func(filter *goqube.Filter)) {
+	if filter == nil {
+		return
+	}
+
+	if filter.Operator != "" && filter.Field != nil {
+		visit(filter)
+	}
+
+	for i := range filter.Filters {
+		walkConditions(filter.Filters[i], visit)
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for i := range values {
+		if values[i] == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasLeadingWildcardPattern(filter *goqube.Filter) bool {
+	var pattern string
+
+	if filter.Operator != goqube.OperatorLikePattern && filter.Operator != goqube.OperatorNotLikePattern {
+		return false
+	}
+
+	if filter.Value == nil {
+		return false
+	}
+
+	pattern, _ = filter.Value.Value.(string)
+
+	return strings.HasPrefix(pattern, "%") || strings.HasPrefix(pattern, "_")
+}
@@ -0,0 +1,225 @@
+package lint
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/fikri240794/goqube"
+)
+
+func TestViolation_Error(t *testing.T) {
+	var (
+		violation *Violation
+		actual    string
+	)
+
+	violation = &Violation{Rule: RulePaginationRequired, Message: msgPaginationRequired}
+	actual = violation.Error()
+
+	if actual != "pagination_required: select query requires limit" {
+		t.Errorf("expectation error is %s, got %s", "pagination_required: select query requires limit", actual)
+	}
+}
+
+func TestLinter_Lint(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Linter      *Linter
+		Query       goqube.Query
+		Expectation []*Violation
+	} = []struct {
+		Name        string
+		Linter      *Linter
+		Query       goqube.Query
+		Expectation []*Violation
+	}{
+		{
+			Name:        "linter without rules",
+			Linter:      NewLinter(),
+			Query:       goqube.Select(goqube.NewField("*")).From(goqube.NewTable("users")),
+			Expectation: []*Violation{},
+		},
+		{
+			Name:        "nil rule and rule without check are skipped",
+			Linter:      NewLinter(nil, &Rule{Name: "empty"}),
+			Query:       goqube.Select(goqube.NewField("*")).From(goqube.NewTable("users")),
+			Expectation: []*Violation{},
+		},
+		{
+			Name:   "select query uses all fields",
+			Linter: NewLinter(ExplicitFields()),
+			Query: goqube.Select(goqube.NewField("*"), goqube.NewField("*").FromTable("u"), goqube.NewField("id")).
+				From(goqube.NewTable("users").As("u")),
+			Expectation: []*Violation{
+				{Rule: RuleExplicitFields, Message: "select query uses * instead of explicit fields"},
+				{Rule: RuleExplicitFields, Message: "select query uses u.* instead of explicit fields"},
+			},
+		},
+		{
+			Name:        "explicit fields ignores non select query",
+			Linter:      NewLinter(ExplicitFields()),
+			Query:       goqube.Delete().From("users"),
+			Expectation: []*Violation{},
+		},
+		{
+			Name:   "select query without limit",
+			Linter: NewLinter(PaginationRequired()),
+			Query:  goqube.Select(goqube.NewField("id")).From(goqube.NewTable("users")).Offset(10),
+			Expectation: []*Violation{
+				{Rule: RulePaginationRequired, Message: msgPaginationRequired},
+			},
+		},
+		{
+			Name:        "select query with limit",
+			Linter:      NewLinter(PaginationRequired()),
+			Query:       goqube.Select(goqube.NewField("id")).From(goqube.NewTable("users")).Limit(10),
+			Expectation: []*Violation{},
+		},
+		{
+			Name:        "select query with limit zero",
+			Linter:      NewLinter(PaginationRequired()),
+			Query:       goqube.Select(goqube.NewField("id")).From(goqube.NewTable("users")).Limit(0),
+			Expectation: []*Violation{},
+		},
+		{
+			Name:   "like on large table through alias and join",
+			Linter: NewLinter(NoLeadingWildcard("users")),
+			Query: goqube.Select(goqube.NewField("id")).
+				From(goqube.NewTable("roles")).
+				Join(goqube.InnerJoin(goqube.NewTable("users").As("u")).On(goqube.NewFilter().SetCondition(goqube.NewField("role_id").FromTable("u"), goqube.OperatorEqual, goqube.NewColumnFilterValue("id").FromTable("roles")))).
+				Where(
+					goqube.NewFilter().
+						SetLogic(goqube.LogicAnd).
+						AddFilter(goqube.NewField("name").FromTable("u"), goqube.OperatorLike, goqube.NewFilterValue("john")).
+						AddFilter(goqube.NewField("name"), goqube.OperatorNotLike, goqube.NewFilterValue("admin")),
+				),
+			Expectation: []*Violation{
+				{Rule: RuleNoLeadingWildcard, Message: "leading wildcard like on large table users column name"},
+			},
+		},
+		{
+			Name:   "like on large table in delete query",
+			Linter: NewLinter(NoLeadingWildcard("users")),
+			Query: goqube.Delete().
+				From("users").
+				Where(goqube.NewFilter().SetCondition(goqube.NewField("name"), goqube.OperatorNotLike, goqube.NewFilterValue("john"))),
+			Expectation: []*Violation{
+				{Rule: RuleNoLeadingWildcard, Message: "leading wildcard not_like on large table users column name"},
+			},
+		},
+		{
+			Name:   "pattern operators on large table",
+			Linter: NewLinter(NoLeadingWildcard("users")),
+			Query: goqube.Select(goqube.NewField("id")).
+				From(goqube.NewTable("users")).
+				Where(
+					goqube.NewFilter().
+						SetLogic(goqube.LogicAnd).
+						AddFilter(goqube.NewField("name"), goqube.OperatorStartsWith, goqube.NewFilterValue("jo")).
+						AddFilter(goqube.NewField("email"), goqube.OperatorEndsWith, goqube.NewFilterValue("@mail.com")).
+						AddFilter(goqube.NewField("bio"), goqube.OperatorContains, goqube.NewFilterValue("go")),
+				),
+			Expectation: []*Violation{
+				{Rule: RuleNoLeadingWildcard, Message: "leading wildcard ends_with on large table users column email"},
+				{Rule: RuleNoLeadingWildcard, Message: "leading wildcard contains on large table users column bio"},
+			},
+		},
+		{
+			Name:   "like pattern operators on large table",
+			Linter: NewLinter(NoLeadingWildcard("users")),
+			Query: goqube.Select(goqube.NewField("id")).
+				From(goqube.NewTable("users")).
+				Where(goqube.And(
+					goqube.LikePattern("name", "Jo%"),
+					goqube.LikePattern("email", "%@mail.com"),
+					goqube.NotLikePattern("bio", "_o%"),
+				)),
+			Expectation: []*Violation{
+				{Rule: RuleNoLeadingWildcard, Message: "leading wildcard like_pattern on large table users column email"},
+				{Rule: RuleNoLeadingWildcard, Message: "leading wildcard not_like_pattern on large table users column bio"},
+			},
+		},
+		{
+			Name: "filter columns are not indexed",
+			Linter: NewLinter(IndexedFilterColumns(map[string][]string{
+				"users": {"id", "email"},
+			})),
+			Query: goqube.Update("users").
+				Set("name", "john").
+				Where(
+					goqube.NewFilter().
+						SetLogic(goqube.LogicOr).
+						AddFilter(goqube.NewField("email"), goqube.OperatorEqual, goqube.NewFilterValue("john@mail.com")).
+						AddFilter(goqube.NewField("name"), goqube.OperatorEqual, goqube.NewFilterValue("john")).
+						AddFilter(goqube.NewField("id").FromTable("roles"), goqube.OperatorEqual, goqube.NewFilterValue(1)),
+				),
+			Expectation: []*Violation{
+				{Rule: RuleIndexedFilterColumns, Message: "filter column users.name is not indexed"},
+			},
+		},
+		{
+			Name: "columns are not in schema",
+			Linter: NewLinter(KnownColumns(goqube.NewSchema().AddTables(
+				goqube.NewSchemaTable("users").AddColumns(goqube.NewSchemaColumn("id"), goqube.NewSchemaColumn("email"), goqube.NewSchemaColumn("role_id")),
+			))),
+			Query: goqube.Select(goqube.NewField("id").FromTable("u"), goqube.NewField("emial").FromTable("u"), goqube.NewField("*"), goqube.NewField("name").FromTable("r")).
+				From(goqube.NewTable("users").As("u")).
+				Join(goqube.InnerJoin(goqube.NewTable("roles").As("r")).On(goqube.NewFilter().SetCondition(goqube.NewField("id").FromTable("r"), goqube.OperatorEqual, goqube.NewColumnFilterValue("role_id").FromTable("u")))).
+				Where(goqube.NewFilter().SetCondition(goqube.NewField("rol_id").FromTable("u"), goqube.OperatorEqual, goqube.NewFilterValue(1))),
+			Expectation: []*Violation{
+				{Rule: RuleKnownColumns, Message: "column users.emial is not in schema"},
+				{Rule: RuleKnownColumns, Message: "column users.rol_id is not in schema"},
+			},
+		},
+		{
+			Name:   "update columns are not in schema",
+			Linter: NewLinter(KnownColumns(goqube.NewSchema().AddTables(goqube.NewSchemaTable("users").AddColumns(goqube.NewSchemaColumn("id"), goqube.NewSchemaColumn("name"))))),
+			Query: goqube.Update("users").
+				Set("nmae", "john").
+				Set("name", "john").
+				Where(goqube.NewFilter().SetCondition(goqube.NewField("uid"), goqube.OperatorEqual, goqube.NewFilterValue(1))),
+			Expectation: []*Violation{
+				{Rule: RuleKnownColumns, Message: "column users.nmae is not in schema"},
+				{Rule: RuleKnownColumns, Message: "column users.uid is not in schema"},
+			},
+		},
+		{
+			Name:   "insert columns are not in schema",
+			Linter: NewLinter(KnownColumns(goqube.NewSchema().AddTables(goqube.NewSchemaTable("users").AddColumns(goqube.NewSchemaColumn("id"), goqube.NewSchemaColumn("name"))))),
+			Query:  goqube.InsertInto("users").Value("name", "john").Value("emial", "john@mail.com"),
+			Expectation: []*Violation{
+				{Rule: RuleKnownColumns, Message: "column users.emial is not in schema"},
+			},
+		},
+		{
+			Name:        "known columns without schema",
+			Linter:      NewLinter(KnownColumns(nil)),
+			Query:       goqube.Select(goqube.NewField("id")).From(goqube.NewTable("users")),
+			Expectation: []*Violation{},
+		},
+		{
+			Name: "all rules",
+			Linter: NewLinter(ExplicitFields(), PaginationRequired()).
+				AddRules(NoLeadingWildcard("users"), IndexedFilterColumns(map[string][]string{"users": {"id"}})),
+			Query: goqube.Select(goqube.NewField("*")).
+				From(goqube.NewTable("users")).
+				Where(goqube.NewFilter().SetCondition(goqube.NewField("name"), goqube.OperatorLike, goqube.NewFilterValue("john"))),
+			Expectation: []*Violation{
+				{Rule: RuleExplicitFields, Message: "select query uses * instead of explicit fields"},
+				{Rule: RulePaginationRequired, Message: msgPaginationRequired},
+				{Rule: RuleNoLeadingWildcard, Message: "leading wildcard like on large table users column name"},
+				{Rule: RuleIndexedFilterColumns, Message: "filter column users.name is not indexed"},
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual []*Violation = testCases[i].Linter.Lint(testCases[i].Query)
+
+			if !reflect.DeepEqual(testCases[i].Expectation, actual) {
+				t.Errorf("expectation violations is %+v, got %+v", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
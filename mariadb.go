@@ -0,0 +1,125 @@
+package goqube
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type sequenceValue struct {
+	sequence string
+}
+
+func NextValue(sequence string) interface{} {
+	return sequenceValue{sequence: sequence}
+}
+
+func (c *Config) SetServerVersion(version string) *Config {
+	c.ServerVersion = version
+	return c
+}
+
+func (c *Config) isMariaDB() bool {
+	if c.Dialect != DialectMySQL {
+		return false
+	}
+
+	return c.Variant == VariantMariaDB || strings.Contains(strings.ToLower(c.ServerVersion), "mariadb")
+}
+
+func (c *Config) supportsRowAlias() bool {
+	if c.Dialect != DialectMySQL || c.ServerVersion == "" || c.isMariaDB() {
+		return false
+	}
+
+	return compareVersion(c.ServerVersion, mysqlRowAliasVersion) >= 0
+}
+
+func (bc *buildContext) insertedValueSQL(column string) string {
+	if bc.config.supportsRowAlias() {
+		return fmt.Sprintf(mysqlRowAliasValuef, mysqlRowAlias, column)
+	}
+
+	return fmt.Sprintf(mysqlInsertedValuef, column)
+}
+
+func (bc *buildContext) onDuplicateKeyUpdateSQL(assignments []string) string {
+	if bc.config.supportsRowAlias() {
+		return fmt.Sprintf(mysqlRowAliasDuplicateKeyf, mysqlRowAlias, strings.Join(assignments, ", "))
+	}
+
+	return fmt.Sprintf(mysqlOnDuplicateKeyUpdatef, strings.Join(assignments, ", "))
+}
+
+func parseVersion(version string) []int {
+	var parts []int
+
+	for _, part := range strings.Split(version, ".") {
+		var (
+			end    int
+			number int
+		)
+
+		for end < len(part) && part[end] >= '0' && part[end] <= '9' {
+			end++
+		}
+
+		if end == 0 {
+			break
+		}
+
+		number, _ = strconv.Atoi(part[:end])
+		parts = append(parts, number)
+
+		if end < len(part) {
+			break
+		}
+	}
+
+	return parts
+}
+
+func compareVersion(version1, version2 string) int {
+	var (
+		parts1 []int = parseVersion(version1)
+		parts2 []int = parseVersion(version2)
+	)
+
+	for i := 0; i < len(parts1) || i < len(parts2); i++ {
+		var part1, part2 int
+
+		if i < len(parts1) {
+			part1 = parts1[i]
+		}
+
+		if i < len(parts2) {
+			part2 = parts2[i]
+		}
+
+		if part1 != part2 {
+			if part1 < part2 {
+				return -1
+			}
+
+			return 1
+		}
+	}
+
+	return 0
+}
+
+func (bc *buildContext) nextValueSQL(sequence string) (string, error) {
+	if !isValidFunctionName(sequence) {
+		return "", ErrSequenceIsInvalid
+	}
+
+	switch {
+	case bc.dialect == DialectPostgres:
+		return fmt.Sprintf(postgresNextValuef, sequence), nil
+
+	case bc.config.isMariaDB():
+		return fmt.Sprintf(mariaDBNextValuef, sequence), nil
+	}
+
+	return "", ErrUnsupportedSequence
+}
@@ -0,0 +1,518 @@
+package goqube
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMariaDB_NextValue(t *testing.T) {
+	var actual interface{} = NextValue("order_seq")
+
+	if actual != (sequenceValue{sequence: "order_seq"}) {
+		t.Errorf("expectation next value is %+v, got %+v", sequenceValue{sequence: "order_seq"}, actual)
+	}
+}
+
+func TestConfig_SetServerVersion(t *testing.T) {
+	var actual *Config = NewConfig(DialectMySQL).SetServerVersion("10.11.6-MariaDB")
+
+	if actual.ServerVersion != "10.11.6-MariaDB" {
+		t.Errorf("expectation server version is %s, got %s", "10.11.6-MariaDB", actual.ServerVersion)
+	}
+}
+
+func TestMariaDB_compareVersion(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Version1    string
+		Version2    string
+		Expectation int
+	} = []struct {
+		Name        string
+		Version1    string
+		Version2    string
+		Expectation int
+	}{
+		{
+			Name:        "versions are equal",
+			Version1:    "8.0.19",
+			Version2:    "8.0.19",
+			Expectation: 0,
+		},
+		{
+			Name:        "version has suffix",
+			Version1:    "8.0.36-log",
+			Version2:    "8.0.19",
+			Expectation: 1,
+		},
+		{
+			Name:        "version is lower",
+			Version1:    "5.7.44",
+			Version2:    "8.0.19",
+			Expectation: -1,
+		},
+		{
+			Name:        "version has fewer parts",
+			Version1:    "8.0",
+			Version2:    "8.0.19",
+			Expectation: -1,
+		},
+		{
+			Name:        "version is empty",
+			Version1:    "",
+			Version2:    "8.0.19",
+			Expectation: -1,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual int = compareVersion(testCases[i].Version1, testCases[i].Version2)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation comparison is %d, got %d", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestMariaDB_Config(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Config      *Config
+		Expectation struct {
+			MariaDB  bool
+			RowAlias bool
+		}
+	} = []struct {
+		Name        string
+		Config      *Config
+		Expectation struct {
+			MariaDB  bool
+			RowAlias bool
+		}
+	}{
+		{
+			Name:   "mysql without server version",
+			Config: NewConfig(DialectMySQL),
+			Expectation: struct {
+				MariaDB  bool
+				RowAlias bool
+			}{
+				MariaDB:  false,
+				RowAlias: false,
+			},
+		},
+		{
+			Name:   "mysql with server version before row alias",
+			Config: NewConfig(DialectMySQL).SetServerVersion("8.0.18"),
+			Expectation: struct {
+				MariaDB  bool
+				RowAlias bool
+			}{
+				MariaDB:  false,
+				RowAlias: false,
+			},
+		},
+		{
+			Name:   "mysql with server version supporting row alias",
+			Config: NewConfig(DialectMySQL).SetServerVersion("8.4.0"),
+			Expectation: struct {
+				MariaDB  bool
+				RowAlias bool
+			}{
+				MariaDB:  false,
+				RowAlias: true,
+			},
+		},
+		{
+			Name:   "mariadb variant",
+			Config: NewConfig(DialectMySQL).SetVariant(VariantMariaDB).SetServerVersion("10.11.6"),
+			Expectation: struct {
+				MariaDB  bool
+				RowAlias bool
+			}{
+				MariaDB:  true,
+				RowAlias: false,
+			},
+		},
+		{
+			Name:   "mariadb detected from server version",
+			Config: NewConfig(DialectMySQL).SetServerVersion("10.11.6-MariaDB-log"),
+			Expectation: struct {
+				MariaDB  bool
+				RowAlias bool
+			}{
+				MariaDB:  true,
+				RowAlias: false,
+			},
+		},
+		{
+			Name:   "postgres with mariadb server version",
+			Config: NewConfig(DialectPostgres).SetServerVersion("10.11.6-MariaDB"),
+			Expectation: struct {
+				MariaDB  bool
+				RowAlias bool
+			}{
+				MariaDB:  false,
+				RowAlias: false,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			if testCases[i].Expectation.MariaDB != testCases[i].Config.isMariaDB() {
+				t.Errorf("expectation mariadb is %t, got %t", testCases[i].Expectation.MariaDB, testCases[i].Config.isMariaDB())
+			}
+
+			if testCases[i].Expectation.RowAlias != testCases[i].Config.supportsRowAlias() {
+				t.Errorf("expectation row alias is %t, got %t", testCases[i].Expectation.RowAlias, testCases[i].Config.supportsRowAlias())
+			}
+		})
+	}
+}
+
+func TestMariaDB_Build(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Config      *Config
+		Query       Query
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Config      *Config
+		Query       Query
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:   "variant is invalid for dialect",
+			Config: NewConfig(DialectPostgres).SetVariant(VariantMariaDB),
+			Query:  Select(NewField("id")).From(NewTable("users")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrVariantIsInvalid,
+			},
+		},
+		{
+			Name:   "insert returning with mariadb",
+			Config: NewConfig(DialectMySQL).SetVariant(VariantMariaDB),
+			Query:  InsertInto("orders").Columns("id", "status").Values(NextValue("order_seq"), "new").Returning(NewField("id"), NewField("created_at")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into orders(id, status) values (next value for order_seq, ?) returning id, created_at",
+				Args:  []interface{}{"new"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "delete returning with mariadb detected from server version",
+			Config: NewConfig(DialectMySQL).SetServerVersion("11.4.2-MariaDB"),
+			Query:  DeleteFrom("sessions").Where(Eq("user_id", 1)).Returning(NewField("id")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "delete from sessions where user_id = ? returning id",
+				Args:  []interface{}{1},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "update returning with mariadb",
+			Config: NewConfig(DialectMySQL).SetVariant(VariantMariaDB),
+			Query:  Update("users").Set("status", "inactive").Where(Eq("id", 1)).Returning(NewField("id")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrUnsupportedReturning,
+			},
+		},
+		{
+			Name:   "insert returning with mysql",
+			Config: NewConfig(DialectMySQL),
+			Query:  InsertInto("orders").Columns("status").Values("new").Returning(NewField("id")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrUnsupportedReturning,
+			},
+		},
+		{
+			Name:   "insert returning nil field with mariadb",
+			Config: NewConfig(DialectMySQL).SetVariant(VariantMariaDB),
+			Query:  InsertInto("orders").Columns("status").Values("new").Returning(nil),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrFieldIsNil,
+			},
+		},
+		{
+			Name:   "insert next value with postgres",
+			Config: NewConfig(DialectPostgres),
+			Query:  InsertInto("orders").Columns("id", "status").Values(NextValue("public.order_seq"), "new"),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into orders(id, status) values (nextval('public.order_seq'), $1)",
+				Args:  []interface{}{"new"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "insert next value with strict args",
+			Config: NewConfig(DialectPostgres).SetStrictArgs(true),
+			Query:  InsertInto("orders").Columns("id").Values(NextValue("order_seq")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into orders(id) values (nextval('order_seq'))",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "insert next value with mysql",
+			Config: NewConfig(DialectMySQL),
+			Query:  InsertInto("orders").Columns("id").Values(NextValue("order_seq")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrUnsupportedSequence,
+			},
+		},
+		{
+			Name:   "insert next value with invalid sequence",
+			Config: NewConfig(DialectPostgres),
+			Query:  InsertInto("orders").Columns("id").Values(NextValue("order_seq'); drop table orders; --")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrSequenceIsInvalid,
+			},
+		},
+		{
+			Name:   "insert on duplicate key update with mysql before row alias",
+			Config: NewConfig(DialectMySQL).SetServerVersion("8.0.18"),
+			Query:  InsertInto("countries").Columns("code", "name").Values("ID", "Indonesia").OnDuplicateKeyUpdate("name"),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into countries(code, name) values (?, ?) on duplicate key update name = values(name)",
+				Args:  []interface{}{"ID", "Indonesia"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "insert on duplicate key update with mysql row alias",
+			Config: NewConfig(DialectMySQL).SetServerVersion("8.0.19"),
+			Query:  InsertInto("countries").Columns("code", "name").Values("ID", "Indonesia").OnDuplicateKeyUpdate("name"),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into countries(code, name) values (?, ?) as new on duplicate key update name = new.name",
+				Args:  []interface{}{"ID", "Indonesia"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "insert on duplicate key update with mariadb",
+			Config: NewConfig(DialectMySQL).SetServerVersion("11.4.2-MariaDB"),
+			Query:  InsertInto("countries").Columns("code", "name").Values("ID", "Indonesia").OnDuplicateKeyUpdate("name"),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into countries(code, name) values (?, ?) on duplicate key update name = values(name)",
+				Args:  []interface{}{"ID", "Indonesia"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "insert on duplicate key update with postgres",
+			Config: NewConfig(DialectPostgres),
+			Query:  InsertInto("countries").Columns("code", "name").Values("ID", "Indonesia").OnDuplicateKeyUpdate("name"),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrUnsupportedOnDuplicateKeyUpdate,
+			},
+		},
+		{
+			Name:   "insert on duplicate key update field is not in columns",
+			Config: NewConfig(DialectMySQL),
+			Query:  InsertInto("countries").Columns("code", "name").Values("ID", "Indonesia").OnDuplicateKeyUpdate("population"),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrFieldIsNotInColumns,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Config.Build(testCases[i].Query)
+
+			if !errors.Is(actualErr, testCases[i].Expectation.Err) {
+				t.Errorf("expectation error is %v, got %v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %+v, got %+v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
+
+func TestMariaDB_ReferenceSyncStatements(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Config      *Config
+		Expectation struct {
+			Query string
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Config      *Config
+		Expectation struct {
+			Query string
+			Err   error
+		}
+	}{
+		{
+			Name:   "mariadb uses values function",
+			Config: NewConfig(DialectMySQL).SetVariant(VariantMariaDB).SetServerVersion("10.11.6"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "insert into countries(code, name) values (?, ?) on duplicate key update name = values(name)",
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "mysql before row alias uses values function",
+			Config: NewConfig(DialectMySQL).SetServerVersion("5.7.44"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "insert into countries(code, name) values (?, ?) on duplicate key update name = values(name)",
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "mysql with row alias",
+			Config: NewConfig(DialectMySQL).SetServerVersion("8.0.36"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "insert into countries(code, name) values (?, ?) as new on duplicate key update name = new.name",
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "variant is invalid for dialect",
+			Config: NewConfig(DialectPostgres).SetVariant(VariantMariaDB),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrVariantIsInvalid,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualStatements []*Statement
+				actualQuery      string
+				actualErr        error
+			)
+
+			actualStatements, actualErr = testCases[i].Config.ReferenceSyncStatements(NewReferenceSync("countries", "code").Columns("code", "name").Row("ID", "Indonesia"))
+
+			if !errors.Is(actualErr, testCases[i].Expectation.Err) {
+				t.Errorf("expectation error is %v, got %v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if len(actualStatements) > 0 {
+				actualQuery = actualStatements[0].Query
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package goqube
+
+import (
+	"fmt"
+	"strings"
+)
+
+type MergeQuery struct {
+	Table        string
+	Keys         []string
+	Fields       []string
+	UpdateFields []string
+	Rows         [][]interface{}
+	Comments     map[string]string
+}
+
+func MergeInto(table string, keys ...string) *MergeQuery {
+	return &MergeQuery{
+		Table: table,
+		Keys:  keys,
+		Rows:  [][]interface{}{},
+	}
+}
+
+func (m *MergeQuery) Columns(fields ...string) *MergeQuery {
+	m.Fields = fields
+	return m
+}
+
+func (m *MergeQuery) Row(values ...interface{}) *MergeQuery {
+	m.Rows = append(m.Rows, values)
+	return m
+}
+
+func (m *MergeQuery) UpdateColumns(fields ...string) *MergeQuery {
+	m.UpdateFields = fields
+	return m
+}
+
+func (m *MergeQuery) getUpdateFields() []string {
+	var fields []string
+
+	if m.UpdateFields != nil {
+		return m.UpdateFields
+	}
+
+	for i := range m.Fields {
+		if !containsString(m.Keys, m.Fields[i]) {
+			fields = append(fields, m.Fields[i])
+		}
+	}
+
+	return fields
+}
+
+func (m *MergeQuery) validate(dialect Dialect) error {
+	var err error
+
+	if dialect == "" {
+		return ErrDialectIsRequired
+	}
+
+	err = validateComments(m.Comments)
+	if err != nil {
+		return err
+	}
+
+	if dialect != DialectPostgres {
+		return ErrUnsupportedMerge
+	}
+
+	if m.Table == "" {
+		return ErrTableIsRequired
+	}
+
+	if len(m.Keys) == 0 {
+		return ErrKeysIsRequired
+	}
+
+	if len(m.Fields) == 0 {
+		return ErrFieldsIsRequired
+	}
+
+	for i := range m.Fields {
+		if m.Fields[i] == "" {
+			return ErrFieldIsRequired
+		}
+
+		if containsString(m.Fields[:i], m.Fields[i]) {
+			return ErrFieldIsDuplicated
+		}
+	}
+
+	for i := range m.Keys {
+		if !containsString(m.Fields, m.Keys[i]) {
+			return ErrKeyIsNotInColumns
+		}
+	}
+
+	for i := range m.UpdateFields {
+		if !containsString(m.Fields, m.UpdateFields[i]) || containsString(m.Keys, m.UpdateFields[i]) {
+			return ErrUpdateFieldIsInvalid
+		}
+	}
+
+	if len(m.Rows) == 0 {
+		return ErrValuesIsRequired
+	}
+
+	for i := range m.Rows {
+		if len(m.Rows[i]) != len(m.Fields) {
+			return ErrValueLengthIsNotEqualToFieldsLength
+		}
+
+		for j := range m.Rows[i] {
+			if _, ok := m.Rows[i][j].(defaultValue); ok {
+				return ErrDefaultValueIsNotAllowed
+			}
+
+			var err error = validateValueKind(m.Rows[i][j], "")
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *MergeQuery) toSQLWithArgs(bc *buildContext, args []interface{}) (string, []interface{}, error) {
+	var (
+		columns      []string
+		rows         []string
+		conditions   []string
+		updates      []string
+		sourceFields []string
+		query        string
+		err          error
+	)
+
+	err = m.validate(bc.dialect)
+	if err != nil {
+		return "", nil, err
+	}
+
+	for i := range m.Fields {
+		columns = append(columns, bc.tableColumn(m.Table, m.Fields[i]))
+		sourceFields = append(sourceFields, fmt.Sprintf("source.%s", columns[i]))
+	}
+
+	for i := range m.Rows {
+		var placeholders []string
+
+		for j := range m.Rows[i] {
+			var placeholder string
+
+			if literal, ok := literalValue(m.Rows[i][j]); ok {
+				placeholders = append(placeholders, literal)
+				continue
+			}
+
+			placeholder, args, err = bc.sensitiveValueWithPlaceholder(m.Table, m.Fields[j], m.Rows[i][j], args)
+			if err != nil {
+				return "", nil, err
+			}
+
+			placeholders = append(placeholders, placeholder)
+		}
+
+		if i == 0 {
+			for j := range placeholders {
+				placeholders[j] = fmt.Sprintf(typedValuef, placeholders[j], bc.tableName(m.Table), columns[j])
+			}
+		}
+
+		rows = append(rows, fmt.Sprintf("(%s)", strings.Join(placeholders, ", ")))
+	}
+
+	for i := range m.Keys {
+		var column string = bc.tableColumn(m.Table, m.Keys[i])
+
+		conditions = append(conditions, fmt.Sprintf("target.%s = source.%s", column, column))
+	}
+
+	for _, field := range m.getUpdateFields() {
+		var column string = bc.tableColumn(m.Table, field)
+
+		updates = append(updates, fmt.Sprintf("%s = source.%s", column, column))
+	}
+
+	query = fmt.Sprintf(
+		"merge into %s as target using (values %s) as source(%s) on %s",
+		bc.tableName(m.Table),
+		strings.Join(rows, ", "),
+		strings.Join(columns, ", "),
+		strings.Join(conditions, " and "),
+	)
+
+	if len(updates) > 0 {
+		query = fmt.Sprintf("%s when matched then update set %s", query, strings.Join(updates, ", "))
+	}
+
+	query = fmt.Sprintf(
+		"%s when not matched then insert (%s) values (%s)",
+		query,
+		strings.Join(columns, ", "),
+		strings.Join(sourceFields, ", "),
+	)
+	query = query + commentSQL(m.Comments)
+
+	return query, args, nil
+}
+
+func (m *MergeQuery) ToSQLWithArgs(dialect Dialect) (string, []interface{}, error) {
+	return buildStatement(dialect, m, []interface{}{})
+}
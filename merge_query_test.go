@@ -0,0 +1,278 @@
+package goqube
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestMergeQuery_MergeInto(t *testing.T) {
+	var actual *MergeQuery = MergeInto("table1", "id").
+		Columns("id", "name", "status").
+		UpdateColumns("name").
+		Row(1, "name1", "active")
+
+	if actual.Table != "table1" {
+		t.Errorf("expectation table is %s, got %s", "table1", actual.Table)
+	}
+
+	if !reflect.DeepEqual(actual.Keys, []string{"id"}) {
+		t.Errorf("expectation keys is %v, got %v", []string{"id"}, actual.Keys)
+	}
+
+	if !reflect.DeepEqual(actual.Fields, []string{"id", "name", "status"}) {
+		t.Errorf("expectation fields is %v, got %v", []string{"id", "name", "status"}, actual.Fields)
+	}
+
+	if !reflect.DeepEqual(actual.UpdateFields, []string{"name"}) {
+		t.Errorf("expectation update fields is %v, got %v", []string{"name"}, actual.UpdateFields)
+	}
+
+	if !reflect.DeepEqual(actual.Rows, [][]interface{}{{1, "name1", "active"}}) {
+		t.Errorf("expectation rows is %v, got %v", [][]interface{}{{1, "name1", "active"}}, actual.Rows)
+	}
+}
+
+func TestMergeQuery_validate(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		MergeQuery  *MergeQuery
+		Dialect     Dialect
+		Expectation error
+	} = []struct {
+		Name        string
+		MergeQuery  *MergeQuery
+		Dialect     Dialect
+		Expectation error
+	}{
+		{
+			Name:        "dialect is empty",
+			MergeQuery:  MergeInto("table1", "id").Columns("id").Row(1),
+			Dialect:     "",
+			Expectation: ErrDialectIsRequired,
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s", DialectMySQL),
+			MergeQuery:  MergeInto("table1", "id").Columns("id").Row(1),
+			Dialect:     DialectMySQL,
+			Expectation: ErrUnsupportedMerge,
+		},
+		{
+			Name:        "table is empty",
+			MergeQuery:  MergeInto("", "id").Columns("id").Row(1),
+			Dialect:     DialectPostgres,
+			Expectation: ErrTableIsRequired,
+		},
+		{
+			Name:        "keys is empty",
+			MergeQuery:  MergeInto("table1").Columns("id").Row(1),
+			Dialect:     DialectPostgres,
+			Expectation: ErrKeysIsRequired,
+		},
+		{
+			Name:        "fields is empty",
+			MergeQuery:  MergeInto("table1", "id").Row(1),
+			Dialect:     DialectPostgres,
+			Expectation: ErrFieldsIsRequired,
+		},
+		{
+			Name:        "field is empty",
+			MergeQuery:  MergeInto("table1", "id").Columns("id", "").Row(1, 2),
+			Dialect:     DialectPostgres,
+			Expectation: ErrFieldIsRequired,
+		},
+		{
+			Name:        "field is duplicated",
+			MergeQuery:  MergeInto("table1", "id").Columns("id", "id").Row(1, 2),
+			Dialect:     DialectPostgres,
+			Expectation: ErrFieldIsDuplicated,
+		},
+		{
+			Name:        "key is not in columns",
+			MergeQuery:  MergeInto("table1", "code").Columns("id").Row(1),
+			Dialect:     DialectPostgres,
+			Expectation: ErrKeyIsNotInColumns,
+		},
+		{
+			Name:        "update field is key",
+			MergeQuery:  MergeInto("table1", "id").Columns("id", "name").UpdateColumns("id").Row(1, "name1"),
+			Dialect:     DialectPostgres,
+			Expectation: ErrUpdateFieldIsInvalid,
+		},
+		{
+			Name:        "update field is not in columns",
+			MergeQuery:  MergeInto("table1", "id").Columns("id", "name").UpdateColumns("status").Row(1, "name1"),
+			Dialect:     DialectPostgres,
+			Expectation: ErrUpdateFieldIsInvalid,
+		},
+		{
+			Name:        "rows is empty",
+			MergeQuery:  MergeInto("table1", "id").Columns("id"),
+			Dialect:     DialectPostgres,
+			Expectation: ErrValuesIsRequired,
+		},
+		{
+			Name:        "row length is not equal to fields length",
+			MergeQuery:  MergeInto("table1", "id").Columns("id", "name").Row(1),
+			Dialect:     DialectPostgres,
+			Expectation: ErrValueLengthIsNotEqualToFieldsLength,
+		},
+		{
+			Name:        "row has default value",
+			MergeQuery:  MergeInto("table1", "id").Columns("id", "name").Row(1, DefaultValue),
+			Dialect:     DialectPostgres,
+			Expectation: ErrDefaultValueIsNotAllowed,
+		},
+		{
+			Name:        "row value kind is unsupported",
+			MergeQuery:  MergeInto("table1", "id").Columns("id", "name").Row(1, map[string]string{}),
+			Dialect:     DialectPostgres,
+			Expectation: &UnsupportedValueTypeError{Kind: reflect.Map},
+		},
+		{
+			Name:        "merge query is valid",
+			MergeQuery:  MergeInto("table1", "id").Columns("id", "name").Row(1, "name1"),
+			Dialect:     DialectPostgres,
+			Expectation: nil,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actualErr error = testCases[i].MergeQuery.validate(testCases[i].Dialect)
+
+			if testCases[i].Expectation != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation != nil && actualErr != nil && testCases[i].Expectation.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Error(), actualErr.Error())
+			}
+		})
+	}
+}
+
+func TestMergeQuery_ToSQLWithArgs(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		MergeQuery  *MergeQuery
+		Dialect     Dialect
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		MergeQuery  *MergeQuery
+		Dialect     Dialect
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:       "merge query is invalid",
+			MergeQuery: MergeInto("table1", "id").Columns("id").Row(1),
+			Dialect:    DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrUnsupportedMerge,
+			},
+		},
+		{
+			Name: "merge query with multiple rows",
+			MergeQuery: MergeInto("table1", "id").
+				Columns("id", "name", "status").
+				Row(1, "name1", "active").
+				Row(2, "name2", NullValue),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "merge into table1 as target using (values (coalesce($1, (null::table1).id), coalesce($2, (null::table1).name), coalesce($3, (null::table1).status)), ($4, $5, null)) as source(id, name, status) on target.id = source.id " +
+					"when matched then update set name = source.name, status = source.status " +
+					"when not matched then insert (id, name, status) values (source.id, source.name, source.status)",
+				Args: []interface{}{1, "name1", "active", 2, "name2"},
+				Err:  nil,
+			},
+		},
+		{
+			Name: "merge query with composite keys and update columns",
+			MergeQuery: MergeInto("table1", "tenant_id", "code").
+				Columns("tenant_id", "code", "name", "created_at").
+				UpdateColumns("name").
+				Row(1, "c1", "name1", "2024-01-01"),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "merge into table1 as target using (values (coalesce($1, (null::table1).tenant_id), coalesce($2, (null::table1).code), coalesce($3, (null::table1).name), coalesce($4, (null::table1).created_at))) as source(tenant_id, code, name, created_at) on target.tenant_id = source.tenant_id and target.code = source.code " +
+					"when matched then update set name = source.name " +
+					"when not matched then insert (tenant_id, code, name, created_at) values (source.tenant_id, source.code, source.name, source.created_at)",
+				Args: []interface{}{1, "c1", "name1", "2024-01-01"},
+				Err:  nil,
+			},
+		},
+		{
+			Name:       "merge query without update columns",
+			MergeQuery: MergeInto("table1", "id").Columns("id").Row(1),
+			Dialect:    DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "merge into table1 as target using (values (coalesce($1, (null::table1).id))) as source(id) on target.id = source.id when not matched then insert (id) values (source.id)",
+				Args:  []interface{}{1},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].MergeQuery.ToSQLWithArgs(testCases[i].Dialect)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %v, got %v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package goqube
+
+import (
+	"strings"
+	"unicode"
+)
+
+type NameMapper struct {
+	Table  func(name string) string
+	Column func(name string) string
+}
+
+func NewNameMapper() *NameMapper {
+	return &NameMapper{}
+}
+
+func (m *NameMapper) MapTables(mapper func(name string) string) *NameMapper {
+	m.Table = mapper
+	return m
+}
+
+func (m *NameMapper) MapColumns(mapper func(name string) string) *NameMapper {
+	m.Column = mapper
+	return m
+}
+
+func SnakeCase(name string) string {
+	var (
+		builder strings.Builder
+		runes   []rune = []rune(name)
+	)
+
+	for i := range runes {
+		if unicode.IsUpper(runes[i]) {
+			if i > 0 && runes[i-1] != '_' &&
+				(unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) || i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+				builder.WriteByte('_')
+			}
+
+			builder.WriteRune(unicode.ToLower(runes[i]))
+			continue
+		}
+
+		builder.WriteRune(runes[i])
+	}
+
+	return builder.String()
+}
+
+func isMappableName(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for _, r := range name {
+		if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (bc *buildContext) mapTableName(name string) string {
+	if bc.config.NameMapper == nil || bc.config.NameMapper.Table == nil || !isMappableName(name) {
+		return name
+	}
+
+	return bc.config.NameMapper.Table(name)
+}
+
+func (bc *buildContext) mapColumnName(column string) string {
+	if bc.config.NameMapper == nil || bc.config.NameMapper.Column == nil || !isMappableName(column) {
+		return column
+	}
+
+	return bc.config.NameMapper.Column(column)
+}
+
+func (bc *buildContext) isAlias(qualifier string) bool {
+	for scope := bc.scope; scope != nil; scope = scope.parent {
+		if _, ok := scope.aliases[qualifier]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (bc *buildContext) qualifierName(qualifier string) string {
+	if bc.isAlias(qualifier) {
+		return qualifier
+	}
+
+	return bc.tableName(qualifier)
+}
+
+func (bc *buildContext) tableColumn(table, column string) string {
+	var physical string = bc.config.Schema.physicalColumn(table, column)
+
+	if physical != column {
+		return physical
+	}
+
+	return bc.mapColumnName(column)
+}
+
+func (c *Config) SetNameMapper(nameMapper *NameMapper) *Config {
+	c.NameMapper = nameMapper
+	return c
+}
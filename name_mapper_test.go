@@ -0,0 +1,210 @@
+package goqube
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestNameMapper_NewNameMapper(t *testing.T) {
+	var actual *NameMapper = NewNameMapper().MapTables(SnakeCase).MapColumns(SnakeCase)
+
+	if actual.Table == nil || actual.Table("UserAccount") != "user_account" {
+		t.Errorf("expectation table mapper maps %s to %s", "UserAccount", "user_account")
+	}
+
+	if actual.Column == nil || actual.Column("createdAt") != "created_at" {
+		t.Errorf("expectation column mapper maps %s to %s", "createdAt", "created_at")
+	}
+}
+
+func TestConfig_SetNameMapper(t *testing.T) {
+	var (
+		nameMapper *NameMapper = NewNameMapper()
+		actual     *Config     = NewConfig(DialectPostgres).SetNameMapper(nameMapper)
+	)
+
+	if actual.NameMapper != nameMapper {
+		t.Errorf("expectation name mapper is %+v, got %+v", nameMapper, actual.NameMapper)
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Expectation string
+	} = []struct {
+		Name        string
+		Expectation string
+	}{
+		{Name: "", Expectation: ""},
+		{Name: "id", Expectation: "id"},
+		{Name: "ID", Expectation: "id"},
+		{Name: "userID", Expectation: "user_id"},
+		{Name: "createdAt", Expectation: "created_at"},
+		{Name: "UserAccount", Expectation: "user_account"},
+		{Name: "HTTPRequest", Expectation: "http_request"},
+		{Name: "address2Line", Expectation: "address2_line"},
+		{Name: "already_snake", Expectation: "already_snake"},
+		{Name: "Mixed_Case", Expectation: "mixed_case"},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = SnakeCase(testCases[i].Name)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation is %s, got %s", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestNameMapper_Build(t *testing.T) {
+	var (
+		nameMapper *NameMapper = NewNameMapper().MapTables(SnakeCase).MapColumns(SnakeCase)
+		schema     *Schema     = NewSchema().AddTables(NewSchemaTable("UserAccount").RenameColumn("fullName", "name"))
+		testCases  []struct {
+			Name        string
+			Config      *Config
+			Query       Query
+			Expectation struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}
+		}
+	)
+
+	testCases = []struct {
+		Name        string
+		Config      *Config
+		Query       Query
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:   fmt.Sprintf("select query with dialect %s and name mapper", DialectPostgres),
+			Config: NewConfig(DialectPostgres).SetNameMapper(nameMapper),
+			Query: Select(NewField("userID"), NewField("createdAt"), NewField("*")).
+				From(NewTable("UserAccount")).
+				Where(Eq("isActive", true)).
+				OrderBy(NewSort(NewField("createdAt"), SortDirectionDescending)),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select user_id as userID, created_at as createdAt, * from user_account where is_active = $1 order by created_at desc",
+				Args:  []interface{}{true},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   fmt.Sprintf("select query with dialect %s, name mapper and table prefix", DialectMySQL),
+			Config: NewConfig(DialectMySQL).SetNameMapper(nameMapper).SetTablePrefix("app_"),
+			Query: Select(NewField("userID").FromTable("UserAccount"), NewField("orderTotal").FromTable("o")).
+				From(NewTable("UserAccount")).
+				Join(InnerJoin(NewTable("CustomerOrder").As("o")).On(NewFilter().SetCondition(NewField("userID").FromTable("o"), OperatorEqual, NewColumnFilterValue("userID").FromTable("UserAccount")))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select app_user_account.user_id as userID, o.order_total as orderTotal from app_user_account inner join app_customer_order as o on o.user_id = app_user_account.user_id",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   fmt.Sprintf("select query with dialect %s, name mapper and schema", DialectPostgres),
+			Config: NewConfig(DialectPostgres).SetNameMapper(nameMapper).SetSchema(schema),
+			Query:  Select(NewField("fullName"), NewField("emailAddress")).From(NewTable("UserAccount")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select name as fullName, email_address as emailAddress from user_account",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   fmt.Sprintf("insert query with dialect %s and name mapper", DialectPostgres),
+			Config: NewConfig(DialectPostgres).SetNameMapper(nameMapper).SetTablePrefix("app_"),
+			Query:  InsertInto("UserAccount").Value("fullName", "john"),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into app_user_account(full_name) values ($1)",
+				Args:  []interface{}{"john"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   fmt.Sprintf("update query with dialect %s and name mapper", DialectMySQL),
+			Config: NewConfig(DialectMySQL).SetNameMapper(nameMapper),
+			Query:  Update("UserAccount").Set("fullName", "john").Where(Eq("userID", 1)),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update user_account set full_name = ? where user_id = ?",
+				Args:  []interface{}{"john", 1},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   fmt.Sprintf("delete query with dialect %s and table mapper only", DialectPostgres),
+			Config: NewConfig(DialectPostgres).SetNameMapper(NewNameMapper().MapTables(SnakeCase)),
+			Query:  DeleteFrom("UserAccount").Where(Eq("userID", 1)),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "delete from user_account where userID = $1",
+				Args:  []interface{}{1},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Config.Build(testCases[i].Query)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Errorf("expectation error is %s, got nil", testCases[i].Expectation.Err.Error())
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Errorf("expectation error is nil, got %s", actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %+v, got %+v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
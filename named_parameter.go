@@ -0,0 +1,93 @@
+package goqube
+
+import (
+	"fmt"
+	"strings"
+)
+
+func (c *Config) SetNamedParameterStyle(style NamedParameterStyle) *Config {
+	c.NamedParameterStyle = style
+	return c
+}
+
+func namedParameterName(index int) string {
+	return fmt.Sprintf(namedParameterNamef, index)
+}
+
+func toNamedParameters(dialect Dialect, style NamedParameterStyle, query string, args []interface{}) (string, map[string]interface{}) {
+	var (
+		builder    strings.Builder
+		inQuote    bool
+		position   int
+		namedArgs  map[string]interface{} = map[string]interface{}{}
+		writeIndex func(index int)
+	)
+
+	writeIndex = func(index int) {
+		var name string = namedParameterName(index)
+
+		builder.WriteString(string(style))
+		builder.WriteString(name)
+
+		if index > 0 && index <= len(args) {
+			namedArgs[name] = args[index-1]
+		}
+	}
+
+	for i := 0; i < len(query); i++ {
+		if query[i] == '\'' {
+			inQuote = !inQuote
+		}
+
+		if inQuote {
+			builder.WriteByte(query[i])
+			continue
+		}
+
+		switch {
+		case query[i] == '?' && dialect == DialectMySQL:
+			position++
+			writeIndex(position)
+
+		case query[i] == '$' && dialect == DialectPostgres && i+1 < len(query) && query[i+1] >= '0' && query[i+1] <= '9':
+			var index int
+
+			for i+1 < len(query) && query[i+1] >= '0' && query[i+1] <= '9' {
+				index = index*10 + int(query[i+1]-'0')
+				i++
+			}
+
+			writeIndex(index)
+
+		case query[i] == ':' && style == NamedParameterStyleColon:
+			builder.WriteString("::")
+
+		default:
+			builder.WriteByte(query[i])
+		}
+	}
+
+	return builder.String(), namedArgs
+}
+
+func (c *Config) BuildNamed(query Query) (string, map[string]interface{}, error) {
+	var (
+		sql       string
+		args      []interface{}
+		namedArgs map[string]interface{}
+		err       error
+	)
+
+	if c.NamedParameterStyle != NamedParameterStyleColon && c.NamedParameterStyle != NamedParameterStyleAt {
+		return "", nil, ErrNamedParameterStyleIsInvalid
+	}
+
+	sql, args, err = c.Build(query)
+	if err != nil {
+		return "", nil, err
+	}
+
+	sql, namedArgs = toNamedParameters(c.Dialect, c.NamedParameterStyle, sql, args)
+
+	return sql, namedArgs, nil
+}
@@ -0,0 +1,148 @@
+package goqube
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestConfig_SetNamedParameterStyle(t *testing.T) {
+	var actual *Config = NewConfig(DialectPostgres).SetNamedParameterStyle(NamedParameterStyleAt)
+
+	if actual.NamedParameterStyle != NamedParameterStyleAt {
+		t.Errorf("expectation named parameter style is %s, got %s", NamedParameterStyleAt, actual.NamedParameterStyle)
+	}
+}
+
+func TestConfig_BuildNamed(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Config      *Config
+		Query       Query
+		Expectation struct {
+			Query string
+			Args  map[string]interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Config      *Config
+		Query       Query
+		Expectation struct {
+			Query string
+			Args  map[string]interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:   "named parameter style is empty",
+			Config: NewConfig(DialectPostgres),
+			Query:  Select(NewField("id")).From(NewTable("users")),
+			Expectation: struct {
+				Query string
+				Args  map[string]interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrNamedParameterStyleIsInvalid,
+			},
+		},
+		{
+			Name:   "query is invalid",
+			Config: NewConfig(DialectPostgres).SetNamedParameterStyle(NamedParameterStyleColon),
+			Query:  Select(NewField("id")),
+			Expectation: struct {
+				Query string
+				Args  map[string]interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrTableIsRequired,
+			},
+		},
+		{
+			Name:   fmt.Sprintf("dialect %s with colon style", DialectMySQL),
+			Config: NewConfig(DialectMySQL).SetNamedParameterStyle(NamedParameterStyleColon),
+			Query: Select(NewField("id")).
+				From(NewTable("users")).
+				Where(
+					NewFilter().
+						SetLogic(LogicAnd).
+						AddFilter(NewField("name"), OperatorEqual, NewFilterValue("what's up?")).
+						AddFilter(NewField("status"), OperatorIn, NewFilterValue([]string{"active", "pending"})),
+				),
+			Expectation: struct {
+				Query string
+				Args  map[string]interface{}
+				Err   error
+			}{
+				Query: "select id from users where name = :p1 and status in (:p2, :p3)",
+				Args:  map[string]interface{}{"p1": "what's up?", "p2": "active", "p3": "pending"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   fmt.Sprintf("dialect %s with colon style escapes casts", DialectPostgres),
+			Config: NewConfig(DialectPostgres).SetNamedParameterStyle(NamedParameterStyleColon),
+			Query: Select(NewRawField(NewRaw("created_at::date")).As("created_date")).
+				From(NewTable("users")).
+				Where(NewFilter().SetCondition(NewField("note"), OperatorEqual, NewRawFilterValue(NewRaw("'a:b?' || ?", "c")))),
+			Expectation: struct {
+				Query string
+				Args  map[string]interface{}
+				Err   error
+			}{
+				Query: "select created_at::::date as created_date from users where note = 'a:b?' || :p1",
+				Args:  map[string]interface{}{"p1": "c"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   fmt.Sprintf("dialect %s with at style", DialectPostgres),
+			Config: NewConfig(DialectPostgres).SetNamedParameterStyle(NamedParameterStyleAt),
+			Query:  Update("users").Set("name", "name1").Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Expectation: struct {
+				Query string
+				Args  map[string]interface{}
+				Err   error
+			}{
+				Query: "update users set name = @p1 where id = @p2",
+				Args:  map[string]interface{}{"p1": "name1", "p2": 1},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  map[string]interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Config.BuildNamed(testCases[i].Query)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %v, got %v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
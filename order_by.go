@@ -0,0 +1,20 @@
+package goqube
+
+func (s *SelectQuery) sortToSQLWithArgs(bc *buildContext, sort *Sort, args []interface{}) (string, []interface{}, error) {
+	var err error
+
+	if sort.Field == nil || !s.isSelectAlias(sort.Field) {
+		return sort.toSQLWithArgs(bc, args)
+	}
+
+	err = sort.validate(bc.dialect)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if bc.dialect == DialectPostgres && (sort.Cast != "" || sort.Collation != "") {
+		return "", nil, ErrUnsupportedAliasSort
+	}
+
+	return sort.orderByToSQL(bc, quoteAlias(bc.dialect, sort.Field.Column)), args, nil
+}
@@ -0,0 +1,153 @@
+package goqube
+
+import "testing"
+
+func TestSelectQuery_ToSQLWithArgs_OrderBy(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Query       *SelectQuery
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Query       *SelectQuery
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:    "order by expression",
+			Dialect: DialectPostgres,
+			Query: Select(NewField("id")).
+				From(NewTable("products")).
+				OrderBy(NewSort(NewExpressionField(NewArithmeticExpression(ExpressionOperatorMultiply, NewField("price"), NewField("quantity"))), SortDirectionDescending)),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from products order by price * quantity desc",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    "order by case",
+			Dialect: DialectPostgres,
+			Query: Select(NewField("id")).
+				From(NewTable("tickets")).
+				OrderBy(
+					NewSort(NewExpressionField(Case().When(Eq("priority", "high"), 1).When(Eq("priority", "low"), 3).Else(2)), SortDirectionAscending),
+					NewSort(NewField("id"), SortDirectionAscending),
+				),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from tickets order by case when priority = $1 then $2 when priority = $3 then $4 else $5 end asc, id asc",
+				Args:  []interface{}{"high", 1, "low", 3, 2},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    "order by select alias",
+			Dialect: DialectPostgres,
+			Query: Select(NewField("id"), NewRawField(NewRaw("count(*)")).As("total")).
+				From(NewTable("orders")).
+				OrderBy(NewSort(NewField("total"), SortDirectionDescending)),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id, count(*) as total from orders order by total desc",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    "order by select alias with collation with dialect mysql",
+			Dialect: DialectMySQL,
+			Query: Select(NewField("first_name").As("name")).
+				From(NewTable("users")).
+				OrderBy(NewSort(NewField("name"), SortDirectionAscending).Collate("utf8mb4_bin")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select first_name as name from users order by name collate utf8mb4_bin asc",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    "order by select alias with cast with dialect postgres",
+			Dialect: DialectPostgres,
+			Query: Select(NewField("code").As("label")).
+				From(NewTable("users")).
+				OrderBy(NewSort(NewField("label"), SortDirectionAscending).CastAs("int")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &PathError{Path: "Sorts[0]", Err: ErrUnsupportedAliasSort},
+			},
+		},
+		{
+			Name:    "order by subquery",
+			Dialect: DialectPostgres,
+			Query: Select(NewField("id")).
+				From(NewTable("users").As("u")).
+				OrderBy(NewSort(NewSelectQueryField(Select(NewRawField(NewRaw("max(created_at)")).As("last")).From(NewTable("orders")).Where(Eq("orders.user_id", NewColumnFilterValue("u.id")))), SortDirectionDescending)),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from users as u order by (select max(created_at) as last from orders where orders.user_id = u.id) desc",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Query.ToSQLWithArgs(testCases[i].Dialect, []interface{}{})
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Errorf("expectation error is nil, got %s", actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Err != nil && (actualErr == nil || testCases[i].Expectation.Err.Error() != actualErr.Error()) {
+				t.Errorf("expectation error is %s, got %v", testCases[i].Expectation.Err.Error(), actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %+v, got %+v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package goqube
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+type paramTransform struct {
+	apply    func(value interface{}) (interface{}, error)
+	previous *paramTransform
+}
+
+type ParamValue struct {
+	Name      string
+	transform *paramTransform
+}
+
+func Param(name string) ParamValue {
+	return ParamValue{
+		Name: name,
+	}
+}
+
+func (p ParamValue) Value() (driver.Value, error) {
+	return nil, fmt.Errorf(errParamf, ErrParamIsNotBound, p.Name)
+}
+
+func (p ParamValue) with(apply func(value interface{}) (interface{}, error)) ParamValue {
+	return ParamValue{
+		Name:      p.Name,
+		transform: &paramTransform{apply: apply, previous: p.transform},
+	}
+}
+
+func (t *paramTransform) bind(value interface{}) (interface{}, error) {
+	var err error
+
+	if t == nil {
+		return value, nil
+	}
+
+	value, err = t.previous.bind(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.apply(value)
+}
+
+type Template struct {
+	Query  string
+	Args   []interface{}
+	config *Config
+}
+
+func (c *Config) Template(query Query) (*Template, error) {
+	var (
+		template *Template = &Template{config: c}
+		err      error
+	)
+
+	template.Query, template.Args, err = c.Build(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+func (b *Builder) Template(query Query) (*Template, error) {
+	return b.config.Template(query)
+}
+
+func (t *Template) Params() []string {
+	var (
+		params []string        = []string{}
+		seen   map[string]bool = map[string]bool{}
+	)
+
+	for i := range t.Args {
+		if param, ok := t.Args[i].(ParamValue); ok && !seen[param.Name] {
+			seen[param.Name] = true
+			params = append(params, param.Name)
+		}
+	}
+
+	return params
+}
+
+func (t *Template) Bind(params map[string]interface{}) (string, []interface{}, error) {
+	var (
+		bc   *buildContext = newBuildContext(t.config)
+		args []interface{} = make([]interface{}, len(t.Args))
+		err  error
+	)
+
+	for i := range t.Args {
+		var (
+			param ParamValue
+			value interface{}
+			ok    bool
+		)
+
+		param, ok = t.Args[i].(ParamValue)
+		if !ok {
+			args[i] = t.Args[i]
+			continue
+		}
+
+		value, ok = params[param.Name]
+		if !ok {
+			return "", nil, fmt.Errorf(errParamf, ErrParamIsNotBound, param.Name)
+		}
+
+		value = bc.adaptValue(value)
+
+		err = validateValueKind(value, "")
+		if err != nil {
+			return "", nil, fmt.Errorf(errParamf, err, param.Name)
+		}
+
+		args[i], err = param.transform.bind(value)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	return t.Query, args, nil
+}
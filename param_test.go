@@ -0,0 +1,354 @@
+package goqube
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestParam_Param(t *testing.T) {
+	var (
+		expectation ParamValue = ParamValue{Name: "status"}
+		actual      ParamValue = Param("status")
+	)
+
+	if expectation != actual {
+		t.Errorf("expectation param is %+v, got %+v", expectation, actual)
+	}
+}
+
+func TestParam_Value(t *testing.T) {
+	var err error
+
+	_, err = Param("status").Value()
+
+	if !errors.Is(err, ErrParamIsNotBound) {
+		t.Errorf("expectation error is %v, got %v", ErrParamIsNotBound, err)
+	}
+
+	if err.Error() != "param is not bound: status" {
+		t.Errorf("expectation error message is param is not bound: status, got %s", err.Error())
+	}
+}
+
+func TestParam_Template(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Builder     *Builder
+		Query       Query
+		Params      map[string]interface{}
+		Expectation struct {
+			Query  string
+			Args   []interface{}
+			Params []string
+			Err    error
+		}
+	} = []struct {
+		Name        string
+		Builder     *Builder
+		Query       Query
+		Params      map[string]interface{}
+		Expectation struct {
+			Query  string
+			Args   []interface{}
+			Params []string
+			Err    error
+		}
+	}{
+		{
+			Name:    "select query with dialect postgres",
+			Builder: NewBuilder(DialectPostgres, WithStrictArgs(true)),
+			Query: Select(NewField("id")).
+				From(NewTable("users")).
+				Where(And(Eq("status", Param("status")), Gt("age", Param("age")), Eq("tenant_id", 1), Lt("score", Param("age")))).
+				Limit(10),
+			Params: map[string]interface{}{"status": "active", "age": 18, "unused": true},
+			Expectation: struct {
+				Query  string
+				Args   []interface{}
+				Params []string
+				Err    error
+			}{
+				Query:  "select id from users where status = $1 and age > $2 and tenant_id = $3 and score < $4 limit $5",
+				Args:   []interface{}{"active", 18, 1, 18, 10},
+				Params: []string{"status", "age"},
+				Err:    nil,
+			},
+		},
+		{
+			Name:    "update query with dialect mysql",
+			Builder: NewBuilder(DialectMySQL),
+			Query:   Update("users").Set("name", Param("name")).Where(Eq("id", Param("id"))),
+			Params:  map[string]interface{}{"name": "john", "id": 1},
+			Expectation: struct {
+				Query  string
+				Args   []interface{}
+				Params []string
+				Err    error
+			}{
+				Query:  "update users set name = ? where id = ?",
+				Args:   []interface{}{"john", 1},
+				Params: []string{"name", "id"},
+				Err:    nil,
+			},
+		},
+		{
+			Name:    "insert query with value adapter",
+			Builder: NewBuilder(DialectPostgres, WithValueAdapter(testValueAdapter)),
+			Query:   InsertInto("products").Value("price", Param("price")).Value("name", "book"),
+			Params:  map[string]interface{}{"price": testValueAdapterMoney{Cents: 150}},
+			Expectation: struct {
+				Query  string
+				Args   []interface{}
+				Params []string
+				Err    error
+			}{
+				Query:  "insert into products(name, price) values ($1, $2)",
+				Args:   []interface{}{"book", "1.50"},
+				Params: []string{"price"},
+				Err:    nil,
+			},
+		},
+		{
+			Name: "update query with sensitivity",
+			Builder: NewBuilder(DialectPostgres, WithSensitivity("ssn", NewSensitivity().EncryptWith(func(value interface{}) (interface{}, error) {
+				return fmt.Sprintf("enc(%v)", value), nil
+			}))),
+			Query:  Update("users").Set("ssn", Param("ssn")).Where(Eq("id", Param("id"))),
+			Params: map[string]interface{}{"ssn": "123", "id": 1},
+			Expectation: struct {
+				Query  string
+				Args   []interface{}
+				Params []string
+				Err    error
+			}{
+				Query:  "update users set ssn = $1 where id = $2",
+				Args:   []interface{}{"enc(123)", 1},
+				Params: []string{"ssn", "id"},
+				Err:    nil,
+			},
+		},
+		{
+			Name:    "select query with pattern and array operators",
+			Builder: NewBuilder(DialectPostgres),
+			Query: Select(NewField("id")).
+				From(NewTable("users")).
+				Where(And(
+					NewFilter().SetCondition(NewField("name"), OperatorStartsWith, NewFilterValue(Param("name"))),
+					NewFilter().SetCondition(NewField("role"), OperatorEqualAny, NewFilterValue(Param("roles"))),
+				)).
+				Limit(10),
+			Params: map[string]interface{}{"name": "jo%", "roles": []string{"admin"}},
+			Expectation: struct {
+				Query  string
+				Args   []interface{}
+				Params []string
+				Err    error
+			}{
+				Query:  "select id from users where name::text ilike $1 escape '\\' and role = any($2) limit $3",
+				Args:   []interface{}{`jo\%%`, Array([]string{"admin"}), 10},
+				Params: []string{"name", "roles"},
+				Err:    nil,
+			},
+		},
+		{
+			Name:    "pattern param is invalid",
+			Builder: NewBuilder(DialectPostgres),
+			Query:   Select(NewField("id")).From(NewTable("users")).Where(NewFilter().SetCondition(NewField("name"), OperatorContains, NewFilterValue(Param("name")))).Limit(10),
+			Params:  map[string]interface{}{"name": []int{1}},
+			Expectation: struct {
+				Query  string
+				Args   []interface{}
+				Params []string
+				Err    error
+			}{
+				Query:  "",
+				Args:   nil,
+				Params: []string{"name"},
+				Err:    ErrPatternValueIsInvalid,
+			},
+		},
+		{
+			Name:    "param is not bound",
+			Builder: NewBuilder(DialectPostgres),
+			Query:   Select(NewField("id")).From(NewTable("users")).Where(Eq("status", Param("status"))).Limit(10),
+			Params:  map[string]interface{}{},
+			Expectation: struct {
+				Query  string
+				Args   []interface{}
+				Params []string
+				Err    error
+			}{
+				Query:  "",
+				Args:   nil,
+				Params: []string{"status"},
+				Err:    ErrParamIsNotBound,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				template    *Template
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			template, actualErr = testCases[i].Builder.Template(testCases[i].Query)
+			if actualErr != nil {
+				t.Fatalf("expectation template error is nil, got %s", actualErr.Error())
+			}
+
+			if !deepEqual(testCases[i].Expectation.Params, template.Params()) {
+				t.Errorf("expectation params is %v, got %v", testCases[i].Expectation.Params, template.Params())
+			}
+
+			actualQuery, actualArgs, actualErr = template.Bind(testCases[i].Params)
+
+			if !errors.Is(actualErr, testCases[i].Expectation.Err) {
+				t.Errorf("expectation error is %v, got %v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %v, got %v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
+
+func TestParam_TemplateIsInvalid(t *testing.T) {
+	var err error
+
+	_, err = NewConfig(DialectPostgres).Template(nil)
+
+	if err == nil {
+		t.Error("expectation error is not nil, got nil")
+	}
+}
+
+func TestParam_TemplateBindValidatesValues(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Builder     *Builder
+		Query       Query
+		Params      map[string]interface{}
+		Expectation struct {
+			Err  error
+			Kind reflect.Kind
+		}
+	}
+
+	testCases = []struct {
+		Name        string
+		Builder     *Builder
+		Query       Query
+		Params      map[string]interface{}
+		Expectation struct {
+			Err  error
+			Kind reflect.Kind
+		}
+	}{
+		{
+			Name:    "comparison with nil",
+			Builder: NewBuilder(DialectPostgres),
+			Query:   Select(NewField("id")).From(NewTable("users")).Where(Eq("status", Param("status"))),
+			Params:  map[string]interface{}{"status": nil},
+			Expectation: struct {
+				Err  error
+				Kind reflect.Kind
+			}{
+				Err:  ErrComparisonWithNil,
+				Kind: reflect.Invalid,
+			},
+		},
+		{
+			Name:    "comparison with nil valuer and nil as null",
+			Builder: NewBuilder(DialectPostgres, WithNilAsNull(true)),
+			Query:   Select(NewField("id")).From(NewTable("users")).Where(Gt("deleted_at", Param("deleted_at"))),
+			Params:  map[string]interface{}{"deleted_at": sql.NullTime{}},
+			Expectation: struct {
+				Err  error
+				Kind reflect.Kind
+			}{
+				Err:  ErrComparisonWithNil,
+				Kind: reflect.Invalid,
+			},
+		},
+		{
+			Name:    "comparison with slice",
+			Builder: NewBuilder(DialectPostgres),
+			Query:   Select(NewField("id")).From(NewTable("users")).Where(Eq("status", Param("status"))),
+			Params:  map[string]interface{}{"status": []string{"active"}},
+			Expectation: struct {
+				Err  error
+				Kind reflect.Kind
+			}{
+				Err:  nil,
+				Kind: reflect.Slice,
+			},
+		},
+		{
+			Name:    "like with int",
+			Builder: NewBuilder(DialectPostgres),
+			Query:   Select(NewField("id")).From(NewTable("users")).Where(NewFilter().SetCondition(NewField("name"), OperatorLike, NewFilterValue(Param("name")))),
+			Params:  map[string]interface{}{"name": 1},
+			Expectation: struct {
+				Err  error
+				Kind reflect.Kind
+			}{
+				Err:  nil,
+				Kind: reflect.Int,
+			},
+		},
+		{
+			Name:    "set with map",
+			Builder: NewBuilder(DialectPostgres),
+			Query:   Update("users").Set("name", Param("name")).Where(Eq("id", 1)),
+			Params:  map[string]interface{}{"name": map[string]string{}},
+			Expectation: struct {
+				Err  error
+				Kind reflect.Kind
+			}{
+				Err:  nil,
+				Kind: reflect.Map,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				template              *Template
+				unsupportedValueError *UnsupportedValueTypeError
+				actualErr             error
+			)
+
+			template, actualErr = testCases[i].Builder.Template(testCases[i].Query)
+			if actualErr != nil {
+				t.Fatalf("expectation template error is nil, got %s", actualErr.Error())
+			}
+
+			_, _, actualErr = template.Bind(testCases[i].Params)
+
+			if actualErr == nil {
+				t.Fatal("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && !errors.Is(actualErr, testCases[i].Expectation.Err) {
+				t.Errorf("expectation error is %v, got %v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Kind != reflect.Invalid && (!errors.As(actualErr, &unsupportedValueError) || unsupportedValueError.Kind != testCases[i].Expectation.Kind) {
+				t.Errorf("expectation unsupported value kind is %s, got %v", testCases[i].Expectation.Kind, actualErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package goqube
+
+func MaxParameters(dialect Dialect) int {
+	return dialectMaxParameters[dialect]
+}
+
+func checkParameterCount(dialect Dialect, args []interface{}) error {
+	var maxParameters int = dialectMaxParameters[dialect]
+
+	if maxParameters > 0 && len(args) > maxParameters {
+		return &TooManyParametersError{Dialect: dialect, Count: len(args), Max: maxParameters}
+	}
+
+	return nil
+}
+
+func buildStatement(dialect Dialect, query Query, args []interface{}) (string, []interface{}, error) {
+	var (
+		sql string
+		err error
+	)
+
+	sql, args, err = query.toSQLWithArgs(newDialectBuildContext(dialect), args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = checkParameterCount(dialect, args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return sql, args, nil
+}
@@ -0,0 +1,100 @@
+package goqube
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMaxParameters(t *testing.T) {
+	if MaxParameters(DialectPostgres) != 65535 {
+		t.Errorf("expectation max parameters is %d, got %d", 65535, MaxParameters(DialectPostgres))
+	}
+
+	if MaxParameters("") != 0 {
+		t.Errorf("expectation max parameters is %d, got %d", 0, MaxParameters(""))
+	}
+}
+
+func TestTooManyParametersError(t *testing.T) {
+	var (
+		ids       []int
+		testCases []struct {
+			Name  string
+			Build func() (string, []interface{}, error)
+		}
+	)
+
+	for i := 0; i < MaxParameters(DialectPostgres)+1; i++ {
+		ids = append(ids, i)
+	}
+
+	testCases = []struct {
+		Name  string
+		Build func() (string, []interface{}, error)
+	}{
+		{
+			Name: "config build",
+			Build: func() (string, []interface{}, error) {
+				return NewConfig(DialectPostgres).Build(Select(NewField("id")).From(NewTable("users")).Where(NewFilter().SetCondition(NewField("id"), OperatorIn, NewFilterValue(ids))))
+			},
+		},
+		{
+			Name: "select query to sql with args",
+			Build: func() (string, []interface{}, error) {
+				return Select(NewField("id")).From(NewTable("users")).Where(NewFilter().SetCondition(NewField("id"), OperatorIn, NewFilterValue(ids))).ToSQLWithArgs(DialectPostgres, []interface{}{})
+			},
+		},
+		{
+			Name: "delete query to sql with args",
+			Build: func() (string, []interface{}, error) {
+				return Delete().From("users").Where(NewFilter().SetCondition(NewField("id"), OperatorIn, NewFilterValue(ids))).ToSQLWithArgs(DialectPostgres)
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+				tooMany     *TooManyParametersError
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Build()
+
+			if !errors.Is(actualErr, ErrTooManyParameters) {
+				t.Errorf("expectation error is %v, got %v", ErrTooManyParameters, actualErr)
+			}
+
+			if !errors.As(actualErr, &tooMany) || tooMany.Count != len(ids) || tooMany.Max != 65535 || tooMany.Dialect != DialectPostgres {
+				t.Errorf("expectation error has count %d, got %+v", len(ids), tooMany)
+			}
+
+			if actualQuery != "" || actualArgs != nil {
+				t.Errorf("expectation query and args are empty, got %s and %d args", actualQuery, len(actualArgs))
+			}
+		})
+	}
+
+	t.Run("error message", func(t *testing.T) {
+		var actual string = (&TooManyParametersError{Dialect: DialectMySQL, Count: 70000, Max: 65535}).Error()
+
+		if actual != "too many parameters: 70000 exceeds maximum 65535 for dialect mysql" {
+			t.Errorf("expectation error message is %s, got %s", "too many parameters: 70000 exceeds maximum 65535 for dialect mysql", actual)
+		}
+	})
+
+	t.Run("parameters within limit", func(t *testing.T) {
+		var (
+			actualArgs []interface{}
+			err        error
+		)
+
+		_, actualArgs, err = InsertInto("users").Value("id", 1).ToSQLWithArgs(DialectMySQL)
+
+		if err != nil || len(actualArgs) != 1 {
+			t.Errorf("expectation error is nil and args length is %d, got %v and %d", 1, err, len(actualArgs))
+		}
+	})
+}
@@ -0,0 +1,1067 @@
+package goqube
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type sqlToken struct {
+	kind     sqlTokenKind
+	text     string
+	position int
+}
+
+type sqlParserState struct {
+	index        int
+	argIndex     int
+	placeholders int
+}
+
+type sqlParser struct {
+	tokens []sqlToken
+	args   []interface{}
+	state  sqlParserState
+}
+
+func ParseSelect(sql string, args ...interface{}) (*SelectQuery, error) {
+	var (
+		parser *sqlParser
+		tokens []sqlToken
+		query  *SelectQuery
+		err    error
+	)
+
+	tokens, err = tokenizeSQL(sql)
+	if err != nil {
+		return nil, err
+	}
+
+	parser = &sqlParser{
+		tokens: tokens,
+		args:   args,
+	}
+
+	query, err = parser.selectQuery()
+	if err != nil {
+		return nil, err
+	}
+
+	parser.acceptSymbol(";")
+	if parser.peek().kind != sqlTokenEOF {
+		return nil, parser.unexpected()
+	}
+
+	if parser.state.placeholders != len(args) {
+		return nil, fmt.Errorf(errPlaceholderCountf, ErrPlaceholderCountMismatch, parser.state.placeholders, len(args))
+	}
+
+	return query, nil
+}
+
+func isSQLIdentifierRune(char rune, first bool) bool {
+	return char == '_' || unicode.IsLetter(char) || (!first && unicode.IsDigit(char))
+}
+
+func tokenizeSQL(sql string) ([]sqlToken, error) {
+	var (
+		tokens []sqlToken
+		runes  []rune = []rune(sql)
+		next   func(i int) rune
+	)
+
+	next = func(i int) rune {
+		if i < len(runes) {
+			return runes[i]
+		}
+
+		return 0
+	}
+
+	tokens = []sqlToken{}
+	for i := 0; i < len(runes); {
+		var (
+			char  rune = runes[i]
+			start int  = i
+		)
+
+		switch {
+		case unicode.IsSpace(char):
+			i++
+
+		case char == '-' && next(i+1) == '-':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+
+		case char == '/' && next(i+1) == '*':
+			for i += 2; i < len(runes) && !(runes[i] == '*' && next(i+1) == '/'); i++ {
+			}
+
+			if i >= len(runes) {
+				return nil, &ParseError{Position: start, Token: "/*", Err: ErrUnexpectedEndOfSQL}
+			}
+
+			i += 2
+
+		case isSQLIdentifierRune(char, true):
+			for i < len(runes) && isSQLIdentifierRune(runes[i], false) {
+				i++
+			}
+
+			tokens = append(tokens, sqlToken{kind: sqlTokenIdentifier, text: string(runes[start:i]), position: start})
+
+		case unicode.IsDigit(char):
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || (runes[i] == '.' && unicode.IsDigit(next(i+1)))) {
+				i++
+			}
+
+			tokens = append(tokens, sqlToken{kind: sqlTokenNumber, text: string(runes[start:i]), position: start})
+
+		case char == '\'' || char == '"' || char == '`':
+			var (
+				text   strings.Builder
+				closed bool
+			)
+
+			for i++; i < len(runes); i++ {
+				if runes[i] == char {
+					if next(i+1) != char {
+						closed = true
+						i++
+						break
+					}
+
+					i++
+				}
+
+				text.WriteRune(runes[i])
+			}
+
+			if !closed {
+				return nil, &ParseError{Position: start, Token: string(char), Err: ErrUnexpectedEndOfSQL}
+			}
+
+			if char == '\'' {
+				tokens = append(tokens, sqlToken{kind: sqlTokenString, text: text.String(), position: start})
+			} else {
+				tokens = append(tokens, sqlToken{kind: sqlTokenQuotedIdentifier, text: text.String(), position: start})
+			}
+
+		case char == '?':
+			i++
+			tokens = append(tokens, sqlToken{kind: sqlTokenPlaceholder, text: "?", position: start})
+
+		case char == '$' && unicode.IsDigit(next(i+1)):
+			for i++; i < len(runes) && unicode.IsDigit(runes[i]); i++ {
+			}
+
+			tokens = append(tokens, sqlToken{kind: sqlTokenPlaceholder, text: string(runes[start:i]), position: start})
+
+		case containsString([]string{"!=", "<>", "<=", ">="}, string(runes[i:minInt(i+2, len(runes))])):
+			i += 2
+			tokens = append(tokens, sqlToken{kind: sqlTokenSymbol, text: string(runes[start:i]), position: start})
+
+		case strings.ContainsRune("(),.*=<>+-/%;", char):
+			i++
+			tokens = append(tokens, sqlToken{kind: sqlTokenSymbol, text: string(char), position: start})
+
+		default:
+			return nil, &ParseError{Position: start, Token: string(char), Err: ErrUnexpectedToken}
+		}
+	}
+
+	tokens = append(tokens, sqlToken{kind: sqlTokenEOF, position: len(runes)})
+
+	return tokens, nil
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+func (p *sqlParser) peek() sqlToken {
+	return p.tokens[p.state.index]
+}
+
+func (p *sqlParser) peekAt(offset int) sqlToken {
+	if p.state.index+offset >= len(p.tokens) {
+		return p.tokens[len(p.tokens)-1]
+	}
+
+	return p.tokens[p.state.index+offset]
+}
+
+func (p *sqlParser) advance() sqlToken {
+	var token sqlToken = p.peek()
+
+	if token.kind != sqlTokenEOF {
+		p.state.index++
+	}
+
+	return token
+}
+
+func (p *sqlParser) unexpected() error {
+	var token sqlToken = p.peek()
+
+	if token.kind == sqlTokenEOF {
+		return &ParseError{Position: token.position, Err: ErrUnexpectedEndOfSQL}
+	}
+
+	return &ParseError{Position: token.position, Token: token.text, Err: ErrUnexpectedToken}
+}
+
+func (t sqlToken) isKeyword(keywords ...string) bool {
+	if t.kind != sqlTokenIdentifier {
+		return false
+	}
+
+	for i := range keywords {
+		if strings.EqualFold(t.text, keywords[i]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (t sqlToken) isSymbol(symbol string) bool {
+	return t.kind == sqlTokenSymbol && t.text == symbol
+}
+
+func (p *sqlParser) acceptKeyword(keyword string) bool {
+	if p.peek().isKeyword(keyword) {
+		p.advance()
+		return true
+	}
+
+	return false
+}
+
+func (p *sqlParser) acceptSymbol(symbol string) bool {
+	if p.peek().isSymbol(symbol) {
+		p.advance()
+		return true
+	}
+
+	return false
+}
+
+func (p *sqlParser) expectKeyword(keyword string) error {
+	if !p.acceptKeyword(keyword) {
+		return p.unexpected()
+	}
+
+	return nil
+}
+
+func (p *sqlParser) expectSymbol(symbol string) error {
+	if !p.acceptSymbol(symbol) {
+		return p.unexpected()
+	}
+
+	return nil
+}
+
+func (p *sqlParser) identifier() (string, error) {
+	var token sqlToken = p.peek()
+
+	if token.kind == sqlTokenQuotedIdentifier ||
+		(token.kind == sqlTokenIdentifier && !containsString(parserReservedWords, strings.ToLower(token.text))) {
+		p.advance()
+		return token.text, nil
+	}
+
+	return "", p.unexpected()
+}
+
+func (p *sqlParser) alias() (string, error) {
+	var token sqlToken
+
+	if p.acceptKeyword("as") {
+		return p.identifier()
+	}
+
+	token = p.peek()
+	if token.kind == sqlTokenQuotedIdentifier ||
+		(token.kind == sqlTokenIdentifier && !containsString(parserReservedWords, strings.ToLower(token.text))) {
+		return p.identifier()
+	}
+
+	return "", nil
+}
+
+func (p *sqlParser) placeholder(token sqlToken) (interface{}, error) {
+	var index int
+
+	if token.text == "?" {
+		index = p.state.argIndex
+		p.state.argIndex++
+	} else {
+		index, _ = strconv.Atoi(token.text[1:])
+		index--
+	}
+
+	if index+1 > p.state.placeholders {
+		p.state.placeholders = index + 1
+	}
+
+	if index < 0 || index >= len(p.args) {
+		return nil, &ParseError{
+			Position: token.position,
+			Token:    token.text,
+			Err:      fmt.Errorf(errPlaceholderCountf, ErrPlaceholderCountMismatch, index+1, len(p.args)),
+		}
+	}
+
+	return p.args[index], nil
+}
+
+func (p *sqlParser) literal() (interface{}, bool, error) {
+	var token sqlToken = p.peek()
+
+	switch {
+	case token.kind == sqlTokenNumber:
+		p.advance()
+
+		if strings.Contains(token.text, ".") {
+			var value float64
+
+			value, _ = strconv.ParseFloat(token.text, 64)
+			return value, true, nil
+		}
+
+		if value, err := strconv.ParseInt(token.text, 10, 64); err == nil {
+			return value, true, nil
+		}
+
+		return nil, true, &ParseError{Position: token.position, Token: token.text, Err: ErrUnexpectedToken}
+
+	case token.kind == sqlTokenString:
+		p.advance()
+		return token.text, true, nil
+
+	case token.kind == sqlTokenPlaceholder:
+		var (
+			value interface{}
+			err   error
+		)
+
+		p.advance()
+		value, err = p.placeholder(token)
+
+		return value, true, err
+
+	case token.isKeyword("true"), token.isKeyword("false"):
+		p.advance()
+		return strings.EqualFold(token.text, "true"), true, nil
+
+	case token.isKeyword("null"):
+		p.advance()
+		return nil, true, nil
+	}
+
+	return nil, false, nil
+}
+
+func (p *sqlParser) selectQuery() (*SelectQuery, error) {
+	var (
+		query *SelectQuery = Select()
+		field *Field
+		table *Table
+		join  *Join
+		err   error
+	)
+
+	err = p.expectKeyword("select")
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		field, err = p.selectField()
+		if err != nil {
+			return nil, err
+		}
+
+		query.Fields = append(query.Fields, field)
+		if !p.acceptSymbol(",") {
+			break
+		}
+	}
+
+	err = p.expectKeyword("from")
+	if err != nil {
+		return nil, err
+	}
+
+	table, err = p.table()
+	if err != nil {
+		return nil, err
+	}
+
+	query.From(table)
+
+	for p.peek().isKeyword("join", "inner", "left", "right", "full", "cross") {
+		join, err = p.join()
+		if err != nil {
+			return nil, err
+		}
+
+		query.Join(join)
+	}
+
+	if p.acceptKeyword("where") {
+		var filter *Filter
+
+		filter, err = p.orFilter()
+		if err != nil {
+			return nil, err
+		}
+
+		query.Where(filter)
+	}
+
+	if p.acceptKeyword("group") {
+		err = p.expectKeyword("by")
+		if err != nil {
+			return nil, err
+		}
+
+		for {
+			field, err = p.operandField()
+			if err != nil {
+				return nil, err
+			}
+
+			query.GroupByFields = append(query.GroupByFields, field)
+			if !p.acceptSymbol(",") {
+				break
+			}
+		}
+	}
+
+	if p.acceptKeyword("order") {
+		err = p.expectKeyword("by")
+		if err != nil {
+			return nil, err
+		}
+
+		for {
+			var direction SortDirection = SortDirectionAscending
+
+			field, err = p.operandField()
+			if err != nil {
+				return nil, err
+			}
+
+			if p.acceptKeyword("desc") {
+				direction = SortDirectionDescending
+			} else {
+				p.acceptKeyword("asc")
+			}
+
+			query.Sorts = append(query.Sorts, NewSort(field, direction))
+			if !p.acceptSymbol(",") {
+				break
+			}
+		}
+	}
+
+	if p.acceptKeyword("limit") {
+		var take uint64
+
+		take, err = p.unsigned()
+		if err != nil {
+			return nil, err
+		}
+
+		query.Limit(take)
+	}
+
+	if p.acceptKeyword("offset") {
+		var skip uint64
+
+		skip, err = p.unsigned()
+		if err != nil {
+			return nil, err
+		}
+
+		query.Offset(skip)
+	}
+
+	return query, nil
+}
+
+func (p *sqlParser) unsigned() (uint64, error) {
+	var (
+		token        sqlToken = p.peek()
+		value        interface{}
+		reflectValue reflect.Value
+		err          error
+	)
+
+	if token.kind != sqlTokenNumber && token.kind != sqlTokenPlaceholder {
+		return 0, p.unexpected()
+	}
+
+	value, _, err = p.literal()
+	if err != nil {
+		return 0, err
+	}
+
+	reflectValue = reflect.ValueOf(value)
+	switch reflectValue.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if reflectValue.Int() >= 0 {
+			return uint64(reflectValue.Int()), nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return reflectValue.Uint(), nil
+	}
+
+	return 0, &ParseError{Position: token.position, Token: token.text, Err: ErrUnexpectedToken}
+}
+
+func (p *sqlParser) selectField() (*Field, error) {
+	var (
+		field *Field
+		alias string
+		err   error
+	)
+
+	if p.acceptSymbol("*") {
+		return NewField("*"), nil
+	}
+
+	field, err = p.operandField()
+	if err != nil {
+		return nil, err
+	}
+
+	alias, err = p.alias()
+	if err != nil {
+		return nil, err
+	}
+
+	if alias != "" {
+		field.As(alias)
+	}
+
+	return field, nil
+}
+
+func (p *sqlParser) operandField() (*Field, error) {
+	var (
+		token   sqlToken = p.peek()
+		operand interface{}
+		err     error
+	)
+
+	operand, err = p.additive()
+	if err != nil {
+		return nil, err
+	}
+
+	switch o := operand.(type) {
+	case *Field:
+		return o, nil
+	case *Expression:
+		return NewExpressionField(o), nil
+	case *SelectQuery:
+		return NewSelectQueryField(o), nil
+	}
+
+	return nil, &ParseError{Position: token.position, Token: token.text, Err: ErrUnexpectedToken}
+}
+
+func (p *sqlParser) additive() (interface{}, error) {
+	var (
+		left interface{}
+		err  error
+	)
+
+	left, err = p.term()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().isSymbol("+") || p.peek().isSymbol("-") {
+		var (
+			operator ExpressionOperator = ExpressionOperator(p.advance().text)
+			right    interface{}
+		)
+
+		right, err = p.term()
+		if err != nil {
+			return nil, err
+		}
+
+		left = NewArithmeticExpression(operator, expressionOperand(left), expressionOperand(right))
+	}
+
+	return left, nil
+}
+
+func (p *sqlParser) term() (interface{}, error) {
+	var (
+		left interface{}
+		err  error
+	)
+
+	left, err = p.primary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().isSymbol("*") || p.peek().isSymbol("/") || p.peek().isSymbol("%") {
+		var (
+			operator ExpressionOperator = ExpressionOperator(p.advance().text)
+			right    interface{}
+		)
+
+		right, err = p.primary()
+		if err != nil {
+			return nil, err
+		}
+
+		left = NewArithmeticExpression(operator, expressionOperand(left), expressionOperand(right))
+	}
+
+	return left, nil
+}
+
+func expressionOperand(operand interface{}) interface{} {
+	if selectQuery, ok := operand.(*SelectQuery); ok {
+		return NewSelectQueryField(selectQuery)
+	}
+
+	return operand
+}
+
+func (p *sqlParser) primary() (interface{}, error) {
+	var (
+		value interface{}
+		ok    bool
+		name  string
+		err   error
+	)
+
+	if p.acceptSymbol("(") {
+		if p.peek().isKeyword("select") {
+			value, err = p.selectQuery()
+		} else {
+			value, err = p.additive()
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		err = p.expectSymbol(")")
+		if err != nil {
+			return nil, err
+		}
+
+		return value, nil
+	}
+
+	value, ok, err = p.literal()
+	if ok || err != nil {
+		return value, err
+	}
+
+	name, err = p.identifier()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.acceptSymbol("(") {
+		return p.function(name)
+	}
+
+	if p.acceptSymbol(".") {
+		if p.acceptSymbol("*") {
+			return NewField("*").FromTable(name), nil
+		}
+
+		var column string
+
+		column, err = p.identifier()
+		if err != nil {
+			return nil, err
+		}
+
+		return NewField(column).FromTable(name), nil
+	}
+
+	return NewField(name), nil
+}
+
+func (p *sqlParser) function(name string) (interface{}, error) {
+	var (
+		operands []interface{}
+		err      error
+	)
+
+	if p.acceptSymbol(")") {
+		return NewFunctionExpression(name), nil
+	}
+
+	for {
+		var operand interface{}
+
+		if p.acceptSymbol("*") {
+			operand = NewField("*")
+		} else {
+			operand, err = p.additive()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		operands = append(operands, expressionOperand(operand))
+		if !p.acceptSymbol(",") {
+			break
+		}
+	}
+
+	err = p.expectSymbol(")")
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFunctionExpression(name, operands...), nil
+}
+
+func (p *sqlParser) table() (*Table, error) {
+	var (
+		table *Table
+		name  string
+		alias string
+		err   error
+	)
+
+	if p.acceptSymbol("(") {
+		var query *SelectQuery
+
+		query, err = p.selectQuery()
+		if err != nil {
+			return nil, err
+		}
+
+		err = p.expectSymbol(")")
+		if err != nil {
+			return nil, err
+		}
+
+		alias, err = p.alias()
+		if err != nil {
+			return nil, err
+		}
+
+		if alias == "" {
+			return nil, p.unexpected()
+		}
+
+		return SelectAs(query, alias), nil
+	}
+
+	name, err = p.identifier()
+	if err != nil {
+		return nil, err
+	}
+
+	table = NewTable(name)
+	if p.acceptSymbol(".") {
+		var database string = name
+
+		name, err = p.identifier()
+		if err != nil {
+			return nil, err
+		}
+
+		table = NewTable(name).FromDatabase(database)
+	}
+
+	alias, err = p.alias()
+	if err != nil {
+		return nil, err
+	}
+
+	if alias != "" {
+		table.As(alias)
+	}
+
+	return table, nil
+}
+
+func (p *sqlParser) join() (*Join, error) {
+	var (
+		joinType JoinType = InnerJoinType
+		join     *Join
+		table    *Table
+		err      error
+	)
+
+	if !p.peek().isKeyword("join") {
+		var keyword string = strings.ToLower(p.advance().text)
+
+		joinType = parserJoinTypeMap[keyword]
+		if keyword == "left" || keyword == "right" || keyword == "full" {
+			p.acceptKeyword("outer")
+		}
+	}
+
+	err = p.expectKeyword("join")
+	if err != nil {
+		return nil, err
+	}
+
+	table, err = p.table()
+	if err != nil {
+		return nil, err
+	}
+
+	join = &Join{
+		Type:  joinType,
+		Table: table,
+	}
+
+	if p.acceptKeyword("on") {
+		var filter *Filter
+
+		filter, err = p.orFilter()
+		if err != nil {
+			return nil, err
+		}
+
+		join.On(filter)
+	}
+
+	return join, nil
+}
+
+func (p *sqlParser) orFilter() (*Filter, error) {
+	return p.logicFilter(LogicOr, "or", p.andFilter)
+}
+
+func (p *sqlParser) andFilter() (*Filter, error) {
+	return p.logicFilter(LogicAnd, "and", p.groupFilter)
+}
+
+func (p *sqlParser) logicFilter(logic Logic, keyword string, operand func() (*Filter, error)) (*Filter, error) {
+	var (
+		filters []*Filter
+		filter  *Filter
+		err     error
+	)
+
+	for {
+		filter, err = operand()
+		if err != nil {
+			return nil, err
+		}
+
+		filters = append(filters, filter)
+		if !p.acceptKeyword(keyword) {
+			break
+		}
+	}
+
+	if len(filters) == 1 {
+		return filters[0], nil
+	}
+
+	return newLogicFilter(logic, filters), nil
+}
+
+func (p *sqlParser) groupFilter() (*Filter, error) {
+	var (
+		state  sqlParserState = p.state
+		filter *Filter
+		err    error
+	)
+
+	if p.peek().isSymbol("(") && !p.peekAt(1).isKeyword("select") {
+		p.advance()
+
+		filter, err = p.orFilter()
+		if err == nil && p.acceptSymbol(")") {
+			return filter, nil
+		}
+
+		p.state = state
+	}
+
+	return p.condition()
+}
+
+func (p *sqlParser) condition() (*Filter, error) {
+	var (
+		field   *Field
+		not     bool
+		operand interface{}
+		err     error
+	)
+
+	field, err = p.operandField()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.acceptKeyword("is") {
+		var operator Operator = OperatorIsNull
+
+		if p.acceptKeyword("not") {
+			operator = OperatorIsNotNull
+		}
+
+		err = p.expectKeyword("null")
+		if err != nil {
+			return nil, err
+		}
+
+		return NewFilter().SetCondition(field, operator, nil), nil
+	}
+
+	not = p.acceptKeyword("not")
+
+	switch {
+	case p.acceptKeyword("in"):
+		var value *FilterValue
+
+		value, err = p.inValue()
+		if err != nil {
+			return nil, err
+		}
+
+		if not {
+			return NewFilter().SetCondition(field, OperatorNotIn, value), nil
+		}
+
+		return NewFilter().SetCondition(field, OperatorIn, value), nil
+
+	case p.acceptKeyword("like"):
+		var (
+			token   sqlToken = p.peek()
+			pattern *FilterValue
+		)
+
+		operand, err = p.additive()
+		if err != nil {
+			return nil, err
+		}
+
+		pattern = filterValueOf(operand)
+		if !pattern.isExpression() && !isStringValue(pattern.Value) {
+			return nil, &ParseError{Position: token.position, Token: token.text, Err: ErrLikePatternIsUnsupported}
+		}
+
+		if not {
+			return NewFilter().SetCondition(field, OperatorNotLikePattern, pattern), nil
+		}
+
+		return NewFilter().SetCondition(field, OperatorLikePattern, pattern), nil
+
+	case !not && p.acceptKeyword("between"):
+		var upper interface{}
+
+		operand, err = p.additive()
+		if err != nil {
+			return nil, err
+		}
+
+		err = p.expectKeyword("and")
+		if err != nil {
+			return nil, err
+		}
+
+		upper, err = p.additive()
+		if err != nil {
+			return nil, err
+		}
+
+		return And(
+			NewFilter().SetCondition(field, OperatorGreaterThanOrEqual, filterValueOf(operand)),
+			NewFilter().SetCondition(field, OperatorLessThanOrEqual, filterValueOf(upper)),
+		), nil
+	}
+
+	if operator, ok := parserComparisonOperatorMap[p.peek().text]; ok && !not && p.peek().kind == sqlTokenSymbol {
+		p.advance()
+
+		operand, err = p.additive()
+		if err != nil {
+			return nil, err
+		}
+
+		return NewFilter().SetCondition(field, operator, filterValueOf(operand)), nil
+	}
+
+	return nil, p.unexpected()
+}
+
+func (p *sqlParser) inValue() (*FilterValue, error) {
+	var (
+		values []interface{}
+		err    error
+	)
+
+	err = p.expectSymbol("(")
+	if err != nil {
+		return nil, err
+	}
+
+	if p.peek().isKeyword("select") {
+		var query *SelectQuery
+
+		query, err = p.selectQuery()
+		if err != nil {
+			return nil, err
+		}
+
+		err = p.expectSymbol(")")
+		if err != nil {
+			return nil, err
+		}
+
+		return NewSelectQueryFilterValue(query), nil
+	}
+
+	values = []interface{}{}
+	for {
+		var (
+			value interface{}
+			ok    bool
+		)
+
+		value, ok, err = p.literal()
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			return nil, p.unexpected()
+		}
+
+		values = append(values, value)
+		if !p.acceptSymbol(",") {
+			break
+		}
+	}
+
+	err = p.expectSymbol(")")
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFilterValue(values), nil
+}
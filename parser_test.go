@@ -0,0 +1,362 @@
+package goqube
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParser_ParseSelect(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		SQL         string
+		Args        []interface{}
+		Expectation struct {
+			Query *SelectQuery
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		SQL         string
+		Args        []interface{}
+		Expectation struct {
+			Query *SelectQuery
+			Err   error
+		}
+	}{
+		{
+			Name: "sql is empty",
+			SQL:  "",
+			Args: nil,
+			Expectation: struct {
+				Query *SelectQuery
+				Err   error
+			}{
+				Query: nil,
+				Err:   ErrUnexpectedEndOfSQL,
+			},
+		},
+		{
+			Name: "sql is not select",
+			SQL:  "delete from users",
+			Args: nil,
+			Expectation: struct {
+				Query *SelectQuery
+				Err   error
+			}{
+				Query: nil,
+				Err:   ErrUnexpectedToken,
+			},
+		},
+		{
+			Name: "where is incomplete",
+			SQL:  "select id from users where",
+			Args: nil,
+			Expectation: struct {
+				Query *SelectQuery
+				Err   error
+			}{
+				Query: nil,
+				Err:   ErrUnexpectedEndOfSQL,
+			},
+		},
+		{
+			Name: "string is not closed",
+			SQL:  "select id from users where name = 'john",
+			Args: nil,
+			Expectation: struct {
+				Query *SelectQuery
+				Err   error
+			}{
+				Query: nil,
+				Err:   ErrUnexpectedEndOfSQL,
+			},
+		},
+		{
+			Name: "args is less than placeholders",
+			SQL:  "select id from users where id = ? and status = ?",
+			Args: []interface{}{1},
+			Expectation: struct {
+				Query *SelectQuery
+				Err   error
+			}{
+				Query: nil,
+				Err:   ErrPlaceholderCountMismatch,
+			},
+		},
+		{
+			Name: "args is more than placeholders",
+			SQL:  "select id from users where id = $1",
+			Args: []interface{}{1, 2},
+			Expectation: struct {
+				Query *SelectQuery
+				Err   error
+			}{
+				Query: nil,
+				Err:   ErrPlaceholderCountMismatch,
+			},
+		},
+		{
+			Name: "select distinct is unsupported",
+			SQL:  "select distinct a from t",
+			Args: nil,
+			Expectation: struct {
+				Query *SelectQuery
+				Err   error
+			}{
+				Query: nil,
+				Err:   ErrUnexpectedToken,
+			},
+		},
+		{
+			Name: "select all is unsupported",
+			SQL:  "select all a from t",
+			Args: nil,
+			Expectation: struct {
+				Query *SelectQuery
+				Err   error
+			}{
+				Query: nil,
+				Err:   ErrUnexpectedToken,
+			},
+		},
+		{
+			Name: "natural join is unsupported",
+			SQL:  "select a from t natural join u",
+			Args: nil,
+			Expectation: struct {
+				Query *SelectQuery
+				Err   error
+			}{
+				Query: nil,
+				Err:   ErrUnexpectedToken,
+			},
+		},
+		{
+			Name: "having is unsupported",
+			SQL:  "select a from t group by a having count(*) > 1",
+			Args: nil,
+			Expectation: struct {
+				Query *SelectQuery
+				Err   error
+			}{
+				Query: nil,
+				Err:   ErrUnexpectedToken,
+			},
+		},
+		{
+			Name: "union is unsupported",
+			SQL:  "select a from t union select a from u",
+			Args: nil,
+			Expectation: struct {
+				Query *SelectQuery
+				Err   error
+			}{
+				Query: nil,
+				Err:   ErrUnexpectedToken,
+			},
+		},
+		{
+			Name: "for update is unsupported",
+			SQL:  "select a from t for update",
+			Args: nil,
+			Expectation: struct {
+				Query *SelectQuery
+				Err   error
+			}{
+				Query: nil,
+				Err:   ErrUnexpectedToken,
+			},
+		},
+		{
+			Name: "window function is unsupported",
+			SQL:  "select count(*) over (partition by a) from t",
+			Args: nil,
+			Expectation: struct {
+				Query *SelectQuery
+				Err   error
+			}{
+				Query: nil,
+				Err:   ErrUnexpectedToken,
+			},
+		},
+		{
+			Name: "join using is unsupported",
+			SQL:  "select a from t join u using (a)",
+			Args: nil,
+			Expectation: struct {
+				Query *SelectQuery
+				Err   error
+			}{
+				Query: nil,
+				Err:   ErrUnexpectedToken,
+			},
+		},
+		{
+			Name: "like pattern is unsupported",
+			SQL:  "select id from users where name like 1",
+			Args: nil,
+			Expectation: struct {
+				Query *SelectQuery
+				Err   error
+			}{
+				Query: nil,
+				Err:   ErrLikePatternIsUnsupported,
+			},
+		},
+		{
+			Name: "select with join, filter, group, order, limit and offset",
+			SQL: "SELECT u.id, name AS n, o.*, count(*) c FROM users u " +
+				"LEFT OUTER JOIN orders AS o ON o.user_id = u.id " +
+				"WHERE status = $1 AND (deleted_at IS NULL OR role IN ($2, $3)) " +
+				"GROUP BY u.id ORDER BY id DESC, name LIMIT $4 OFFSET 5;",
+			Args: []interface{}{"active", "admin", "owner", 10},
+			Expectation: struct {
+				Query *SelectQuery
+				Err   error
+			}{
+				Query: Select(
+					NewField("id").FromTable("u"),
+					NewField("name").As("n"),
+					NewField("*").FromTable("o"),
+					NewExpressionField(NewFunctionExpression("count", NewField("*"))).As("c"),
+				).
+					From(NewTable("users").As("u")).
+					Join(LeftJoin(NewTable("orders").As("o")).On(NewFilter().SetCondition(NewField("user_id").FromTable("o"), OperatorEqual, NewColumnFilterValue("id").FromTable("u")))).
+					Where(And(Eq("status", "active"), Or(IsNull("deleted_at"), In("role", []interface{}{"admin", "owner"})))).
+					GroupBy(NewField("id").FromTable("u")).
+					OrderBy(NewSort(NewField("id"), SortDirectionDescending), NewSort(NewField("name"), SortDirectionAscending)).
+					Limit(10).
+					Offset(5),
+				Err: nil,
+			},
+		},
+		{
+			Name: "select with subqueries, expressions and literals",
+			SQL: "select price * quantity as total from (select * from db.items) as i " +
+				"where quantity between 1 and 10 and name not like '%test%' and code like 'A%' " +
+				"and id not in (select item_id from refunds) and active = true and note is not null",
+			Args: nil,
+			Expectation: struct {
+				Query *SelectQuery
+				Err   error
+			}{
+				Query: Select(NewExpressionField(NewArithmeticExpression(ExpressionOperatorMultiply, NewField("price"), NewField("quantity"))).As("total")).
+					From(SelectAs(Select(NewField("*")).From(NewTable("items").FromDatabase("db")), "i")).
+					Where(And(
+						And(Gte("quantity", int64(1)), Lte("quantity", int64(10))),
+						NewFilter().SetCondition(NewField("name"), OperatorNotLikePattern, NewFilterValue("%test%")),
+						NewFilter().SetCondition(NewField("code"), OperatorLikePattern, NewFilterValue("A%")),
+						NewFilter().SetCondition(NewField("id"), OperatorNotIn, NewSelectQueryFilterValue(Select(NewField("item_id")).From(NewTable("refunds")))),
+						Eq("active", true),
+						IsNotNull("note"),
+					)),
+				Err: nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actual    *SelectQuery
+				actualErr error
+			)
+
+			actual, actualErr = ParseSelect(testCases[i].SQL, testCases[i].Args...)
+
+			if !errors.Is(actualErr, testCases[i].Expectation.Err) {
+				t.Errorf("expectation error is %v, got %v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Query == nil {
+				if actual != nil {
+					t.Errorf("expectation query is nil, got %+v", actual)
+				}
+
+				return
+			}
+
+			for _, difference := range Diff(testCases[i].Expectation.Query, actual) {
+				t.Errorf("expectation query is equal, got difference %s", difference.String())
+			}
+		})
+	}
+}
+
+func TestParser_ParseError(t *testing.T) {
+	var err error
+
+	_, err = ParseSelect("select id from users where id = = 1")
+
+	if err == nil || err.Error() != `unexpected token: "=" at position 32` {
+		t.Errorf("expectation error is unexpected token: \"=\" at position 32, got %v", err)
+	}
+}
+
+func TestParser_RoundTrip(t *testing.T) {
+	var testCases []struct {
+		Name    string
+		Dialect Dialect
+		SQL     string
+		Args    []interface{}
+	} = []struct {
+		Name    string
+		Dialect Dialect
+		SQL     string
+		Args    []interface{}
+	}{
+		{
+			Name:    "dialect postgres",
+			Dialect: DialectPostgres,
+			SQL:     "select u.id, name as n, count(*) as c from users as u inner join orders as o on o.user_id = u.id where status = $1 and (x is null or y in ($2, $3)) group by u.id order by id desc limit $4 offset $5",
+			Args:    []interface{}{"active", 1, 2, 10, 5},
+		},
+		{
+			Name:    "dialect mysql",
+			Dialect: DialectMySQL,
+			SQL:     "select id from users where age >= ? and id in (select user_id from orders where total > ?) order by id asc limit ?",
+			Args:    []interface{}{18, 100, 10},
+		},
+		{
+			Name:    "like patterns with dialect postgres",
+			Dialect: DialectPostgres,
+			SQL:     "select id from users where name like $1 and email like $2 and code like $3 and bio not like $4 and nick like $5",
+			Args:    []interface{}{"Jo%", "%@Mail.com", "%Go%", "%Spam%", "j_n"},
+		},
+		{
+			Name:    "like patterns with dialect mysql",
+			Dialect: DialectMySQL,
+			SQL:     "select id from users where name like ? and bio not like ?",
+			Args:    []interface{}{"Jo%", "%Spam%"},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				query       *SelectQuery
+				actualQuery string
+				actualArgs  []interface{}
+				err         error
+			)
+
+			query, err = ParseSelect(testCases[i].SQL, testCases[i].Args...)
+			if err != nil {
+				t.Fatalf("expectation error is nil, got %s", err.Error())
+			}
+
+			actualQuery, actualArgs, err = query.ToSQLWithArgs(testCases[i].Dialect, []interface{}{})
+			if err != nil {
+				t.Errorf("expectation error is nil, got %s", err.Error())
+			}
+
+			if testCases[i].SQL != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].SQL, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Args, actualArgs) {
+				t.Errorf("expectation args is %v, got %v", testCases[i].Args, actualArgs)
+			}
+		})
+	}
+}
@@ -0,0 +1,363 @@
+package goqube
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+var planPackagePath string = reflect.TypeOf(SelectQuery{}).PkgPath()
+
+type queryPlan struct {
+	query   string
+	args    []interface{}
+	sources []int
+}
+
+type PlanCache struct {
+	MaxEntries int
+	mu         sync.RWMutex
+	plans      map[string]*queryPlan
+}
+
+func NewPlanCache(maxEntries int) *PlanCache {
+	return &PlanCache{
+		MaxEntries: maxEntries,
+		plans:      map[string]*queryPlan{},
+	}
+}
+
+func (p *PlanCache) Len() int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return len(p.plans)
+}
+
+func (p *PlanCache) Clear() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.plans = map[string]*queryPlan{}
+}
+
+func (p *PlanCache) lookup(key []byte) *queryPlan {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.plans[string(key)]
+}
+
+func (p *PlanCache) store(key string, plan *queryPlan) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.plans == nil {
+		p.plans = map[string]*queryPlan{}
+	}
+
+	if p.MaxEntries > 0 && len(p.plans) >= p.MaxEntries {
+		return
+	}
+
+	p.plans[key] = plan
+}
+
+func (p *PlanCache) build(bc *buildContext, query Query) (string, []interface{}, error) {
+	var (
+		fingerprint *planFingerprint = getPlanFingerprint()
+		plan        *queryPlan
+		sql         string
+		args        []interface{}
+		err         error
+	)
+
+	defer putPlanFingerprint(fingerprint)
+
+	fingerprint.key = strconv.AppendUint(fingerprint.key, uint64(reflect.ValueOf(bc.config).Pointer()), 16)
+	fingerprint.key = append(fingerprint.key, '|')
+	fingerprint.key = append(fingerprint.key, bc.dialect...)
+	fingerprint.key = append(fingerprint.key, '|')
+	fingerprint.writeString(reflect.TypeOf(query).String())
+	fingerprint.write(reflect.ValueOf(query))
+
+	plan = p.lookup(fingerprint.key)
+	if plan != nil {
+		return plan.query, plan.bind(fingerprint.values), nil
+	}
+
+	sql, args, err = query.toSQLWithArgs(bc, []interface{}{})
+	if err != nil {
+		return "", nil, err
+	}
+
+	plan = newQueryPlan(sql, args, fingerprint.values)
+	if plan != nil {
+		p.store(string(fingerprint.key), plan)
+	}
+
+	return sql, args, nil
+}
+
+func newQueryPlan(sql string, args, values []interface{}) *queryPlan {
+	var (
+		plan *queryPlan
+		used []bool
+	)
+
+	plan = &queryPlan{
+		query:   sql,
+		args:    make([]interface{}, len(args)),
+		sources: make([]int, len(args)),
+	}
+	used = make([]bool, len(values))
+
+	for i := range args {
+		plan.sources[i] = -1
+
+		for j := range values {
+			if !reflect.DeepEqual(args[i], values[j]) {
+				continue
+			}
+
+			if plan.sources[i] >= 0 || used[j] {
+				return nil
+			}
+
+			plan.sources[i] = j
+			used[j] = true
+		}
+
+		if plan.sources[i] < 0 {
+			plan.args[i] = args[i]
+		}
+	}
+
+	for j := range used {
+		if !used[j] {
+			return nil
+		}
+	}
+
+	return plan
+}
+
+func (p *queryPlan) bind(values []interface{}) []interface{} {
+	var args []interface{} = make([]interface{}, len(p.sources))
+
+	for i := range p.sources {
+		args[i] = p.args[i]
+		if p.sources[i] >= 0 {
+			args[i] = values[p.sources[i]]
+		}
+	}
+
+	return args
+}
+
+type planFingerprint struct {
+	key    []byte
+	values []interface{}
+}
+
+var planFingerprintPool sync.Pool = sync.Pool{
+	New: func() interface{} {
+		return &planFingerprint{key: make([]byte, 0, 1024)}
+	},
+}
+
+func getPlanFingerprint() *planFingerprint {
+	return planFingerprintPool.Get().(*planFingerprint)
+}
+
+func putPlanFingerprint(fingerprint *planFingerprint) {
+	if cap(fingerprint.key) > maxPooledBufferSize {
+		return
+	}
+
+	for i := range fingerprint.values {
+		fingerprint.values[i] = nil
+	}
+
+	fingerprint.key = fingerprint.key[:0]
+	fingerprint.values = fingerprint.values[:0]
+	planFingerprintPool.Put(fingerprint)
+}
+
+func isPlanStructure(value reflect.Value) bool {
+	if value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+
+	return value.Kind() == reflect.Struct && value.Type().PkgPath() == planPackagePath
+}
+
+func isPlanLeaf(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Ptr:
+		return !value.IsNil() && !isPlanStructure(value)
+	case reflect.Slice, reflect.Array:
+		return value.Type().Elem().Kind() == reflect.Uint8
+	case reflect.Interface, reflect.Invalid:
+		return false
+	}
+
+	return !isPlanStructure(value)
+}
+
+func (f *planFingerprint) writeString(value string) {
+	f.key = strconv.AppendInt(f.key, int64(len(value)), 10)
+	f.key = append(f.key, ':')
+	f.key = append(f.key, value...)
+}
+
+func (f *planFingerprint) write(value reflect.Value) {
+	switch value.Kind() {
+	case reflect.Invalid:
+		f.key = append(f.key, 'n')
+
+	case reflect.Ptr:
+		if value.IsNil() {
+			f.key = append(f.key, 'n')
+			return
+		}
+
+		f.key = append(f.key, '&')
+		f.write(value.Elem())
+
+	case reflect.Interface:
+		if value.IsNil() {
+			f.key = append(f.key, 'n')
+			return
+		}
+
+		if isPlanLeaf(value.Elem()) && value.CanInterface() {
+			f.writeValue(value.Elem().Type(), value.Interface())
+			return
+		}
+
+		f.writeString(value.Elem().Type().String())
+		f.writeElement(value.Elem())
+
+	case reflect.Struct:
+		f.key = append(f.key, '{')
+		for i := 0; i < value.NumField(); i++ {
+			f.write(value.Field(i))
+			f.key = append(f.key, ',')
+		}
+		f.key = append(f.key, '}')
+
+	case reflect.Slice, reflect.Array:
+		f.key = append(f.key, '[')
+		f.key = strconv.AppendInt(f.key, int64(value.Len()), 10)
+		f.key = append(f.key, ':')
+		for i := 0; i < value.Len(); i++ {
+			f.write(value.Index(i))
+			f.key = append(f.key, ',')
+		}
+		f.key = append(f.key, ']')
+
+	case reflect.Map:
+		var keys []reflect.Value = value.MapKeys()
+
+		sort.Slice(keys, func(i, j int) bool {
+			if keys[i].Kind() == reflect.String && keys[j].Kind() == reflect.String {
+				return keys[i].String() < keys[j].String()
+			}
+
+			return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j])
+		})
+
+		f.key = append(f.key, "map["...)
+		f.key = strconv.AppendInt(f.key, int64(value.Len()), 10)
+		f.key = append(f.key, ':')
+		for i := range keys {
+			f.write(keys[i])
+			f.key = append(f.key, '=')
+			f.write(value.MapIndex(keys[i]))
+			f.key = append(f.key, ',')
+		}
+		f.key = append(f.key, ']')
+
+	case reflect.String:
+		f.writeString(value.String())
+
+	case reflect.Bool:
+		f.key = strconv.AppendBool(f.key, value.Bool())
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		f.key = strconv.AppendInt(f.key, value.Int(), 10)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		f.key = strconv.AppendUint(f.key, value.Uint(), 10)
+
+	case reflect.Float32, reflect.Float64:
+		f.key = strconv.AppendFloat(f.key, value.Float(), 'g', -1, 64)
+
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		f.writeString(value.Type().String())
+		f.key = strconv.AppendUint(f.key, uint64(value.Pointer()), 16)
+
+	default:
+		f.key = append(f.key, fmt.Sprintf("%s(%v)", value.Type(), value)...)
+	}
+}
+
+func (f *planFingerprint) writeElement(value reflect.Value) {
+	if isPlanStructure(value) || !value.CanInterface() {
+		f.write(value)
+		return
+	}
+
+	if (value.Kind() == reflect.Slice || value.Kind() == reflect.Array) && value.Type().Elem().Kind() != reflect.Uint8 {
+		f.writeString(value.Type().String())
+		f.key = append(f.key, '[')
+		f.key = strconv.AppendInt(f.key, int64(value.Len()), 10)
+		f.key = append(f.key, ':')
+		for i := 0; i < value.Len(); i++ {
+			f.writeElement(value.Index(i))
+			f.key = append(f.key, ',')
+		}
+		f.key = append(f.key, ']')
+		return
+	}
+
+	if value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			f.key = append(f.key, 'n')
+			return
+		}
+
+		if isPlanLeaf(value.Elem()) {
+			f.writeValue(value.Elem().Type(), value.Interface())
+			return
+		}
+
+		f.writeString(value.Elem().Type().String())
+		f.writeElement(value.Elem())
+		return
+	}
+
+	if value.Kind() == reflect.Ptr && value.IsNil() {
+		f.writeString(value.Type().String())
+		f.key = append(f.key, 'n')
+		return
+	}
+
+	f.writeValue(value.Type(), value.Interface())
+}
+
+func (f *planFingerprint) writeValue(valueType reflect.Type, value interface{}) {
+	f.writeString(valueType.String())
+
+	if _, isValuer := value.(driver.Valuer); isValuer && isNilValue(value) {
+		f.key = append(f.key, 'n')
+		return
+	}
+
+	f.key = append(f.key, '?')
+	f.values = append(f.values, value)
+}
@@ -0,0 +1,282 @@
+package goqube
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestPlanCache_NewPlanCache(t *testing.T) {
+	var actual *PlanCache = NewPlanCache(10)
+
+	if actual.MaxEntries != 10 {
+		t.Errorf("expectation max entries is %d, got %d", 10, actual.MaxEntries)
+	}
+
+	if actual.Len() != 0 {
+		t.Errorf("expectation length is %d, got %d", 0, actual.Len())
+	}
+}
+
+func TestPlanCache_build(t *testing.T) {
+	var (
+		selectQuery func(status string, ids []int64) *SelectQuery = func(status string, ids []int64) *SelectQuery {
+			return Select(NewField("id"), NewField("name")).
+				From(NewTable("users")).
+				Where(NewFilter().SetLogic(LogicAnd).AddFilters(
+					NewFilter().SetCondition(NewField("status"), OperatorEqual, NewFilterValue(status)),
+					NewFilter().SetCondition(NewField("id"), OperatorIn, NewFilterValue(ids)),
+				)).
+				Limit(10)
+		}
+		testCases []struct {
+			Name        string
+			Queries     []Query
+			Expectation struct {
+				Query string
+				Args  []interface{}
+				Len   int
+				Err   error
+			}
+		}
+	)
+
+	testCases = []struct {
+		Name        string
+		Queries     []Query
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Len   int
+			Err   error
+		}
+	}{
+		{
+			Name:    "invalid query is not cached",
+			Queries: []Query{Select().From(NewTable("users"))},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Len   int
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Len:   0,
+				Err:   ErrFieldsIsRequired,
+			},
+		},
+		{
+			Name:    "same shape with different values",
+			Queries: []Query{selectQuery("active", []int64{1, 2}), selectQuery("inactive", []int64{3, 4})},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Len   int
+				Err   error
+			}{
+				Query: "select id, name from users where status = $1 and id in ($2, $3) limit $4",
+				Args:  []interface{}{"inactive", int64(3), int64(4), uint64(10)},
+				Len:   1,
+				Err:   nil,
+			},
+		},
+		{
+			Name:    "different in list length",
+			Queries: []Query{selectQuery("active", []int64{1, 2}), selectQuery("inactive", []int64{3})},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Len   int
+				Err   error
+			}{
+				Query: "select id, name from users where status = $1 and id in ($2) limit $3",
+				Args:  []interface{}{"inactive", int64(3), uint64(10)},
+				Len:   2,
+				Err:   nil,
+			},
+		},
+		{
+			Name:    "ambiguous values are not cached",
+			Queries: []Query{Update("users").Set("status", "active").Where(NewFilter().SetCondition(NewField("status"), OperatorEqual, NewFilterValue("active")))},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Len   int
+				Err   error
+			}{
+				Query: "update users set status = $1 where status = $2",
+				Args:  []interface{}{"active", "active"},
+				Len:   0,
+				Err:   nil,
+			},
+		},
+		{
+			Name: "nil valuer is not served from cache",
+			Queries: []Query{
+				Select(NewField("id")).From(NewTable("users")).Where(NewFilter().SetCondition(NewField("a"), OperatorEqual, NewFilterValue(sql.NullString{String: "x", Valid: true}))),
+				Select(NewField("id")).From(NewTable("users")).Where(NewFilter().SetCondition(NewField("a"), OperatorEqual, NewFilterValue(sql.NullString{}))),
+			},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Len   int
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Len:   1,
+				Err:   ErrComparisonWithNil,
+			},
+		},
+		{
+			Name:    "map values are bound in rendering order",
+			Queries: []Query{Insert().Into("users").Value("name", "user1").Value("email", "user1@example.com"), Insert().Into("users").Value("name", "user2").Value("email", "user2@example.com")},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Len   int
+				Err   error
+			}{
+				Query: "insert into users(email, name) values ($1, $2)",
+				Args:  []interface{}{"user2@example.com", "user2"},
+				Len:   1,
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				config      *Config = NewConfig(DialectPostgres).SetPlanCache(NewPlanCache(0))
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			for j := range testCases[i].Queries {
+				actualQuery, actualArgs, actualErr = config.Build(testCases[i].Queries[j])
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && !errors.Is(actualErr, testCases[i].Expectation.Err) {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %+v, got %+v", testCases[i].Expectation.Args, actualArgs)
+			}
+
+			if testCases[i].Expectation.Len != config.PlanCache.Len() {
+				t.Errorf("expectation length is %d, got %d", testCases[i].Expectation.Len, config.PlanCache.Len())
+			}
+		})
+	}
+}
+
+func TestPlanCache_MaxEntries(t *testing.T) {
+	var (
+		config *Config = NewConfig(DialectMySQL).SetPlanCache(NewPlanCache(1))
+		err    error
+	)
+
+	for i := 1; i <= 3; i++ {
+		var (
+			query string
+			args  []interface{}
+		)
+
+		query, args, err = config.Build(Select(NewField("id")).From(NewTable(fmt.Sprintf("table%d", i))).Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(i))))
+		if err != nil {
+			t.Fatalf("expectation error is nil, got %s", err.Error())
+		}
+
+		if query != fmt.Sprintf("select id from table%d where id = ?", i) {
+			t.Errorf("expectation query is %s, got %s", fmt.Sprintf("select id from table%d where id = ?", i), query)
+		}
+
+		if !deepEqual([]interface{}{i}, args) {
+			t.Errorf("expectation args is %+v, got %+v", []interface{}{i}, args)
+		}
+	}
+
+	if config.PlanCache.Len() != 1 {
+		t.Errorf("expectation length is %d, got %d", 1, config.PlanCache.Len())
+	}
+
+	config.PlanCache.Clear()
+	if config.PlanCache.Len() != 0 {
+		t.Errorf("expectation length is %d, got %d", 0, config.PlanCache.Len())
+	}
+}
+
+func benchmarkPlanCacheQuery() *SelectQuery {
+	var (
+		selectQuery *SelectQuery
+		filter      *Filter = NewFilter().SetLogic(LogicAnd)
+	)
+
+	selectQuery = Select(NewField("id").FromTable("u"), NewField("name").FromTable("u"), NewField("email").FromTable("u")).
+		From(NewTable("users").As("u"))
+
+	for i := 1; i <= 8; i++ {
+		var alias string = fmt.Sprintf("t%d", i)
+
+		selectQuery.Fields = append(selectQuery.Fields, NewField("value").FromTable(alias).As(fmt.Sprintf("value%d", i)))
+		selectQuery.Join(LeftJoin(NewTable(fmt.Sprintf("table%d", i)).As(alias)).On(NewFilter().SetCondition(NewField("user_id").FromTable(alias), OperatorEqual, NewFilterValue(NewField("id").FromTable("u")))))
+		filter.AddFilters(NewFilter().SetCondition(NewField("status").FromTable(alias), OperatorIn, NewFilterValue([]string{fmt.Sprintf("active%d", i), fmt.Sprintf("pending%d", i), fmt.Sprintf("verified%d", i)})))
+	}
+
+	return selectQuery.
+		Where(filter).
+		OrderBy(NewSort(NewField("name").FromTable("u"), SortDirectionAscending)).
+		Limit(50).
+		Offset(100)
+}
+
+func BenchmarkConfig_Build(b *testing.B) {
+	var (
+		config      *Config      = NewConfig(DialectPostgres)
+		selectQuery *SelectQuery = benchmarkPlanCacheQuery()
+	)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _, err := config.Build(selectQuery)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkConfig_BuildWithPlanCache(b *testing.B) {
+	var (
+		config      *Config      = NewConfig(DialectPostgres).SetPlanCache(NewPlanCache(0))
+		selectQuery *SelectQuery = benchmarkPlanCacheQuery()
+	)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _, err := config.Build(selectQuery)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package goqube
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+type Difference struct {
+	Path  string
+	Left  interface{}
+	Right interface{}
+}
+
+func (d *Difference) String() string {
+	return fmt.Sprintf(errDifferencef, d.Path, d.Left, d.Right)
+}
+
+func Equal(a, b *SelectQuery) bool {
+	return len(Diff(a, b)) == 0
+}
+
+func Diff(a, b *SelectQuery) []*Difference {
+	var differences []*Difference = []*Difference{}
+
+	diffValue("", reflect.ValueOf(a), reflect.ValueOf(b), &differences)
+
+	return differences
+}
+
+func diffInterface(value reflect.Value) interface{} {
+	if !value.IsValid() || !value.CanInterface() {
+		return nil
+	}
+
+	return value.Interface()
+}
+
+func diffValue(path string, left, right reflect.Value, differences *[]*Difference) {
+	var addDifference func()
+
+	addDifference = func() {
+		*differences = append(*differences, &Difference{
+			Path:  path,
+			Left:  diffInterface(left),
+			Right: diffInterface(right),
+		})
+	}
+
+	if !left.IsValid() || !right.IsValid() {
+		if left.IsValid() != right.IsValid() {
+			addDifference()
+		}
+
+		return
+	}
+
+	if left.Type() != right.Type() {
+		addDifference()
+		return
+	}
+
+	switch left.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if left.IsNil() || right.IsNil() {
+			if left.IsNil() != right.IsNil() {
+				addDifference()
+			}
+
+			return
+		}
+
+		diffValue(path, left.Elem(), right.Elem(), differences)
+
+	case reflect.Struct:
+		var exported int
+
+		for i := 0; left.Type().PkgPath() == planPackagePath && i < left.NumField(); i++ {
+			if left.Type().Field(i).PkgPath != "" {
+				continue
+			}
+
+			exported++
+			diffValue(validationPath(path, left.Type().Field(i).Name), left.Field(i), right.Field(i), differences)
+		}
+
+		if exported == 0 && !reflect.DeepEqual(diffInterface(left), diffInterface(right)) {
+			addDifference()
+		}
+
+	case reflect.Slice, reflect.Array:
+		if left.Len() != right.Len() {
+			addDifference()
+			return
+		}
+
+		for i := 0; i < left.Len(); i++ {
+			diffValue(fmt.Sprintf("%s[%d]", path, i), left.Index(i), right.Index(i), differences)
+		}
+
+	case reflect.Map:
+		var keys []reflect.Value
+
+		keys = append(keys, left.MapKeys()...)
+		for _, key := range right.MapKeys() {
+			if !left.MapIndex(key).IsValid() {
+				keys = append(keys, key)
+			}
+		}
+
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j])
+		})
+
+		for i := range keys {
+			diffValue(fmt.Sprintf("%s[%v]", path, keys[i]), left.MapIndex(keys[i]), right.MapIndex(keys[i]), differences)
+		}
+
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		if left.Pointer() != right.Pointer() {
+			addDifference()
+		}
+
+	default:
+		if !reflect.DeepEqual(diffInterface(left), diffInterface(right)) {
+			addDifference()
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package goqube
+
+import "testing"
+
+func TestQueryDiff_Diff(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		A           *SelectQuery
+		B           *SelectQuery
+		Expectation []*Difference
+	} = []struct {
+		Name        string
+		A           *SelectQuery
+		B           *SelectQuery
+		Expectation []*Difference
+	}{
+		{
+			Name:        "both queries are nil",
+			A:           nil,
+			B:           nil,
+			Expectation: []*Difference{},
+		},
+		{
+			Name:        "queries are equal",
+			A:           Select(NewField("id")).From(NewTable("users")).Where(Eq("id", 1)).Limit(10),
+			B:           Select(NewField("id")).From(NewTable("users")).Where(Eq("id", 1)).Limit(10),
+			Expectation: []*Difference{},
+		},
+		{
+			Name:        "nil and empty slices are equal",
+			A:           &SelectQuery{Fields: nil, Comments: nil},
+			B:           &SelectQuery{Fields: []*Field{}, Comments: map[string]string{}},
+			Expectation: []*Difference{},
+		},
+		{
+			Name: "field, filter value and take are different",
+			A:    Select(NewField("id"), NewField("name")).From(NewTable("users")).Where(And(Eq("id", 1), IsNull("deleted_at"))).Limit(10),
+			B:    Select(NewField("id"), NewField("email")).From(NewTable("users")).Where(And(Eq("id", 2), IsNull("deleted_at"))).Limit(20),
+			Expectation: []*Difference{
+				{Path: "Fields[1].Column", Left: "name", Right: "email"},
+				{Path: "Filter.Filters[0].Value.Value", Left: 1, Right: 2},
+				{Path: "Take", Left: uint64(10), Right: uint64(20)},
+			},
+		},
+		{
+			Name: "filter is missing and fields length is different",
+			A:    Select(NewField("id")).From(NewTable("users")).Where(Eq("id", 1)),
+			B:    Select(NewField("id"), NewField("name")).From(NewTable("users")),
+			Expectation: []*Difference{
+				{Path: "Fields", Left: []*Field{NewField("id")}, Right: []*Field{NewField("id"), NewField("name")}},
+				{Path: "Filter", Left: Eq("id", 1), Right: (*Filter)(nil)},
+			},
+		},
+		{
+			Name: "value type and comments are different",
+			A:    Select(NewField("id")).From(NewTable("users")).Where(Eq("id", 1)),
+			B:    Select(NewField("id")).From(NewTable("users")).Where(Eq("id", "1")).Comment("route", "users"),
+			Expectation: []*Difference{
+				{Path: "Filter.Value.Value", Left: 1, Right: "1"},
+				{Path: "Comments[route]", Left: nil, Right: "users"},
+			},
+		},
+		{
+			Name: "unexported values are different",
+			A:    Select(NewField("id")).From(NewTable("users")).Where(Eq("id", NextValue("seq_a"))),
+			B:    Select(NewField("id")).From(NewTable("users")).Where(Eq("id", NextValue("seq_b"))),
+			Expectation: []*Difference{
+				{Path: "Filter.Value.Value", Left: NextValue("seq_a"), Right: NextValue("seq_b")},
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual []*Difference = Diff(testCases[i].A, testCases[i].B)
+
+			if len(testCases[i].Expectation) != len(actual) {
+				t.Fatalf("expectation differences length is %d, got %d: %v", len(testCases[i].Expectation), len(actual), actual)
+			}
+
+			for j := range actual {
+				if testCases[i].Expectation[j].Path != actual[j].Path {
+					t.Errorf("expectation difference path is %s, got %s", testCases[i].Expectation[j].Path, actual[j].Path)
+				}
+
+				if !deepEqual(testCases[i].Expectation[j].Left, actual[j].Left) || !deepEqual(testCases[i].Expectation[j].Right, actual[j].Right) {
+					t.Errorf("expectation difference is %s, got %s", testCases[i].Expectation[j].String(), actual[j].String())
+				}
+			}
+		})
+	}
+}
+
+func TestQueryDiff_Equal(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		A           *SelectQuery
+		B           *SelectQuery
+		Expectation bool
+	} = []struct {
+		Name        string
+		A           *SelectQuery
+		B           *SelectQuery
+		Expectation bool
+	}{
+		{
+			Name:        "queries are equal",
+			A:           Select(NewField("id")).From(NewTable("users")).Where(In("id", []int{1, 2})).OrderBy(NewSort(NewField("id"), SortDirectionAscending)),
+			B:           Select(NewField("id")).From(NewTable("users")).Where(In("id", []int{1, 2})).OrderBy(NewSort(NewField("id"), SortDirectionAscending)),
+			Expectation: true,
+		},
+		{
+			Name:        "queries are different",
+			A:           Select(NewField("id")).From(NewTable("users")).Where(In("id", []int{1, 2})),
+			B:           Select(NewField("id")).From(NewTable("users")).Where(In("id", []int{1, 3})),
+			Expectation: false,
+		},
+		{
+			Name:        "one query is nil",
+			A:           Select(NewField("id")).From(NewTable("users")),
+			B:           nil,
+			Expectation: false,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual bool = Equal(testCases[i].A, testCases[i].B)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation equal is %t, got %t", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestQueryDiff_DifferenceString(t *testing.T) {
+	var (
+		expectation string      = "Take: 10 != 20"
+		difference  *Difference = &Difference{Path: "Take", Left: 10, Right: 20}
+	)
+
+	if expectation != difference.String() {
+		t.Errorf("expectation string is %s, got %s", expectation, difference.String())
+	}
+}
@@ -0,0 +1,202 @@
+package goqube
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type QueryParamBinder struct {
+	AllowedColumns []string
+	MaxPageSize    uint64
+}
+
+func NewQueryParamBinder(allowedColumns ...string) *QueryParamBinder {
+	return &QueryParamBinder{
+		AllowedColumns: allowedColumns,
+	}
+}
+
+func (b *QueryParamBinder) SetMaxPageSize(size uint64) *QueryParamBinder {
+	b.MaxPageSize = size
+	return b
+}
+
+func (b *QueryParamBinder) Bind(query *SelectQuery, values url.Values) error {
+	var (
+		filter *Filter
+		sorts  []*Sort
+		err    error
+	)
+
+	if query == nil {
+		return ErrQueryIsRequired
+	}
+
+	filter, err = b.ParseFilter(values)
+	if err != nil {
+		return err
+	}
+
+	if len(filter.Filters) > 0 {
+		if query.Filter != nil {
+			filter = NewFilter().SetLogic(LogicAnd).AddFilters(query.Filter, filter)
+		}
+
+		query.Filter = filter
+	}
+
+	if values.Get(queryParamSortKey) != "" {
+		sorts, err = ParseSorts(values.Get(queryParamSortKey), b.AllowedColumns)
+		if err != nil {
+			return err
+		}
+
+		query.Sorts = sorts
+	}
+
+	return b.bindPage(query, values)
+}
+
+func (b *QueryParamBinder) ParseFilter(values url.Values) (*Filter, error) {
+	var (
+		filter *Filter
+		keys   []string
+	)
+
+	filter = NewFilter().SetLogic(LogicAnd)
+	keys = make([]string, 0, len(values))
+
+	for key := range values {
+		if key == queryParamSortKey || key == queryParamPageSizeKey || key == queryParamPageNumberKey {
+			continue
+		}
+
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		var (
+			expression string
+			column     string
+			suffix     string
+			isFilter   bool
+		)
+
+		expression = key
+		if strings.HasPrefix(key, queryParamFilterPrefix) && strings.HasSuffix(key, "]") {
+			expression = key[len(queryParamFilterPrefix) : len(key)-1]
+			isFilter = true
+		}
+
+		column = expression
+		if index := strings.LastIndex(expression, queryParamOperatorSeparator); index >= 0 {
+			column = expression[:index]
+			suffix = expression[index+len(queryParamOperatorSeparator):]
+		}
+
+		if !containsString(b.AllowedColumns, column) {
+			if isFilter {
+				return nil, fmt.Errorf(errFilterColumnf, ErrFilterColumnIsNotAllowed, column)
+			}
+
+			continue
+		}
+
+		for _, value := range values[key] {
+			var (
+				condition *Filter
+				err       error
+			)
+
+			condition, err = queryParamCondition(column, suffix, value)
+			if err != nil {
+				return nil, err
+			}
+
+			filter.AddFilters(condition)
+		}
+	}
+
+	return filter, nil
+}
+
+func queryParamCondition(column, suffix, value string) (*Filter, error) {
+	var (
+		operator Operator
+		isNull   bool
+		ok       bool
+		err      error
+	)
+
+	if suffix == queryParamNullOperator {
+		isNull, err = strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf(errQueryParamf, ErrQueryParamIsInvalid, column+queryParamOperatorSeparator+suffix)
+		}
+
+		if isNull {
+			return NewFilter().SetCondition(fieldFromColumn(column), OperatorIsNull, nil), nil
+		}
+
+		return NewFilter().SetCondition(fieldFromColumn(column), OperatorIsNotNull, nil), nil
+	}
+
+	operator = OperatorEqual
+	if suffix != "" {
+		operator, ok = queryParamOperatorMap[suffix]
+		if !ok {
+			return nil, fmt.Errorf(errQueryParamf, ErrQueryParamIsInvalid, column+queryParamOperatorSeparator+suffix)
+		}
+	}
+
+	if operator == OperatorIn || operator == OperatorNotIn {
+		return NewFilter().SetCondition(fieldFromColumn(column), operator, NewFilterValue(strings.Split(value, queryParamListSeparator))), nil
+	}
+
+	return NewFilter().SetCondition(fieldFromColumn(column), operator, NewFilterValue(value)), nil
+}
+
+func (b *QueryParamBinder) bindPage(query *SelectQuery, values url.Values) error {
+	var (
+		size   uint64
+		number uint64
+		err    error
+	)
+
+	size = b.MaxPageSize
+	if values.Get(queryParamPageSizeKey) != "" {
+		size, err = strconv.ParseUint(values.Get(queryParamPageSizeKey), 10, 64)
+		if err != nil || size == 0 {
+			return fmt.Errorf(errQueryParamf, ErrQueryParamIsInvalid, queryParamPageSizeKey)
+		}
+
+		if b.MaxPageSize > 0 && size > b.MaxPageSize {
+			size = b.MaxPageSize
+		}
+	}
+
+	number = 1
+	if values.Get(queryParamPageNumberKey) != "" {
+		number, err = strconv.ParseUint(values.Get(queryParamPageNumberKey), 10, 64)
+		if err != nil || number == 0 {
+			return fmt.Errorf(errQueryParamf, ErrQueryParamIsInvalid, queryParamPageNumberKey)
+		}
+
+		if size == 0 {
+			return fmt.Errorf(errQueryParamf, ErrQueryParamIsInvalid, queryParamPageSizeKey)
+		}
+	}
+
+	if size == 0 {
+		return nil
+	}
+
+	query.Take = size
+	query.Skip = (number - 1) * size
+
+	return nil
+}
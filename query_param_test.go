@@ -0,0 +1,264 @@
+package goqube
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+)
+
+func TestQueryParamBinder_NewQueryParamBinder(t *testing.T) {
+	var (
+		expectation *QueryParamBinder = &QueryParamBinder{AllowedColumns: []string{"status", "created_at"}, MaxPageSize: 50}
+		actual      *QueryParamBinder = NewQueryParamBinder("status", "created_at").SetMaxPageSize(50)
+	)
+
+	if !deepEqual(expectation, actual) {
+		t.Errorf("expectation is %+v, got %+v", expectation, actual)
+	}
+}
+
+func TestQueryParamBinder_Bind(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Binder      *QueryParamBinder
+		Query       *SelectQuery
+		Values      string
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Binder      *QueryParamBinder
+		Query       *SelectQuery
+		Values      string
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:   "query is nil",
+			Binder: NewQueryParamBinder("status"),
+			Query:  nil,
+			Values: "status=active",
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrQueryIsRequired,
+			},
+		},
+		{
+			Name:   "filter, sort and page",
+			Binder: NewQueryParamBinder("status", "created_at", "u.name"),
+			Query:  Select(NewField("id")).From(NewTable("users").As("u")),
+			Values: "filter[status]=active&created_at__gte=2024-01-01&sort=-created_at,u.name&page[size]=20&page[number]=3&unknown=1",
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from users as u where created_at >= $1 and status = $2 order by created_at desc, u.name asc limit $3 offset $4",
+				Args:  []interface{}{"2024-01-01", "active", uint64(20), uint64(40)},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "filter is combined with existing filter",
+			Binder: NewQueryParamBinder("status", "deleted_at", "id"),
+			Query:  Select(NewField("id")).From(NewTable("users")).Where(NewFilter().SetCondition(NewField("tenant_id"), OperatorEqual, NewFilterValue(1))),
+			Values: "filter[deleted_at__null]=true&filter[id__in]=1,2&filter[status__ne]=banned",
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from users where tenant_id = $1 and (deleted_at is null and id in ($2, $3) and status != $4)",
+				Args:  []interface{}{1, "1", "2", "banned"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "page size is clamped to max page size",
+			Binder: NewQueryParamBinder().SetMaxPageSize(50),
+			Query:  Select(NewField("id")).From(NewTable("users")),
+			Values: "page[size]=500",
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from users limit $1",
+				Args:  []interface{}{uint64(50)},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "page number uses max page size",
+			Binder: NewQueryParamBinder().SetMaxPageSize(10),
+			Query:  Select(NewField("id")).From(NewTable("users")),
+			Values: "page[number]=2",
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from users limit $1 offset $2",
+				Args:  []interface{}{uint64(10), uint64(10)},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "page number without page size",
+			Binder: NewQueryParamBinder(),
+			Query:  Select(NewField("id")).From(NewTable("users")),
+			Values: "page[number]=2",
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errQueryParamf, ErrQueryParamIsInvalid, queryParamPageSizeKey),
+			},
+		},
+		{
+			Name:   "page size is invalid",
+			Binder: NewQueryParamBinder(),
+			Query:  Select(NewField("id")).From(NewTable("users")),
+			Values: "page[size]=abc",
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errQueryParamf, ErrQueryParamIsInvalid, queryParamPageSizeKey),
+			},
+		},
+		{
+			Name:   "page number is zero",
+			Binder: NewQueryParamBinder(),
+			Query:  Select(NewField("id")).From(NewTable("users")),
+			Values: "page[size]=10&page[number]=0",
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errQueryParamf, ErrQueryParamIsInvalid, queryParamPageNumberKey),
+			},
+		},
+		{
+			Name:   "filter column is not allowed",
+			Binder: NewQueryParamBinder("status"),
+			Query:  Select(NewField("id")).From(NewTable("users")),
+			Values: "filter[password]=secret",
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errFilterColumnf, ErrFilterColumnIsNotAllowed, "password"),
+			},
+		},
+		{
+			Name:   "filter operator is invalid",
+			Binder: NewQueryParamBinder("status"),
+			Query:  Select(NewField("id")).From(NewTable("users")),
+			Values: "status__regex=a",
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errQueryParamf, ErrQueryParamIsInvalid, "status__regex"),
+			},
+		},
+		{
+			Name:   "null operator value is invalid",
+			Binder: NewQueryParamBinder("deleted_at"),
+			Query:  Select(NewField("id")).From(NewTable("users")),
+			Values: "deleted_at__null=maybe",
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errQueryParamf, ErrQueryParamIsInvalid, "deleted_at__null"),
+			},
+		},
+		{
+			Name:   "sort column is not allowed",
+			Binder: NewQueryParamBinder("status"),
+			Query:  Select(NewField("id")).From(NewTable("users")),
+			Values: "sort=password",
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errSortColumnf, ErrSortColumnIsNotAllowed, "password"),
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				values      url.Values
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			values, actualErr = url.ParseQuery(testCases[i].Values)
+			if actualErr != nil {
+				t.Fatalf("expectation error is nil, got %+v", actualErr)
+			}
+
+			actualErr = testCases[i].Binder.Bind(testCases[i].Query, values)
+			if actualErr == nil {
+				actualQuery, actualArgs, actualErr = testCases[i].Query.ToSQLWithArgs(DialectPostgres, []interface{}{})
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Errorf("expectation error is %+v, got nil", testCases[i].Expectation.Err)
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Errorf("expectation error is nil, got %+v", actualErr)
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %+v, got %+v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %+v, got %+v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
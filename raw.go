@@ -0,0 +1,166 @@
+package goqube
+
+import (
+	"bytes"
+	"fmt"
+)
+
+type Raw struct {
+	SQL       string
+	Args      []interface{}
+	NamedArgs RawArgsMap
+	Fragment  Fragment
+	prepared  *PreparedRaw
+}
+
+func NewRaw(sql string, args ...interface{}) *Raw {
+	return &Raw{
+		SQL:  sql,
+		Args: args,
+	}
+}
+
+func NewFragmentRaw(fragment Fragment) *Raw {
+	return &Raw{
+		Fragment: fragment,
+	}
+}
+
+type PreparedRaw struct {
+	SQL      string
+	segments []string
+}
+
+func PrepareRaw(sql string) *PreparedRaw {
+	return &PreparedRaw{
+		SQL:      sql,
+		segments: parseRawSegments(sql),
+	}
+}
+
+func (p *PreparedRaw) PlaceholderCount() int {
+	return len(p.segments) - 1
+}
+
+func (p *PreparedRaw) Bind(args ...interface{}) *Raw {
+	return &Raw{
+		SQL:      p.SQL,
+		Args:     args,
+		prepared: p,
+	}
+}
+
+func parseRawSegments(sql string) []string {
+	var (
+		segments []string
+		start    int
+		quote    rune
+	)
+
+	segments = []string{}
+	for i, char := range sql {
+		if quote != 0 {
+			if char == quote {
+				quote = 0
+			}
+
+			continue
+		}
+
+		switch char {
+		case '\'', '"', '`':
+			quote = char
+		case '?':
+			segments = append(segments, sql[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(segments, sql[start:])
+}
+
+func (r *Raw) validate(dialect Dialect) error {
+	if dialect == "" {
+		return ErrDialectIsRequired
+	}
+
+	if r.SQL == "" && r.Fragment == nil {
+		return ErrSQLIsRequired
+	}
+
+	if r.SQL != "" && r.Fragment != nil {
+		return ErrConflictRawFragment
+	}
+
+	if len(r.Args) > 0 && r.NamedArgs != nil {
+		return ErrConflictRawNamedArgs
+	}
+
+	return nil
+}
+
+func (r *Raw) segments() []string {
+	if r.prepared != nil && r.prepared.SQL == r.SQL {
+		return r.prepared.segments
+	}
+
+	return parseRawSegments(r.SQL)
+}
+
+func (r *Raw) toSQLWithArgs(bc *buildContext, args []interface{}) (string, []interface{}, error) {
+	var (
+		segments []string
+		rawArgs  []interface{}
+		buffer   *bytes.Buffer
+		err      error
+	)
+
+	err = r.validate(bc.dialect)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if r.Fragment != nil {
+		return NewFragmentQuery(r.Fragment).toSQLWithArgs(bc, args)
+	}
+
+	if bc.config.ReadOnlyRaw {
+		err = sanitizeRaw(bc.dialect, r.SQL, false)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	if r.NamedArgs != nil {
+		segments, rawArgs, err = r.namedArgs()
+		if err != nil {
+			return "", nil, err
+		}
+	} else {
+		segments, rawArgs = r.segments(), r.Args
+	}
+
+	if len(segments)-1 != len(rawArgs) {
+		return "", nil, fmt.Errorf(errPlaceholderCountf, ErrPlaceholderCountMismatch, len(segments)-1, len(rawArgs))
+	}
+
+	if len(segments) == 1 {
+		return r.SQL, args, nil
+	}
+
+	buffer = getBuffer(len(r.SQL) + 4*len(rawArgs))
+	defer putBuffer(buffer)
+
+	buffer.WriteString(segments[0])
+	for i := 1; i < len(segments); i++ {
+		args = append(args, rawArgs[i-1])
+		buffer.WriteString(getPlaceholder(bc.dialect, len(args), len(args)))
+		buffer.WriteString(segments[i])
+	}
+
+	return buffer.String(), args, nil
+}
+
+func (r *Raw) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+	return r.toSQLWithArgs(newDialectBuildContext(dialect), args)
+}
@@ -0,0 +1,110 @@
+package goqube
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type RawArgsMap map[string]interface{}
+
+func NewRawNamed(sql string, args RawArgsMap) *Raw {
+	return &Raw{
+		SQL:       sql,
+		NamedArgs: args,
+	}
+}
+
+func isRawNameRune(char rune, first bool) bool {
+	return char == '_' || unicode.IsLetter(char) || (!first && unicode.IsDigit(char))
+}
+
+func parseRawNamedSegments(sql string) ([]string, []string) {
+	var (
+		segments []string
+		names    []string
+		start    int
+		quote    rune
+		runes    []rune = []rune(sql)
+		offsets  []int  = make([]int, len(runes)+1)
+		offset   int
+	)
+
+	for i := range runes {
+		offsets[i] = offset
+		offset += len(string(runes[i]))
+	}
+	offsets[len(runes)] = offset
+
+	segments = []string{}
+	names = []string{}
+	for i := 0; i < len(runes); i++ {
+		var char rune = runes[i]
+
+		if quote != 0 {
+			if char == quote {
+				quote = 0
+			}
+
+			continue
+		}
+
+		switch char {
+		case '\'', '"', '`':
+			quote = char
+		case ':':
+			if i+1 < len(runes) && runes[i+1] == ':' {
+				i++
+				continue
+			}
+
+			if (i > 0 && runes[i-1] == ':') || i+1 >= len(runes) || !isRawNameRune(runes[i+1], true) {
+				continue
+			}
+
+			var end int = i + 1
+			for end < len(runes) && isRawNameRune(runes[end], false) {
+				end++
+			}
+
+			segments = append(segments, sql[offsets[start]:offsets[i]])
+			names = append(names, string(runes[i+1:end]))
+			start = end
+			i = end - 1
+		}
+	}
+
+	return append(segments, sql[offsets[start]:]), names
+}
+
+func (r *Raw) namedArgs() ([]string, []interface{}, error) {
+	var (
+		segments []string
+		names    []string
+		args     []interface{}
+		missing  []string
+	)
+
+	segments, names = parseRawNamedSegments(r.SQL)
+	args = make([]interface{}, 0, len(names))
+	for i := range names {
+		var (
+			value interface{}
+			ok    bool
+		)
+
+		value, ok = r.NamedArgs[names[i]]
+		if !ok {
+			missing = append(missing, names[i])
+			continue
+		}
+
+		args = append(args, value)
+	}
+
+	if len(missing) > 0 {
+		return nil, nil, fmt.Errorf(errRawNamedArgf, ErrRawNamedArgIsMissing, strings.Join(missing, ", "))
+	}
+
+	return segments, args, nil
+}
@@ -0,0 +1,252 @@
+package goqube
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRaw_NewRawNamed(t *testing.T) {
+	var (
+		expectation *Raw
+		actual      *Raw
+	)
+
+	expectation = &Raw{
+		SQL:       "status = :status",
+		NamedArgs: RawArgsMap{"status": "active"},
+	}
+	actual = NewRawNamed("status = :status", RawArgsMap{"status": "active"})
+
+	if !deepEqual(expectation, actual) {
+		t.Errorf("expectation raw is %+v, got %+v", expectation, actual)
+	}
+}
+
+func TestRaw_parseRawNamedSegments(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		SQL         string
+		Expectation struct {
+			Segments []string
+			Names    []string
+		}
+	} = []struct {
+		Name        string
+		SQL         string
+		Expectation struct {
+			Segments []string
+			Names    []string
+		}
+	}{
+		{
+			Name: "named placeholders",
+			SQL:  "status = :status and age > :age_1",
+			Expectation: struct {
+				Segments []string
+				Names    []string
+			}{
+				Segments: []string{"status = ", " and age > ", ""},
+				Names:    []string{"status", "age_1"},
+			},
+		},
+		{
+			Name: "casts, assignments and quotes are ignored",
+			SQL:  "created_at::date = :day and name = ':name' and @x := 1 and data ? 'key'",
+			Expectation: struct {
+				Segments []string
+				Names    []string
+			}{
+				Segments: []string{"created_at::date = ", " and name = ':name' and @x := 1 and data ? 'key'"},
+				Names:    []string{"day"},
+			},
+		},
+		{
+			Name: "multibyte text before placeholder",
+			SQL:  "név = :név",
+			Expectation: struct {
+				Segments []string
+				Names    []string
+			}{
+				Segments: []string{"név = ", ""},
+				Names:    []string{"név"},
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualSegments []string
+				actualNames    []string
+			)
+
+			actualSegments, actualNames = parseRawNamedSegments(testCases[i].SQL)
+
+			if !deepEqual(testCases[i].Expectation.Segments, actualSegments) {
+				t.Errorf("expectation segments is %q, got %q", testCases[i].Expectation.Segments, actualSegments)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Names, actualNames) {
+				t.Errorf("expectation names is %q, got %q", testCases[i].Expectation.Names, actualNames)
+			}
+		})
+	}
+}
+
+func TestRaw_NamedToSQLWithArgs(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Raw         *Raw
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Raw         *Raw
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:    "args and named args are conflicted",
+			Dialect: DialectPostgres,
+			Raw:     &Raw{SQL: "status = :status", Args: []interface{}{1}, NamedArgs: RawArgsMap{"status": 1}},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrConflictRawNamedArgs,
+			},
+		},
+		{
+			Name:    "named args are missing",
+			Dialect: DialectPostgres,
+			Raw:     NewRawNamed("status = :status and age > :age and role = :role", RawArgsMap{"status": "active"}),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errRawNamedArgf, ErrRawNamedArgIsMissing, "age, role"),
+			},
+		},
+		{
+			Name:    "dialect postgres with repeated name",
+			Dialect: DialectPostgres,
+			Raw:     NewRawNamed("status = :status and age > :age or status = :status", RawArgsMap{"status": "active", "age": 18, "unused": true}),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "status = $1 and age > $2 or status = $3",
+				Args:  []interface{}{"active", 18, "active"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    "dialect mysql",
+			Dialect: DialectMySQL,
+			Raw:     NewRawNamed("status = :status and age > :age", RawArgsMap{"status": "active", "age": 18}),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "status = ? and age > ?",
+				Args:  []interface{}{"active", 18},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    "without named placeholders",
+			Dialect: DialectPostgres,
+			Raw:     NewRawNamed("deleted_at is null", RawArgsMap{}),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "deleted_at is null",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Raw.ToSQLWithArgs(testCases[i].Dialect, []interface{}{})
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %v, got %v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
+
+func TestRaw_NamedInSelectQuery(t *testing.T) {
+	var (
+		expectationQuery string        = "select id from users where status = $1 and age > greatest(':min', $2) limit $3"
+		expectationArgs  []interface{} = []interface{}{"active", 18, 10}
+		actualQuery      string
+		actualArgs       []interface{}
+		actualErr        error
+	)
+
+	actualQuery, actualArgs, actualErr = Select(NewField("id")).
+		From(NewTable("users")).
+		Where(
+			NewFilter().
+				SetLogic(LogicAnd).
+				AddFilter(NewField("status"), OperatorEqual, NewFilterValue("active")).
+				AddFilter(NewField("age"), OperatorGreaterThan, NewRawFilterValue(NewRawNamed("greatest(':min', :age)", RawArgsMap{"age": 18}))),
+		).
+		Limit(10).
+		ToSQLWithArgs(DialectPostgres, []interface{}{})
+
+	if actualErr != nil {
+		t.Errorf("expectation error is nil, got %s", actualErr.Error())
+	}
+
+	if expectationQuery != actualQuery {
+		t.Errorf("expectation query is %s, got %s", expectationQuery, actualQuery)
+	}
+
+	if !deepEqual(expectationArgs, actualArgs) {
+		t.Errorf("expectation args is %v, got %v", expectationArgs, actualArgs)
+	}
+}
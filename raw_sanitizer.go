@@ -0,0 +1,168 @@
+package goqube
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+func rawWords(dialect Dialect, sql string) ([]string, error) {
+	var (
+		words      []string
+		plainWords []string
+		err        error
+	)
+
+	words, err = scanRawWords(dialect, sql, dialect == DialectMySQL)
+	if err != nil || dialect != DialectMySQL {
+		return words, err
+	}
+
+	plainWords, err = scanRawWords(dialect, sql, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(words, plainWords...), nil
+}
+
+func scanRawWords(dialect Dialect, sql string, backslashEscapes bool) ([]string, error) {
+	var (
+		words     []string
+		word      strings.Builder
+		quote     rune
+		escapes   bool
+		runes     []rune = []rune(sql)
+		statement bool
+		flush     func()
+	)
+
+	flush = func() {
+		if word.Len() > 0 {
+			words = append(words, strings.ToLower(word.String()))
+			word.Reset()
+		}
+	}
+
+	for i := 0; i < len(runes); i++ {
+		var char rune = runes[i]
+
+		if quote != 0 {
+			if escapes && char == '\\' {
+				i++
+			} else if char == quote {
+				quote = 0
+			}
+
+			continue
+		}
+
+		if statement && !unicode.IsSpace(char) {
+			return nil, ErrRawHasMultipleStatements
+		}
+
+		if (char == '-' && i+1 < len(runes) && runes[i+1] == '-') ||
+			(char == '/' && i+1 < len(runes) && runes[i+1] == '*') ||
+			(char == '#' && dialect == DialectMySQL) {
+			return nil, ErrRawHasComment
+		}
+
+		if char == '$' && dialect == DialectPostgres && word.Len() == 0 {
+			var (
+				tag []rune = dollarQuoteTag(runes, i)
+				end int
+			)
+
+			if tag != nil {
+				end = indexRunes(runes[i+len(tag):], tag)
+				if end < 0 {
+					return nil, ErrRawHasUnterminatedQuote
+				}
+
+				i += len(tag) + end + len(tag) - 1
+				continue
+			}
+		}
+
+		if char == '_' || unicode.IsLetter(char) || ((unicode.IsDigit(char) || char == '$') && word.Len() > 0) {
+			word.WriteRune(char)
+			continue
+		}
+
+		var prefix string = word.String()
+
+		flush()
+
+		switch char {
+		case '\'':
+			quote = char
+			escapes = backslashEscapes || (dialect == DialectPostgres && strings.EqualFold(prefix, "e"))
+		case '"':
+			quote = char
+			escapes = backslashEscapes
+		case '`':
+			quote = char
+			escapes = false
+		case ';':
+			statement = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, ErrRawHasUnterminatedQuote
+	}
+
+	flush()
+
+	return words, nil
+}
+
+func dollarQuoteTag(runes []rune, start int) []rune {
+	var end int = start + 1
+
+	if end < len(runes) && (runes[end] == '_' || unicode.IsLetter(runes[end])) {
+		for end < len(runes) && (runes[end] == '_' || unicode.IsLetter(runes[end]) || unicode.IsDigit(runes[end])) {
+			end++
+		}
+	}
+
+	if end < len(runes) && runes[end] == '$' {
+		return runes[start : end+1]
+	}
+
+	return nil
+}
+
+func indexRunes(runes []rune, sub []rune) int {
+	for i := 0; i+len(sub) <= len(runes); i++ {
+		if string(runes[i:i+len(sub)]) == string(sub) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func sanitizeRaw(dialect Dialect, sql string, statement bool) error {
+	var (
+		words []string
+		err   error
+	)
+
+	words, err = rawWords(dialect, sql)
+	if err != nil {
+		return err
+	}
+
+	if statement && (len(words) == 0 || !containsString(rawReadKeywords, words[0])) {
+		return ErrRawIsNotSelect
+	}
+
+	for i := range words {
+		if containsString(rawWriteKeywords, words[i]) {
+			return fmt.Errorf(errRawKeywordf, ErrRawHasWriteKeyword, words[i])
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,309 @@
+package goqube
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSanitizeRaw(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		SQL         string
+		Statement   bool
+		Expectation error
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		SQL         string
+		Statement   bool
+		Expectation error
+	}{
+		{
+			Name:        "select statement",
+			Dialect:     DialectPostgres,
+			SQL:         "select id, name from users where name = 'drop; -- it' and id > ?;",
+			Statement:   true,
+			Expectation: nil,
+		},
+		{
+			Name:        "with statement",
+			Dialect:     DialectPostgres,
+			SQL:         "  WITH t AS (SELECT 1) SELECT * FROM t",
+			Statement:   true,
+			Expectation: nil,
+		},
+		{
+			Name:        "fragment with quoted keyword",
+			Dialect:     DialectMySQL,
+			SQL:         "`update` = 'set' and created_at2 > now()",
+			Statement:   false,
+			Expectation: nil,
+		},
+		{
+			Name:        "multiple statements",
+			Dialect:     DialectPostgres,
+			SQL:         "select 1; select 2",
+			Statement:   true,
+			Expectation: ErrRawHasMultipleStatements,
+		},
+		{
+			Name:        "line comment",
+			Dialect:     DialectPostgres,
+			SQL:         "id = 1 -- and tenant_id = 2",
+			Statement:   false,
+			Expectation: ErrRawHasComment,
+		},
+		{
+			Name:        "block comment",
+			Dialect:     DialectPostgres,
+			SQL:         "id = 1 /* and tenant_id = 2 */",
+			Statement:   false,
+			Expectation: ErrRawHasComment,
+		},
+		{
+			Name:        "hash comment with dialect mysql",
+			Dialect:     DialectMySQL,
+			SQL:         "id = 1 # and tenant_id = 2",
+			Statement:   false,
+			Expectation: ErrRawHasComment,
+		},
+		{
+			Name:        "hash operator with dialect postgres",
+			Dialect:     DialectPostgres,
+			SQL:         "flags # 1 = 0",
+			Statement:   false,
+			Expectation: nil,
+		},
+		{
+			Name:        "statement is not select",
+			Dialect:     DialectPostgres,
+			SQL:         "delete from users",
+			Statement:   true,
+			Expectation: ErrRawIsNotSelect,
+		},
+		{
+			Name:        "statement is empty",
+			Dialect:     DialectPostgres,
+			SQL:         " ",
+			Statement:   true,
+			Expectation: ErrRawIsNotSelect,
+		},
+		{
+			Name:        "select into",
+			Dialect:     DialectPostgres,
+			SQL:         "select * into backup_users from users",
+			Statement:   true,
+			Expectation: ErrRawHasWriteKeyword,
+		},
+		{
+			Name:        "dollar quoted string",
+			Dialect:     DialectPostgres,
+			SQL:         "select $body$it's; -- fine$body$, $1, a$$b from t",
+			Statement:   true,
+			Expectation: nil,
+		},
+		{
+			Name:        "dollar quoted injection",
+			Dialect:     DialectPostgres,
+			SQL:         "select $$'$$; delete from users; select $$'$$",
+			Statement:   true,
+			Expectation: ErrRawHasMultipleStatements,
+		},
+		{
+			Name:        "escape string injection",
+			Dialect:     DialectPostgres,
+			SQL:         "select E'\\''; delete from users; select '",
+			Statement:   true,
+			Expectation: ErrRawHasMultipleStatements,
+		},
+		{
+			Name:        "backslash escape injection with dialect mysql",
+			Dialect:     DialectMySQL,
+			SQL:         "select '\\'' ; drop table users -- '",
+			Statement:   true,
+			Expectation: ErrRawHasMultipleStatements,
+		},
+		{
+			Name:        "backslash in string with dialect postgres",
+			Dialect:     DialectPostgres,
+			SQL:         "select 'C:\\' from t",
+			Statement:   true,
+			Expectation: nil,
+		},
+		{
+			Name:        "unterminated dollar quote",
+			Dialect:     DialectPostgres,
+			SQL:         "select $tag$ delete from users",
+			Statement:   true,
+			Expectation: ErrRawHasUnterminatedQuote,
+		},
+		{
+			Name:        "unterminated string",
+			Dialect:     DialectPostgres,
+			SQL:         "name = 'john",
+			Statement:   false,
+			Expectation: ErrRawHasUnterminatedQuote,
+		},
+		{
+			Name:        "backslash escape without backslash escapes with dialect mysql",
+			Dialect:     DialectMySQL,
+			SQL:         "name = 'john\\'s'",
+			Statement:   false,
+			Expectation: ErrRawHasUnterminatedQuote,
+		},
+		{
+			Name:        "data modifying cte",
+			Dialect:     DialectPostgres,
+			SQL:         "with d as (DELETE from users returning id) select * from d",
+			Statement:   true,
+			Expectation: ErrRawHasWriteKeyword,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual error = sanitizeRaw(testCases[i].Dialect, testCases[i].SQL, testCases[i].Statement)
+
+			if !errors.Is(actual, testCases[i].Expectation) {
+				t.Errorf("expectation error is %v, got %v", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestConfig_BuildWithReadOnlyRaw(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Config      *Config
+		Query       Query
+		Expectation struct {
+			Query string
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Config      *Config
+		Query       Query
+		Expectation struct {
+			Query string
+			Err   error
+		}
+	}{
+		{
+			Name:   "raw select query",
+			Config: NewConfig(DialectPostgres).SetReadOnlyRaw(true),
+			Query:  NewRaw("select id from users where id = ?", 1),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "select id from users where id = $1",
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "raw write query",
+			Config: NewConfig(DialectPostgres).SetReadOnlyRaw(true),
+			Query:  NewRaw("update users set name = ?", "john"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrRawIsNotSelect,
+			},
+		},
+		{
+			Name:   "raw filter value with injected statement",
+			Config: NewConfig(DialectMySQL).SetReadOnlyRaw(true),
+			Query:  Select(NewField("id")).From(NewTable("users")).Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewRawFilterValue(NewRaw("1; drop table users")))),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrRawHasMultipleStatements,
+			},
+		},
+		{
+			Name:   "raw table with write keyword",
+			Config: NewBuilder(DialectMySQL, WithReadOnlyRaw(true)).Config(),
+			Query:  Select(NewField("id")).From(RawAs(NewRaw("select id from users for update"), "u")),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrRawHasWriteKeyword,
+			},
+		},
+		{
+			Name:   "raw dollar quoted injection",
+			Config: NewConfig(DialectPostgres).SetReadOnlyRaw(true),
+			Query:  NewRaw("select $$'$$; delete from users; select $$'$$"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrRawHasMultipleStatements,
+			},
+		},
+		{
+			Name:   "raw backslash escape injection",
+			Config: NewConfig(DialectMySQL).SetReadOnlyRaw(true),
+			Query:  NewRaw("select '\\'' ; drop table users -- '"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrRawHasMultipleStatements,
+			},
+		},
+		{
+			Name:   "raw escape string injection",
+			Config: NewConfig(DialectPostgres).SetReadOnlyRaw(true),
+			Query:  NewRaw("select E'\\''; delete from users; select '"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrRawHasMultipleStatements,
+			},
+		},
+		{
+			Name:   "raw write query without read only raw",
+			Config: NewConfig(DialectPostgres),
+			Query:  NewRaw("update users set name = ?", "john"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "update users set name = $1",
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualErr   error
+			)
+
+			actualQuery, _, actualErr = testCases[i].Config.Build(testCases[i].Query)
+
+			if !errors.Is(actualErr, testCases[i].Expectation.Err) {
+				t.Errorf("expectation error is %v, got %v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+		})
+	}
+}
@@ -0,0 +1,328 @@
+package goqube
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRaw_NewRaw(t *testing.T) {
+	var (
+		expectation *Raw
+		actual      *Raw
+	)
+
+	expectation = &Raw{
+		SQL:  "select ?",
+		Args: []interface{}{1},
+	}
+	actual = NewRaw("select ?", 1)
+
+	if !deepEqual(expectation, actual) {
+		t.Errorf("expectation raw is %+v, got %+v", expectation, actual)
+	}
+}
+
+func TestRaw_validate(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Raw         *Raw
+		Expectation error
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Raw         *Raw
+		Expectation error
+	}{
+		{
+			Name:        "dialect is empty",
+			Dialect:     "",
+			Raw:         NewRaw("select 1"),
+			Expectation: ErrDialectIsRequired,
+		},
+		{
+			Name:        "sql is empty",
+			Dialect:     DialectPostgres,
+			Raw:         NewRaw(""),
+			Expectation: ErrSQLIsRequired,
+		},
+		{
+			Name:        "raw is valid",
+			Dialect:     DialectPostgres,
+			Raw:         NewRaw("select 1"),
+			Expectation: nil,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual error = testCases[i].Raw.validate(testCases[i].Dialect)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation error is %v, got %v", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestRaw_ToSQLWithArgs(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Raw         *Raw
+		Args        []interface{}
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Raw         *Raw
+		Args        []interface{}
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:    "raw is invalid",
+			Dialect: DialectPostgres,
+			Raw:     NewRaw(""),
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrSQLIsRequired,
+			},
+		},
+		{
+			Name:    "args is more than placeholders",
+			Dialect: DialectPostgres,
+			Raw:     NewRaw("select ?", 1, 2),
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errPlaceholderCountf, ErrPlaceholderCountMismatch, 1, 2),
+			},
+		},
+		{
+			Name:    "placeholders is more than args",
+			Dialect: DialectMySQL,
+			Raw:     NewRaw("select ?, ?", 1),
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errPlaceholderCountf, ErrPlaceholderCountMismatch, 2, 1),
+			},
+		},
+		{
+			Name:    fmt.Sprintf("raw with quoted question marks and dialect %s", DialectMySQL),
+			Dialect: DialectMySQL,
+			Raw:     NewRaw("select '?', \"?\", `?` from table1 where field1 = ?", "value1"),
+			Args:    []interface{}{},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select '?', \"?\", `?` from table1 where field1 = ?",
+				Args:  []interface{}{"value1"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("raw with existing args and dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			Raw:     NewRaw("field1 = ? and field2 = '?' and field3 in (?, ?)", 1, 2, 3),
+			Args:    []interface{}{"value1"},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "field1 = $2 and field2 = '?' and field3 in ($3, $4)",
+				Args:  []interface{}{"value1", 1, 2, 3},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Raw.ToSQLWithArgs(testCases[i].Dialect, testCases[i].Args)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %v, got %v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
+
+func TestRaw_PrepareRaw(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		SQL         string
+		Expectation struct {
+			Segments         []string
+			PlaceholderCount int
+		}
+	} = []struct {
+		Name        string
+		SQL         string
+		Expectation struct {
+			Segments         []string
+			PlaceholderCount int
+		}
+	}{
+		{
+			Name: "without placeholder",
+			SQL:  "select 1",
+			Expectation: struct {
+				Segments         []string
+				PlaceholderCount int
+			}{
+				Segments:         []string{"select 1"},
+				PlaceholderCount: 0,
+			},
+		},
+		{
+			Name: "with placeholders",
+			SQL:  "coalesce(?, ?)",
+			Expectation: struct {
+				Segments         []string
+				PlaceholderCount int
+			}{
+				Segments:         []string{"coalesce(", ", ", ")"},
+				PlaceholderCount: 2,
+			},
+		},
+		{
+			Name: "with quoted question mark",
+			SQL:  "concat('?', ?, \"?\")",
+			Expectation: struct {
+				Segments         []string
+				PlaceholderCount int
+			}{
+				Segments:         []string{"concat('?', ", ", \"?\")"},
+				PlaceholderCount: 1,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual *PreparedRaw = PrepareRaw(testCases[i].SQL)
+
+			if actual.SQL != testCases[i].SQL {
+				t.Errorf("expectation sql is %s, got %s", testCases[i].SQL, actual.SQL)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Segments, actual.segments) {
+				t.Errorf("expectation segments is %+v, got %+v", testCases[i].Expectation.Segments, actual.segments)
+			}
+
+			if testCases[i].Expectation.PlaceholderCount != actual.PlaceholderCount() {
+				t.Errorf("expectation placeholder count is %d, got %d", testCases[i].Expectation.PlaceholderCount, actual.PlaceholderCount())
+			}
+		})
+	}
+}
+
+func TestRaw_PreparedRawBind(t *testing.T) {
+	var (
+		prepared    *PreparedRaw = PrepareRaw("coalesce(?, ?)")
+		selectQuery *SelectQuery
+		query       string
+		args        []interface{}
+		err         error
+	)
+
+	selectQuery = Select(NewField("id"), &Field{Raw: prepared.Bind("name", "unknown"), Alias: "display_name"}).
+		From(NewTable("users")).
+		Where(NewFilter().SetCondition(&Field{Raw: prepared.Bind("nickname", "")}, OperatorNotEqual, NewFilterValue("")))
+
+	query, args, err = selectQuery.ToSQLWithArgs(DialectPostgres, []interface{}{})
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %s", err.Error())
+	}
+
+	if query != "select id, coalesce($1, $2) as display_name from users where coalesce($3, $4) != $5" {
+		t.Errorf("expectation query is %s, got %s", "select id, coalesce($1, $2) as display_name from users where coalesce($3, $4) != $5", query)
+	}
+
+	if !deepEqual([]interface{}{"name", "unknown", "nickname", "", ""}, args) {
+		t.Errorf("expectation args is %+v, got %+v", []interface{}{"name", "unknown", "nickname", "", ""}, args)
+	}
+
+	_, _, err = prepared.Bind(1).ToSQLWithArgs(DialectPostgres, []interface{}{})
+	if err == nil || err.Error() != fmt.Errorf(errPlaceholderCountf, ErrPlaceholderCountMismatch, 2, 1).Error() {
+		t.Errorf("expectation error is %v, got %v", fmt.Errorf(errPlaceholderCountf, ErrPlaceholderCountMismatch, 2, 1), err)
+	}
+}
+
+func BenchmarkRaw_ToSQLWithArgs(b *testing.B) {
+	var raw *Raw = NewRaw("case when status = ? then ? when status = ? then ? else ? end", "a", 1, "b", 2, 0)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _, err := raw.ToSQLWithArgs(DialectPostgres, []interface{}{})
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPreparedRaw_ToSQLWithArgs(b *testing.B) {
+	var raw *Raw = PrepareRaw("case when status = ? then ? when status = ? then ? else ? end").Bind("a", 1, "b", 2, 0)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _, err := raw.ToSQLWithArgs(DialectPostgres, []interface{}{})
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
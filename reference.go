@@ -0,0 +1,329 @@
+package goqube
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type referenceScope struct {
+	parent       *referenceScope
+	aliases      map[string]string
+	defaultTable string
+}
+
+func (s *referenceScope) resolve(qualifier string) (string, bool) {
+	for scope := s; scope != nil; scope = scope.parent {
+		if table, ok := scope.aliases[qualifier]; ok {
+			return table, table != ""
+		}
+	}
+
+	return qualifier, true
+}
+
+func (s *referenceScope) outer(qualifier string) *referenceScope {
+	if s == nil {
+		return nil
+	}
+
+	for scope := s.parent; scope != nil; scope = scope.parent {
+		if _, ok := scope.aliases[qualifier]; ok || scope.defaultTable == qualifier {
+			return scope
+		}
+	}
+
+	return nil
+}
+
+func (s *referenceScope) table(qualifier string) string {
+	if table, ok := s.aliases[qualifier]; ok {
+		return table
+	}
+
+	return qualifier
+}
+
+type referenceCollector struct {
+	tables  map[string]bool
+	columns map[string]bool
+	aliases map[string]bool
+}
+
+func newReferenceCollector(query Query) *referenceCollector {
+	var collector *referenceCollector = &referenceCollector{
+		tables:  map[string]bool{},
+		columns: map[string]bool{},
+		aliases: map[string]bool{},
+	}
+
+	collector.query(query, nil)
+
+	return collector
+}
+
+func (r *referenceCollector) addTable(table string) {
+	if table != "" {
+		r.tables[table] = true
+	}
+}
+
+func (r *referenceCollector) addColumn(table, column string) {
+	if column == "" || strings.ContainsAny(column, "() ") {
+		return
+	}
+
+	if table != "" {
+		column = fmt.Sprintf("%s.%s", table, column)
+	}
+
+	r.columns[column] = true
+}
+
+func (r *referenceCollector) query(query Query, scope *referenceScope) {
+	switch q := query.(type) {
+	case *SelectQuery:
+		r.selectQuery(q, scope)
+	case *CompoundQuery:
+		for i := range q.Branches {
+			if q.Branches[i] != nil {
+				r.query(q.Branches[i].Query, scope)
+			}
+		}
+	case *InsertQuery:
+		var columns []string
+
+		r.addTable(q.Table)
+		columns, _ = q.getColumnsAndRowsValues()
+		for i := range columns {
+			r.addColumn(q.Table, columns[i])
+		}
+
+		r.addColumn(q.Table, q.Ordinal)
+		r.fields(q.Returnings, &referenceScope{parent: scope, defaultTable: q.Table})
+	case *UpdateQuery:
+		var updateScope *referenceScope = &referenceScope{parent: scope, defaultTable: q.Table}
+
+		r.addTable(q.Table)
+		for field, value := range q.FieldsValue {
+			r.addColumn(q.Table, field)
+
+			switch v := value.(type) {
+			case *Field:
+				r.field(v, updateScope)
+			case *SelectQuery:
+				r.selectQuery(v, updateScope)
+			}
+		}
+
+		for i := range q.RowSets {
+			if q.RowSets[i] == nil {
+				continue
+			}
+
+			for j := range q.RowSets[i].Fields {
+				r.addColumn(q.Table, q.RowSets[i].Fields[j])
+			}
+
+			r.selectQuery(q.RowSets[i].SelectQuery, updateScope)
+		}
+
+		r.filter(q.Filter, updateScope)
+		r.fields(q.Returnings, updateScope)
+	case *MergeQuery:
+		r.addTable(q.Table)
+		for i := range q.Fields {
+			r.addColumn(q.Table, q.Fields[i])
+		}
+	case *DeleteQuery:
+		var deleteScope *referenceScope = &referenceScope{parent: scope, defaultTable: q.Table}
+
+		r.addTable(q.Table)
+		r.filter(q.Filter, deleteScope)
+		r.fields(q.Returnings, deleteScope)
+	}
+}
+
+func (r *referenceCollector) tableName(table *Table) string {
+	if table == nil || table.Name == "" {
+		return ""
+	}
+
+	if table.Database != "" {
+		return fmt.Sprintf("%s.%s", table.Database, table.Name)
+	}
+
+	return table.Name
+}
+
+func (r *referenceCollector) table(table *Table, scope *referenceScope) {
+	var name string
+
+	if table == nil {
+		return
+	}
+
+	if table.SelectQuery != nil || table.Raw != nil {
+		if table.Alias != "" {
+			scope.aliases[table.Alias] = ""
+		}
+
+		r.selectQuery(table.SelectQuery, scope)
+		return
+	}
+
+	name = r.tableName(table)
+	r.addTable(name)
+
+	if table.Alias != "" && name != "" {
+		scope.aliases[table.Alias] = name
+	}
+}
+
+func (r *referenceCollector) selectQuery(selectQuery *SelectQuery, parent *referenceScope) {
+	var scope *referenceScope
+
+	if selectQuery == nil {
+		return
+	}
+
+	scope = &referenceScope{
+		parent:  parent,
+		aliases: map[string]string{},
+	}
+
+	r.table(selectQuery.Table, scope)
+	for i := range selectQuery.Joins {
+		if selectQuery.Joins[i] != nil {
+			r.table(selectQuery.Joins[i].Table, scope)
+		}
+	}
+
+	if len(selectQuery.Joins) == 0 {
+		scope.defaultTable = r.tableName(selectQuery.Table)
+	}
+
+	r.fields(selectQuery.Fields, scope)
+	for i := range selectQuery.Joins {
+		if selectQuery.Joins[i] != nil {
+			r.filter(selectQuery.Joins[i].Filter, scope)
+		}
+	}
+
+	r.filter(selectQuery.Filter, scope)
+	r.fields(selectQuery.GroupByFields, scope)
+	for i := range selectQuery.Sorts {
+		if selectQuery.Sorts[i] != nil {
+			r.field(selectQuery.Sorts[i].Field, scope)
+		}
+	}
+}
+
+func (r *referenceCollector) fields(fields []*Field, scope *referenceScope) {
+	for i := range fields {
+		r.field(fields[i], scope)
+	}
+}
+
+func (r *referenceCollector) field(field *Field, scope *referenceScope) {
+	if field == nil {
+		return
+	}
+
+	if field.Alias != "" {
+		r.aliases[field.Alias] = true
+	}
+
+	if field.SelectQuery != nil {
+		r.selectQuery(field.SelectQuery, scope)
+		return
+	}
+
+	if field.Expression != nil {
+		r.expression(field.Expression, scope)
+		return
+	}
+
+	if field.Table != "" {
+		if table, ok := scope.resolve(field.Table); ok {
+			r.addColumn(table, field.Column)
+		}
+
+		return
+	}
+
+	r.addColumn(scope.defaultTable, field.Column)
+}
+
+func (r *referenceCollector) expression(expression *Expression, scope *referenceScope) {
+	if expression.Case != nil {
+		for i := range expression.Case.Whens {
+			r.filter(expression.Case.Whens[i], scope)
+		}
+	}
+
+	for i := range expression.Operands {
+		switch operand := expression.Operands[i].(type) {
+		case *Field:
+			r.field(operand, scope)
+		case *Expression:
+			if operand != nil {
+				r.expression(operand, scope)
+			}
+		}
+	}
+}
+
+func (r *referenceCollector) filter(filter *Filter, scope *referenceScope) {
+	if filter == nil {
+		return
+	}
+
+	r.field(filter.Field, scope)
+
+	if filter.Value != nil {
+		if filter.Value.SelectQuery != nil {
+			r.selectQuery(filter.Value.SelectQuery, scope)
+		}
+
+		if filter.Value.Expression != nil {
+			r.expression(filter.Value.Expression, scope)
+		}
+
+		if search, ok := filter.Value.Value.(*SearchFilter); ok && search != nil {
+			r.fields(search.Fields, scope)
+		}
+
+		if filter.Value.OuterTable != "" {
+			if outer := scope.outer(filter.Value.OuterTable); outer != nil {
+				r.field(&Field{Table: filter.Value.OuterTable, Column: filter.Value.Column}, outer)
+			}
+		} else if filter.Value.Column != "" {
+			r.field(&Field{Table: filter.Value.Table, Column: filter.Value.Column}, scope)
+		}
+	}
+
+	for i := range filter.Filters {
+		r.filter(filter.Filters[i], scope)
+	}
+}
+
+func sortedKeys(values map[string]bool) []string {
+	var keys []string = []string{}
+
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func ListReferencedTables(query Query) []string {
+	return sortedKeys(newReferenceCollector(query).tables)
+}
+
+func ListReferencedColumns(query Query) []string {
+	return sortedKeys(newReferenceCollector(query).columns)
+}
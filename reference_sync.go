@@ -0,0 +1,204 @@
+package goqube
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Statement struct {
+	Query string
+	Args  []interface{}
+}
+
+type ReferenceSync struct {
+	Table  string
+	Keys   []string
+	Fields []string
+	Rows   [][]interface{}
+}
+
+func NewReferenceSync(table string, keys ...string) *ReferenceSync {
+	return &ReferenceSync{
+		Table: table,
+		Keys:  keys,
+		Rows:  [][]interface{}{},
+	}
+}
+
+func (r *ReferenceSync) Columns(fields ...string) *ReferenceSync {
+	r.Fields = fields
+	return r
+}
+
+func (r *ReferenceSync) Row(values ...interface{}) *ReferenceSync {
+	r.Rows = append(r.Rows, values)
+	return r
+}
+
+func (r *ReferenceSync) validate(dialect Dialect) error {
+	if dialect == "" {
+		return ErrDialectIsRequired
+	}
+
+	if r.Table == "" {
+		return ErrTableIsRequired
+	}
+
+	if len(r.Keys) == 0 {
+		return ErrKeysIsRequired
+	}
+
+	if len(r.Fields) == 0 {
+		return ErrFieldsIsRequired
+	}
+
+	for i := range r.Keys {
+		if !containsString(r.Fields, r.Keys[i]) {
+			return ErrKeyIsNotInColumns
+		}
+	}
+
+	if len(r.Rows) == 0 {
+		return ErrValuesIsRequired
+	}
+
+	for i := range r.Rows {
+		if len(r.Rows[i]) != len(r.Fields) {
+			return ErrValueLengthIsNotEqualToFieldsLength
+		}
+	}
+
+	return nil
+}
+
+func (r *ReferenceSync) upsertClause(bc *buildContext) string {
+	var (
+		dialect    Dialect = bc.dialect
+		updates    []string
+		conditions []string
+	)
+
+	for i := range r.Fields {
+		if containsString(r.Keys, r.Fields[i]) {
+			continue
+		}
+
+		switch dialect {
+		case DialectMySQL:
+			updates = append(updates, fmt.Sprintf("%s = %s", r.Fields[i], bc.insertedValueSQL(r.Fields[i])))
+		default:
+			updates = append(updates, fmt.Sprintf("%s = excluded.%s", r.Fields[i], r.Fields[i]))
+			conditions = append(conditions, fmt.Sprintf("%s.%s is distinct from excluded.%s", bc.tableName(r.Table), r.Fields[i], r.Fields[i]))
+		}
+	}
+
+	if dialect == DialectMySQL {
+		if len(updates) == 0 {
+			updates = []string{fmt.Sprintf("%s = %s", r.Keys[0], r.Keys[0])}
+		}
+
+		return bc.onDuplicateKeyUpdateSQL(updates)
+	}
+
+	if len(updates) == 0 {
+		return fmt.Sprintf("on conflict (%s) do nothing", strings.Join(r.Keys, ", "))
+	}
+
+	return fmt.Sprintf(
+		"on conflict (%s) do update set %s where %s",
+		strings.Join(r.Keys, ", "),
+		strings.Join(updates, ", "),
+		strings.Join(conditions, " or "),
+	)
+}
+
+func (r *ReferenceSync) missingFilter() *Filter {
+	var (
+		keyIndexes []int
+		filter     *Filter
+	)
+
+	for i := range r.Fields {
+		if containsString(r.Keys, r.Fields[i]) {
+			keyIndexes = append(keyIndexes, i)
+		}
+	}
+
+	if len(keyIndexes) == 1 {
+		var keys []interface{} = []interface{}{}
+
+		for i := range r.Rows {
+			keys = append(keys, r.Rows[i][keyIndexes[0]])
+		}
+
+		return NewFilter().SetCondition(NewField(r.Fields[keyIndexes[0]]), OperatorNotIn, NewFilterValue(keys))
+	}
+
+	filter = NewFilter().SetLogic(LogicAnd)
+	for i := range r.Rows {
+		var rowFilter *Filter = NewFilter().SetLogic(LogicOr)
+
+		for _, keyIndex := range keyIndexes {
+			rowFilter.AddFilter(NewField(r.Fields[keyIndex]), OperatorNotEqual, NewFilterValue(r.Rows[i][keyIndex]))
+		}
+
+		filter.AddFilters(rowFilter)
+	}
+
+	return filter
+}
+
+func (r *ReferenceSync) toStatements(bc *buildContext) ([]*Statement, error) {
+	var (
+		insertQuery *InsertQuery
+		upsertQuery string
+		upsertArgs  []interface{}
+		deleteQuery string
+		deleteArgs  []interface{}
+		err         error
+	)
+
+	err = r.validate(bc.dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	insertQuery = InsertInto(r.Table).Columns(r.Fields...)
+	for i := range r.Rows {
+		insertQuery.Values(r.Rows[i]...)
+	}
+
+	upsertQuery, upsertArgs, err = insertQuery.toSQLWithArgs(bc, []interface{}{})
+	if err != nil {
+		return nil, err
+	}
+
+	deleteQuery, deleteArgs, err = DeleteFrom(r.Table).Where(r.missingFilter()).toSQLWithArgs(bc, []interface{}{})
+	if err != nil {
+		return nil, err
+	}
+
+	return []*Statement{
+		{
+			Query: fmt.Sprintf("%s %s", upsertQuery, r.upsertClause(bc)),
+			Args:  upsertArgs,
+		},
+		{
+			Query: deleteQuery,
+			Args:  deleteArgs,
+		},
+	}, nil
+}
+
+func (r *ReferenceSync) ToStatements(dialect Dialect) ([]*Statement, error) {
+	return r.toStatements(newDialectBuildContext(dialect))
+}
+
+func (c *Config) ReferenceSyncStatements(referenceSync *ReferenceSync) ([]*Statement, error) {
+	var err error = c.validateVariant()
+	if err != nil {
+		return nil, err
+	}
+
+	return referenceSync.toStatements(newBuildContext(c))
+}
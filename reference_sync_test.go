@@ -0,0 +1,293 @@
+package goqube
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestReferenceSync_NewReferenceSync(t *testing.T) {
+	var (
+		expectation *ReferenceSync
+		actual      *ReferenceSync
+	)
+
+	expectation = &ReferenceSync{
+		Table:  "statuses",
+		Keys:   []string{"code"},
+		Fields: []string{"code", "name"},
+		Rows: [][]interface{}{
+			{"active", "Active"},
+			{"inactive", "Inactive"},
+		},
+	}
+	actual = NewReferenceSync("statuses", "code").
+		Columns("code", "name").
+		Row("active", "Active").
+		Row("inactive", "Inactive")
+
+	if !deepEqual(expectation, actual) {
+		t.Errorf("expectation reference sync is %+v, got %+v", expectation, actual)
+	}
+}
+
+func TestReferenceSync_validate(t *testing.T) {
+	var testCases []struct {
+		Name          string
+		Dialect       Dialect
+		ReferenceSync *ReferenceSync
+		Expectation   error
+	} = []struct {
+		Name          string
+		Dialect       Dialect
+		ReferenceSync *ReferenceSync
+		Expectation   error
+	}{
+		{
+			Name:          "dialect is empty",
+			Dialect:       "",
+			ReferenceSync: &ReferenceSync{},
+			Expectation:   ErrDialectIsRequired,
+		},
+		{
+			Name:          "table is empty",
+			Dialect:       DialectPostgres,
+			ReferenceSync: &ReferenceSync{},
+			Expectation:   ErrTableIsRequired,
+		},
+		{
+			Name:          "keys is empty",
+			Dialect:       DialectPostgres,
+			ReferenceSync: NewReferenceSync("statuses"),
+			Expectation:   ErrKeysIsRequired,
+		},
+		{
+			Name:          "fields is empty",
+			Dialect:       DialectPostgres,
+			ReferenceSync: NewReferenceSync("statuses", "code"),
+			Expectation:   ErrFieldsIsRequired,
+		},
+		{
+			Name:          "key is not in fields",
+			Dialect:       DialectPostgres,
+			ReferenceSync: NewReferenceSync("statuses", "id").Columns("code"),
+			Expectation:   ErrKeyIsNotInColumns,
+		},
+		{
+			Name:          "rows is empty",
+			Dialect:       DialectPostgres,
+			ReferenceSync: NewReferenceSync("statuses", "code").Columns("code"),
+			Expectation:   ErrValuesIsRequired,
+		},
+		{
+			Name:          "row length is not equal to fields length",
+			Dialect:       DialectPostgres,
+			ReferenceSync: NewReferenceSync("statuses", "code").Columns("code", "name").Row("active"),
+			Expectation:   ErrValueLengthIsNotEqualToFieldsLength,
+		},
+		{
+			Name:          "reference sync is valid",
+			Dialect:       DialectPostgres,
+			ReferenceSync: NewReferenceSync("statuses", "code").Columns("code", "name").Row("active", "Active"),
+			Expectation:   nil,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual error = testCases[i].ReferenceSync.validate(testCases[i].Dialect)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation error is %v, got %v", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestReferenceSync_ToStatements(t *testing.T) {
+	var testCases []struct {
+		Name          string
+		Dialect       Dialect
+		ReferenceSync *ReferenceSync
+		Expectation   struct {
+			Statements []*Statement
+			Err        error
+		}
+	} = []struct {
+		Name          string
+		Dialect       Dialect
+		ReferenceSync *ReferenceSync
+		Expectation   struct {
+			Statements []*Statement
+			Err        error
+		}
+	}{
+		{
+			Name:          "reference sync is invalid",
+			Dialect:       DialectPostgres,
+			ReferenceSync: NewReferenceSync("statuses"),
+			Expectation: struct {
+				Statements []*Statement
+				Err        error
+			}{
+				Statements: nil,
+				Err:        ErrKeysIsRequired,
+			},
+		},
+		{
+			Name:          "row value type is unsupported",
+			Dialect:       DialectPostgres,
+			ReferenceSync: NewReferenceSync("statuses", "code").Columns("code").Row(map[string]int{}),
+			Expectation: struct {
+				Statements []*Statement
+				Err        error
+			}{
+				Statements: nil,
+				Err:        fmt.Errorf(errUnsupportedValueTypef, "map"),
+			},
+		},
+		{
+			Name:    fmt.Sprintf("single key with dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			ReferenceSync: NewReferenceSync("statuses", "code").
+				Columns("code", "name", "sort").
+				Row("active", "Active", 1).
+				Row("inactive", "Inactive", 2),
+			Expectation: struct {
+				Statements []*Statement
+				Err        error
+			}{
+				Statements: []*Statement{
+					{
+						Query: "insert into statuses(code, name, sort) values ($1, $2, $3), ($4, $5, $6) on conflict (code) do update set name = excluded.name, sort = excluded.sort where statuses.name is distinct from excluded.name or statuses.sort is distinct from excluded.sort",
+						Args:  []interface{}{"active", "Active", 1, "inactive", "Inactive", 2},
+					},
+					{
+						Query: "delete from statuses where code not in ($1, $2)",
+						Args:  []interface{}{"active", "inactive"},
+					},
+				},
+				Err: nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("single key with dialect %s", DialectMySQL),
+			Dialect: DialectMySQL,
+			ReferenceSync: NewReferenceSync("statuses", "code").
+				Columns("code", "name").
+				Row("active", "Active"),
+			Expectation: struct {
+				Statements []*Statement
+				Err        error
+			}{
+				Statements: []*Statement{
+					{
+						Query: "insert into statuses(code, name) values (?, ?) on duplicate key update name = values(name)",
+						Args:  []interface{}{"active", "Active"},
+					},
+					{
+						Query: "delete from statuses where code not in (?)",
+						Args:  []interface{}{"active"},
+					},
+				},
+				Err: nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("composite keys only with dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			ReferenceSync: NewReferenceSync("role_permissions", "role", "permission").
+				Columns("role", "permission").
+				Row("admin", "read").
+				Row("admin", "write"),
+			Expectation: struct {
+				Statements []*Statement
+				Err        error
+			}{
+				Statements: []*Statement{
+					{
+						Query: "insert into role_permissions(role, permission) values ($1, $2), ($3, $4) on conflict (role, permission) do nothing",
+						Args:  []interface{}{"admin", "read", "admin", "write"},
+					},
+					{
+						Query: "delete from role_permissions where (role != $1 or permission != $2) and (role != $3 or permission != $4)",
+						Args:  []interface{}{"admin", "read", "admin", "write"},
+					},
+				},
+				Err: nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("keys only with dialect %s", DialectMySQL),
+			Dialect: DialectMySQL,
+			ReferenceSync: NewReferenceSync("statuses", "code").
+				Columns("code").
+				Row("active"),
+			Expectation: struct {
+				Statements []*Statement
+				Err        error
+			}{
+				Statements: []*Statement{
+					{
+						Query: "insert into statuses(code) values (?) on duplicate key update code = code",
+						Args:  []interface{}{"active"},
+					},
+					{
+						Query: "delete from statuses where code not in (?)",
+						Args:  []interface{}{"active"},
+					},
+				},
+				Err: nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualStatements []*Statement
+				actualErr        error
+			)
+
+			actualStatements, actualErr = testCases[i].ReferenceSync.ToStatements(testCases[i].Dialect)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if len(testCases[i].Expectation.Statements) != len(actualStatements) {
+				t.Errorf("expectation length of statements is %d, got %d", len(testCases[i].Expectation.Statements), len(actualStatements))
+			}
+
+			for j := range testCases[i].Expectation.Statements {
+				if !deepEqual(testCases[i].Expectation.Statements[j], actualStatements[j]) {
+					t.Errorf("expectation element of statements is %+v, got %+v", testCases[i].Expectation.Statements[j], actualStatements[j])
+				}
+			}
+		})
+	}
+}
+
+func TestReferenceSync_ToStatementsWithTablePrefix(t *testing.T) {
+	var (
+		expectation string = "insert into staging_statuses(code, name) values ($1, $2) on conflict (code) do update set name = excluded.name where staging_statuses.name is distinct from excluded.name"
+		statements  []*Statement
+		err         error
+	)
+
+	statements, err = NewBuilder(DialectPostgres, WithTablePrefix("staging_")).ReferenceSyncStatements(NewReferenceSync("statuses", "code").Columns("code", "name").Row("active", "Active"))
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %s", err.Error())
+	}
+
+	if statements[0].Query != expectation {
+		t.Errorf("expectation query is %s, got %s", expectation, statements[0].Query)
+	}
+}
@@ -0,0 +1,183 @@
+package goqube
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReference_ListReferencedTablesAndColumns(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Query       Query
+		Expectation struct {
+			Tables  []string
+			Columns []string
+		}
+	} = []struct {
+		Name        string
+		Query       Query
+		Expectation struct {
+			Tables  []string
+			Columns []string
+		}
+	}{
+		{
+			Name:  "query is nil",
+			Query: nil,
+			Expectation: struct {
+				Tables  []string
+				Columns []string
+			}{
+				Tables:  []string{},
+				Columns: []string{},
+			},
+		},
+		{
+			Name: "select query with single table",
+			Query: Select(NewField("id"), NewField("name")).
+				From(NewTable("users")).
+				Where(NewFilter().SetCondition(NewField("status"), OperatorEqual, NewFilterValue("active"))).
+				OrderBy(NewSort(NewField("created_at"), SortDirectionDescending)),
+			Expectation: struct {
+				Tables  []string
+				Columns []string
+			}{
+				Tables:  []string{"users"},
+				Columns: []string{"users.created_at", "users.id", "users.name", "users.status"},
+			},
+		},
+		{
+			Name: "select query with expression fields",
+			Query: Select(
+				NewExpressionField(NewArithmeticExpression(ExpressionOperatorMultiply, NewField("price").FromTable("o"), NewField("quantity").FromTable("o"))).As("total"),
+				NewExpressionField(NewFunctionExpression("coalesce", NewField("nickname"), NewFunctionExpression("lower", NewField("name")))).As("display_name"),
+			).
+				From(NewTable("users")).
+				Join(InnerJoin(NewTable("orders").As("o")).On(NewFilter().SetCondition(NewField("user_id").FromTable("o"), OperatorEqual, NewColumnFilterValue("id").FromTable("users")))),
+			Expectation: struct {
+				Tables  []string
+				Columns []string
+			}{
+				Tables:  []string{"orders", "users"},
+				Columns: []string{"name", "nickname", "orders.price", "orders.quantity", "orders.user_id", "users.id"},
+			},
+		},
+		{
+			Name: "select query with joins, aliases and subqueries",
+			Query: Select(
+				NewField("id").FromTable("u"),
+				NewField("total").FromTable("o"),
+				NewSelectQueryField(Select(NewField("count(*)")).From(NewTable("logins")).Where(NewFilter().SetCondition(NewField("user_id"), OperatorEqual, NewColumnFilterValue("id").FromTable("u")))).As("login_count"),
+			).
+				From(NewTable("users").FromDatabase("app").As("u")).
+				Join(InnerJoin(NewTable("orders").As("o")).On(NewFilter().SetCondition(NewField("user_id").FromTable("o"), OperatorEqual, NewColumnFilterValue("id").FromTable("u")))).
+				Join(LeftJoin(SelectAs(Select(NewField("user_id")).From(NewTable("bans")), "b")).On(NewFilter().SetCondition(NewField("user_id").FromTable("b"), OperatorEqual, NewColumnFilterValue("id").FromTable("u")))).
+				Where(NewFilter().SetCondition(NewField("region").FromTable("u"), OperatorIn, NewSelectQueryFilterValue(Select(NewField("code")).From(NewTable("regions"))))).
+				GroupBy(NewField("id").FromTable("u"), NewField("total").FromTable("o")),
+			Expectation: struct {
+				Tables  []string
+				Columns []string
+			}{
+				Tables:  []string{"app.users", "bans", "logins", "orders", "regions"},
+				Columns: []string{"app.users.id", "app.users.region", "bans.user_id", "logins.user_id", "orders.total", "orders.user_id", "regions.code"},
+			},
+		},
+		{
+			Name: "select query with outer table reference",
+			Query: Select(NewField("id").FromTable("t")).
+				From(NewTable("posts").As("t")).
+				Where(NewFilter().SetCondition(NewField("id").FromTable("t"), OperatorIn, NewSelectQueryFilterValue(Select(NewField("post_id").FromTable("t")).
+					From(NewTable("comments").As("t")).
+					Where(NewFilter().SetCondition(NewField("author_id").FromTable("t"), OperatorEqual, NewOuterColumnFilterValue("t", "author_id")))))),
+			Expectation: struct {
+				Tables  []string
+				Columns []string
+			}{
+				Tables:  []string{"comments", "posts"},
+				Columns: []string{"comments.author_id", "comments.post_id", "posts.author_id", "posts.id"},
+			},
+		},
+		{
+			Name: "compound query",
+			Query: Union(
+				Select(NewField("id")).From(NewTable("customers")),
+				NewRaw("select id from partners"),
+				Select(NewField("id")).From(NewTable("suppliers")),
+			),
+			Expectation: struct {
+				Tables  []string
+				Columns []string
+			}{
+				Tables:  []string{"customers", "suppliers"},
+				Columns: []string{"customers.id", "suppliers.id"},
+			},
+		},
+		{
+			Name:  "insert query",
+			Query: InsertInto("users").Columns("id", "name").Values(1, "name1").Returning(NewField("created_at")),
+			Expectation: struct {
+				Tables  []string
+				Columns []string
+			}{
+				Tables:  []string{"users"},
+				Columns: []string{"users.created_at", "users.id", "users.name"},
+			},
+		},
+		{
+			Name: "update query",
+			Query: Update("orders").
+				Set("previous_status", NewField("status")).
+				SetRow(Select(NewField("name")).From(NewTable("customers")).Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))), "customer_name").
+				Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Expectation: struct {
+				Tables  []string
+				Columns []string
+			}{
+				Tables:  []string{"customers", "orders"},
+				Columns: []string{"customers.id", "customers.name", "orders.customer_name", "orders.id", "orders.previous_status", "orders.status"},
+			},
+		},
+		{
+			Name:  "merge query",
+			Query: MergeInto("products", "id").Columns("id", "name").Row(1, "name1"),
+			Expectation: struct {
+				Tables  []string
+				Columns []string
+			}{
+				Tables:  []string{"products"},
+				Columns: []string{"products.id", "products.name"},
+			},
+		},
+		{
+			Name:  "delete query",
+			Query: DeleteFrom("sessions").Where(NewFilter().SetCondition(NewField("expired_at"), OperatorLessThan, NewFilterValue("2024-01-01"))),
+			Expectation: struct {
+				Tables  []string
+				Columns []string
+			}{
+				Tables:  []string{"sessions"},
+				Columns: []string{"sessions.expired_at"},
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualTables  []string
+				actualColumns []string
+			)
+
+			actualTables = ListReferencedTables(testCases[i].Query)
+			actualColumns = ListReferencedColumns(testCases[i].Query)
+
+			if !reflect.DeepEqual(testCases[i].Expectation.Tables, actualTables) {
+				t.Errorf("expectation tables is %v, got %v", testCases[i].Expectation.Tables, actualTables)
+			}
+
+			if !reflect.DeepEqual(testCases[i].Expectation.Columns, actualColumns) {
+				t.Errorf("expectation columns is %v, got %v", testCases[i].Expectation.Columns, actualColumns)
+			}
+		})
+	}
+}
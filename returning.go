@@ -0,0 +1,60 @@
+package goqube
+
+import (
+	"fmt"
+	"strings"
+)
+
+func validateReturning(dialect Dialect, fields []*Field) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	if dialect == DialectMySQL {
+		return ErrUnsupportedReturning
+	}
+
+	return validateReturningFields(fields)
+}
+
+func validateReturningFields(fields []*Field) error {
+	for i := range fields {
+		if fields[i] == nil {
+			return ErrFieldIsNil
+		}
+	}
+
+	return nil
+}
+
+func (bc *buildContext) validateReturning(fields []*Field) error {
+	if bc.config.isMariaDB() {
+		return validateReturningFields(fields)
+	}
+
+	return validateReturning(bc.dialect, fields)
+}
+
+func returningToSQLWithArgs(bc *buildContext, fields []*Field, args []interface{}) (string, []interface{}, error) {
+	var (
+		columns []string
+		err     error
+	)
+
+	if len(fields) == 0 {
+		return "", args, nil
+	}
+
+	for i := range fields {
+		var column string
+
+		column, args, err = fields[i].toSQLWithArgsWithAlias(bc, args)
+		if err != nil {
+			return "", nil, err
+		}
+
+		columns = append(columns, column)
+	}
+
+	return fmt.Sprintf(" returning %s", strings.Join(columns, ", ")), args, nil
+}
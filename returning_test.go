@@ -0,0 +1,257 @@
+package goqube
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestReturning_validateReturning(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Fields      []*Field
+		Expectation error
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Fields      []*Field
+		Expectation error
+	}{
+		{
+			Name:        "fields is empty",
+			Dialect:     DialectMySQL,
+			Fields:      nil,
+			Expectation: nil,
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s is unsupported", DialectMySQL),
+			Dialect:     DialectMySQL,
+			Fields:      []*Field{NewField("id")},
+			Expectation: ErrUnsupportedReturning,
+		},
+		{
+			Name:        "field is nil",
+			Dialect:     DialectPostgres,
+			Fields:      []*Field{NewField("id"), nil},
+			Expectation: ErrFieldIsNil,
+		},
+		{
+			Name:        "fields is valid",
+			Dialect:     DialectPostgres,
+			Fields:      []*Field{NewField("id")},
+			Expectation: nil,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual error = validateReturning(testCases[i].Dialect, testCases[i].Fields)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation error is %v, got %v", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestReturning_Build(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Query       Query
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Query       Query
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:    fmt.Sprintf("insert query returning with dialect %s", DialectMySQL),
+			Dialect: DialectMySQL,
+			Query:   InsertInto("table1").Value("field1", "value1").Returning(NewField("id")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrUnsupportedReturning,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("insert query returning with dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			Query:   InsertInto("table1").Value("field1", "value1").Value("field1", "value2").Returning(NewField("id"), NewField("created_at").As("created")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into table1(field1) values ($1), ($2) returning id, created_at as created",
+				Args:  []interface{}{"value1", "value2"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("insert query returning with ordinal with dialect %s", DialectMySQL),
+			Dialect: DialectMySQL,
+			Query:   InsertInto("table1").Value("field1", "value1").Returning(NewField("id"), NewField("field1")).WithOrdinal("ordinal", "field1"),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrUnsupportedReturning,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("insert query returning with ordinal with dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			Query: InsertInto("table1").
+				Columns("field1", "field2").
+				Values("value1", 1).
+				Values("value2", 2).
+				Returning(NewField("id"), NewField("field1")).
+				WithOrdinal("ordinal", "field1"),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "with input_rows(field1, field2, ordinal) as (values (coalesce($1, (null::table1).field1), coalesce($2, (null::table1).field2), 1), ($3, $4, 2)), inserted_rows as (insert into table1(field1, field2) select field1, field2 from input_rows order by ordinal returning id, field1) select inserted_rows.*, input_rows.ordinal from inserted_rows inner join input_rows on input_rows.field1 = inserted_rows.field1 order by input_rows.ordinal",
+				Args:  []interface{}{"value1", 1, "value2", 2},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("insert query returning with ordinal into non text columns with dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			Query: InsertInto("table1").
+				Columns("user_id", "created_at").
+				Values(1, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).
+				Values(2, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)).
+				Returning(NewField("id"), NewField("user_id").As("input_user_id"), NewField("created_at")).
+				WithOrdinal("ordinal", "user_id", "created_at"),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "with input_rows(user_id, created_at, ordinal) as (values (coalesce($1, (null::table1).user_id), coalesce($2, (null::table1).created_at), 1), ($3, $4, 2)), inserted_rows as (insert into table1(user_id, created_at) select user_id, created_at from input_rows order by ordinal returning id, user_id as input_user_id, created_at) select inserted_rows.*, input_rows.ordinal from inserted_rows inner join input_rows on input_rows.user_id = inserted_rows.input_user_id and input_rows.created_at = inserted_rows.created_at order by input_rows.ordinal",
+				Args:  []interface{}{1, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), 2, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("update query returning invalid field with dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			Query: Update("table1").
+				Set("field1", "value1").
+				Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))).
+				Returning(&Field{}),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrColumnIsRequired,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("update query returning with dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			Query: Update("table1").
+				Set("field1", "value1").
+				Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))).
+				Returning(NewField("id"), NewField("field1")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update table1 set field1 = $1 where id = $2 returning id, field1",
+				Args:  []interface{}{"value1", 1},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("delete query returning with dialect %s", DialectMySQL),
+			Dialect: DialectMySQL,
+			Query: DeleteFrom("table1").
+				Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))).
+				Returning(NewField("id")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrUnsupportedReturning,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("delete query returning with dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			Query: DeleteFrom("table1").
+				Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))).
+				Returning(NewField("id")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "delete from table1 where id = $1 returning id",
+				Args:  []interface{}{1},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = NewConfig(testCases[i].Dialect).Build(testCases[i].Query)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %v, got %v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
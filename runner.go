@@ -0,0 +1,116 @@
+package goqube
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+type sqlConn interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+type Runner interface {
+	Exec(ctx context.Context, query Query) (sql.Result, error)
+	Query(ctx context.Context, query Query, dest interface{}) error
+	RunInTransaction(ctx context.Context, fn func(runner Runner) error) error
+}
+
+func (e *Executor) WithTx(tx *sql.Tx) *Executor {
+	var executor Executor = *e
+
+	executor.tx = tx
+	executor.depth = 0
+	if tx != nil {
+		executor.depth = 1
+	}
+
+	return &executor
+}
+
+func (e *Executor) conn() sqlConn {
+	if e.tx != nil {
+		return e.tx
+	}
+
+	return e.DB
+}
+
+func (e *Executor) RunInTransaction(ctx context.Context, fn func(runner Runner) error) error {
+	var (
+		tx       *sql.Tx
+		executor *Executor
+		err      error
+	)
+
+	if fn == nil {
+		return ErrTransactionFuncIsRequired
+	}
+
+	if e.tx != nil {
+		return e.runInSavepoint(ctx, fn)
+	}
+
+	if e.DB == nil {
+		return ErrDBIsRequired
+	}
+
+	tx, err = e.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	executor = e.WithTx(tx)
+
+	defer func() {
+		var recovered interface{} = recover()
+
+		if recovered != nil {
+			tx.Rollback()
+			panic(recovered)
+		}
+	}()
+
+	err = fn(executor)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (e *Executor) runInSavepoint(ctx context.Context, fn func(runner Runner) error) error {
+	var (
+		step     *TransactionStep = &TransactionStep{Savepoint: fmt.Sprintf(savepointNamef, e.depth)}
+		executor Executor         = *e
+		err      error
+	)
+
+	_, err = e.tx.ExecContext(ctx, step.SavepointSQL())
+	if err != nil {
+		return err
+	}
+
+	executor.depth++
+
+	defer func() {
+		var recovered interface{} = recover()
+
+		if recovered != nil {
+			e.tx.ExecContext(ctx, step.RollbackSQL())
+			panic(recovered)
+		}
+	}()
+
+	err = fn(&executor)
+	if err != nil {
+		e.tx.ExecContext(ctx, step.RollbackSQL())
+		return err
+	}
+
+	_, err = e.tx.ExecContext(ctx, step.ReleaseSQL())
+
+	return err
+}
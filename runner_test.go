@@ -0,0 +1,231 @@
+package goqube
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+)
+
+func TestExecutor_WithTx(t *testing.T) {
+	var (
+		db       *sql.DB = openFakeDB(t, nil)
+		executor *Executor
+		tx       *sql.Tx
+		actual   *Executor
+		err      error
+	)
+
+	executor = NewExecutor(db, DialectPostgres)
+
+	tx, err = db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual = executor.WithTx(tx)
+
+	if actual == executor || actual.tx != tx || executor.tx != nil {
+		t.Errorf("expectation executor is a copy bound to tx %p, got %+v", tx, actual)
+	}
+
+	_, err = actual.Exec(context.Background(), DeleteFrom("users").Where(Eq("id", 1)))
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %s", err.Error())
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !deepEqual([]string{"begin", "delete from users where id = $1", "commit"}, fakeDriverInstance.queries) {
+		t.Errorf("expectation queries is %+v, got %+v", []string{"begin", "delete from users where id = $1", "commit"}, fakeDriverInstance.queries)
+	}
+}
+
+func TestExecutor_RunInTransaction(t *testing.T) {
+	var (
+		errRunner error = errors.New("runner error")
+		testCases []struct {
+			Name        string
+			Executor    func(t *testing.T) *Executor
+			Func        func(runner Runner) error
+			Expectation struct {
+				Queries []string
+				Err     error
+			}
+		}
+	)
+
+	testCases = []struct {
+		Name        string
+		Executor    func(t *testing.T) *Executor
+		Func        func(runner Runner) error
+		Expectation struct {
+			Queries []string
+			Err     error
+		}
+	}{
+		{
+			Name: "db is nil",
+			Executor: func(t *testing.T) *Executor {
+				return NewExecutor(nil, DialectPostgres)
+			},
+			Func: func(runner Runner) error {
+				return nil
+			},
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     ErrDBIsRequired,
+			},
+		},
+		{
+			Name: "func is nil",
+			Executor: func(t *testing.T) *Executor {
+				return NewExecutor(openFakeDB(t, nil), DialectPostgres)
+			},
+			Func: nil,
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     ErrTransactionFuncIsRequired,
+			},
+		},
+		{
+			Name: "commit",
+			Executor: func(t *testing.T) *Executor {
+				return NewExecutor(openFakeDB(t, nil), DialectPostgres)
+			},
+			Func: func(runner Runner) error {
+				var err error
+
+				_, err = runner.Exec(context.Background(), InsertInto("users").Value("name", "john"))
+				if err != nil {
+					return err
+				}
+
+				_, err = runner.Exec(context.Background(), Update("accounts").Set("balance", 10).Where(Eq("id", 1)))
+				return err
+			},
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: []string{"begin", "insert into users(name) values ($1)", "update accounts set balance = $1 where id = $2", "commit"},
+				Err:     nil,
+			},
+		},
+		{
+			Name: "rollback",
+			Executor: func(t *testing.T) *Executor {
+				return NewExecutor(openFakeDB(t, nil), DialectPostgres)
+			},
+			Func: func(runner Runner) error {
+				var err error
+
+				_, err = runner.Exec(context.Background(), DeleteFrom("users").Where(Eq("id", 1)))
+				if err != nil {
+					return err
+				}
+
+				return errRunner
+			},
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: []string{"begin", "delete from users where id = $1", "rollback"},
+				Err:     errRunner,
+			},
+		},
+		{
+			Name: "nested savepoints",
+			Executor: func(t *testing.T) *Executor {
+				return NewExecutor(openFakeDB(t, nil), DialectMySQL)
+			},
+			Func: func(runner Runner) error {
+				var err error
+
+				err = runner.RunInTransaction(context.Background(), func(runner Runner) error {
+					_, err := runner.Exec(context.Background(), DeleteFrom("users").Where(Eq("id", 1)))
+					if err != nil {
+						return err
+					}
+
+					return runner.RunInTransaction(context.Background(), func(runner Runner) error {
+						return errRunner
+					})
+				})
+				if err != errRunner {
+					return err
+				}
+
+				return runner.RunInTransaction(context.Background(), func(runner Runner) error {
+					_, err := runner.Exec(context.Background(), DeleteFrom("users").Where(Eq("id", 2)))
+					return err
+				})
+			},
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: []string{
+					"begin",
+					"savepoint goqube_savepoint_1",
+					"delete from users where id = ?",
+					"savepoint goqube_savepoint_2",
+					"rollback to savepoint goqube_savepoint_2",
+					"rollback to savepoint goqube_savepoint_1",
+					"savepoint goqube_savepoint_1",
+					"delete from users where id = ?",
+					"release savepoint goqube_savepoint_1",
+					"commit",
+				},
+				Err: nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				executor  *Executor = testCases[i].Executor(t)
+				actualErr error
+			)
+
+			actualErr = executor.RunInTransaction(context.Background(), testCases[i].Func)
+
+			if testCases[i].Expectation.Err != actualErr {
+				t.Errorf("expectation error is %v, got %v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Queries != nil && !deepEqual(testCases[i].Expectation.Queries, fakeDriverInstance.queries) {
+				t.Errorf("expectation queries is %+v, got %+v", testCases[i].Expectation.Queries, fakeDriverInstance.queries)
+			}
+		})
+	}
+}
+
+func TestExecutor_RunInTransaction_Panic(t *testing.T) {
+	var executor *Executor = NewExecutor(openFakeDB(t, nil), DialectPostgres)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expectation panic is propagated, got nil")
+		}
+
+		if !deepEqual([]string{"begin", "rollback"}, fakeDriverInstance.queries) {
+			t.Errorf("expectation queries is %+v, got %+v", []string{"begin", "rollback"}, fakeDriverInstance.queries)
+		}
+	}()
+
+	executor.RunInTransaction(context.Background(), func(runner Runner) error {
+		panic("runner panic")
+	})
+}
@@ -0,0 +1,336 @@
+package goqube
+
+import (
+	"fmt"
+	"strings"
+)
+
+type SchemaColumn struct {
+	Name      string
+	Type      string
+	Generated bool
+	Identity  bool
+	NotNull   bool
+}
+
+func NewSchemaColumn(name string) *SchemaColumn {
+	return &SchemaColumn{
+		Name: name,
+	}
+}
+
+func (c *SchemaColumn) OfType(columnType string) *SchemaColumn {
+	c.Type = columnType
+	return c
+}
+
+func (c *SchemaColumn) AsGenerated() *SchemaColumn {
+	c.Generated = true
+	return c
+}
+
+func (c *SchemaColumn) AsIdentity() *SchemaColumn {
+	c.Identity = true
+	return c
+}
+
+func (c *SchemaColumn) AsNotNull() *SchemaColumn {
+	c.NotNull = true
+	return c
+}
+
+func (c *SchemaColumn) isGenerated() bool {
+	return c.Generated || c.Identity
+}
+
+type SchemaTable struct {
+	Name    string
+	Columns []*SchemaColumn
+	Renames map[string]string
+	Indexes []*Index
+}
+
+func NewSchemaTable(name string) *SchemaTable {
+	return &SchemaTable{
+		Name:    name,
+		Columns: []*SchemaColumn{},
+		Renames: map[string]string{},
+		Indexes: []*Index{},
+	}
+}
+
+func (t *SchemaTable) RenameColumn(logical, physical string) *SchemaTable {
+	if t.Renames == nil {
+		t.Renames = map[string]string{}
+	}
+
+	t.Renames[logical] = physical
+	return t
+}
+
+func (t *SchemaTable) physicalColumn(column string) string {
+	if t.Renames[column] != "" {
+		return t.Renames[column]
+	}
+
+	return column
+}
+
+func (t *SchemaTable) AddColumns(columns ...*SchemaColumn) *SchemaTable {
+	t.Columns = append(t.Columns, columns...)
+	return t
+}
+
+func (t *SchemaTable) AddIndexes(indexes ...*Index) *SchemaTable {
+	t.Indexes = append(t.Indexes, indexes...)
+	return t
+}
+
+func (t *SchemaTable) Index(name string) *Index {
+	for i := range t.Indexes {
+		if t.Indexes[i] != nil && t.Indexes[i].Name == name {
+			return t.Indexes[i]
+		}
+	}
+
+	return nil
+}
+
+func (t *SchemaTable) columnDefinition(column *SchemaColumn) *ColumnDefinition {
+	var definition *ColumnDefinition = NewColumnDefinition(t.physicalColumn(column.Name), ColumnType(column.Type))
+
+	if column.Identity {
+		definition.AsAutoIncrement()
+	}
+
+	if column.NotNull {
+		definition.NotNullable()
+	}
+
+	return definition
+}
+
+func (t *SchemaTable) physicalIndex(index *Index) *Index {
+	var physical *Index = NewIndex(index.Name)
+
+	for i := range index.Columns {
+		physical.Columns = append(physical.Columns, t.physicalColumn(index.Columns[i]))
+	}
+
+	physical.Unique = index.Unique
+
+	return physical
+}
+
+func (t *SchemaTable) Column(name string) *SchemaColumn {
+	for i := range t.Columns {
+		if t.Columns[i] != nil && t.Columns[i].Name == name {
+			return t.Columns[i]
+		}
+	}
+
+	return nil
+}
+
+type Schema struct {
+	Tables map[string]*SchemaTable
+}
+
+func NewSchema() *Schema {
+	return &Schema{
+		Tables: map[string]*SchemaTable{},
+	}
+}
+
+func (s *Schema) AddTables(tables ...*SchemaTable) *Schema {
+	if s.Tables == nil {
+		s.Tables = map[string]*SchemaTable{}
+	}
+
+	for i := range tables {
+		if tables[i] != nil {
+			s.Tables[tables[i].Name] = tables[i]
+		}
+	}
+
+	return s
+}
+
+func (s *Schema) RegisterTable(name string, columns ...string) *Schema {
+	var table *SchemaTable = NewSchemaTable(name)
+
+	for i := range columns {
+		table.AddColumns(NewSchemaColumn(columns[i]))
+	}
+
+	return s.AddTables(table)
+}
+
+func (s *Schema) Column(table, column string) *SchemaColumn {
+	var schemaTable *SchemaTable
+
+	if s == nil {
+		return nil
+	}
+
+	schemaTable = s.Tables[table]
+	if schemaTable == nil {
+		return nil
+	}
+
+	return schemaTable.Column(column)
+}
+
+func (s *Schema) checkReferences(query Query) error {
+	var (
+		collector *referenceCollector
+		tables    []string
+		columns   []string
+	)
+
+	if s == nil {
+		return ErrSchemaIsRequired
+	}
+
+	collector = newReferenceCollector(query)
+
+	tables = sortedKeys(collector.tables)
+	for i := range tables {
+		if s.Tables[tables[i]] == nil {
+			return fmt.Errorf(errSchemaColumnf, ErrTableIsNotInSchema, tables[i])
+		}
+	}
+
+	columns = sortedKeys(collector.columns)
+	for i := range columns {
+		var (
+			separator int = strings.LastIndex(columns[i], ".")
+			table     string
+			column    string = columns[i]
+		)
+
+		if separator >= 0 {
+			table, column = columns[i][:separator], columns[i][separator+1:]
+		}
+
+		if column == allColumns || collector.aliases[column] || s.hasColumn(table, tables, column) {
+			continue
+		}
+
+		return fmt.Errorf(errSchemaColumnf, ErrColumnIsNotInSchema, columns[i])
+	}
+
+	return nil
+}
+
+func (s *Schema) hasColumn(table string, tables []string, column string) bool {
+	if table != "" {
+		return s.Column(table, column) != nil
+	}
+
+	for i := range tables {
+		if s.Column(tables[i], column) != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Schema) physicalColumn(table, column string) string {
+	var schemaTable *SchemaTable
+
+	if s == nil {
+		return column
+	}
+
+	schemaTable = s.Tables[table]
+	if schemaTable == nil {
+		return column
+	}
+
+	return schemaTable.physicalColumn(column)
+}
+
+func (bc *buildContext) pushScope(scope *referenceScope) {
+	scope.parent = bc.scope
+	bc.scope = scope
+}
+
+func (bc *buildContext) pushTableScope(table string) {
+	bc.pushScope(&referenceScope{defaultTable: table})
+}
+
+func (bc *buildContext) pushSelectQueryScope(selectQuery *SelectQuery) {
+	var (
+		scope  *referenceScope
+		tables []*Table
+	)
+
+	scope = &referenceScope{aliases: map[string]string{}}
+
+	tables = []*Table{selectQuery.Table}
+	for i := range selectQuery.Joins {
+		if selectQuery.Joins[i] != nil {
+			tables = append(tables, selectQuery.Joins[i].Table)
+		}
+	}
+
+	for i := range tables {
+		if tables[i] != nil && tables[i].Alias != "" {
+			scope.aliases[tables[i].Alias] = tables[i].Name
+		}
+	}
+
+	if len(selectQuery.Joins) == 0 && selectQuery.Table != nil {
+		scope.defaultTable = selectQuery.Table.Name
+	}
+
+	bc.pushScope(scope)
+}
+
+func (bc *buildContext) popScope() {
+	if bc.scope != nil {
+		bc.scope = bc.scope.parent
+	}
+}
+
+func (bc *buildContext) physicalColumn(qualifier, column string) string {
+	var table string
+
+	if bc.config.Schema == nil {
+		return bc.mapColumnName(column)
+	}
+
+	if qualifier == "" && bc.scope != nil {
+		table = bc.scope.defaultTable
+	}
+
+	if qualifier != "" {
+		var ok bool
+
+		table = qualifier
+		if bc.scope != nil {
+			table, ok = bc.scope.resolve(qualifier)
+			if !ok {
+				return bc.mapColumnName(column)
+			}
+		}
+	}
+
+	return bc.tableColumn(table, column)
+}
+
+func (bc *buildContext) skipGeneratedColumn(table, column string) (bool, error) {
+	var schemaColumn *SchemaColumn = bc.config.Schema.Column(table, column)
+
+	if schemaColumn == nil || !schemaColumn.isGenerated() {
+		return false, nil
+	}
+
+	if bc.config.GeneratedColumnPolicy == GeneratedColumnPolicyReject {
+		return false, fmt.Errorf(errGeneratedColumnf, ErrGeneratedColumn, table, column)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,287 @@
+package goqube
+
+import (
+	"fmt"
+	"sort"
+)
+
+type SchemaMigration struct {
+	Current *Schema
+	Desired *Schema
+}
+
+func NewSchemaMigration(current, desired *Schema) *SchemaMigration {
+	return &SchemaMigration{
+		Current: current,
+		Desired: desired,
+	}
+}
+
+func schemaTableNames(schema *Schema) []string {
+	var names map[string]bool = map[string]bool{}
+
+	if schema != nil {
+		for name := range schema.Tables {
+			names[name] = true
+		}
+	}
+
+	return sortedKeys(names)
+}
+
+func (m *SchemaMigration) validateColumnType(column *SchemaColumn) error {
+	if column.Type == "" {
+		return fmt.Errorf(errSchemaColumnf, ErrColumnTypeIsRequired, column.Name)
+	}
+
+	if !isValidSortModifier(column.Type, " (),") {
+		return fmt.Errorf(errSchemaColumnf, ErrColumnTypeIsInvalid, column.Name)
+	}
+
+	return nil
+}
+
+func (m *SchemaMigration) validate(dialect Dialect) error {
+	if dialect == "" {
+		return ErrDialectIsRequired
+	}
+
+	if m.Current == nil || m.Desired == nil {
+		return ErrSchemaIsRequired
+	}
+
+	return nil
+}
+
+func (m *SchemaMigration) createTable(bc *buildContext, table *SchemaTable) ([]*Statement, error) {
+	var columns int
+
+	for i := range table.Columns {
+		if table.Columns[i] == nil {
+			continue
+		}
+
+		var err error = m.validateColumnType(table.Columns[i])
+		if err != nil {
+			return nil, err
+		}
+
+		columns++
+	}
+
+	if columns == 0 {
+		return nil, fmt.Errorf(errSchemaColumnf, ErrColumnsIsRequired, table.Name)
+	}
+
+	return CreateTableFromSchema(table).toStatements(bc)
+}
+
+func (m *SchemaMigration) changeColumn(bc *buildContext, desired *SchemaTable, currentColumn, column *SchemaColumn) (*AlterTableQuery, error) {
+	var (
+		query       *AlterTableQuery = AlterTable(desired.Name)
+		physical    string           = desired.physicalColumn(column.Name)
+		typeChanged bool             = column.Type != "" && column.Type != currentColumn.Type
+	)
+
+	if typeChanged {
+		var err error = m.validateColumnType(column)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if bc.dialect == DialectMySQL {
+		if typeChanged || column.NotNull != currentColumn.NotNull || column.Identity != currentColumn.Identity {
+			var definition *ColumnDefinition = desired.columnDefinition(column)
+
+			if !typeChanged {
+				definition.Type = ColumnType(currentColumn.Type)
+			}
+
+			query.ModifyColumn(definition)
+		}
+
+		return query, nil
+	}
+
+	if typeChanged {
+		query.ChangeColumnType(physical, ColumnType(column.Type))
+	}
+
+	if column.NotNull != currentColumn.NotNull {
+		query.SetNotNull(physical, column.NotNull)
+	}
+
+	if column.Identity != currentColumn.Identity {
+		query.SetIdentity(physical, column.Identity)
+	}
+
+	return query, nil
+}
+
+func (m *SchemaMigration) alterTable(bc *buildContext, current, desired *SchemaTable) ([]*Statement, error) {
+	var (
+		queries     []Query
+		renames     []Query
+		adds        []Query
+		changes     []Query
+		dropIndexes []Query
+		addIndexes  []Query
+		drops       []string
+		statements  []*Statement
+	)
+
+	for i := range desired.Columns {
+		var (
+			column        *SchemaColumn = desired.Columns[i]
+			currentColumn *SchemaColumn
+			physical      string
+		)
+
+		if column == nil {
+			continue
+		}
+
+		physical = desired.physicalColumn(column.Name)
+		currentColumn = current.Column(column.Name)
+
+		if currentColumn == nil {
+			var err error = m.validateColumnType(column)
+			if err != nil {
+				return nil, err
+			}
+
+			adds = append(adds, AlterTable(desired.Name).AddColumn(desired.columnDefinition(column)))
+			continue
+		}
+
+		if current.physicalColumn(column.Name) != physical {
+			renames = append(renames, AlterTable(desired.Name).RenameColumn(current.physicalColumn(column.Name), physical))
+		}
+
+		var (
+			change *AlterTableQuery
+			err    error
+		)
+
+		change, err = m.changeColumn(bc, desired, currentColumn, column)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(change.Actions) > 0 {
+			changes = append(changes, change)
+		}
+	}
+
+	for i := range current.Indexes {
+		if current.Indexes[i] == nil {
+			continue
+		}
+
+		var index *Index = desired.Index(current.Indexes[i].Name)
+		if index == nil || !deepEqual(current.physicalIndex(current.Indexes[i]), desired.physicalIndex(index)) {
+			dropIndexes = append(dropIndexes, DropIndex(current.Indexes[i].Name).On(current.Name))
+		}
+	}
+
+	for i := range desired.Indexes {
+		if desired.Indexes[i] == nil {
+			continue
+		}
+
+		var (
+			index    *Index = desired.physicalIndex(desired.Indexes[i])
+			existing *Index = current.Index(index.Name)
+		)
+
+		if existing == nil || !deepEqual(current.physicalIndex(existing), index) {
+			var query *CreateIndexQuery = CreateIndex(index.Name, desired.Name, index.Columns...)
+
+			query.Unique = index.Unique
+			addIndexes = append(addIndexes, query)
+		}
+	}
+
+	for i := range current.Columns {
+		if current.Columns[i] != nil && desired.Column(current.Columns[i].Name) == nil {
+			drops = append(drops, current.physicalColumn(current.Columns[i].Name))
+		}
+	}
+
+	sort.Strings(drops)
+
+	queries = append(append(append(append(append(queries, renames...), adds...), changes...), dropIndexes...), addIndexes...)
+	for i := range drops {
+		queries = append(queries, AlterTable(current.Name).DropColumn(drops[i]))
+	}
+
+	statements = []*Statement{}
+	for i := range queries {
+		var (
+			query string
+			err   error
+		)
+
+		query, _, err = queries[i].toSQLWithArgs(bc, []interface{}{})
+		if err != nil {
+			return nil, wrapPath(fmt.Sprintf("Tables[%s]", desired.Name), err)
+		}
+
+		statements = append(statements, &Statement{Query: query, Args: []interface{}{}})
+	}
+
+	return statements, nil
+}
+
+func (m *SchemaMigration) toStatements(bc *buildContext) ([]*Statement, error) {
+	var (
+		statements []*Statement
+		err        error
+	)
+
+	err = m.validate(bc.dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	statements = []*Statement{}
+
+	for _, name := range schemaTableNames(m.Desired) {
+		var (
+			desired         *SchemaTable = m.Desired.Tables[name]
+			current         *SchemaTable = m.Current.Tables[name]
+			tableStatements []*Statement
+		)
+
+		if current == nil {
+			tableStatements, err = m.createTable(bc, desired)
+		} else {
+			tableStatements, err = m.alterTable(bc, current, desired)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		statements = append(statements, tableStatements...)
+	}
+
+	for _, name := range schemaTableNames(m.Current) {
+		if m.Desired.Tables[name] != nil {
+			continue
+		}
+
+		if !isValidIdentifier(name) {
+			return nil, wrapPath(fmt.Sprintf("Tables[%s]", name), ErrIdentifierIsInvalid)
+		}
+
+		statements = append(statements, &Statement{Query: fmt.Sprintf("drop table %s", bc.tableName(name)), Args: []interface{}{}})
+	}
+
+	return statements, nil
+}
+
+func (m *SchemaMigration) ToStatements(dialect Dialect) ([]*Statement, error) {
+	return m.toStatements(newDialectBuildContext(dialect))
+}
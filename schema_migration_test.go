@@ -0,0 +1,332 @@
+package goqube
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSchemaMigration_NewSchemaMigration(t *testing.T) {
+	var (
+		current *Schema = NewSchema()
+		desired *Schema = NewSchema()
+		actual  *SchemaMigration
+	)
+
+	actual = NewSchemaMigration(current, desired)
+
+	if actual.Current != current {
+		t.Errorf("expectation current schema is %+v, got %+v", current, actual.Current)
+	}
+
+	if actual.Desired != desired {
+		t.Errorf("expectation desired schema is %+v, got %+v", desired, actual.Desired)
+	}
+}
+
+func TestSchemaMigration_ToStatements(t *testing.T) {
+	var (
+		current           *Schema
+		desired           *Schema
+		constraintCurrent *Schema
+		constraintDesired *Schema
+		testCases         []struct {
+			Name        string
+			Migration   *SchemaMigration
+			Dialect     Dialect
+			Expectation struct {
+				Queries []string
+				Err     error
+			}
+		}
+	)
+
+	constraintCurrent = NewSchema().AddTables(
+		NewSchemaTable("users").
+			AddColumns(
+				NewSchemaColumn("id").OfType("bigint"),
+				NewSchemaColumn("name").OfType("varchar(100)").AsNotNull(),
+				NewSchemaColumn("email").OfType("varchar(100)"),
+				NewSchemaColumn("status").OfType("varchar(20)"),
+			).
+			AddIndexes(NewIndex("users_name_idx", "name"), NewIndex("users_email_idx", "email")),
+	)
+
+	constraintDesired = NewSchema().AddTables(
+		NewSchemaTable("users").
+			AddColumns(
+				NewSchemaColumn("id").OfType("bigint").AsIdentity(),
+				NewSchemaColumn("name").OfType("varchar(255)"),
+				NewSchemaColumn("email").OfType("varchar(100)").AsNotNull(),
+				NewSchemaColumn("status").OfType("varchar(20)"),
+			).
+			AddIndexes(NewIndex("users_email_idx", "email").AsUnique(), NewIndex("users_status_idx", "status")),
+		NewSchemaTable("tokens").
+			AddColumns(
+				NewSchemaColumn("id").OfType("bigint").AsIdentity().AsNotNull(),
+				NewSchemaColumn("value").OfType("text"),
+			).
+			AddIndexes(NewIndex("tokens_value_key", "value").AsUnique()),
+	)
+
+	current = NewSchema().AddTables(
+		NewSchemaTable("users").AddColumns(
+			NewSchemaColumn("id").OfType("bigint"),
+			NewSchemaColumn("name").OfType("varchar(100)"),
+			NewSchemaColumn("email").OfType("varchar(100)"),
+			NewSchemaColumn("legacy_code").OfType("text"),
+		),
+		NewSchemaTable("sessions").AddColumns(NewSchemaColumn("id").OfType("bigint")),
+	)
+
+	desired = NewSchema().AddTables(
+		NewSchemaTable("users").
+			AddColumns(
+				NewSchemaColumn("id").OfType("bigint"),
+				NewSchemaColumn("name").OfType("varchar(255)"),
+				NewSchemaColumn("email").OfType("varchar(100)"),
+				NewSchemaColumn("status").OfType("varchar(20)"),
+			).
+			RenameColumn("email", "email_address"),
+		NewSchemaTable("audit_logs").AddColumns(
+			NewSchemaColumn("id").OfType("bigint"),
+			NewSchemaColumn("payload").OfType("text"),
+		),
+	)
+
+	testCases = []struct {
+		Name        string
+		Migration   *SchemaMigration
+		Dialect     Dialect
+		Expectation struct {
+			Queries []string
+			Err     error
+		}
+	}{
+		{
+			Name:      "dialect is empty",
+			Migration: NewSchemaMigration(current, desired),
+			Dialect:   "",
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     ErrDialectIsRequired,
+			},
+		},
+		{
+			Name:      "schema is nil",
+			Migration: NewSchemaMigration(nil, desired),
+			Dialect:   DialectPostgres,
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     ErrSchemaIsRequired,
+			},
+		},
+		{
+			Name:      "new column type is empty",
+			Migration: NewSchemaMigration(current, NewSchema().AddTables(NewSchemaTable("sessions").AddColumns(NewSchemaColumn("id").OfType("bigint"), NewSchemaColumn("token")))),
+			Dialect:   DialectPostgres,
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     fmt.Errorf(errSchemaColumnf, ErrColumnTypeIsRequired, "token"),
+			},
+		},
+		{
+			Name:      "new column type is invalid",
+			Migration: NewSchemaMigration(current, NewSchema().AddTables(NewSchemaTable("tokens").AddColumns(NewSchemaColumn("id").OfType("bigint; drop table users")))),
+			Dialect:   DialectPostgres,
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     fmt.Errorf(errSchemaColumnf, ErrColumnTypeIsInvalid, "id"),
+			},
+		},
+		{
+			Name:      "new table without columns",
+			Migration: NewSchemaMigration(current, NewSchema().AddTables(NewSchemaTable("tokens"))),
+			Dialect:   DialectPostgres,
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     fmt.Errorf(errSchemaColumnf, ErrColumnsIsRequired, "tokens"),
+			},
+		},
+		{
+			Name:      "renamed column is invalid",
+			Migration: NewSchemaMigration(current, NewSchema().AddTables(NewSchemaTable("sessions").AddColumns(NewSchemaColumn("id").OfType("bigint")).RenameColumn("id", "id; drop table users"))),
+			Dialect:   DialectPostgres,
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     wrapPath("Tables[sessions].Actions[0]", fmt.Errorf(errSchemaColumnf, ErrIdentifierIsInvalid, "id; drop table users")),
+			},
+		},
+		{
+			Name:      "new table name is invalid",
+			Migration: NewSchemaMigration(NewSchema(), NewSchema().AddTables(NewSchemaTable("tokens; drop table users").AddColumns(NewSchemaColumn("id").OfType("bigint")))),
+			Dialect:   DialectPostgres,
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     ErrIdentifierIsInvalid,
+			},
+		},
+		{
+			Name:      "dropped table name is invalid",
+			Migration: NewSchemaMigration(NewSchema().AddTables(NewSchemaTable("tokens; drop table users")), NewSchema()),
+			Dialect:   DialectPostgres,
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     wrapPath("Tables[tokens; drop table users]", ErrIdentifierIsInvalid),
+			},
+		},
+		{
+			Name:      fmt.Sprintf("not null, identity and indexes with dialect %s", DialectPostgres),
+			Migration: NewSchemaMigration(constraintCurrent, constraintDesired),
+			Dialect:   DialectPostgres,
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: []string{
+					"create table tokens (id bigint generated by default as identity not null, value text)",
+					"create unique index tokens_value_key on tokens (value)",
+					"alter table users alter column id add generated by default as identity",
+					"alter table users alter column name type varchar(255), alter column name drop not null",
+					"alter table users alter column email set not null",
+					"drop index users_name_idx",
+					"drop index users_email_idx",
+					"create unique index users_email_idx on users (email)",
+					"create index users_status_idx on users (status)",
+				},
+				Err: nil,
+			},
+		},
+		{
+			Name:      fmt.Sprintf("not null, identity and indexes with dialect %s", DialectMySQL),
+			Migration: NewSchemaMigration(constraintCurrent, constraintDesired),
+			Dialect:   DialectMySQL,
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: []string{
+					"create table tokens (id bigint not null auto_increment, value text)",
+					"create unique index tokens_value_key on tokens (value)",
+					"alter table users modify column id bigint auto_increment",
+					"alter table users modify column name varchar(255)",
+					"alter table users modify column email varchar(100) not null",
+					"drop index users_name_idx on users",
+					"drop index users_email_idx on users",
+					"create unique index users_email_idx on users (email)",
+					"create index users_status_idx on users (status)",
+				},
+				Err: nil,
+			},
+		},
+		{
+			Name:      "schemas are equal",
+			Migration: NewSchemaMigration(current, current),
+			Dialect:   DialectPostgres,
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: []string{},
+				Err:     nil,
+			},
+		},
+		{
+			Name:      fmt.Sprintf("dialect %s", DialectPostgres),
+			Migration: NewSchemaMigration(current, desired),
+			Dialect:   DialectPostgres,
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: []string{
+					"create table audit_logs (id bigint, payload text)",
+					"alter table users rename column email to email_address",
+					"alter table users add column status varchar(20)",
+					"alter table users alter column name type varchar(255)",
+					"alter table users drop column legacy_code",
+					"drop table sessions",
+				},
+				Err: nil,
+			},
+		},
+		{
+			Name:      fmt.Sprintf("dialect %s", DialectMySQL),
+			Migration: NewSchemaMigration(current, desired),
+			Dialect:   DialectMySQL,
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: []string{
+					"create table audit_logs (id bigint, payload text)",
+					"alter table users rename column email to email_address",
+					"alter table users add column status varchar(20)",
+					"alter table users modify column name varchar(255)",
+					"alter table users drop column legacy_code",
+					"drop table sessions",
+				},
+				Err: nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualStatements []*Statement
+				actualQueries    []string
+				actualErr        error
+			)
+
+			actualStatements, actualErr = testCases[i].Migration.ToStatements(testCases[i].Dialect)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if actualStatements != nil {
+				actualQueries = []string{}
+			}
+
+			for j := range actualStatements {
+				actualQueries = append(actualQueries, actualStatements[j].Query)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Queries, actualQueries) {
+				t.Errorf("expectation queries is %v, got %v", testCases[i].Expectation.Queries, actualQueries)
+			}
+		})
+	}
+}
@@ -0,0 +1,631 @@
+package goqube
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestSchema_NewSchemaColumn(t *testing.T) {
+	var (
+		expectation *SchemaColumn
+		actual      *SchemaColumn
+	)
+
+	expectation = &SchemaColumn{
+		Name:      "id",
+		Type:      "bigint",
+		Generated: true,
+		Identity:  true,
+		NotNull:   true,
+	}
+	actual = NewSchemaColumn("id").OfType("bigint").AsGenerated().AsIdentity().AsNotNull()
+
+	if !deepEqual(expectation, actual) {
+		t.Errorf("expectation schema column is %+v, got %+v", expectation, actual)
+	}
+}
+
+func TestSchema_NewSchemaTable(t *testing.T) {
+	var (
+		expectation *SchemaTable
+		actual      *SchemaTable
+	)
+
+	expectation = &SchemaTable{
+		Name:    "table1",
+		Columns: []*SchemaColumn{NewSchemaColumn("id"), NewSchemaColumn("field1")},
+		Renames: map[string]string{},
+		Indexes: []*Index{NewIndex("table1_field1_idx", "field1")},
+	}
+	actual = NewSchemaTable("table1").AddColumns(NewSchemaColumn("id"), NewSchemaColumn("field1")).AddIndexes(NewIndex("table1_field1_idx", "field1"))
+
+	if !deepEqual(expectation, actual) {
+		t.Errorf("expectation schema table is %+v, got %+v", expectation, actual)
+	}
+}
+
+func TestSchema_RenameColumn(t *testing.T) {
+	var actual *SchemaTable = (&SchemaTable{Name: "users"}).RenameColumn("email", "email_address")
+
+	if actual.Renames["email"] != "email_address" {
+		t.Errorf("expectation renamed column is %s, got %s", "email_address", actual.Renames["email"])
+	}
+}
+
+func TestSchema_NewSchema(t *testing.T) {
+	var (
+		table  *SchemaTable
+		actual *Schema
+	)
+
+	table = NewSchemaTable("table1")
+	actual = (&Schema{}).AddTables(table, nil)
+
+	if len(actual.Tables) != 1 {
+		t.Errorf("expectation length of tables is %d, got %d", 1, len(actual.Tables))
+	}
+
+	if actual.Tables["table1"] != table {
+		t.Errorf("expectation table is %+v, got %+v", table, actual.Tables["table1"])
+	}
+}
+
+func TestSchema_Column(t *testing.T) {
+	var (
+		schema    *Schema
+		testCases []struct {
+			Name        string
+			Schema      *Schema
+			Table       string
+			Column      string
+			Expectation *SchemaColumn
+		}
+	)
+
+	schema = NewSchema().AddTables(
+		NewSchemaTable("table1").AddColumns(nil, NewSchemaColumn("id").AsIdentity()),
+	)
+
+	testCases = []struct {
+		Name        string
+		Schema      *Schema
+		Table       string
+		Column      string
+		Expectation *SchemaColumn
+	}{
+		{
+			Name:        "schema is nil",
+			Schema:      nil,
+			Table:       "table1",
+			Column:      "id",
+			Expectation: nil,
+		},
+		{
+			Name:        "table is not found",
+			Schema:      schema,
+			Table:       "table2",
+			Column:      "id",
+			Expectation: nil,
+		},
+		{
+			Name:        "column is not found",
+			Schema:      schema,
+			Table:       "table1",
+			Column:      "field1",
+			Expectation: nil,
+		},
+		{
+			Name:        "column is found",
+			Schema:      schema,
+			Table:       "table1",
+			Column:      "id",
+			Expectation: NewSchemaColumn("id").AsIdentity(),
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual *SchemaColumn = testCases[i].Schema.Column(testCases[i].Table, testCases[i].Column)
+
+			if !deepEqual(testCases[i].Expectation, actual) {
+				t.Errorf("expectation schema column is %+v, got %+v", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestSchema_Build(t *testing.T) {
+	var (
+		schema    *Schema
+		testCases []struct {
+			Name        string
+			Config      *Config
+			Query       Query
+			Expectation struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}
+		}
+	)
+
+	schema = NewSchema().AddTables(
+		NewSchemaTable("table1").AddColumns(
+			NewSchemaColumn("id").AsIdentity(),
+			NewSchemaColumn("field1"),
+			NewSchemaColumn("search_vector").AsGenerated(),
+		),
+	)
+
+	testCases = []struct {
+		Name        string
+		Config      *Config
+		Query       Query
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:   "insert query excludes generated columns",
+			Config: NewConfig(DialectPostgres).SetSchema(schema),
+			Query: InsertInto("table1").
+				Value("id", 1).
+				Value("field1", "value1").
+				Value("search_vector", "value2").
+				Value("id", 2).
+				Value("field1", "value3").
+				Value("search_vector", "value4"),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into table1(field1) values ($1), ($2)",
+				Args:  []interface{}{"value1", "value3"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "insert query only has generated columns",
+			Config: NewConfig(DialectPostgres).SetSchema(schema),
+			Query:  InsertInto("table1").Value("id", 1),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrFieldsIsRequired,
+			},
+		},
+		{
+			Name:   "insert query rejects generated columns",
+			Config: NewConfig(DialectPostgres).SetSchema(schema).SetGeneratedColumnPolicy(GeneratedColumnPolicyReject),
+			Query:  InsertInto("table1").Columns("id", "field1").Values(1, "value1"),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errGeneratedColumnf, ErrGeneratedColumn, "table1", "id"),
+			},
+		},
+		{
+			Name:   "update query excludes generated columns",
+			Config: NewConfig(DialectMySQL).SetSchema(schema),
+			Query: Update("table1").
+				Set("search_vector", "value1").
+				Set("field1", "value2").
+				Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update table1 set field1 = ? where id = ?",
+				Args:  []interface{}{"value2", 1},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "update query only has generated columns",
+			Config: NewConfig(DialectMySQL).SetSchema(schema),
+			Query: Update("table1").
+				Set("search_vector", "value1").
+				Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrFieldsIsRequired,
+			},
+		},
+		{
+			Name:   "update query rejects generated columns",
+			Config: NewConfig(DialectMySQL).SetSchema(schema).SetGeneratedColumnPolicy(GeneratedColumnPolicyReject),
+			Query: Update("table1").
+				Set("search_vector", "value1").
+				Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errGeneratedColumnf, ErrGeneratedColumn, "table1", "search_vector"),
+			},
+		},
+		{
+			Name:   "table is not in schema",
+			Config: NewConfig(DialectMySQL).SetSchema(schema).SetGeneratedColumnPolicy(GeneratedColumnPolicyReject),
+			Query:  InsertInto("table2").Value("id", 1),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into table2(id) values (?)",
+				Args:  []interface{}{1},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Config.Build(testCases[i].Query)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %v, got %v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
+
+func TestSchema_BuildWithRenames(t *testing.T) {
+	var (
+		schema    *Schema
+		testCases []struct {
+			Name        string
+			Config      *Config
+			Query       Query
+			Expectation struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}
+		}
+	)
+
+	schema = NewSchema().AddTables(
+		NewSchemaTable("users").RenameColumn("email", "email_address"),
+		NewSchemaTable("orders").RenameColumn("user_id", "customer_id"),
+	)
+
+	testCases = []struct {
+		Name        string
+		Config      *Config
+		Query       Query
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:   "select query with aliased tables",
+			Config: NewConfig(DialectPostgres).SetSchema(schema),
+			Query: Select(NewField("id").FromTable("u"), NewField("email").FromTable("u"), NewField("email").FromTable("o").As("order_email")).
+				From(NewTable("users").As("u")).
+				Join(InnerJoin(NewTable("orders").As("o")).On(NewFilter().SetCondition(NewField("user_id").FromTable("o"), OperatorEqual, NewColumnFilterValue("id").FromTable("u")))).
+				Where(NewFilter().SetCondition(NewField("email").FromTable("u"), OperatorEqual, NewColumnFilterValue("email").FromTable("o"))).
+				OrderBy(NewSort(NewField("email").FromTable("u"), SortDirectionAscending)),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select u.id, u.email_address as email, o.email as order_email from users as u inner join orders as o on o.customer_id = u.id where u.email_address = o.email order by u.email_address asc",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "select query with single table and subquery",
+			Config: NewConfig(DialectPostgres).SetSchema(schema),
+			Query: Select(NewField("email")).
+				From(NewTable("users")).
+				Where(NewFilter().SetCondition(NewField("id"), OperatorIn, NewSelectQueryFilterValue(Select(NewField("user_id")).From(NewTable("orders"))))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select email_address as email from users where id in (select customer_id as user_id from orders)",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "insert query",
+			Config: NewConfig(DialectPostgres).SetSchema(schema),
+			Query:  InsertInto("users").Value("email", "name1@example.com").Returning(NewField("email")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into users(email_address) values ($1) returning email_address",
+				Args:  []interface{}{"name1@example.com"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "update query",
+			Config: NewConfig(DialectMySQL).SetSchema(schema),
+			Query:  Update("users").Set("email", "name1@example.com").Where(NewFilter().SetCondition(NewField("email"), OperatorEqual, NewFilterValue("name2@example.com"))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update users set email_address = ? where email_address = ?",
+				Args:  []interface{}{"name1@example.com", "name2@example.com"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "delete query",
+			Config: NewConfig(DialectPostgres).SetSchema(schema),
+			Query:  DeleteFrom("orders").Where(NewFilter().SetCondition(NewField("user_id"), OperatorEqual, NewFilterValue(1))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "delete from orders where customer_id = $1",
+				Args:  []interface{}{1},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Config.Build(testCases[i].Query)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %v, got %v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
+
+func TestSchema_RegisterTable(t *testing.T) {
+	var (
+		expectation *Schema
+		actual      *Schema
+	)
+
+	expectation = NewSchema().AddTables(NewSchemaTable("users").AddColumns(NewSchemaColumn("id"), NewSchemaColumn("name")))
+	actual = NewSchema().RegisterTable("users", "id", "name")
+
+	if !deepEqual(expectation, actual) {
+		t.Errorf("expectation schema is %+v, got %+v", expectation, actual)
+	}
+}
+
+func TestSchema_BuildWithStrictMode(t *testing.T) {
+	var (
+		schema    *Schema
+		testCases []struct {
+			Name        string
+			Config      *Config
+			Query       Query
+			Expectation struct {
+				Query string
+				Err   error
+			}
+		}
+	)
+
+	schema = NewSchema().
+		RegisterTable("users", "id", "name", "email", "role_id").
+		RegisterTable("roles", "id", "name")
+
+	testCases = []struct {
+		Name        string
+		Config      *Config
+		Query       Query
+		Expectation struct {
+			Query string
+			Err   error
+		}
+	}{
+		{
+			Name:   "select query with known tables and columns",
+			Config: NewConfig(DialectPostgres).SetSchema(schema).SetStrictMode(true),
+			Query: Select(NewField("*").FromTable("u"), NewField("name").FromTable("r").As("role_name")).
+				From(NewTable("users").As("u")).
+				Join(InnerJoin(NewTable("roles").As("r")).On(NewFilter().SetCondition(NewField("id").FromTable("r"), OperatorEqual, NewColumnFilterValue("role_id").FromTable("u")))).
+				Where(NewFilter().SetCondition(NewField("email"), OperatorEqual, NewFilterValue("john@mail.com"))).
+				OrderBy(NewSort(NewField("role_name"), SortDirectionAscending)),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "select u.*, r.name as role_name from users as u inner join roles as r on r.id = u.role_id where email = $1 order by role_name asc",
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "select query with unknown table",
+			Config: NewConfig(DialectPostgres).SetSchema(schema).SetStrictMode(true),
+			Query:  Select(NewField("id")).From(NewTable("usres")),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrTableIsNotInSchema,
+			},
+		},
+		{
+			Name:   "select query with unknown column",
+			Config: NewConfig(DialectPostgres).SetSchema(schema).SetStrictMode(true),
+			Query:  Select(NewField("id")).From(NewTable("users")).Where(NewFilter().SetCondition(NewField("emial"), OperatorEqual, NewFilterValue("john@mail.com"))),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrColumnIsNotInSchema,
+			},
+		},
+		{
+			Name:   "select query with unknown unqualified column in join",
+			Config: NewConfig(DialectPostgres).SetSchema(schema).SetStrictMode(true),
+			Query: Select(NewField("password")).
+				From(NewTable("users").As("u")).
+				Join(InnerJoin(NewTable("roles").As("r")).On(NewFilter().SetCondition(NewField("id").FromTable("r"), OperatorEqual, NewColumnFilterValue("role_id").FromTable("u")))),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrColumnIsNotInSchema,
+			},
+		},
+		{
+			Name:   "update query with unknown column",
+			Config: NewConfig(DialectPostgres).SetSchema(schema).SetStrictMode(true),
+			Query:  Update("users").Set("is_admin", true).Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrColumnIsNotInSchema,
+			},
+		},
+		{
+			Name:   "insert query with unknown column",
+			Config: NewConfig(DialectPostgres).SetSchema(schema).SetStrictMode(true),
+			Query:  InsertInto("users").Value("name", "john").Value("password", "secret"),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrColumnIsNotInSchema,
+			},
+		},
+		{
+			Name:   "strict mode without schema",
+			Config: NewConfig(DialectPostgres).SetStrictMode(true),
+			Query:  Select(NewField("id")).From(NewTable("users")),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "",
+				Err:   ErrSchemaIsRequired,
+			},
+		},
+		{
+			Name:   "unknown column without strict mode",
+			Config: NewConfig(DialectPostgres).SetSchema(schema),
+			Query:  Select(NewField("password")).From(NewTable("users")),
+			Expectation: struct {
+				Query string
+				Err   error
+			}{
+				Query: "select password from users",
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualErr   error
+			)
+
+			actualQuery, _, actualErr = testCases[i].Config.Build(testCases[i].Query)
+
+			if !errors.Is(actualErr, testCases[i].Expectation.Err) {
+				t.Errorf("expectation error is %v, got %v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+		})
+	}
+
+	t.Run("error message", func(t *testing.T) {
+		var actualErr error
+
+		_, _, actualErr = NewConfig(DialectMySQL).SetSchema(schema).SetStrictMode(true).Build(Select(NewField("emial").FromTable("users")).From(NewTable("users")))
+
+		if actualErr == nil || actualErr.Error() != "column is not in schema: users.emial" {
+			t.Errorf("expectation error is %s, got %v", "column is not in schema: users.emial", actualErr)
+		}
+	})
+}
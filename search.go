@@ -0,0 +1,166 @@
+package goqube
+
+import (
+	"fmt"
+	"strings"
+)
+
+type SearchFilter struct {
+	Fields   []*Field
+	Query    string
+	Mode     SearchMode
+	Language string
+}
+
+func NewSearchFilter(query string, fields ...*Field) *SearchFilter {
+	return &SearchFilter{
+		Fields: fields,
+		Query:  query,
+		Mode:   SearchModeNatural,
+	}
+}
+
+func (s *SearchFilter) SetMode(mode SearchMode) *SearchFilter {
+	s.Mode = mode
+	return s
+}
+
+func (s *SearchFilter) SetLanguage(language string) *SearchFilter {
+	s.Language = language
+	return s
+}
+
+func (s *SearchFilter) Filter() *Filter {
+	return &Filter{
+		Operator: OperatorSearch,
+		Value:    NewFilterValue(s),
+	}
+}
+
+func (s *SearchFilter) Rank() *Field {
+	return NewRawField(NewFragmentRaw(&searchRank{Search: s}))
+}
+
+func (s *SearchFilter) OrderByRank() *Sort {
+	return NewSort(s.Rank(), SortDirectionDescending)
+}
+
+func (s *SearchFilter) validate() error {
+	if len(s.Fields) == 0 {
+		return ErrSearchFieldsIsRequired
+	}
+
+	for i := range s.Fields {
+		if s.Fields[i] == nil {
+			return ErrFieldIsNil
+		}
+	}
+
+	if s.Query == "" {
+		return ErrValueIsRequired
+	}
+
+	if _, ok := postgresSearchQueryFunctionMap[s.Mode]; !ok {
+		return ErrSearchModeIsInvalid
+	}
+
+	if s.Language != "" && !isValidIdentifier(s.Language) {
+		return ErrSearchLanguageIsInvalid
+	}
+
+	return nil
+}
+
+func (s *SearchFilter) writeFields(w *FragmentWriter, separator string, format string) {
+	for i := range s.Fields {
+		var sql string
+
+		if w.err != nil {
+			return
+		}
+
+		if i > 0 {
+			w.WriteSQL(separator)
+		}
+
+		sql, w.args, w.err = s.Fields[i].toSQLWithArgs(w.bc, w.args)
+		w.WriteSQL(fmt.Sprintf(format, sql))
+	}
+}
+
+func (s *SearchFilter) writeLanguage(w *FragmentWriter) {
+	if s.Language != "" {
+		w.WriteSQL("'" + s.Language + "', ")
+	}
+}
+
+func (s *SearchFilter) writePostgresVector(w *FragmentWriter) {
+	w.WriteSQL("to_tsvector(")
+	s.writeLanguage(w)
+	if len(s.Fields) == 1 {
+		s.writeFields(w, "", "%s")
+	} else {
+		s.writeFields(w, " || ' ' || ", "coalesce(%s, '')")
+	}
+	w.WriteSQL(")")
+}
+
+func (s *SearchFilter) writePostgresQuery(w *FragmentWriter) {
+	w.WriteSQL(postgresSearchQueryFunctionMap[s.Mode] + "(")
+	s.writeLanguage(w)
+	w.WriteArg(s.Query).WriteSQL(")")
+}
+
+func (s *SearchFilter) writeMySQLMatch(w *FragmentWriter) {
+	var query string = s.Query
+
+	if s.Mode == SearchModePhrase {
+		query = `"` + strings.ReplaceAll(query, `"`, "") + `"`
+	}
+
+	w.WriteSQL("match (")
+	s.writeFields(w, ", ", "%s")
+	w.WriteSQL(") against (").WriteArg(query).WriteSQL(" " + mysqlSearchModifierMap[s.Mode] + ")")
+}
+
+func (s *SearchFilter) WriteFragment(w *FragmentWriter) error {
+	var err error = s.validate()
+	if err != nil {
+		return err
+	}
+
+	switch w.Dialect() {
+	case DialectMySQL:
+		s.writeMySQLMatch(w)
+	case DialectPostgres:
+		s.writePostgresVector(w)
+		w.WriteSQL(" @@ ")
+		s.writePostgresQuery(w)
+	}
+
+	return w.Err()
+}
+
+type searchRank struct {
+	Search *SearchFilter
+}
+
+func (r *searchRank) WriteFragment(w *FragmentWriter) error {
+	var err error = r.Search.validate()
+	if err != nil {
+		return err
+	}
+
+	switch w.Dialect() {
+	case DialectMySQL:
+		r.Search.writeMySQLMatch(w)
+	case DialectPostgres:
+		w.WriteSQL("ts_rank(")
+		r.Search.writePostgresVector(w)
+		w.WriteSQL(", ")
+		r.Search.writePostgresQuery(w)
+		w.WriteSQL(")")
+	}
+
+	return w.Err()
+}
@@ -0,0 +1,284 @@
+package goqube
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSearchFilter_NewSearchFilter(t *testing.T) {
+	var (
+		expectation *SearchFilter
+		actual      *SearchFilter
+	)
+
+	expectation = &SearchFilter{
+		Fields:   []*Field{NewField("title")},
+		Query:    "go",
+		Mode:     SearchModeBoolean,
+		Language: "english",
+	}
+	actual = NewSearchFilter("go", NewField("title")).SetMode(SearchModeBoolean).SetLanguage("english")
+
+	if !deepEqual(expectation, actual) {
+		t.Errorf("expectation search filter is %+v, got %+v", expectation, actual)
+	}
+}
+
+func TestSearchFilter_ToSQLWithArgs(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Query       *SelectQuery
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Query       *SelectQuery
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:    "fields is empty",
+			Dialect: DialectPostgres,
+			Query:   Select(NewField("id")).From(NewTable("posts")).Where(NewSearchFilter("go").Filter()),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrSearchFieldsIsRequired,
+			},
+		},
+		{
+			Name:    "query is empty",
+			Dialect: DialectPostgres,
+			Query:   Select(NewField("id")).From(NewTable("posts")).Where(NewSearchFilter("", NewField("title")).Filter()),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrValueIsRequired,
+			},
+		},
+		{
+			Name:    "mode is invalid",
+			Dialect: DialectMySQL,
+			Query:   Select(NewField("id")).From(NewTable("posts")).Where(NewSearchFilter("go", NewField("title")).SetMode("fuzzy").Filter()),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrSearchModeIsInvalid,
+			},
+		},
+		{
+			Name:    "language is invalid",
+			Dialect: DialectPostgres,
+			Query:   Select(NewField("id")).From(NewTable("posts")).Where(NewSearchFilter("go", NewField("title")).SetLanguage("english'); drop").Filter()),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrSearchLanguageIsInvalid,
+			},
+		},
+		{
+			Name:    "field is not empty",
+			Dialect: DialectPostgres,
+			Query:   Select(NewField("id")).From(NewTable("posts")).Where(&Filter{Field: NewField("title"), Operator: OperatorSearch, Value: NewFilterValue(NewSearchFilter("go", NewField("title")))}),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrFieldIsNotEmpty,
+			},
+		},
+		{
+			Name:    "dialect postgres with single field and language",
+			Dialect: DialectPostgres,
+			Query: Select(NewField("id")).
+				From(NewTable("posts")).
+				Where(NewSearchFilter("go & sql", NewField("title")).SetMode(SearchModeBoolean).SetLanguage("english").Filter()).
+				Limit(10),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from posts where to_tsvector('english', title) @@ to_tsquery('english', $1) limit $2",
+				Args:  []interface{}{"go & sql", 10},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    "dialect postgres with multiple fields",
+			Dialect: DialectPostgres,
+			Query: Select(NewField("id")).
+				From(NewTable("posts")).
+				Where(NewSearchFilter("query builder", NewField("title"), NewField("body")).SetMode(SearchModePhrase).Filter()).
+				Limit(10),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from posts where to_tsvector(coalesce(title, '') || ' ' || coalesce(body, '')) @@ phraseto_tsquery($1) limit $2",
+				Args:  []interface{}{"query builder", 10},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    "dialect mysql with natural mode",
+			Dialect: DialectMySQL,
+			Query: Select(NewField("id")).
+				From(NewTable("posts")).
+				Where(NewSearchFilter("query builder", NewField("title"), NewField("body")).Filter()).
+				Limit(10),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from posts where match (title, body) against (? in natural language mode) limit ?",
+				Args:  []interface{}{"query builder", 10},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    "dialect mysql with phrase mode",
+			Dialect: DialectMySQL,
+			Query: Select(NewField("id")).
+				From(NewTable("posts")).
+				Where(NewSearchFilter(`query "builder"`, NewField("title")).SetMode(SearchModePhrase).Filter()).
+				Limit(10),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from posts where match (title) against (? in boolean mode) limit ?",
+				Args:  []interface{}{`"query builder"`, 10},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Query.ToSQLWithArgs(testCases[i].Dialect, []interface{}{})
+
+			if !errors.Is(actualErr, testCases[i].Expectation.Err) {
+				t.Errorf("expectation error is %v, got %v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %v, got %v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
+
+func TestSearchFilter_Rank(t *testing.T) {
+	var (
+		search    *SearchFilter = NewSearchFilter("go", NewField("title").FromTable("p"))
+		testCases []struct {
+			Name        string
+			Dialect     Dialect
+			Expectation string
+		}
+	)
+
+	testCases = []struct {
+		Name        string
+		Dialect     Dialect
+		Expectation string
+	}{
+		{
+			Name:        "dialect postgres",
+			Dialect:     DialectPostgres,
+			Expectation: "select p.id, ts_rank(to_tsvector(p.title), plainto_tsquery($1)) as rank from posts as p where to_tsvector(p.title) @@ plainto_tsquery($2) order by ts_rank(to_tsvector(p.title), plainto_tsquery($3)) desc limit $4",
+		},
+		{
+			Name:        "dialect mysql",
+			Dialect:     DialectMySQL,
+			Expectation: "select p.id, match (p.title) against (? in natural language mode) as rank from posts as p where match (p.title) against (? in natural language mode) order by match (p.title) against (? in natural language mode) desc limit ?",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = Select(NewField("id").FromTable("p"), search.Rank().As("rank")).
+				From(NewTable("posts").As("p")).
+				Where(search.Filter()).
+				OrderBy(search.OrderByRank()).
+				Limit(5).
+				ToSQLWithArgs(testCases[i].Dialect, []interface{}{})
+
+			if actualErr != nil {
+				t.Errorf("expectation error is nil, got %s", actualErr.Error())
+			}
+
+			if testCases[i].Expectation != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation, actualQuery)
+			}
+
+			if !deepEqual([]interface{}{"go", "go", "go", 5}, actualArgs) {
+				t.Errorf("expectation args is %v, got %v", []interface{}{"go", "go", "go", 5}, actualArgs)
+			}
+		})
+	}
+}
+
+func TestSearchFilter_StrictMode(t *testing.T) {
+	var (
+		schema *Schema = NewSchema()
+		config *Config
+		err    error
+	)
+
+	schema.RegisterTable("posts", "id", "title")
+	config = NewConfig(DialectPostgres).SetSchema(schema).SetStrictMode(true)
+
+	_, _, err = config.Build(Select(NewField("id")).From(NewTable("posts")).Where(NewSearchFilter("go", NewField("body")).Filter()).Limit(1))
+
+	if !errors.Is(err, ErrColumnIsNotInSchema) {
+		t.Errorf("expectation error is %v, got %v", ErrColumnIsNotInSchema, err)
+	}
+}
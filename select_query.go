@@ -1,20 +1,33 @@
 package goqube
 
 import (
+	"bytes"
 	"fmt"
+	"reflect"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type SelectQuery struct {
-	Fields        []*Field
-	Table         *Table
-	Joins         []*Join
-	Filter        *Filter
-	GroupByFields []*Field
-	Sorts         []*Sort
-	Take          uint64
-	Skip          uint64
-	Alias         string
+	Fields         []*Field
+	Table          *Table
+	Joins          []*Join
+	Filter         *Filter
+	GroupByFields  []*Field
+	GroupByOrdinal bool
+	Sorts          []*Sort
+	Take           uint64
+	TakeIsSet      bool
+	Skip           uint64
+	TakeWithTies   bool
+	Alias          string
+	SystemTime     string
+	LockMode       LockMode
+	LockWait       LockWait
+	Trashed        TrashedMode
+	Hints          []string
+	Comments       map[string]string
 }
 
 func Select(fields ...*Field) *SelectQuery {
@@ -50,6 +63,13 @@ func (s *SelectQuery) OrderBy(sorts ...*Sort) *SelectQuery {
 
 func (s *SelectQuery) Limit(take uint64) *SelectQuery {
 	s.Take = take
+	s.TakeIsSet = true
+	return s
+}
+
+func (s *SelectQuery) NoLimit() *SelectQuery {
+	s.Take = 0
+	s.TakeIsSet = false
 	return s
 }
 
@@ -58,12 +78,94 @@ func (s *SelectQuery) Offset(skip uint64) *SelectQuery {
 	return s
 }
 
+func (s *SelectQuery) WithTies() *SelectQuery {
+	s.TakeWithTies = true
+	return s
+}
+
+func (s *SelectQuery) AsOfSystemTime(systemTime string) *SelectQuery {
+	s.SystemTime = systemTime
+	return s
+}
+
+func (s *SelectQuery) ForUpdate() *SelectQuery {
+	s.LockMode = LockModeForUpdate
+	return s
+}
+
+func (s *SelectQuery) ForShare() *SelectQuery {
+	s.LockMode = LockModeForShare
+	return s
+}
+
+func (s *SelectQuery) SkipLocked() *SelectQuery {
+	s.LockWait = LockWaitSkipLocked
+	return s
+}
+
+func (s *SelectQuery) NoWait() *SelectQuery {
+	s.LockWait = LockWaitNoWait
+	return s
+}
+
+func (s *SelectQuery) WithTrashed() *SelectQuery {
+	s.Trashed = TrashedModeWith
+	return s
+}
+
+func (s *SelectQuery) OnlyTrashed() *SelectQuery {
+	s.Trashed = TrashedModeOnly
+	return s
+}
+
 func (s *SelectQuery) As(alias string) *SelectQuery {
 	s.Alias = alias
 	return s
 }
 
+func (s *SelectQuery) DeduplicateFields() error {
+	var (
+		fields      []*Field
+		outputNames map[string]*Field = map[string]*Field{}
+	)
+
+	for i := range s.Fields {
+		var (
+			isDuplicated bool
+			outputName   string
+		)
+
+		for j := range fields {
+			if reflect.DeepEqual(s.Fields[i], fields[j]) {
+				isDuplicated = true
+				break
+			}
+		}
+
+		if isDuplicated {
+			continue
+		}
+
+		outputName = s.Fields[i].outputName()
+		if outputName != "" {
+			if _, ok := outputNames[outputName]; ok {
+				return fmt.Errorf(errFieldAliasf, ErrFieldAliasIsDuplicated, outputName)
+			}
+
+			outputNames[outputName] = s.Fields[i]
+		}
+
+		fields = append(fields, s.Fields[i])
+	}
+
+	s.Fields = fields
+
+	return nil
+}
+
 func (s *SelectQuery) validate(dialect Dialect) error {
+	var err error
+
 	if dialect == "" {
 		return ErrDialectIsRequired
 	}
@@ -74,7 +176,7 @@ func (s *SelectQuery) validate(dialect Dialect) error {
 
 	for i := range s.Fields {
 		if s.Fields[i] == nil {
-			return ErrFieldIsNil
+			return wrapPath(fmt.Sprintf("Fields[%d]", i), ErrFieldIsNil)
 		}
 	}
 
@@ -82,153 +184,291 @@ func (s *SelectQuery) validate(dialect Dialect) error {
 		return ErrTableIsRequired
 	}
 
+	if !isValidAlias(s.Alias) {
+		return ErrAliasIsInvalid
+	}
+
+	err = s.validateHints()
+	if err != nil {
+		return err
+	}
+
+	err = validateComments(s.Comments)
+	if err != nil {
+		return err
+	}
+
+	if s.TakeWithTies {
+		if dialect == DialectMySQL {
+			return ErrUnsupportedWithTies
+		}
+
+		if s.Take == 0 {
+			return ErrTakeIsRequired
+		}
+
+		if len(s.Sorts) == 0 {
+			return ErrSortsIsRequired
+		}
+	}
+
+	return s.validateLock()
+}
+
+func (s *SelectQuery) validateLock() error {
+	if s.LockMode == "" {
+		if s.LockWait != "" {
+			return ErrLockModeIsRequired
+		}
+
+		return nil
+	}
+
+	if s.LockMode != LockModeForUpdate && s.LockMode != LockModeForShare {
+		return ErrLockModeIsInvalid
+	}
+
+	if s.LockWait != "" && s.LockWait != LockWaitSkipLocked && s.LockWait != LockWaitNoWait {
+		return ErrLockWaitIsInvalid
+	}
+
+	if len(s.GroupByFields) > 0 {
+		return ErrLockWithGroupBy
+	}
+
 	return nil
 }
 
-func (s *SelectQuery) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+func (s *SelectQuery) toSQLWithArgs(bc *buildContext, args []interface{}) (string, []interface{}, error) {
 	var (
-		fields         []string
-		table          string
-		query          string
-		joinQueries    []string
-		allJoinQueries string
-		whereClause    string
-		groupByFields  []string
-		orderBy        string
-		orderByClause  []string
-		placeholder    string
-		err            error
+		buffer     *bytes.Buffer
+		clause     string
+		hasClause  bool
+		traceStart time.Time
+		err        error
 	)
 
-	err = s.validate(dialect)
+	err = s.validate(bc.dialect)
 	if err != nil {
 		return "", nil, err
 	}
 
+	bc.pushSelectQueryScope(s)
+	defer bc.popScope()
+
+	buffer = getBuffer(selectQueryBufferSize(s))
+	defer putBuffer(buffer)
+
+	traceStart = bc.startTrace()
+	buffer.WriteString("select ")
+	buffer.WriteString(s.hintsSQL())
 	for i := range s.Fields {
 		if s.Fields != nil {
 			var field string
-			field, args, err = s.Fields[i].ToSQLWithArgsWithAlias(dialect, args)
+			field, args, err = s.Fields[i].toSelectSQLWithArgs(bc, args)
 			if err != nil {
-				return "", nil, err
+				return "", nil, wrapPath(fmt.Sprintf("Fields[%d]", i), err)
 			}
 
-			fields = append(fields, field)
+			if i > 0 {
+				buffer.WriteString(", ")
+			}
+			buffer.WriteString(field)
 		}
 	}
+	bc.endTrace(buildStageFields, traceStart)
 
+	buffer.WriteString(" from ")
 	if s.Table != nil {
-		table, args, err = s.Table.ToSQLWithArgsWithAlias(dialect, args)
+		var table string
+		table, args, err = s.Table.toSQLWithArgsWithAlias(bc, args)
 		if err != nil {
-			return "", nil, err
+			return "", nil, wrapPath("Table", err)
 		}
-	}
 
-	query = fmt.Sprintf("select %s from %s", strings.Join(fields, ", "), table)
+		buffer.WriteString(table)
+	}
 
 	if len(s.Joins) > 0 {
-		joinQueries = []string{}
-
+		traceStart = bc.startTrace()
 		for i := range s.Joins {
 			if s.Joins[i] == nil {
 				continue
 			}
 
 			var joinQuery string
-			joinQuery, args, err = s.Joins[i].ToSQLWithArgs(dialect, args)
+			joinQuery, args, err = s.Joins[i].toSQLWithArgs(bc, args)
 			if err != nil {
-				return "", nil, err
+				return "", nil, wrapPath(fmt.Sprintf("Joins[%d]", i), err)
 			}
 
-			joinQueries = append(joinQueries, joinQuery)
+			if joinQuery != "" {
+				buffer.WriteByte(' ')
+				buffer.WriteString(joinQuery)
+			}
 		}
+		bc.endTrace(buildStageJoins, traceStart)
+	}
 
-		allJoinQueries = strings.Join(joinQueries, " ")
-		if allJoinQueries != "" {
-			query = fmt.Sprintf("%s %s", query, allJoinQueries)
+	if s.SystemTime != "" {
+		if bc.config.Variant != VariantCockroach {
+			return "", nil, ErrUnsupportedAsOfSystemTime
 		}
+
+		buffer.WriteString(" as of system time ")
+		buffer.WriteString(systemTimeLiteral(s.SystemTime))
 	}
 
 	if s.Filter != nil {
-		whereClause, args, err = s.Filter.ToSQLWithArgs(dialect, args)
+		traceStart = bc.startTrace()
+		clause, args, err = s.Filter.toRootSQLWithArgs(bc, args)
+		bc.endTrace(buildStageFilter, traceStart)
 		if err != nil {
-			return "", nil, err
+			return "", nil, wrapPath("Filter", err)
 		}
 
-		if whereClause != "" {
-			query = fmt.Sprintf("%s where %s", query, whereClause)
+		if clause != "" {
+			buffer.WriteString(" where ")
+			buffer.WriteString(clause)
 		}
 	}
 
-	if len(s.GroupByFields) > 0 {
-		for i := range s.GroupByFields {
-			if s.GroupByFields[i] == nil {
-				continue
-			}
-
-			var groupByField string
-			groupByField, args, err = s.GroupByFields[i].ToSQLWithArgs(dialect, args)
-			if err != nil {
-				return "", nil, err
-			}
+	hasClause = false
+	for i := range s.GroupByFields {
+		if s.GroupByFields[i] == nil {
+			continue
+		}
 
-			groupByFields = append(groupByFields, groupByField)
+		clause, args, err = s.groupByFieldToSQLWithArgs(bc, s.GroupByFields[i], args)
+		if err != nil {
+			return "", nil, wrapPath(fmt.Sprintf("GroupByFields[%d]", i), err)
 		}
 
-		if len(groupByFields) > 0 {
-			query = fmt.Sprintf("%s group by %s", query, strings.Join(groupByFields, ", "))
+		if hasClause {
+			buffer.WriteString(", ")
+		} else {
+			buffer.WriteString(" group by ")
+			hasClause = true
 		}
+		buffer.WriteString(clause)
 	}
 
-	if len(s.Sorts) > 0 {
-		orderByClause = []string{}
-		for i := range s.Sorts {
-			if s.Sorts[i] == nil {
-				continue
-			}
+	hasClause = false
+	for i := range s.Sorts {
+		if s.Sorts[i] == nil {
+			continue
+		}
 
-			orderBy, args, err = s.Sorts[i].ToSQLWithArgs(dialect, args)
-			if err != nil {
-				return "", nil, err
-			}
+		clause, args, err = s.sortToSQLWithArgs(bc, s.Sorts[i], args)
+		if err != nil {
+			return "", nil, wrapPath(fmt.Sprintf("Sorts[%d]", i), err)
+		}
 
-			orderByClause = append(orderByClause, orderBy)
+		if hasClause {
+			buffer.WriteString(", ")
+		} else {
+			buffer.WriteString(" order by ")
+			hasClause = true
 		}
+		buffer.WriteString(clause)
+	}
 
-		if len(orderByClause) > 0 {
-			query = fmt.Sprintf("%s order by %s", query, strings.Join(orderByClause, ", "))
+	if s.TakeWithTies {
+		if s.Skip > 0 {
+			buffer.WriteString(" offset ")
+			args = writePaginationValue(bc, buffer, s.Skip, args)
+			buffer.WriteString(" rows")
 		}
+
+		buffer.WriteString(" fetch first ")
+		args = writePaginationValue(bc, buffer, s.Take, args)
+		buffer.WriteString(" rows with ties")
+	} else {
+		args = writeLimitOffset(bc, buffer, s.Take, s.TakeIsSet, s.Skip, args)
 	}
 
-	if s.Take > 0 {
-		args = append(args, s.Take)
-		placeholder = getPlaceholder(dialect, len(args), len(args))
-		query = fmt.Sprintf("%s limit %s", query, placeholder)
+	if s.LockMode != "" {
+		buffer.WriteByte(' ')
+		buffer.WriteString(string(s.LockMode))
 	}
 
-	if s.Skip > 0 {
-		args = append(args, s.Skip)
-		placeholder = getPlaceholder(dialect, len(args), len(args))
-		query = fmt.Sprintf("%s offset %s", query, placeholder)
+	if s.LockWait != "" {
+		buffer.WriteByte(' ')
+		buffer.WriteString(string(s.LockWait))
 	}
 
-	return query, args, nil
+	buffer.WriteString(commentSQL(s.Comments))
+
+	return buffer.String(), args, nil
 }
 
-func (s *SelectQuery) ToSQLWithArgsWithAlias(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+func selectQueryBufferSize(s *SelectQuery) int {
+	return 64 + 32*(len(s.Fields)+len(s.GroupByFields)+len(s.Sorts)) + 96*len(s.Joins)
+}
+
+func systemTimeLiteral(systemTime string) string {
+	if systemTime == FollowerReadTimestamp {
+		return systemTime
+	}
+
+	return fmt.Sprintf("'%s'", strings.ReplaceAll(systemTime, "'", "''"))
+}
+
+func writeLimitOffset(bc *buildContext, buffer *bytes.Buffer, take uint64, takeIsSet bool, skip uint64, args []interface{}) []interface{} {
+	var hasLimit bool = take > 0 || takeIsSet
+
+	if hasLimit {
+		buffer.WriteString(" limit ")
+		args = writePaginationValue(bc, buffer, take, args)
+	}
+
+	if !hasLimit && skip > 0 && bc.dialect == DialectMySQL {
+		buffer.WriteString(" limit ")
+		buffer.WriteString(mysqlMaxLimit)
+	}
+
+	if skip > 0 {
+		buffer.WriteString(" offset ")
+		args = writePaginationValue(bc, buffer, skip, args)
+	}
+
+	return args
+}
+
+func writePaginationValue(bc *buildContext, buffer *bytes.Buffer, value uint64, args []interface{}) []interface{} {
+	if bc.config.InlineLimitOffset {
+		buffer.WriteString(strconv.FormatUint(value, 10))
+		return args
+	}
+
+	args = append(args, value)
+	buffer.WriteString(getPlaceholder(bc.dialect, len(args), len(args)))
+
+	return args
+}
+
+func (s *SelectQuery) toSQLWithArgsWithAlias(bc *buildContext, args []interface{}) (string, []interface{}, error) {
 	var (
 		query string
 		err   error
 	)
 
-	query, args, err = s.ToSQLWithArgs(dialect, args)
+	query, args, err = s.toSQLWithArgs(bc, args)
 	if err != nil {
 		return "", nil, err
 	}
 
 	if s.Alias != "" {
-		query = fmt.Sprintf("(%s) as %s", query, s.Alias)
+		query = fmt.Sprintf("(%s) as %s", query, quoteAlias(bc.dialect, s.Alias))
 	}
 
 	return query, args, nil
 }
+
+func (s *SelectQuery) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+	return buildStatement(dialect, s, args)
+}
+
+func (s *SelectQuery) ToSQLWithArgsWithAlias(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+	return s.toSQLWithArgsWithAlias(newDialectBuildContext(dialect), args)
+}
@@ -82,10 +82,18 @@ func testSelectQuery_SelectQueryEquality(t *testing.T, expectation, actual *Sele
 		t.Errorf("expectation take is %d, got %d", expectation.Take, actual.Take)
 	}
 
+	if expectation.TakeIsSet != actual.TakeIsSet {
+		t.Errorf("expectation take is set is %t, got %t", expectation.TakeIsSet, actual.TakeIsSet)
+	}
+
 	if expectation.Skip != actual.Skip {
 		t.Errorf("expectation skip is %d, got %d", expectation.Skip, actual.Skip)
 	}
 
+	if expectation.TakeWithTies != actual.TakeWithTies {
+		t.Errorf("expectation take with ties is %t, got %t", expectation.TakeWithTies, actual.TakeWithTies)
+	}
+
 	if expectation.Alias != actual.Alias {
 		t.Errorf("expectation alias is %s, got %s", expectation.Alias, actual.Alias)
 	}
@@ -363,7 +371,8 @@ func TestSelectQuery_Limit(t *testing.T) {
 		Table: &Table{
 			Name: "table1",
 		},
-		Take: 10,
+		Take:      10,
+		TakeIsSet: true,
 	}
 
 	actual = Select(NewField("field1"), NewField("field2"), NewField("field3")).
@@ -435,6 +444,213 @@ func TestSelectQuery_As(t *testing.T) {
 	testSelectQuery_SelectQueryEquality(t, expectation, actual)
 }
 
+func TestSelectQuery_WithTies(t *testing.T) {
+	var (
+		expectation *SelectQuery
+		actual      *SelectQuery
+	)
+
+	expectation = &SelectQuery{
+		Fields: []*Field{
+			{
+				Column: "field1",
+			},
+		},
+		Table: &Table{
+			Name: "table1",
+		},
+		Take:         3,
+		TakeIsSet:    true,
+		TakeWithTies: true,
+	}
+
+	actual = Select(NewField("field1")).
+		From(NewTable("table1")).
+		Limit(3).
+		WithTies()
+
+	testSelectQuery_SelectQueryEquality(t, expectation, actual)
+}
+
+func TestSelectQuery_ForUpdate(t *testing.T) {
+	var (
+		expectation *SelectQuery
+		actual      *SelectQuery
+	)
+
+	expectation = &SelectQuery{
+		Fields: []*Field{
+			{
+				Column: "field1",
+			},
+		},
+		Table: &Table{
+			Name: "table1",
+		},
+		LockMode: LockModeForUpdate,
+		LockWait: LockWaitSkipLocked,
+	}
+
+	actual = Select(NewField("field1")).
+		From(NewTable("table1")).
+		ForUpdate().
+		SkipLocked()
+
+	testSelectQuery_SelectQueryEquality(t, expectation, actual)
+}
+
+func TestSelectQuery_ForShare(t *testing.T) {
+	var (
+		expectation *SelectQuery
+		actual      *SelectQuery
+	)
+
+	expectation = &SelectQuery{
+		Fields: []*Field{
+			{
+				Column: "field1",
+			},
+		},
+		Table: &Table{
+			Name: "table1",
+		},
+		LockMode: LockModeForShare,
+		LockWait: LockWaitNoWait,
+	}
+
+	actual = Select(NewField("field1")).
+		From(NewTable("table1")).
+		ForShare().
+		NoWait()
+
+	testSelectQuery_SelectQueryEquality(t, expectation, actual)
+}
+
+func TestSelectQuery_AsOfSystemTime(t *testing.T) {
+	var actual *SelectQuery = Select(NewField("field1")).From(NewTable("table1")).AsOfSystemTime("-10s")
+
+	if actual.SystemTime != "-10s" {
+		t.Errorf("expectation system time is %s, got %s", "-10s", actual.SystemTime)
+	}
+}
+
+func TestSelectQuery_DeduplicateFields(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		SelectQuery *SelectQuery
+		Expectation struct {
+			Fields []*Field
+			Err    error
+		}
+	} = []struct {
+		Name        string
+		SelectQuery *SelectQuery
+		Expectation struct {
+			Fields []*Field
+			Err    error
+		}
+	}{
+		{
+			Name: "fields without duplicates",
+			SelectQuery: Select(
+				NewField("id").FromTable("u"),
+				NewField("name").FromTable("u"),
+				NewField("*").FromTable("o"),
+				NewRawField(NewRaw("count(*)")),
+			),
+			Expectation: struct {
+				Fields []*Field
+				Err    error
+			}{
+				Fields: []*Field{
+					NewField("id").FromTable("u"),
+					NewField("name").FromTable("u"),
+					NewField("*").FromTable("o"),
+					NewRawField(NewRaw("count(*)")),
+				},
+				Err: nil,
+			},
+		},
+		{
+			Name: "exact duplicated fields are removed",
+			SelectQuery: Select(
+				NewField("id").FromTable("u"),
+				NewField("name").FromTable("u").As("user_name"),
+				NewField("id").FromTable("u"),
+				NewField("name").FromTable("u").As("user_name"),
+				NewField("status"),
+			),
+			Expectation: struct {
+				Fields []*Field
+				Err    error
+			}{
+				Fields: []*Field{
+					NewField("id").FromTable("u"),
+					NewField("name").FromTable("u").As("user_name"),
+					NewField("status"),
+				},
+				Err: nil,
+			},
+		},
+		{
+			Name: "column collides with column from another table",
+			SelectQuery: Select(
+				NewField("id").FromTable("u"),
+				NewField("id").FromTable("o"),
+			),
+			Expectation: struct {
+				Fields []*Field
+				Err    error
+			}{
+				Fields: []*Field{
+					NewField("id").FromTable("u"),
+					NewField("id").FromTable("o"),
+				},
+				Err: fmt.Errorf(errFieldAliasf, ErrFieldAliasIsDuplicated, "id"),
+			},
+		},
+		{
+			Name: "alias collides with column",
+			SelectQuery: Select(
+				NewField("name"),
+				NewSelectQueryField(Select(NewField("name")).From(NewTable("profiles"))).As("name"),
+			),
+			Expectation: struct {
+				Fields []*Field
+				Err    error
+			}{
+				Fields: []*Field{
+					NewField("name"),
+					NewSelectQueryField(Select(NewField("name")).From(NewTable("profiles"))).As("name"),
+				},
+				Err: fmt.Errorf(errFieldAliasf, ErrFieldAliasIsDuplicated, "name"),
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actualErr error = testCases[i].SelectQuery.DeduplicateFields()
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if !deepEqual(testCases[i].Expectation.Fields, testCases[i].SelectQuery.Fields) {
+				t.Errorf("expectation fields is %+v, got %+v", testCases[i].Expectation.Fields, testCases[i].SelectQuery.Fields)
+			}
+		})
+	}
+}
+
 func TestSelectQuery_validate(t *testing.T) {
 	var testCases []struct {
 		Name        string
@@ -465,7 +681,7 @@ func TestSelectQuery_validate(t *testing.T) {
 			SelectQuery: &SelectQuery{
 				Fields: []*Field{nil},
 			},
-			Expectation: ErrFieldIsNil,
+			Expectation: &PathError{Path: "Fields[0]", Err: ErrFieldIsNil},
 		},
 		{
 			Name:    "table is nil",
@@ -479,6 +695,24 @@ func TestSelectQuery_validate(t *testing.T) {
 			},
 			Expectation: ErrTableIsRequired,
 		},
+		{
+			Name:        fmt.Sprintf("with ties with dialect %s", DialectMySQL),
+			Dialect:     DialectMySQL,
+			SelectQuery: Select(NewField("field1")).From(NewTable("table1")).Limit(3).WithTies(),
+			Expectation: ErrUnsupportedWithTies,
+		},
+		{
+			Name:        "with ties without take",
+			Dialect:     DialectPostgres,
+			SelectQuery: Select(NewField("field1")).From(NewTable("table1")).WithTies(),
+			Expectation: ErrTakeIsRequired,
+		},
+		{
+			Name:        "with ties without sorts",
+			Dialect:     DialectPostgres,
+			SelectQuery: Select(NewField("field1")).From(NewTable("table1")).Limit(3).WithTies(),
+			Expectation: ErrSortsIsRequired,
+		},
 		{
 			Name:    "select query is valid",
 			Dialect: DialectPostgres,
@@ -549,6 +783,104 @@ func TestSelectQuery_ToSQLWithArgs(t *testing.T) {
 				Err:   ErrFieldsIsRequired,
 			},
 		},
+		{
+			Name:        "lock wait without lock mode",
+			SelectQuery: Select(NewField("field1")).From(NewTable("table1")).SkipLocked(),
+			Dialect:     DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrLockModeIsRequired,
+			},
+		},
+		{
+			Name:        "lock mode is invalid",
+			SelectQuery: &SelectQuery{Fields: []*Field{NewField("field1")}, Table: NewTable("table1"), LockMode: "for key share"},
+			Dialect:     DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrLockModeIsInvalid,
+			},
+		},
+		{
+			Name:        "lock wait is invalid",
+			SelectQuery: &SelectQuery{Fields: []*Field{NewField("field1")}, Table: NewTable("table1"), LockMode: LockModeForUpdate, LockWait: "wait 5"},
+			Dialect:     DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrLockWaitIsInvalid,
+			},
+		},
+		{
+			Name:        "lock with group by",
+			SelectQuery: Select(NewField("field1")).From(NewTable("table1")).GroupBy(NewField("field1")).ForUpdate(),
+			Dialect:     DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrLockWithGroupBy,
+			},
+		},
+		{
+			Name:        fmt.Sprintf("for update skip locked with dialect %s", DialectPostgres),
+			SelectQuery: Select(NewField("id")).From(NewTable("jobs")).Where(NewFilter().SetCondition(NewField("status"), OperatorEqual, NewFilterValue("pending"))).OrderBy(NewSort(NewField("id"), SortDirectionAscending)).Limit(10).ForUpdate().SkipLocked(),
+			Dialect:     DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from jobs where status = $1 order by id asc limit $2 for update skip locked",
+				Args:  []interface{}{"pending", uint64(10)},
+				Err:   nil,
+			},
+		},
+		{
+			Name:        fmt.Sprintf("for share nowait with dialect %s", DialectMySQL),
+			SelectQuery: Select(NewField("id")).From(NewTable("accounts")).Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))).ForShare().NoWait(),
+			Dialect:     DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from accounts where id = ? for share nowait",
+				Args:  []interface{}{1},
+				Err:   nil,
+			},
+		},
+		{
+			Name:        fmt.Sprintf("for update with ties with dialect %s", DialectPostgres),
+			SelectQuery: Select(NewField("id")).From(NewTable("jobs")).OrderBy(NewSort(NewField("priority"), SortDirectionDescending)).Limit(5).WithTies().ForUpdate(),
+			Dialect:     DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from jobs order by priority desc fetch first $1 rows with ties for update",
+				Args:  []interface{}{uint64(5)},
+				Err:   nil,
+			},
+		},
 		{
 			Name: "fields is not empty and fields element is not nil and fields element to sql with args with alias is error",
 			SelectQuery: &SelectQuery{
@@ -565,7 +897,7 @@ func TestSelectQuery_ToSQLWithArgs(t *testing.T) {
 			}{
 				Query: "",
 				Args:  nil,
-				Err:   ErrColumnIsRequired,
+				Err:   &PathError{Path: "Fields[0]", Err: ErrColumnIsRequired},
 			},
 		},
 		{
@@ -609,7 +941,7 @@ func TestSelectQuery_ToSQLWithArgs(t *testing.T) {
 			}{
 				Query: "",
 				Args:  nil,
-				Err:   ErrNameIsRequired,
+				Err:   &PathError{Path: "Table", Err: ErrNameIsRequired},
 			},
 		},
 		{
@@ -664,7 +996,7 @@ func TestSelectQuery_ToSQLWithArgs(t *testing.T) {
 			}{
 				Query: "",
 				Args:  nil,
-				Err:   ErrFilterIsRequired,
+				Err:   &PathError{Path: "Joins[0]", Err: ErrFilterIsRequired},
 			},
 		},
 		{
@@ -738,7 +1070,7 @@ func TestSelectQuery_ToSQLWithArgs(t *testing.T) {
 			}{
 				Query: "",
 				Args:  nil,
-				Err:   ErrFiltersIsRequired,
+				Err:   &PathError{Path: "Filter", Err: ErrFiltersIsRequired},
 			},
 		},
 		{
@@ -825,7 +1157,7 @@ func TestSelectQuery_ToSQLWithArgs(t *testing.T) {
 			}{
 				Query: "",
 				Args:  nil,
-				Err:   ErrColumnIsRequired,
+				Err:   &PathError{Path: "GroupByFields[0]", Err: ErrColumnIsRequired},
 			},
 		},
 		{
@@ -912,7 +1244,7 @@ func TestSelectQuery_ToSQLWithArgs(t *testing.T) {
 			}{
 				Query: "",
 				Args:  nil,
-				Err:   ErrFieldIsRequired,
+				Err:   &PathError{Path: "Sorts[0]", Err: ErrFieldIsRequired},
 			},
 		},
 		{
@@ -994,6 +1326,112 @@ func TestSelectQuery_ToSQLWithArgs(t *testing.T) {
 				Err:   nil,
 			},
 		},
+		{
+			Name: fmt.Sprintf("dialect %s with skip only", DialectMySQL),
+			SelectQuery: &SelectQuery{
+				Fields: []*Field{
+					{
+						Column: "field1",
+					},
+				},
+				Table: &Table{
+					Name: "table1",
+				},
+				Skip: 10,
+			},
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select field1 from table1 limit 18446744073709551615 offset ?",
+				Args:  []interface{}{10},
+				Err:   nil,
+			},
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s with limit zero", DialectPostgres),
+			SelectQuery: Select(NewField("field1")).From(NewTable("table1")).Limit(0),
+			Dialect:     DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select field1 from table1 limit $1",
+				Args:  []interface{}{uint64(0)},
+				Err:   nil,
+			},
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s with limit zero and skip", DialectMySQL),
+			SelectQuery: Select(NewField("field1")).From(NewTable("table1")).Limit(0).Offset(10),
+			Dialect:     DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select field1 from table1 limit ? offset ?",
+				Args:  []interface{}{uint64(0), uint64(10)},
+				Err:   nil,
+			},
+		},
+		{
+			Name:        fmt.Sprintf("dialect %s with no limit and skip", DialectMySQL),
+			SelectQuery: Select(NewField("field1")).From(NewTable("table1")).Limit(0).NoLimit().Offset(10),
+			Dialect:     DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select field1 from table1 limit 18446744073709551615 offset ?",
+				Args:  []interface{}{uint64(10)},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with select and raw join targets", DialectPostgres),
+			SelectQuery: Select(NewField("id").FromTable("u"), NewField("total").FromTable("o")).
+				From(NewTable("users").As("u")).
+				Join(InnerJoin(SelectAs(Select(NewField("user_id"), NewField("total")).From(NewTable("orders")).Where(NewFilter().SetCondition(NewField("status"), OperatorEqual, NewFilterValue("paid"))), "o")).
+					On(NewFilter().SetCondition(NewField("user_id").FromTable("o"), OperatorEqual, NewColumnFilterValue("id").FromTable("u")))).
+				Join(LeftJoin(RawAs(NewRaw("select user_id from bans where until > ?", 100), "b")).
+					On(NewFilter().SetCondition(NewField("user_id").FromTable("b"), OperatorEqual, NewColumnFilterValue("id").FromTable("u")))).
+				Where(NewFilter().SetCondition(NewField("name").FromTable("u"), OperatorEqual, NewFilterValue("user1"))),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select u.id, o.total from users as u inner join (select user_id, total from orders where status = $1) as o on o.user_id = u.id left join (select user_id from bans where until > $2) as b on b.user_id = u.id where u.name = $3",
+				Args:  []interface{}{"paid", 100, "user1"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("dialect %s with ties", DialectPostgres),
+			SelectQuery: Select(NewField("name"), NewField("score")).
+				From(NewTable("scores")).
+				Where(NewFilter().SetCondition(NewField("season"), OperatorEqual, NewFilterValue(1))).
+				OrderBy(NewSort(NewField("score"), SortDirectionDescending)).
+				Limit(3).
+				Offset(10).
+				WithTies(),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select name, score from scores where season = $1 order by score desc offset $2 rows fetch first $3 rows with ties",
+				Args:  []interface{}{1, uint64(10), uint64(3)},
+				Err:   nil,
+			},
+		},
 	}
 
 	for i := range testCases {
@@ -1133,3 +1571,119 @@ func TestSelectQuery_ToSQLWithArgsWithAlias(t *testing.T) {
 		})
 	}
 }
+
+func benchmarkSelectQuery() *SelectQuery {
+	var (
+		selectQuery *SelectQuery
+		filter      *Filter = NewFilter().SetLogic(LogicAnd)
+	)
+
+	selectQuery = Select(NewField("id").FromTable("u"), NewField("name").FromTable("u"), NewField("email").FromTable("u")).
+		From(NewTable("users").As("u"))
+
+	for i := 1; i <= 8; i++ {
+		var alias string = fmt.Sprintf("t%d", i)
+
+		selectQuery.Fields = append(selectQuery.Fields, NewField("value").FromTable(alias).As(fmt.Sprintf("value%d", i)))
+		selectQuery.Join(LeftJoin(NewTable(fmt.Sprintf("table%d", i)).As(alias)).On(NewFilter().SetCondition(NewField("user_id").FromTable(alias), OperatorEqual, NewFilterValue(NewField("id").FromTable("u")))))
+		filter.AddFilters(NewFilter().SetCondition(NewField("status").FromTable(alias), OperatorIn, NewFilterValue([]string{"active", "pending", "verified"})))
+	}
+
+	return selectQuery.
+		Where(filter).
+		OrderBy(NewSort(NewField("name").FromTable("u"), SortDirectionAscending)).
+		Limit(50).
+		Offset(100)
+}
+
+func BenchmarkSelectQuery_ToSQLWithArgs(b *testing.B) {
+	var selectQuery *SelectQuery = benchmarkSelectQuery()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _, err := selectQuery.ToSQLWithArgs(DialectPostgres, []interface{}{})
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestSelectQuery_ToSQLWithArgs_ParameterNumbering(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Args        []interface{}
+		SelectQuery *SelectQuery
+		Expectation struct {
+			Query string
+			Args  []interface{}
+		}
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Args        []interface{}
+		SelectQuery *SelectQuery
+		Expectation struct {
+			Query string
+			Args  []interface{}
+		}
+	}{
+		{
+			Name:    "nested structures continue outer parameters",
+			Dialect: DialectPostgres,
+			Args:    []interface{}{"outer"},
+			SelectQuery: Select(
+				NewExpressionField(NewFunctionExpression("coalesce", NewField("nickname").FromTable("u"), "anonymous")).As("name"),
+				NewSelectQueryField(Select(NewRawField(NewRaw("count(*)"))).
+					From(NewTable("orders").As("o")).
+					Where(NewFilter().
+						SetLogic(LogicAnd).
+						AddFilter(NewField("user_id").FromTable("o"), OperatorEqual, NewOuterColumnFilterValue("u", "id")).
+						AddFilter(NewField("status").FromTable("o"), OperatorEqual, NewFilterValue("paid")))).As("paid_orders"),
+			).
+				From(NewTable("users").As("u")).
+				Join(LeftJoin(NewSelectQueryTable(Select(NewField("user_id"), NewField("score")).From(NewTable("ratings")).Where(Eq("kind", "review"))).As("r")).
+					On(NewFilter().
+						SetLogic(LogicAnd).
+						AddFilter(NewField("user_id").FromTable("r"), OperatorEqual, NewColumnFilterValue("id").FromTable("u")).
+						AddFilter(NewField("score").FromTable("r"), OperatorGreaterThan, NewFilterValue(3)))).
+				Where(NewFilter().SetCondition(NewField("id").FromTable("u"), OperatorIn, NewSelectQueryFilterValue(Select(NewField("user_id")).From(NewTable("sessions")).Where(Eq("active", true))))).
+				GroupBy(NewField("id").FromTable("u"), NewExpressionField(NewFunctionExpression("date_trunc", "day", NewField("created_at").FromTable("u")))).
+				OrderBy(NewSort(NewExpressionField(Case().When(Eq("u.tier", "gold"), 1).Else(2)), SortDirectionAscending)).
+				Limit(10),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+			}{
+				Query: "select coalesce(u.nickname, $2) as name, (select count(*) from orders as o where o.user_id = u.id and o.status = $3) as paid_orders from users as u left join (select user_id, score from ratings where kind = $4) as r on r.user_id = u.id and r.score > $5 where u.id in (select user_id from sessions where active = $6) group by u.id, date_trunc($7, u.created_at) order by case when u.tier = $8 then $9 else $10 end asc limit $11",
+				Args:  []interface{}{"outer", "anonymous", "paid", "review", 3, true, "day", "gold", 1, 2, uint64(10)},
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].SelectQuery.ToSQLWithArgs(testCases[i].Dialect, testCases[i].Args)
+
+			if actualErr != nil {
+				t.Errorf("expectation error is nil, got %s", actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %+v, got %+v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package goqube
+
+import "fmt"
+
+type Sensitivity struct {
+	Encrypt       func(value interface{}) (interface{}, error)
+	EncryptFormat string
+	DecryptFormat string
+}
+
+func NewSensitivity() *Sensitivity {
+	return &Sensitivity{}
+}
+
+func (s *Sensitivity) EncryptWith(encrypt func(value interface{}) (interface{}, error)) *Sensitivity {
+	s.Encrypt = encrypt
+	return s
+}
+
+func (s *Sensitivity) WrapPlaceholder(format string) *Sensitivity {
+	s.EncryptFormat = format
+	return s
+}
+
+func (s *Sensitivity) WrapColumn(format string) *Sensitivity {
+	s.DecryptFormat = format
+	return s
+}
+
+func (s *Sensitivity) encryptValue(value interface{}) (interface{}, error) {
+	if s.Encrypt == nil {
+		return value, nil
+	}
+
+	return s.Encrypt(value)
+}
+
+func (s *Sensitivity) encryptPlaceholder(placeholder string) string {
+	if s.EncryptFormat == "" {
+		return placeholder
+	}
+
+	return fmt.Sprintf(s.EncryptFormat, placeholder)
+}
+
+func (s *Sensitivity) decryptColumn(column string) string {
+	if s.DecryptFormat == "" {
+		return column
+	}
+
+	return fmt.Sprintf(s.DecryptFormat, column)
+}
+
+func (bc *buildContext) sensitivity(table, column string) *Sensitivity {
+	var sensitivity *Sensitivity
+
+	if len(bc.config.Sensitivities) == 0 || column == "" {
+		return nil
+	}
+
+	if table != "" {
+		sensitivity = bc.config.Sensitivities[fmt.Sprintf("%s.%s", table, column)]
+		if sensitivity != nil {
+			return sensitivity
+		}
+	}
+
+	return bc.config.Sensitivities[column]
+}
+
+func (bc *buildContext) sensitiveValueWithPlaceholder(table, column string, value interface{}, args []interface{}) (string, []interface{}, error) {
+	var (
+		sensitivity *Sensitivity
+		placeholder string
+		err         error
+	)
+
+	value = bc.adaptValue(value)
+
+	sensitivity = bc.sensitivity(table, column)
+	if param, ok := value.(ParamValue); ok && sensitivity != nil {
+		value = param.with(func(value interface{}) (interface{}, error) {
+			var (
+				encrypted interface{}
+				err       error
+			)
+
+			encrypted, err = sensitivity.encryptValue(value)
+			if err != nil {
+				return nil, fmt.Errorf(errSensitiveColumnf, column, err)
+			}
+
+			return encrypted, nil
+		})
+	} else if sensitivity != nil {
+		value, err = sensitivity.encryptValue(value)
+		if err != nil {
+			return "", nil, fmt.Errorf(errSensitiveColumnf, column, err)
+		}
+	}
+
+	args = append(args, value)
+	placeholder = valuePlaceholder(bc.dialect, value, len(args))
+
+	if sensitivity != nil {
+		placeholder = sensitivity.encryptPlaceholder(placeholder)
+	}
+
+	return placeholder, args, nil
+}
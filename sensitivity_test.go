@@ -0,0 +1,205 @@
+package goqube
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestSensitivity_NewSensitivity(t *testing.T) {
+	var actual *Sensitivity = NewSensitivity().
+		WrapPlaceholder("pgp_sym_encrypt(%s, 'key1')").
+		WrapColumn("pgp_sym_decrypt(%s, 'key1')")
+
+	if actual.EncryptFormat != "pgp_sym_encrypt(%s, 'key1')" {
+		t.Errorf("expectation encrypt format is %s, got %s", "pgp_sym_encrypt(%s, 'key1')", actual.EncryptFormat)
+	}
+
+	if actual.DecryptFormat != "pgp_sym_decrypt(%s, 'key1')" {
+		t.Errorf("expectation decrypt format is %s, got %s", "pgp_sym_decrypt(%s, 'key1')", actual.DecryptFormat)
+	}
+}
+
+func TestSensitivity_sensitivity(t *testing.T) {
+	var (
+		tableSensitivity  *Sensitivity
+		columnSensitivity *Sensitivity
+		bc                *buildContext
+	)
+
+	tableSensitivity = NewSensitivity()
+	columnSensitivity = NewSensitivity()
+	bc = newBuildContext(
+		NewConfig(DialectPostgres).
+			SetSensitivity("table1.field1", tableSensitivity).
+			SetSensitivity("field1", columnSensitivity),
+	)
+
+	if bc.sensitivity("table1", "field1") != tableSensitivity {
+		t.Error("expectation table qualified sensitivity is preferred")
+	}
+
+	if bc.sensitivity("table2", "field1") != columnSensitivity {
+		t.Error("expectation column sensitivity is used as fallback")
+	}
+
+	if bc.sensitivity("table1", "field2") != nil {
+		t.Error("expectation sensitivity of unregistered column is nil")
+	}
+}
+
+func TestSensitivity_Build(t *testing.T) {
+	var (
+		errKeyIsMissing error = errors.New("key is missing")
+		config          *Config
+		testCases       []struct {
+			Name        string
+			Query       Query
+			Expectation struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}
+		}
+	)
+
+	config = NewConfig(DialectPostgres).
+		SetSensitivity(
+			"users.email",
+			NewSensitivity().
+				WrapPlaceholder("pgp_sym_encrypt(%s, 'key1')").
+				WrapColumn("pgp_sym_decrypt(%s, 'key1')"),
+		).
+		SetSensitivity(
+			"phone",
+			NewSensitivity().
+				EncryptWith(func(value interface{}) (interface{}, error) {
+					return fmt.Sprintf("encrypted:%v", value), nil
+				}),
+		).
+		SetSensitivity(
+			"users.password",
+			NewSensitivity().
+				EncryptWith(func(value interface{}) (interface{}, error) {
+					return nil, errKeyIsMissing
+				}),
+		)
+
+	testCases = []struct {
+		Name        string
+		Query       Query
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name: "insert query with sensitive columns",
+			Query: Insert().
+				Into("users").
+				Value("email", "user1@mail.com").
+				Value("name", "user1").
+				Value("phone", "0811"),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into users(email, name, phone) values (pgp_sym_encrypt($1, 'key1'), $2, $3)",
+				Args:  []interface{}{"user1@mail.com", "user1", "encrypted:0811"},
+				Err:   nil,
+			},
+		},
+		{
+			Name: "update query with sensitive column",
+			Query: Update("users").
+				Set("email", "user1@mail.com").
+				Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update users set email = pgp_sym_encrypt($1, 'key1') where id = $2",
+				Args:  []interface{}{"user1@mail.com", 1},
+				Err:   nil,
+			},
+		},
+		{
+			Name: "update query with sensitive column and encrypt is error",
+			Query: Update("users").
+				Set("password", "secret").
+				Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errSensitiveColumnf, "password", errKeyIsMissing),
+			},
+		},
+		{
+			Name: "select query with sensitive column",
+			Query: Select(
+				NewField("id").FromTable("users"),
+				NewField("email").FromTable("users"),
+				NewField("email").FromTable("users").As("user_email"),
+			).
+				From(NewTable("users")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select users.id, pgp_sym_decrypt(users.email, 'key1') as email, pgp_sym_decrypt(users.email, 'key1') as user_email from users",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = config.Build(testCases[i].Query)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Err != nil && !errors.Is(actualErr, errors.Unwrap(testCases[i].Expectation.Err)) {
+				t.Errorf("expectation error wraps %v, got %v", errors.Unwrap(testCases[i].Expectation.Err), actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if len(testCases[i].Expectation.Args) != len(actualArgs) {
+				t.Errorf("expectation length of args is %d, got %d", len(testCases[i].Expectation.Args), len(actualArgs))
+			}
+
+			for j := range testCases[i].Expectation.Args {
+				if !deepEqual(testCases[i].Expectation.Args[j], actualArgs[j]) {
+					t.Errorf("expectation element of args is %v, got %v", testCases[i].Expectation.Args[j], actualArgs[j])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package goqube
+
+type SoftDelete struct {
+	Column       string
+	DeletedValue interface{}
+	Tables       []string
+}
+
+func NewSoftDelete(column string) *SoftDelete {
+	return &SoftDelete{
+		Column: column,
+	}
+}
+
+func (s *SoftDelete) SetDeletedValue(value interface{}) *SoftDelete {
+	s.DeletedValue = value
+	return s
+}
+
+func (s *SoftDelete) ForTables(tables ...string) *SoftDelete {
+	s.Tables = tables
+	return s
+}
+
+func (s *SoftDelete) validate() error {
+	if s.Column == "" {
+		return ErrColumnIsRequired
+	}
+
+	return nil
+}
+
+func (s *SoftDelete) appliesTo(table string) bool {
+	return table != "" && (len(s.Tables) == 0 || containsString(s.Tables, table))
+}
+
+func (s *SoftDelete) field(qualifier string) *Field {
+	return NewField(s.Column).FromTable(qualifier)
+}
+
+func (s *SoftDelete) liveFilter(qualifier string) *Filter {
+	if s.DeletedValue == nil {
+		return NewFilter().SetCondition(s.field(qualifier), OperatorIsNull, nil)
+	}
+
+	return NewFilter().SetCondition(s.field(qualifier), OperatorNotEqual, filterValueOf(s.DeletedValue))
+}
+
+func (s *SoftDelete) trashedFilter(qualifier string) *Filter {
+	if s.DeletedValue == nil {
+		return NewFilter().SetCondition(s.field(qualifier), OperatorIsNotNull, nil)
+	}
+
+	return NewFilter().SetCondition(s.field(qualifier), OperatorEqual, filterValueOf(s.DeletedValue))
+}
+
+func (s *SoftDelete) deletedValue() interface{} {
+	if s.DeletedValue == nil {
+		return Now()
+	}
+
+	return s.DeletedValue
+}
+
+func (s *SoftDelete) rewrite(query Query) (Query, error) {
+	var err error = s.validate()
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch q := query.(type) {
+	case *DeleteQuery:
+		return s.rewriteDeleteQuery(q), nil
+	case *SelectQuery:
+		return s.rewriteSelectQuery(q), nil
+	}
+
+	return query, nil
+}
+
+func (s *SoftDelete) rewriteDeleteQuery(deleteQuery *DeleteQuery) Query {
+	if deleteQuery.Force || !s.appliesTo(deleteQuery.Table) {
+		return deleteQuery
+	}
+
+	return &UpdateQuery{
+		Table:       deleteQuery.Table,
+		Fields:      []string{s.Column},
+		FieldsValue: map[string]interface{}{s.Column: s.deletedValue()},
+		Filter:      And(deleteQuery.Filter, s.liveFilter("")),
+		Returnings:  deleteQuery.Returnings,
+		Comments:    deleteQuery.Comments,
+	}
+}
+
+func (s *SoftDelete) rewriteSelectQuery(selectQuery *SelectQuery) Query {
+	var (
+		rewritten SelectQuery
+		qualifier string
+		filter    *Filter
+	)
+
+	if selectQuery.Trashed == TrashedModeWith || selectQuery.Table == nil || !s.appliesTo(selectQuery.Table.Name) {
+		return selectQuery
+	}
+
+	if len(selectQuery.Joins) > 0 {
+		qualifier = selectQuery.Table.Name
+		if selectQuery.Table.Alias != "" {
+			qualifier = selectQuery.Table.Alias
+		}
+	}
+
+	filter = s.liveFilter(qualifier)
+	if selectQuery.Trashed == TrashedModeOnly {
+		filter = s.trashedFilter(qualifier)
+	}
+
+	rewritten = *selectQuery
+	rewritten.Filter = And(selectQuery.Filter, filter)
+
+	return &rewritten
+}
@@ -0,0 +1,238 @@
+package goqube
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSoftDelete_NewSoftDelete(t *testing.T) {
+	var (
+		expectation *SoftDelete = &SoftDelete{Column: "is_deleted", DeletedValue: true, Tables: []string{"users"}}
+		actual      *SoftDelete = NewSoftDelete("is_deleted").SetDeletedValue(true).ForTables("users")
+	)
+
+	if !deepEqual(expectation, actual) {
+		t.Errorf("expectation is %+v, got %+v", expectation, actual)
+	}
+}
+
+func TestConfig_SetSoftDelete(t *testing.T) {
+	var (
+		softDelete *SoftDelete = NewSoftDelete("deleted_at")
+		actual     *Config     = NewConfig(DialectPostgres).SetSoftDelete(softDelete)
+	)
+
+	if actual.SoftDelete != softDelete {
+		t.Errorf("expectation soft delete is %+v, got %+v", softDelete, actual.SoftDelete)
+	}
+}
+
+func TestSoftDelete_Build(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Config      *Config
+		Query       Query
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Config      *Config
+		Query       Query
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:   "soft delete column is empty",
+			Config: NewConfig(DialectPostgres).SetSoftDelete(NewSoftDelete("")),
+			Query:  Select(NewField("id")).From(NewTable("users")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrColumnIsRequired,
+			},
+		},
+		{
+			Name:   fmt.Sprintf("select query with dialect %s", DialectPostgres),
+			Config: NewConfig(DialectPostgres).SetSoftDelete(NewSoftDelete("deleted_at")),
+			Query:  Select(NewField("id")).From(NewTable("users")).Where(Eq("status", "active")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from users where status = $1 and deleted_at is null",
+				Args:  []interface{}{"active"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "select query with joins is qualified by alias",
+			Config: NewConfig(DialectPostgres).SetSoftDelete(NewSoftDelete("deleted_at")),
+			Query: Select(NewField("id").FromTable("u")).
+				From(NewTable("users").As("u")).
+				Join(InnerJoin(NewTable("orders").As("o")).On(NewFilter().SetCondition(NewField("user_id").FromTable("o"), OperatorEqual, NewColumnFilterValue("id").FromTable("u")))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select u.id from users as u inner join orders as o on o.user_id = u.id where u.deleted_at is null",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "select query with trashed",
+			Config: NewConfig(DialectPostgres).SetSoftDelete(NewSoftDelete("deleted_at")),
+			Query:  Select(NewField("id")).From(NewTable("users")).WithTrashed(),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from users",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "select query only trashed",
+			Config: NewConfig(DialectPostgres).SetSoftDelete(NewSoftDelete("deleted_at")),
+			Query:  Select(NewField("id")).From(NewTable("users")).OnlyTrashed(),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from users where deleted_at is not null",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "select query with deleted value",
+			Config: NewConfig(DialectMySQL).SetSoftDelete(NewSoftDelete("is_deleted").SetDeletedValue(true)),
+			Query:  Select(NewField("id")).From(NewTable("users")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from users where is_deleted != ?",
+				Args:  []interface{}{true},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "select query table is not soft deleted",
+			Config: NewConfig(DialectPostgres).SetSoftDelete(NewSoftDelete("deleted_at").ForTables("users")),
+			Query:  Select(NewField("id")).From(NewTable("audit_logs")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from audit_logs",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   fmt.Sprintf("delete query with dialect %s", DialectPostgres),
+			Config: NewConfig(DialectPostgres).SetSoftDelete(NewSoftDelete("deleted_at")),
+			Query:  DeleteFrom("users").Where(Eq("id", 1)).Returning(NewField("id")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update users set deleted_at = current_timestamp where id = $1 and deleted_at is null returning id",
+				Args:  []interface{}{1},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "delete query with deleted value",
+			Config: NewConfig(DialectMySQL).SetSoftDelete(NewSoftDelete("is_deleted").SetDeletedValue(true)),
+			Query:  DeleteFrom("users").Where(Eq("id", 1)),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update users set is_deleted = ? where id = ? and is_deleted != ?",
+				Args:  []interface{}{true, 1, true},
+				Err:   nil,
+			},
+		},
+		{
+			Name:   "delete query is forced",
+			Config: NewConfig(DialectPostgres).SetSoftDelete(NewSoftDelete("deleted_at")),
+			Query:  DeleteFrom("users").Where(Eq("id", 1)).ForceDelete(),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "delete from users where id = $1",
+				Args:  []interface{}{1},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Config.Build(testCases[i].Query)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Errorf("expectation error is %+v, got nil", testCases[i].Expectation.Err)
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Errorf("expectation error is nil, got %+v", actualErr)
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %+v, got %+v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %+v, got %+v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
+
+func TestSoftDelete_rewrite(t *testing.T) {
+	var (
+		query  *SelectQuery = Select(NewField("id")).From(NewTable("users")).Where(Eq("status", "active"))
+		filter *Filter      = query.Filter
+	)
+
+	_, _, _ = NewConfig(DialectPostgres).SetSoftDelete(NewSoftDelete("deleted_at")).Build(query)
+
+	if query.Filter != filter || !deepEqual(Eq("status", "active"), query.Filter) {
+		t.Errorf("expectation query filter is unchanged, got %+v", query.Filter)
+	}
+}
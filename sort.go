@@ -2,11 +2,14 @@ package goqube
 
 import (
 	"fmt"
+	"strings"
 )
 
 type Sort struct {
 	Field     *Field
 	Direction SortDirection
+	Cast      string
+	Collation string
 }
 
 func NewSort(field *Field, direction SortDirection) *Sort {
@@ -16,6 +19,88 @@ func NewSort(field *Field, direction SortDirection) *Sort {
 	}
 }
 
+func ParseSorts(sorts string, allowedColumns []string) ([]*Sort, error) {
+	var (
+		result  []*Sort
+		columns []string
+	)
+
+	result = []*Sort{}
+	columns = []string{}
+
+	for _, part := range strings.Split(sorts, ",") {
+		var (
+			column    string
+			direction SortDirection
+		)
+
+		column = strings.TrimSpace(part)
+		if column == "" {
+			continue
+		}
+
+		direction = SortDirectionAscending
+		switch column[0] {
+		case '-':
+			direction = SortDirectionDescending
+			column = strings.TrimSpace(column[1:])
+		case '+':
+			column = strings.TrimSpace(column[1:])
+		}
+
+		if column == "" {
+			return nil, ErrFieldIsRequired
+		}
+
+		if !containsString(allowedColumns, column) {
+			return nil, fmt.Errorf(errSortColumnf, ErrSortColumnIsNotAllowed, column)
+		}
+
+		if containsString(columns, column) {
+			return nil, ErrFieldIsDuplicated
+		}
+
+		columns = append(columns, column)
+		result = append(result, NewSort(fieldFromColumn(column), direction))
+	}
+
+	return result, nil
+}
+
+func fieldFromColumn(column string) *Field {
+	var dotIndex int = strings.LastIndex(column, ".")
+
+	if dotIndex >= 0 {
+		return NewField(column[dotIndex+1:]).FromTable(column[:dotIndex])
+	}
+
+	return NewField(column)
+}
+
+func (s *Sort) CastAs(cast string) *Sort {
+	s.Cast = cast
+	return s
+}
+
+func (s *Sort) Collate(collation string) *Sort {
+	s.Collation = collation
+	return s
+}
+
+func isValidSortModifier(modifier string, allowed string) bool {
+	for _, r := range modifier {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+			continue
+		}
+
+		if !strings.ContainsRune(allowed, r) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (s *Sort) validate(dialect Dialect) error {
 	if dialect == "" {
 		return ErrDialectIsRequired
@@ -25,27 +110,77 @@ func (s *Sort) validate(dialect Dialect) error {
 		return ErrFieldIsRequired
 	}
 
+	if !isValidSortModifier(s.Cast, " (),") {
+		return ErrCastIsInvalid
+	}
+
+	if !isValidSortModifier(s.Collation, "-.") {
+		return ErrCollationIsInvalid
+	}
+
 	return nil
 }
 
-func (s *Sort) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+func (s *Sort) fieldToSQLWithArgs(bc *buildContext, args []interface{}) (string, []interface{}, error) {
 	var (
-		field              string
-		orderByQueryFormat string
-		orderByQuery       string
-		err                error
+		field string
+		err   error
+	)
+
+	if s.Field.isBareSelectQuery() {
+		field, args, err = s.Field.SelectQuery.toSQLWithArgsWithAlias(bc, args)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return fmt.Sprintf("(%s)", field), args, nil
+	}
+
+	if s.Cast == "" && s.Collation == "" {
+		return s.Field.toSQLWithArgsWithAlias(bc, args)
+	}
+
+	return s.Field.toSQLWithArgs(bc, args)
+}
+
+func (s *Sort) toSQLWithArgs(bc *buildContext, args []interface{}) (string, []interface{}, error) {
+	var (
+		field string
+		err   error
 	)
 
-	err = s.validate(dialect)
+	err = s.validate(bc.dialect)
 	if err != nil {
 		return "", nil, err
 	}
 
-	field, args, err = s.Field.ToSQLWithArgsWithAlias(dialect, args)
+	field, args, err = s.fieldToSQLWithArgs(bc, args)
 	if err != nil {
 		return "", nil, err
 	}
 
+	return s.orderByToSQL(bc, field), args, nil
+}
+
+func (s *Sort) orderByToSQL(bc *buildContext, field string) string {
+	var (
+		orderByQueryFormat string
+		orderByQuery       string
+	)
+
+	if s.Cast != "" {
+		field = fmt.Sprintf("cast(%s as %s)", field, s.Cast)
+	}
+
+	if s.Collation != "" {
+		var collation string = s.Collation
+		if bc.dialect == DialectPostgres {
+			collation = fmt.Sprintf(`"%s"`, collation)
+		}
+
+		field = fmt.Sprintf("%s collate %s", field, collation)
+	}
+
 	if s.Direction == "" {
 		s.Direction = SortDirectionAscending
 	}
@@ -53,5 +188,9 @@ func (s *Sort) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []int
 	orderByQueryFormat = "%s %s"
 	orderByQuery = fmt.Sprintf(orderByQueryFormat, field, s.Direction)
 
-	return orderByQuery, args, nil
+	return orderByQuery
+}
+
+func (s *Sort) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+	return s.toSQLWithArgs(newDialectBuildContext(dialect), args)
 }
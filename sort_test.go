@@ -1,6 +1,7 @@
 package goqube
 
 import (
+	"fmt"
 	"testing"
 )
 
@@ -32,6 +33,14 @@ func testSort_SortEquality(t *testing.T, expectation, actual *Sort) {
 	if expectation.Direction != actual.Direction {
 		t.Errorf("expectation direction is %s, got %s", expectation.Direction, actual.Direction)
 	}
+
+	if expectation.Cast != actual.Cast {
+		t.Errorf("expectation cast is %s, got %s", expectation.Cast, actual.Cast)
+	}
+
+	if expectation.Collation != actual.Collation {
+		t.Errorf("expectation collation is %s, got %s", expectation.Collation, actual.Collation)
+	}
 }
 
 func TestSort_NewSort(t *testing.T) {
@@ -52,6 +61,26 @@ func TestSort_NewSort(t *testing.T) {
 	testSort_SortEquality(t, expectation, actual)
 }
 
+func TestSort_CastAs(t *testing.T) {
+	var expectation *Sort = &Sort{
+		Field:     NewField("field1"),
+		Direction: SortDirectionDescending,
+		Cast:      "decimal(10, 2)",
+	}
+
+	testSort_SortEquality(t, expectation, NewSort(NewField("field1"), SortDirectionDescending).CastAs("decimal(10, 2)"))
+}
+
+func TestSort_Collate(t *testing.T) {
+	var expectation *Sort = &Sort{
+		Field:     NewField("field1"),
+		Direction: SortDirectionAscending,
+		Collation: "en-US",
+	}
+
+	testSort_SortEquality(t, expectation, NewSort(NewField("field1"), SortDirectionAscending).Collate("en-US"))
+}
+
 func TestSort_validate(t *testing.T) {
 	var testCases []struct {
 		Name        string
@@ -76,6 +105,18 @@ func TestSort_validate(t *testing.T) {
 			Sort:        &Sort{},
 			Expectation: ErrFieldIsRequired,
 		},
+		{
+			Name:        "cast is invalid",
+			Dialect:     DialectPostgres,
+			Sort:        NewSort(NewField("field1"), SortDirectionAscending).CastAs("int); drop table users; --"),
+			Expectation: ErrCastIsInvalid,
+		},
+		{
+			Name:        "collation is invalid",
+			Dialect:     DialectPostgres,
+			Sort:        NewSort(NewField("field1"), SortDirectionAscending).Collate(`C" desc`),
+			Expectation: ErrCollationIsInvalid,
+		},
 		{
 			Name:    "sort is valid",
 			Dialect: DialectPostgres,
@@ -208,6 +249,34 @@ func TestSort_ToSQLWithArgs(t *testing.T) {
 				Err:   nil,
 			},
 		},
+		{
+			Name:    fmt.Sprintf("cast and collation with dialect %s", DialectMySQL),
+			Dialect: DialectMySQL,
+			Sort:    NewSort(NewField("price").FromTable("t1").As("p"), SortDirectionDescending).CastAs("decimal(10, 2)").Collate("utf8mb4_general_ci"),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "cast(t1.price as decimal(10, 2)) collate utf8mb4_general_ci desc",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("collation with dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			Sort:    NewSort(NewField("name"), SortDirectionAscending).Collate("C"),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "name collate \"C\" asc",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
 	}
 
 	for i := range testCases {
@@ -248,3 +317,115 @@ func TestSort_ToSQLWithArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestSort_ParseSorts(t *testing.T) {
+	var testCases []struct {
+		Name           string
+		Sorts          string
+		AllowedColumns []string
+		Expectation    struct {
+			Sorts []*Sort
+			Err   error
+		}
+	} = []struct {
+		Name           string
+		Sorts          string
+		AllowedColumns []string
+		Expectation    struct {
+			Sorts []*Sort
+			Err   error
+		}
+	}{
+		{
+			Name:           "sorts is empty",
+			Sorts:          " , ",
+			AllowedColumns: []string{"name"},
+			Expectation: struct {
+				Sorts []*Sort
+				Err   error
+			}{
+				Sorts: []*Sort{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:           "column is empty",
+			Sorts:          "name,-",
+			AllowedColumns: []string{"name"},
+			Expectation: struct {
+				Sorts []*Sort
+				Err   error
+			}{
+				Sorts: nil,
+				Err:   ErrFieldIsRequired,
+			},
+		},
+		{
+			Name:           "column is not allowed",
+			Sorts:          "name,-password",
+			AllowedColumns: []string{"name"},
+			Expectation: struct {
+				Sorts []*Sort
+				Err   error
+			}{
+				Sorts: nil,
+				Err:   fmt.Errorf(errSortColumnf, ErrSortColumnIsNotAllowed, "password"),
+			},
+		},
+		{
+			Name:           "column is duplicated",
+			Sorts:          "name,-name",
+			AllowedColumns: []string{"name"},
+			Expectation: struct {
+				Sorts []*Sort
+				Err   error
+			}{
+				Sorts: nil,
+				Err:   ErrFieldIsDuplicated,
+			},
+		},
+		{
+			Name:           "sorts is valid",
+			Sorts:          "-created_at, +name,u.email",
+			AllowedColumns: []string{"created_at", "name", "u.email"},
+			Expectation: struct {
+				Sorts []*Sort
+				Err   error
+			}{
+				Sorts: []*Sort{
+					NewSort(NewField("created_at"), SortDirectionDescending),
+					NewSort(NewField("name"), SortDirectionAscending),
+					NewSort(NewField("email").FromTable("u"), SortDirectionAscending),
+				},
+				Err: nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualSorts []*Sort
+				actualErr   error
+			)
+
+			actualSorts, actualErr = ParseSorts(testCases[i].Sorts, testCases[i].AllowedColumns)
+
+			if !deepEqual(testCases[i].Expectation.Sorts, actualSorts) {
+				t.Errorf("expectation sorts is %+v, got %+v", testCases[i].Expectation.Sorts, actualSorts)
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package goqube
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"os"
+	"sort"
+)
+
+type SQLConstant struct {
+	Name  string
+	Query Query
+}
+
+type SQLExport struct {
+	PackageName string
+	Constants   []*SQLConstant
+	config      *Config
+}
+
+func (c *Config) SQLExport(packageName string) *SQLExport {
+	return &SQLExport{
+		PackageName: packageName,
+		config:      c,
+	}
+}
+
+func (b *Builder) SQLExport(packageName string) *SQLExport {
+	return b.config.SQLExport(packageName)
+}
+
+func (e *SQLExport) Add(name string, query Query) *SQLExport {
+	e.Constants = append(e.Constants, &SQLConstant{Name: name, Query: query})
+	return e
+}
+
+func (e *SQLExport) validate() error {
+	var names map[string]bool = map[string]bool{}
+
+	if !token.IsIdentifier(e.PackageName) {
+		return fmt.Errorf(errSQLConstantf, ErrPackageNameIsInvalid, e.PackageName)
+	}
+
+	for i := range e.Constants {
+		if e.Constants[i] == nil {
+			continue
+		}
+
+		if !token.IsIdentifier(e.Constants[i].Name) {
+			return fmt.Errorf(errSQLConstantf, ErrSQLConstantNameIsInvalid, e.Constants[i].Name)
+		}
+
+		if names[e.Constants[i].Name] {
+			return fmt.Errorf(errSQLConstantf, ErrSQLConstantNameIsDuplicated, e.Constants[i].Name)
+		}
+
+		names[e.Constants[i].Name] = true
+	}
+
+	return nil
+}
+
+func sqlExportArgType(arg interface{}) string {
+	if param, ok := arg.(ParamValue); ok {
+		return fmt.Sprintf(sqlExportParamf, param.Name)
+	}
+
+	if arg == nil {
+		return sqlExportNilArgType
+	}
+
+	return fmt.Sprintf("%T", arg)
+}
+
+func (e *SQLExport) Generate() ([]byte, error) {
+	var (
+		constants []*SQLConstant
+		body      bytes.Buffer
+		code      bytes.Buffer
+		err       error
+	)
+
+	err = e.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range e.Constants {
+		if e.Constants[i] != nil {
+			constants = append(constants, e.Constants[i])
+		}
+	}
+
+	sort.Slice(constants, func(i, j int) bool {
+		return constants[i].Name < constants[j].Name
+	})
+
+	for i := range constants {
+		var (
+			query string
+			args  []interface{}
+		)
+
+		query, args, err = e.config.Build(constants[i].Query)
+		if err != nil {
+			return nil, fmt.Errorf(errSQLConstantBuildf, constants[i].Name, err)
+		}
+
+		if i > 0 {
+			body.WriteString("\n")
+		}
+
+		if len(args) == 0 {
+			fmt.Fprintf(&body, sqlExportNoArgsf, constants[i].Name)
+		} else {
+			fmt.Fprintf(&body, sqlExportArgsf, constants[i].Name)
+		}
+
+		for j := range args {
+			fmt.Fprintf(&body, sqlExportArgf, j+1, sqlExportArgType(args[j]))
+		}
+
+		fmt.Fprintf(&body, sqlExportConstantf, constants[i].Name, query)
+	}
+
+	fmt.Fprintf(&code, sqlExportHeaderf, e.PackageName)
+	if body.Len() > 0 {
+		fmt.Fprintf(&code, "const (\n%s)\n", body.String())
+	}
+
+	return format.Source(code.Bytes())
+}
+
+func (e *SQLExport) WriteFile(path string) error {
+	var (
+		code []byte
+		err  error
+	)
+
+	code, err = e.Generate()
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, code, 0o644)
+}
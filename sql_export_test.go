@@ -0,0 +1,204 @@
+package goqube
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSQLExport_Add(t *testing.T) {
+	var (
+		query       *SelectQuery = Select(NewField("id")).From(NewTable("users"))
+		expectation *SQLExport
+		actual      *SQLExport
+	)
+
+	expectation = &SQLExport{
+		PackageName: "queries",
+		Constants:   []*SQLConstant{{Name: "AllUsers", Query: query}},
+	}
+	actual = NewConfig(DialectPostgres).SQLExport("queries").Add("AllUsers", query)
+
+	if !deepEqual(expectation.PackageName, actual.PackageName) || !deepEqual(expectation.Constants, actual.Constants) {
+		t.Errorf("expectation sql export is %+v, got %+v", expectation, actual)
+	}
+}
+
+func TestSQLExport_Generate(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Export      *SQLExport
+		Expectation struct {
+			Code string
+			Err  error
+		}
+	} = []struct {
+		Name        string
+		Export      *SQLExport
+		Expectation struct {
+			Code string
+			Err  error
+		}
+	}{
+		{
+			Name:   "package name is invalid",
+			Export: NewBuilder(DialectPostgres).SQLExport("my-queries"),
+			Expectation: struct {
+				Code string
+				Err  error
+			}{
+				Code: "",
+				Err:  ErrPackageNameIsInvalid,
+			},
+		},
+		{
+			Name:   "constant name is invalid",
+			Export: NewBuilder(DialectPostgres).SQLExport("queries").Add("all users", Select(NewField("id")).From(NewTable("users"))),
+			Expectation: struct {
+				Code string
+				Err  error
+			}{
+				Code: "",
+				Err:  ErrSQLConstantNameIsInvalid,
+			},
+		},
+		{
+			Name: "constant name is duplicated",
+			Export: NewBuilder(DialectPostgres).SQLExport("queries").
+				Add("AllUsers", Select(NewField("id")).From(NewTable("users"))).
+				Add("AllUsers", Select(NewField("name")).From(NewTable("users"))),
+			Expectation: struct {
+				Code string
+				Err  error
+			}{
+				Code: "",
+				Err:  ErrSQLConstantNameIsDuplicated,
+			},
+		},
+		{
+			Name:   "query is invalid",
+			Export: NewBuilder(DialectPostgres).SQLExport("queries").Add("AllUsers", Select(NewField("id"))),
+			Expectation: struct {
+				Code string
+				Err  error
+			}{
+				Code: "",
+				Err:  ErrTableIsRequired,
+			},
+		},
+		{
+			Name:   "constants is empty",
+			Export: NewBuilder(DialectPostgres).SQLExport("queries"),
+			Expectation: struct {
+				Code string
+				Err  error
+			}{
+				Code: "// Code generated by goqube export. DO NOT EDIT.\n\npackage queries\n",
+				Err:  nil,
+			},
+		},
+		{
+			Name: "dialect postgres",
+			Export: NewBuilder(DialectPostgres).SQLExport("queries").
+				Add("UserByID", Select(NewField("id"), NewField("name")).From(NewTable("users")).Where(Eq("id", int64(0))).Limit(1)).
+				Add("ActiveUsers", Select(NewField("id")).From(NewTable("users")).Where(And(Eq("status", Param("status")), IsNull("deleted_at")))).
+				Add("AllUsers", Select(NewField("id")).From(NewTable("users"))).
+				Add("DeleteUser", DeleteFrom("users").Where(Eq("id", ""))),
+			Expectation: struct {
+				Code string
+				Err  error
+			}{
+				Code: `// Code generated by goqube export. DO NOT EDIT.
+
+package queries
+
+const (
+	// ActiveUsers args:
+	// 1. param status
+	ActiveUsers string = "select id from users where status = $1 and deleted_at is null"
+
+	// AllUsers has no args
+	AllUsers string = "select id from users"
+
+	// DeleteUser args:
+	// 1. string
+	DeleteUser string = "delete from users where id = $1"
+
+	// UserByID args:
+	// 1. int64
+	// 2. uint64
+	UserByID string = "select id, name from users where id = $1 limit $2"
+)
+`,
+				Err: nil,
+			},
+		},
+		{
+			Name: "dialect mysql",
+			Export: NewBuilder(DialectMySQL).SQLExport("queries").
+				Add("UsersByStatus", Select(NewField("id")).From(NewTable("users")).Where(In("status", []string{"active", "pending"}))),
+			Expectation: struct {
+				Code string
+				Err  error
+			}{
+				Code: `// Code generated by goqube export. DO NOT EDIT.
+
+package queries
+
+const (
+	// UsersByStatus args:
+	// 1. string
+	// 2. string
+	UsersByStatus string = "select id from users where status in (?, ?)"
+)
+`,
+				Err: nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualCode []byte
+				actualErr  error
+			)
+
+			actualCode, actualErr = testCases[i].Export.Generate()
+
+			if !errors.Is(actualErr, testCases[i].Expectation.Err) {
+				t.Errorf("expectation error is %v, got %v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Code != string(actualCode) {
+				t.Errorf("expectation code is %s, got %s", testCases[i].Expectation.Code, string(actualCode))
+			}
+		})
+	}
+}
+
+func TestSQLExport_WriteFile(t *testing.T) {
+	var (
+		path     string     = filepath.Join(t.TempDir(), "queries.go")
+		export   *SQLExport = NewBuilder(DialectPostgres).SQLExport("queries").Add("AllUsers", Select(NewField("id")).From(NewTable("users")))
+		expected []byte
+		actual   []byte
+		err      error
+	)
+
+	err = export.WriteFile(path)
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %s", err.Error())
+	}
+
+	expected, _ = export.Generate()
+	actual, err = os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %s", err.Error())
+	}
+
+	if string(expected) != string(actual) {
+		t.Errorf("expectation file content is %s, got %s", string(expected), string(actual))
+	}
+}
@@ -0,0 +1,128 @@
+package goqube
+
+import (
+	"strings"
+)
+
+const statementNameMaxFilterColumns int = 3
+
+func normalizeStatementNamePart(part string) string {
+	var (
+		builder     strings.Builder
+		underscored bool
+	)
+
+	for _, r := range strings.ToLower(part) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			builder.WriteRune(r)
+			underscored = false
+			continue
+		}
+
+		if !underscored && builder.Len() > 0 {
+			builder.WriteRune('_')
+			underscored = true
+		}
+	}
+
+	return strings.TrimSuffix(builder.String(), "_")
+}
+
+func statementNameTable(table *Table) string {
+	if table == nil {
+		return ""
+	}
+
+	if table.SelectQuery != nil {
+		return normalizeStatementNamePart(table.Alias)
+	}
+
+	return normalizeStatementNamePart(table.Name)
+}
+
+func statementNameFilterColumns(filter *Filter, columns []string) []string {
+	if filter == nil {
+		return columns
+	}
+
+	if filter.Field != nil && filter.Field.Column != "" {
+		var column string = normalizeStatementNamePart(filter.Field.Column)
+
+		if !containsString(columns, column) {
+			columns = append(columns, column)
+		}
+	}
+
+	for i := range filter.Filters {
+		columns = statementNameFilterColumns(filter.Filters[i], columns)
+	}
+
+	return columns
+}
+
+func statementName(operation string, tables []string, filter *Filter, suffixes ...string) string {
+	var (
+		parts   []string
+		columns []string
+	)
+
+	parts = []string{operation}
+	for i := range tables {
+		if tables[i] != "" {
+			parts = append(parts, tables[i])
+		}
+	}
+
+	columns = statementNameFilterColumns(filter, []string{})
+	if len(columns) > statementNameMaxFilterColumns {
+		columns = columns[:statementNameMaxFilterColumns]
+	}
+
+	if len(columns) > 0 {
+		parts = append(parts, "by", strings.Join(columns, "_and_"))
+	}
+
+	parts = append(parts, suffixes...)
+
+	return strings.Join(parts, "_")
+}
+
+func (s *SelectQuery) StatementName() string {
+	var (
+		tables   []string
+		suffixes []string
+	)
+
+	tables = []string{statementNameTable(s.Table)}
+	for i := range s.Joins {
+		if s.Joins[i] != nil {
+			tables = append(tables, statementNameTable(s.Joins[i].Table))
+		}
+	}
+
+	if len(s.GroupByFields) > 0 {
+		suffixes = append(suffixes, "grouped")
+	}
+
+	if len(s.Sorts) > 0 {
+		suffixes = append(suffixes, "ordered")
+	}
+
+	if s.Take > 0 || s.TakeIsSet || s.Skip > 0 {
+		suffixes = append(suffixes, "paged")
+	}
+
+	return statementName("select", tables, s.Filter, suffixes...)
+}
+
+func (i *InsertQuery) StatementName() string {
+	return statementName("insert", []string{normalizeStatementNamePart(i.Table)}, nil)
+}
+
+func (u *UpdateQuery) StatementName() string {
+	return statementName("update", []string{normalizeStatementNamePart(u.Table)}, u.Filter)
+}
+
+func (d *DeleteQuery) StatementName() string {
+	return statementName("delete", []string{normalizeStatementNamePart(d.Table)}, d.Filter)
+}
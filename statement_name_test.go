@@ -0,0 +1,116 @@
+package goqube
+
+import "testing"
+
+func Test_normalizeStatementNamePart(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Part        string
+		Expectation string
+	} = []struct {
+		Name        string
+		Part        string
+		Expectation string
+	}{
+		{
+			Name:        "part is empty",
+			Part:        "",
+			Expectation: "",
+		},
+		{
+			Name:        "part is lower case",
+			Part:        "users",
+			Expectation: "users",
+		},
+		{
+			Name:        "part contains upper case and symbols",
+			Part:        "Main.User-Profiles ",
+			Expectation: "main_user_profiles",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = normalizeStatementNamePart(testCases[i].Part)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation statement name part is %s, got %s", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestStatementName(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Query       interface{ StatementName() string }
+		Expectation string
+	} = []struct {
+		Name        string
+		Query       interface{ StatementName() string }
+		Expectation string
+	}{
+		{
+			Name: "select query with filter and sorts",
+			Query: Select(NewField("id")).
+				From(NewTable("users")).
+				Where(NewFilter().SetCondition(NewField("status"), OperatorEqual, NewFilterValue("active"))).
+				OrderBy(NewSort(NewField("created_at"), SortDirectionDescending)),
+			Expectation: "select_users_by_status_ordered",
+		},
+		{
+			Name: "select query with joins, group by and pagination",
+			Query: Select(NewField("id")).
+				From(NewTable("users").FromDatabase("main")).
+				Join(InnerJoin(NewTable("roles")).On(NewFilter().SetCondition(NewField("role_id"), OperatorEqual, NewColumnFilterValue("id")))).
+				Join(LeftJoin(NewSelectQueryTable(Select(NewField("id")).From(NewTable("orders"))).As("o")).On(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewColumnFilterValue("user_id")))).
+				GroupBy(NewField("id")).
+				Limit(10),
+			Expectation: "select_users_roles_o_grouped_paged",
+		},
+		{
+			Name: "select query with duplicated and many filter columns",
+			Query: Select(NewField("id")).
+				From(NewTable("users")).
+				Where(
+					NewFilter().
+						SetLogic(LogicOr).
+						AddFilter(NewField("status"), OperatorEqual, NewFilterValue("active")).
+						AddFilter(NewField("status"), OperatorEqual, NewFilterValue("pending")).
+						AddFilter(NewField("role"), OperatorEqual, NewFilterValue("admin")).
+						AddFilter(NewField("age"), OperatorGreaterThan, NewFilterValue(17)).
+						AddFilter(NewField("name"), OperatorLike, NewFilterValue("a")),
+				),
+			Expectation: "select_users_by_status_and_role_and_age",
+		},
+		{
+			Name:        "insert query",
+			Query:       Insert().Into("users").Value("name", "user1"),
+			Expectation: "insert_users",
+		},
+		{
+			Name: "update query",
+			Query: Update("users").
+				Set("name", "user1").
+				Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Expectation: "update_users_by_id",
+		},
+		{
+			Name: "delete query",
+			Query: Delete().
+				From("users").
+				Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Expectation: "delete_users_by_id",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = testCases[i].Query.StatementName()
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation statement name is %s, got %s", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
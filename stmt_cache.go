@@ -0,0 +1,235 @@
+package goqube
+
+import (
+	"container/list"
+	"context"
+	"database/sql"
+	"sync"
+)
+
+type stmtCacheKey struct {
+	db    *sql.DB
+	query string
+}
+
+type stmtCacheEntry struct {
+	key     stmtCacheKey
+	stmt    *sql.Stmt
+	refs    int
+	evicted bool
+}
+
+type StmtCache struct {
+	MaxEntries int
+	mu         sync.Mutex
+	entries    map[stmtCacheKey]*list.Element
+	order      *list.List
+}
+
+func NewStmtCache(maxEntries int) *StmtCache {
+	return &StmtCache{
+		MaxEntries: maxEntries,
+		entries:    map[stmtCacheKey]*list.Element{},
+		order:      list.New(),
+	}
+}
+
+func (c *StmtCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
+
+func (c *StmtCache) Clear() error {
+	var (
+		closing []*sql.Stmt
+		err     error
+	)
+
+	c.mu.Lock()
+	for _, element := range c.entries {
+		var stmt *sql.Stmt = c.retire(element.Value.(*stmtCacheEntry))
+		if stmt != nil {
+			closing = append(closing, stmt)
+		}
+	}
+
+	c.entries = map[stmtCacheKey]*list.Element{}
+	c.order = list.New()
+	c.mu.Unlock()
+
+	for i := range closing {
+		var closeErr error = closing[i].Close()
+		if closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+
+	return err
+}
+
+func (c *StmtCache) retire(entry *stmtCacheEntry) *sql.Stmt {
+	entry.evicted = true
+
+	if entry.refs > 0 {
+		return nil
+	}
+
+	return entry.stmt
+}
+
+func (c *StmtCache) evict() *sql.Stmt {
+	var (
+		element *list.Element
+		entry   *stmtCacheEntry
+	)
+
+	element = c.order.Back()
+	if element == nil {
+		return nil
+	}
+
+	entry = element.Value.(*stmtCacheEntry)
+	c.order.Remove(element)
+	delete(c.entries, entry.key)
+
+	return c.retire(entry)
+}
+
+func (c *StmtCache) acquire(key stmtCacheKey) *stmtCacheEntry {
+	var element *list.Element
+
+	if c.entries == nil {
+		c.entries = map[stmtCacheKey]*list.Element{}
+		c.order = list.New()
+	}
+
+	element = c.entries[key]
+	if element == nil {
+		return nil
+	}
+
+	c.order.MoveToFront(element)
+
+	var entry *stmtCacheEntry = element.Value.(*stmtCacheEntry)
+	entry.refs++
+
+	return entry
+}
+
+func (c *StmtCache) prepare(ctx context.Context, db *sql.DB, query string) (*stmtCacheEntry, error) {
+	var (
+		key     stmtCacheKey = stmtCacheKey{db: db, query: query}
+		entry   *stmtCacheEntry
+		stmt    *sql.Stmt
+		closing []*sql.Stmt
+		err     error
+	)
+
+	c.mu.Lock()
+	entry = c.acquire(key)
+	c.mu.Unlock()
+
+	if entry != nil {
+		return entry, nil
+	}
+
+	stmt, err = db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	entry = c.acquire(key)
+	if entry != nil {
+		c.mu.Unlock()
+		stmt.Close()
+		return entry, nil
+	}
+
+	for c.MaxEntries > 0 && len(c.entries) >= c.MaxEntries {
+		var evicted *sql.Stmt = c.evict()
+		if evicted != nil {
+			closing = append(closing, evicted)
+		}
+	}
+
+	entry = &stmtCacheEntry{key: key, stmt: stmt, refs: 1}
+	c.entries[key] = c.order.PushFront(entry)
+	c.mu.Unlock()
+
+	for i := range closing {
+		closing[i].Close()
+	}
+
+	return entry, nil
+}
+
+func (c *StmtCache) release(entry *stmtCacheEntry) {
+	var closing bool
+
+	c.mu.Lock()
+	entry.refs--
+	closing = entry.evicted && entry.refs == 0
+	c.mu.Unlock()
+
+	if closing {
+		entry.stmt.Close()
+	}
+}
+
+func (e *Executor) SetStmtCache(stmtCache *StmtCache) *Executor {
+	e.StmtCache = stmtCache
+	return e
+}
+
+func (e *Executor) execContext(ctx context.Context, sqlQuery string, args []interface{}) (sql.Result, error) {
+	var (
+		entry *stmtCacheEntry
+		stmt  *sql.Stmt
+		err   error
+	)
+
+	if e.StmtCache == nil || e.DB == nil {
+		return e.conn().ExecContext(ctx, sqlQuery, args...)
+	}
+
+	entry, err = e.StmtCache.prepare(ctx, e.DB, sqlQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer e.StmtCache.release(entry)
+
+	stmt = entry.stmt
+	if e.tx != nil {
+		stmt = e.tx.StmtContext(ctx, stmt)
+	}
+
+	return stmt.ExecContext(ctx, args...)
+}
+
+func (e *Executor) queryContext(ctx context.Context, sqlQuery string, args []interface{}) (*sql.Rows, error) {
+	var (
+		entry *stmtCacheEntry
+		stmt  *sql.Stmt
+		err   error
+	)
+
+	if e.StmtCache == nil || e.DB == nil {
+		return e.conn().QueryContext(ctx, sqlQuery, args...)
+	}
+
+	entry, err = e.StmtCache.prepare(ctx, e.DB, sqlQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer e.StmtCache.release(entry)
+
+	stmt = entry.stmt
+	if e.tx != nil {
+		stmt = e.tx.StmtContext(ctx, stmt)
+	}
+
+	return stmt.QueryContext(ctx, args...)
+}
@@ -0,0 +1,164 @@
+package goqube
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+)
+
+func TestStmtCache_NewStmtCache(t *testing.T) {
+	var actual *StmtCache = NewStmtCache(2)
+
+	if actual.MaxEntries != 2 {
+		t.Errorf("expectation max entries is %d, got %d", 2, actual.MaxEntries)
+	}
+
+	if actual.Len() != 0 {
+		t.Errorf("expectation length is %d, got %d", 0, actual.Len())
+	}
+}
+
+func TestExecutor_SetStmtCache(t *testing.T) {
+	var (
+		stmtCache *StmtCache = NewStmtCache(1)
+		actual    *Executor  = NewExecutor(&sql.DB{}, DialectPostgres).SetStmtCache(stmtCache)
+	)
+
+	if actual.StmtCache != stmtCache {
+		t.Errorf("expectation stmt cache is %+v, got %+v", stmtCache, actual.StmtCache)
+	}
+}
+
+func TestStmtCache_Executor(t *testing.T) {
+	var (
+		db        *sql.DB
+		stmtCache *StmtCache
+		executor  *Executor
+		users     []executorTestUser
+		err       error
+	)
+
+	db = openFakeDB(t, &fakeDriverResult{
+		columns: []string{"id", "name"},
+		rows:    [][]driver.Value{{int64(1), "name1"}},
+	})
+	stmtCache = NewStmtCache(2)
+	executor = NewExecutor(db, DialectPostgres).SetStmtCache(stmtCache)
+
+	for i := 0; i < 2; i++ {
+		_, err = executor.Exec(context.Background(), Update("users").Set("name", "name1").Where(Eq("id", i)))
+		if err != nil {
+			t.Fatalf("expectation error is nil, got %s", err.Error())
+		}
+
+		err = executor.Query(context.Background(), Select(NewField("id"), NewField("name")).From(NewTable("users")).Where(Eq("id", i)), &users)
+		if err != nil {
+			t.Fatalf("expectation error is nil, got %s", err.Error())
+		}
+	}
+
+	if !deepEqual([]string{"update users set name = $1 where id = $2", "select id, name from users where id = $1"}, fakeDriverInstance.prepares) {
+		t.Errorf("expectation prepares is %+v, got %+v", []string{"update users set name = $1 where id = $2", "select id, name from users where id = $1"}, fakeDriverInstance.prepares)
+	}
+
+	if !deepEqual([]interface{}{"name1", int64(1)}, fakeDriverInstance.args[2]) {
+		t.Errorf("expectation args is %+v, got %+v", []interface{}{"name1", int64(1)}, fakeDriverInstance.args[2])
+	}
+
+	if !deepEqual([]executorTestUser{{ID: 1, Name: "name1"}}, users) {
+		t.Errorf("expectation users is %+v, got %+v", []executorTestUser{{ID: 1, Name: "name1"}}, users)
+	}
+
+	_, err = executor.Exec(context.Background(), DeleteFrom("users").Where(Eq("id", 1)))
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %s", err.Error())
+	}
+
+	if stmtCache.Len() != 2 {
+		t.Errorf("expectation length is %d, got %d", 2, stmtCache.Len())
+	}
+
+	if fakeDriverInstance.closes != 1 {
+		t.Errorf("expectation closed statements is %d, got %d", 1, fakeDriverInstance.closes)
+	}
+
+	_, err = executor.Exec(context.Background(), Update("users").Set("name", "name1").Where(Eq("id", 1)))
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %s", err.Error())
+	}
+
+	if len(fakeDriverInstance.prepares) != 4 {
+		t.Errorf("expectation prepares length is %d, got %d", 4, len(fakeDriverInstance.prepares))
+	}
+
+	err = stmtCache.Clear()
+	if err != nil {
+		t.Errorf("expectation error is nil, got %s", err.Error())
+	}
+
+	if stmtCache.Len() != 0 {
+		t.Errorf("expectation length is %d, got %d", 0, stmtCache.Len())
+	}
+
+	if fakeDriverInstance.closes != 4 {
+		t.Errorf("expectation closed statements is %d, got %d", 4, fakeDriverInstance.closes)
+	}
+}
+
+func TestStmtCache_ReleaseEvicted(t *testing.T) {
+	var (
+		db        *sql.DB
+		stmtCache *StmtCache
+		first     *stmtCacheEntry
+		second    *stmtCacheEntry
+		err       error
+	)
+
+	db = openFakeDB(t, &fakeDriverResult{})
+	stmtCache = NewStmtCache(1)
+
+	first, err = stmtCache.prepare(context.Background(), db, "select 1")
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %s", err.Error())
+	}
+
+	second, err = stmtCache.prepare(context.Background(), db, "select 2")
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %s", err.Error())
+	}
+
+	if stmtCache.Len() != 1 {
+		t.Errorf("expectation length is %d, got %d", 1, stmtCache.Len())
+	}
+
+	_, err = first.stmt.ExecContext(context.Background())
+	if err != nil {
+		t.Errorf("expectation error is nil, got %s", err.Error())
+	}
+
+	if fakeDriverInstance.closes != 0 {
+		t.Errorf("expectation closed statements is %d, got %d", 0, fakeDriverInstance.closes)
+	}
+
+	stmtCache.release(first)
+
+	if fakeDriverInstance.closes != 1 {
+		t.Errorf("expectation closed statements is %d, got %d", 1, fakeDriverInstance.closes)
+	}
+
+	err = stmtCache.Clear()
+	if err != nil {
+		t.Errorf("expectation error is nil, got %s", err.Error())
+	}
+
+	if fakeDriverInstance.closes != 1 {
+		t.Errorf("expectation closed statements is %d, got %d", 1, fakeDriverInstance.closes)
+	}
+
+	stmtCache.release(second)
+
+	if fakeDriverInstance.closes != 2 {
+		t.Errorf("expectation closed statements is %d, got %d", 2, fakeDriverInstance.closes)
+	}
+}
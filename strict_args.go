@@ -0,0 +1,396 @@
+package goqube
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+)
+
+var (
+	strictArgByteSliceType reflect.Type = reflect.TypeOf([]byte(nil))
+	strictArgTimeType      reflect.Type = reflect.TypeOf(time.Time{})
+)
+
+func isStrictArg(value interface{}) bool {
+	var reflectValue reflect.Value
+
+	if value == nil {
+		return true
+	}
+
+	if _, ok := normalizeValue(value).(driver.Valuer); ok {
+		return true
+	}
+
+	reflectValue = reflect.ValueOf(value)
+	for reflectValue.Kind() == reflect.Ptr {
+		if reflectValue.IsNil() {
+			return true
+		}
+
+		reflectValue = reflectValue.Elem()
+	}
+
+	if reflectValue.Type() == strictArgTimeType || reflectValue.Type() == strictArgByteSliceType {
+		return true
+	}
+
+	switch reflectValue.Kind() {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64,
+		reflect.String:
+		return true
+	}
+
+	return false
+}
+
+func (bc *buildContext) checkStrictArg(path string, value interface{}) error {
+	if _, ok := literalValue(value); ok {
+		return nil
+	}
+
+	if _, ok := value.(sequenceValue); ok {
+		return nil
+	}
+
+	if isStrictArg(bc.adaptValue(value)) {
+		return nil
+	}
+
+	return &ArgTypeError{Path: path, Type: reflect.TypeOf(value)}
+}
+
+func (bc *buildContext) checkStrictArgs(query Query) error {
+	switch q := query.(type) {
+	case *SelectQuery:
+		return bc.checkStrictSelectQueryArgs("select", q)
+	case *InsertQuery:
+		var fields []string
+
+		for field := range q.FieldsValues {
+			fields = append(fields, field)
+		}
+
+		sort.Strings(fields)
+
+		for _, field := range fields {
+			for i, value := range q.FieldsValues[field] {
+				var err error = bc.checkStrictArg(fmt.Sprintf("values.%s[%d]", field, i), value)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	case *UpdateQuery:
+		var fields []string
+
+		for field := range q.FieldsValue {
+			fields = append(fields, field)
+		}
+
+		sort.Strings(fields)
+
+		for _, field := range fields {
+			var (
+				path string = fmt.Sprintf("set.%s", field)
+				err  error
+			)
+
+			switch value := q.FieldsValue[field].(type) {
+			case *Field:
+				err = bc.checkStrictFieldArgs(path, value)
+			case *Raw:
+				err = bc.checkStrictRawArgs(path, value)
+			case *SelectQuery:
+				err = bc.checkStrictSelectQueryArgs(path, value)
+			default:
+				err = bc.checkStrictArg(path, value)
+			}
+
+			if err != nil {
+				return err
+			}
+		}
+
+		for i := range q.RowSets {
+			if q.RowSets[i] == nil || q.RowSets[i].SelectQuery == nil {
+				continue
+			}
+
+			var err error = bc.checkStrictSelectQueryArgs(fmt.Sprintf("set.rows[%d]", i), q.RowSets[i].SelectQuery)
+			if err != nil {
+				return err
+			}
+		}
+
+		return bc.checkStrictFilterArgs("where", q.Filter)
+	case *DeleteQuery:
+		return bc.checkStrictFilterArgs("where", q.Filter)
+	case *MergeQuery:
+		for rowIndex := range q.Rows {
+			for fieldIndex := range q.Rows[rowIndex] {
+				var field string
+
+				if fieldIndex < len(q.Fields) {
+					field = q.Fields[fieldIndex]
+				}
+
+				var err error = bc.checkStrictArg(fmt.Sprintf("values.%s[%d]", field, rowIndex), q.Rows[rowIndex][fieldIndex])
+				if err != nil {
+					return err
+				}
+			}
+		}
+	case *Raw:
+		return bc.checkStrictRawArgs("raw", q)
+	case *CompoundQuery:
+		return bc.checkStrictCompoundQueryArgs("compound", q)
+	}
+
+	return nil
+}
+
+func (bc *buildContext) checkStrictCompoundQueryArgs(path string, compoundQuery *CompoundQuery) error {
+	for i := range compoundQuery.Branches {
+		var (
+			branchPath string = fmt.Sprintf("%s.branches[%d]", path, i)
+			err        error
+		)
+
+		if compoundQuery.Branches[i] == nil {
+			continue
+		}
+
+		switch q := compoundQuery.Branches[i].Query.(type) {
+		case *SelectQuery:
+			err = bc.checkStrictSelectQueryArgs(branchPath, q)
+		case *Raw:
+			err = bc.checkStrictRawArgs(branchPath, q)
+		case *CompoundQuery:
+			err = bc.checkStrictCompoundQueryArgs(branchPath, q)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (bc *buildContext) checkStrictRawArgs(path string, raw *Raw) error {
+	var names []string
+
+	if raw == nil {
+		return nil
+	}
+
+	for i := range raw.Args {
+		var err error = bc.checkStrictArg(fmt.Sprintf("%s.args[%d]", path, i), raw.Args[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	for name := range raw.NamedArgs {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		var err error = bc.checkStrictArg(fmt.Sprintf("%s.args[%s]", path, name), raw.NamedArgs[name])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (bc *buildContext) checkStrictFieldArgs(path string, field *Field) error {
+	if field == nil {
+		return nil
+	}
+
+	if field.Raw != nil {
+		return bc.checkStrictRawArgs(path, field.Raw)
+	}
+
+	if field.Expression != nil {
+		return bc.checkStrictExpressionArgs(fmt.Sprintf("%s.expression", path), field.Expression)
+	}
+
+	if field.SelectQuery == nil {
+		return nil
+	}
+
+	return bc.checkStrictSelectQueryArgs(path, field.SelectQuery)
+}
+
+func (bc *buildContext) checkStrictExpressionArgs(path string, expression *Expression) error {
+	if expression.Case != nil {
+		for i := range expression.Case.Whens {
+			var err error = bc.checkStrictFilterArgs(fmt.Sprintf("%s.case.whens[%d]", path, i), expression.Case.Whens[i])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for i := range expression.Operands {
+		var (
+			operandPath string = fmt.Sprintf("%s.operands[%d]", path, i)
+			err         error
+		)
+
+		switch operand := expression.Operands[i].(type) {
+		case *Field:
+			err = bc.checkStrictFieldArgs(operandPath, operand)
+		case *Expression:
+			if operand != nil {
+				err = bc.checkStrictExpressionArgs(operandPath, operand)
+			}
+		case *Raw:
+			err = bc.checkStrictRawArgs(operandPath, operand)
+		default:
+			err = bc.checkStrictArg(operandPath, operand)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (bc *buildContext) checkStrictTableArgs(path string, table *Table) error {
+	if table == nil {
+		return nil
+	}
+
+	if table.SelectQuery != nil {
+		return bc.checkStrictSelectQueryArgs(path, table.SelectQuery)
+	}
+
+	return bc.checkStrictRawArgs(path, table.Raw)
+}
+
+func (bc *buildContext) checkStrictSelectQueryArgs(path string, selectQuery *SelectQuery) error {
+	var err error
+
+	for i := range selectQuery.Fields {
+		err = bc.checkStrictFieldArgs(fmt.Sprintf("%s.fields[%d]", path, i), selectQuery.Fields[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	err = bc.checkStrictTableArgs(fmt.Sprintf("%s.from", path), selectQuery.Table)
+	if err != nil {
+		return err
+	}
+
+	for i := range selectQuery.Joins {
+		if selectQuery.Joins[i] == nil {
+			continue
+		}
+
+		err = bc.checkStrictTableArgs(fmt.Sprintf("%s.joins[%d].table", path, i), selectQuery.Joins[i].Table)
+		if err != nil {
+			return err
+		}
+
+		err = bc.checkStrictFilterArgs(fmt.Sprintf("%s.joins[%d].on", path, i), selectQuery.Joins[i].Filter)
+		if err != nil {
+			return err
+		}
+	}
+
+	return bc.checkStrictFilterArgs(fmt.Sprintf("%s.where", path), selectQuery.Filter)
+}
+
+func (bc *buildContext) checkStrictFilterArgs(path string, filter *Filter) error {
+	var err error
+
+	if filter == nil {
+		return nil
+	}
+
+	err = bc.checkStrictFieldArgs(fmt.Sprintf("%s.field", path), filter.Field)
+	if err != nil {
+		return err
+	}
+
+	if filter.Value != nil && filter.Operator == OperatorGeo {
+		return nil
+	}
+
+	if filter.Value != nil && filter.Operator == OperatorSearch {
+		if search, ok := filter.Value.Value.(*SearchFilter); ok && search != nil {
+			for i := range search.Fields {
+				err = bc.checkStrictFieldArgs(fmt.Sprintf("%s.value.fields[%d]", path, i), search.Fields[i])
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+
+	if filter.Value != nil && filter.Value.SelectQuery != nil {
+		err = bc.checkStrictSelectQueryArgs(fmt.Sprintf("%s.value", path), filter.Value.SelectQuery)
+		if err != nil {
+			return err
+		}
+	}
+
+	if filter.Value != nil && filter.Value.Expression != nil {
+		err = bc.checkStrictExpressionArgs(fmt.Sprintf("%s.value.expression", path), filter.Value.Expression)
+		if err != nil {
+			return err
+		}
+	}
+
+	if filter.Value != nil && filter.Value.Raw != nil {
+		err = bc.checkStrictRawArgs(fmt.Sprintf("%s.value", path), filter.Value.Raw)
+		if err != nil {
+			return err
+		}
+	}
+
+	if filter.Value != nil && !filter.Value.isExpression() {
+		var reflectValue reflect.Value = reflect.ValueOf(filter.Value.Value)
+
+		if (filter.Operator == OperatorIn || filter.Operator == OperatorNotIn) &&
+			(reflectValue.Kind() == reflect.Slice || reflectValue.Kind() == reflect.Array) {
+			for i := 0; i < reflectValue.Len(); i++ {
+				err = bc.checkStrictArg(fmt.Sprintf("%s.value[%d]", path, i), reflectValue.Index(i).Interface())
+				if err != nil {
+					return err
+				}
+			}
+		} else {
+			err = bc.checkStrictArg(fmt.Sprintf("%s.value", path), filter.Value.Value)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for i := range filter.Filters {
+		err = bc.checkStrictFilterArgs(fmt.Sprintf("%s.filters[%d]", path, i), filter.Filters[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,259 @@
+package goqube
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestStrictArgs_isStrictArg(t *testing.T) {
+	var (
+		text      string = "value1"
+		nilText   *string
+		testCases []struct {
+			Name        string
+			Value       interface{}
+			Expectation bool
+		}
+	)
+
+	testCases = []struct {
+		Name        string
+		Value       interface{}
+		Expectation bool
+	}{
+		{
+			Name:        "value is nil",
+			Value:       nil,
+			Expectation: true,
+		},
+		{
+			Name:        "value is valuer",
+			Value:       testValuerMap{},
+			Expectation: true,
+		},
+		{
+			Name:        "value is scalar",
+			Value:       uint8(1),
+			Expectation: true,
+		},
+		{
+			Name:        "value is pointer to scalar",
+			Value:       &text,
+			Expectation: true,
+		},
+		{
+			Name:        "value is nil pointer",
+			Value:       nilText,
+			Expectation: true,
+		},
+		{
+			Name:        "value is time",
+			Value:       time.Time{},
+			Expectation: true,
+		},
+		{
+			Name:        "value is byte slice",
+			Value:       []byte("value1"),
+			Expectation: true,
+		},
+		{
+			Name:        "value is struct",
+			Value:       struct{ Name string }{},
+			Expectation: false,
+		},
+		{
+			Name:        "value is slice",
+			Value:       []int{1},
+			Expectation: false,
+		},
+		{
+			Name:        "value is func",
+			Value:       func() {},
+			Expectation: false,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual bool = isStrictArg(testCases[i].Value)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation strict arg is %t, got %t", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestStrictArgs_checkStrictArgs(t *testing.T) {
+	type user struct {
+		Name string
+	}
+
+	var testCases []struct {
+		Name        string
+		Query       Query
+		Expectation error
+	} = []struct {
+		Name        string
+		Query       Query
+		Expectation error
+	}{
+		{
+			Name: "select query args are allowed",
+			Query: Select(NewField("id")).
+				From(NewTable("table1")).
+				Where(NewFilter().SetCondition(NewField("id"), OperatorIn, NewFilterValue([]int{1, 2}))),
+			Expectation: nil,
+		},
+		{
+			Name:        "insert explicit null and default values are allowed",
+			Query:       InsertInto("table1").Value("field1", NullValue).Value("field2", DefaultValue),
+			Expectation: nil,
+		},
+		{
+			Name:        "select query expression operand is struct",
+			Query:       Select(NewExpressionField(NewArithmeticExpression(ExpressionOperatorMultiply, NewField("price"), user{})).As("total")).From(NewTable("table1")),
+			Expectation: &ArgTypeError{Path: "select.fields[0].expression.operands[1]", Type: reflect.TypeOf(user{})},
+		},
+		{
+			Name:        "merge query row value is struct",
+			Query:       MergeInto("table1", "id").Columns("id", "name").Row(1, user{}),
+			Expectation: &ArgTypeError{Path: "values.name[0]", Type: reflect.TypeOf(user{})},
+		},
+		{
+			Name:        "update explicit null value is allowed",
+			Query:       Update("table1").Set("field1", NullValue).Where(FilterTrue()),
+			Expectation: nil,
+		},
+		{
+			Name: "select query nested filter value is struct",
+			Query: Select(NewField("id")).
+				From(NewTable("table1")).
+				Where(
+					NewFilter().
+						SetLogic(LogicAnd).
+						AddFilter(NewField("id"), OperatorEqual, NewFilterValue(1)).
+						AddFilter(NewField("name"), OperatorEqual, NewFilterValue(user{})),
+				),
+			Expectation: &ArgTypeError{Path: "select.where.filters[1].value", Type: reflect.TypeOf(user{})},
+		},
+		{
+			Name: "select query in filter element is struct",
+			Query: Select(NewField("id")).
+				From(NewTable("table1")).
+				Where(NewFilter().SetCondition(NewField("id"), OperatorIn, NewFilterValue([]interface{}{1, user{}}))),
+			Expectation: &ArgTypeError{Path: "select.where.value[1]", Type: reflect.TypeOf(user{})},
+		},
+		{
+			Name: "select query field subquery filter value is struct",
+			Query: Select(NewSelectQueryField(Select(NewField("id")).From(NewTable("table2")).Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(user{})))).As("s")).
+				From(NewTable("table1")),
+			Expectation: &ArgTypeError{Path: "select.fields[0].where.value", Type: reflect.TypeOf(user{})},
+		},
+		{
+			Name: "select query join raw arg is struct",
+			Query: Select(NewField("id")).
+				From(NewTable("table1")).
+				Join(InnerJoin(RawAs(NewRaw("select ?", user{}), "t2")).On(FilterTrue())),
+			Expectation: &ArgTypeError{Path: "select.joins[0].table.args[0]", Type: reflect.TypeOf(user{})},
+		},
+		{
+			Name: "select query join filter subquery is struct",
+			Query: Select(NewField("id")).
+				From(NewSelectQueryTable(Select(NewField("id")).From(NewTable("table2"))).As("t1")).
+				Join(InnerJoin(NewTable("table2")).On(NewFilter().SetCondition(NewField("id"), OperatorIn, NewSelectQueryFilterValue(Select(NewField("id")).From(NewTable("table3")).Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(user{}))))))),
+			Expectation: &ArgTypeError{Path: "select.joins[0].on.value.where.value", Type: reflect.TypeOf(user{})},
+		},
+		{
+			Name:        "insert query value is struct",
+			Query:       InsertInto("table1").Value("name", "value1").Value("name", user{}),
+			Expectation: &ArgTypeError{Path: "values.name[1]", Type: reflect.TypeOf(user{})},
+		},
+		{
+			Name:        "update query value is struct",
+			Query:       Update("table1").Set("name", user{}).Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Expectation: &ArgTypeError{Path: "set.name", Type: reflect.TypeOf(user{})},
+		},
+		{
+			Name: "update query row set filter value is struct",
+			Query: Update("table1").
+				SetRow(Select(NewField("name")).From(NewTable("table2")).Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(user{}))), "name").
+				Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Expectation: &ArgTypeError{Path: "set.rows[0].where.value", Type: reflect.TypeOf(user{})},
+		},
+		{
+			Name:        "update query raw expression arg is struct",
+			Query:       Update("table1").Set("counter", NewRaw("counter + ?", user{})).Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Expectation: &ArgTypeError{Path: "set.counter.args[0]", Type: reflect.TypeOf(user{})},
+		},
+		{
+			Name:        "update query column value is allowed",
+			Query:       Update("table1").Set("previous_status", NewField("status")).Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Expectation: nil,
+		},
+		{
+			Name:        "delete query filter value is struct",
+			Query:       DeleteFrom("table1").Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(user{}))),
+			Expectation: &ArgTypeError{Path: "where.value", Type: reflect.TypeOf(user{})},
+		},
+		{
+			Name:        "raw arg is struct",
+			Query:       NewRaw("select ?", user{}),
+			Expectation: &ArgTypeError{Path: "raw.args[0]", Type: reflect.TypeOf(user{})},
+		},
+		{
+			Name:        "raw named arg is struct",
+			Query:       NewRawNamed("select :id, :user", RawArgsMap{"user": user{}, "id": 1}),
+			Expectation: &ArgTypeError{Path: "raw.args[user]", Type: reflect.TypeOf(user{})},
+		},
+		{
+			Name: "compound query nested branch value is struct",
+			Query: Union(
+				Select(NewField("id")).From(NewTable("table1")),
+				UnionAll(
+					NewRaw("select ?", 1),
+					Select(NewField("id")).From(NewTable("table2")).Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(user{}))),
+				),
+			),
+			Expectation: &ArgTypeError{Path: "compound.branches[1].branches[1].where.value", Type: reflect.TypeOf(user{})},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual error = newDialectBuildContext(DialectPostgres).checkStrictArgs(testCases[i].Query)
+
+			if testCases[i].Expectation != nil && actual == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation == nil && actual != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation != nil && actual != nil && testCases[i].Expectation.Error() != actual.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Error(), actual.Error())
+			}
+		})
+	}
+}
+
+func TestStrictArgs_Build(t *testing.T) {
+	var (
+		query     Query
+		actualErr error
+	)
+
+	query = DeleteFrom("table1").Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(struct{}{})))
+
+	_, _, actualErr = NewConfig(DialectPostgres).Build(query)
+	if actualErr != nil {
+		t.Errorf("expectation error is nil, got %s", actualErr.Error())
+	}
+
+	_, _, actualErr = NewConfig(DialectPostgres).SetStrictArgs(true).Build(query)
+	if actualErr == nil || actualErr.Error() != "arg type is not allowed at where.value: struct {}" {
+		t.Errorf("expectation error is %s, got %v", "arg type is not allowed at where.value: struct {}", actualErr)
+	}
+}
@@ -0,0 +1,133 @@
+package goqube
+
+import (
+	"reflect"
+	"strings"
+)
+
+func hasStructOption(options []StructOption, option StructOption) bool {
+	for i := range options {
+		if options[i] == option {
+			return true
+		}
+	}
+
+	return false
+}
+
+func structColumnsAndValues(v interface{}, options []StructOption) ([]string, []interface{}, error) {
+	var (
+		reflectValue reflect.Value
+		columns      []string
+		values       []interface{}
+	)
+
+	reflectValue = reflect.ValueOf(v)
+	for reflectValue.Kind() == reflect.Ptr {
+		if reflectValue.IsNil() {
+			return nil, nil, ErrValueIsNotStruct
+		}
+
+		reflectValue = reflectValue.Elem()
+	}
+
+	if reflectValue.Kind() != reflect.Struct {
+		return nil, nil, ErrValueIsNotStruct
+	}
+
+	columns, values = appendStructColumnsAndValues(reflectValue, options, []string{}, []interface{}{})
+
+	return columns, values, nil
+}
+
+func appendStructColumnsAndValues(reflectValue reflect.Value, options []StructOption, columns []string, values []interface{}) ([]string, []interface{}) {
+	var reflectType reflect.Type = reflectValue.Type()
+
+	for i := 0; i < reflectType.NumField(); i++ {
+		var (
+			structField reflect.StructField = reflectType.Field(i)
+			fieldValue  reflect.Value       = reflectValue.Field(i)
+			tag         string
+			tagOptions  []string
+			hasTag      bool
+		)
+
+		tag, hasTag = structField.Tag.Lookup(structTagName)
+
+		if structField.Anonymous && !hasTag {
+			for fieldValue.Kind() == reflect.Ptr && !fieldValue.IsNil() {
+				fieldValue = fieldValue.Elem()
+			}
+
+			if fieldValue.Kind() == reflect.Struct {
+				columns, values = appendStructColumnsAndValues(fieldValue, options, columns, values)
+			}
+
+			continue
+		}
+
+		if structField.PkgPath != "" || !hasTag {
+			continue
+		}
+
+		tagOptions = strings.Split(tag, ",")
+		if tagOptions[0] == "" || tagOptions[0] == structTagIgnoredColumn {
+			continue
+		}
+
+		if containsString(tagOptions[1:], structTagGenerated) {
+			continue
+		}
+
+		if (hasStructOption(options, StructOptionOmitZero) || containsString(tagOptions[1:], structTagOmitEmpty)) && fieldValue.IsZero() {
+			continue
+		}
+
+		columns = append(columns, tagOptions[0])
+		values = append(values, fieldValue.Interface())
+	}
+
+	return columns, values
+}
+
+func InsertStruct(table string, v interface{}, options ...StructOption) *InsertQuery {
+	var (
+		insertQuery *InsertQuery
+		columns     []string
+		values      []interface{}
+		err         error
+	)
+
+	insertQuery = InsertInto(table)
+
+	columns, values, err = structColumnsAndValues(v, options)
+	if err != nil {
+		insertQuery.valuesErr = err
+		return insertQuery
+	}
+
+	return insertQuery.Columns(columns...).Values(values...)
+}
+
+func UpdateStruct(table string, v interface{}, options ...StructOption) *UpdateQuery {
+	var (
+		updateQuery *UpdateQuery
+		columns     []string
+		values      []interface{}
+		err         error
+	)
+
+	updateQuery = Update(table)
+
+	columns, values, err = structColumnsAndValues(v, options)
+	if err != nil {
+		updateQuery.valuesErr = err
+		return updateQuery
+	}
+
+	for i := range columns {
+		updateQuery.Set(columns[i], values[i])
+	}
+
+	return updateQuery
+}
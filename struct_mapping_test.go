@@ -0,0 +1,303 @@
+package goqube
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+type testStructMappingAudit struct {
+	CreatedBy string `db:"created_by"`
+}
+
+type testStructMappingUser struct {
+	testStructMappingAudit
+	ID       int64   `db:"id,generated"`
+	Name     string  `db:"name"`
+	Email    *string `db:"email,omitempty"`
+	Age      int     `db:"age"`
+	Password string  `db:"-"`
+	Note     string
+	internal string `db:"internal"`
+}
+
+func TestStructMapping_structColumnsAndValues(t *testing.T) {
+	var (
+		email     string = "name1@example.com"
+		testCases []struct {
+			Name        string
+			Value       interface{}
+			Options     []StructOption
+			Expectation struct {
+				Columns []string
+				Values  []interface{}
+				Err     error
+			}
+		}
+	)
+
+	testCases = []struct {
+		Name        string
+		Value       interface{}
+		Options     []StructOption
+		Expectation struct {
+			Columns []string
+			Values  []interface{}
+			Err     error
+		}
+	}{
+		{
+			Name:  "value is not struct",
+			Value: "name1",
+			Expectation: struct {
+				Columns []string
+				Values  []interface{}
+				Err     error
+			}{
+				Err: ErrValueIsNotStruct,
+			},
+		},
+		{
+			Name:  "value is nil pointer",
+			Value: (*testStructMappingUser)(nil),
+			Expectation: struct {
+				Columns []string
+				Values  []interface{}
+				Err     error
+			}{
+				Err: ErrValueIsNotStruct,
+			},
+		},
+		{
+			Name: "value is struct",
+			Value: testStructMappingUser{
+				testStructMappingAudit: testStructMappingAudit{CreatedBy: "admin"},
+				ID:                     1,
+				Name:                   "name1",
+				Password:               "secret",
+				Note:                   "note1",
+				internal:               "internal1",
+			},
+			Expectation: struct {
+				Columns []string
+				Values  []interface{}
+				Err     error
+			}{
+				Columns: []string{"created_by", "name", "age"},
+				Values:  []interface{}{"admin", "name1", 0},
+			},
+		},
+		{
+			Name: "value is pointer to struct with omit zero option",
+			Value: &testStructMappingUser{
+				Name:  "name1",
+				Email: &email,
+			},
+			Options: []StructOption{StructOptionOmitZero},
+			Expectation: struct {
+				Columns []string
+				Values  []interface{}
+				Err     error
+			}{
+				Columns: []string{"name", "email"},
+				Values:  []interface{}{"name1", &email},
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualColumns []string
+				actualValues  []interface{}
+				actualErr     error
+			)
+
+			actualColumns, actualValues, actualErr = structColumnsAndValues(testCases[i].Value, testCases[i].Options)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if !reflect.DeepEqual(testCases[i].Expectation.Columns, actualColumns) {
+				t.Errorf("expectation columns is %v, got %v", testCases[i].Expectation.Columns, actualColumns)
+			}
+
+			if !reflect.DeepEqual(testCases[i].Expectation.Values, actualValues) {
+				t.Errorf("expectation values is %v, got %v", testCases[i].Expectation.Values, actualValues)
+			}
+		})
+	}
+}
+
+func TestStructMapping_InsertStruct(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		InsertQuery *InsertQuery
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		InsertQuery *InsertQuery
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:        "value is not struct",
+			InsertQuery: InsertStruct("users", 1),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Err: ErrValueIsNotStruct,
+			},
+		},
+		{
+			Name:        "all fields are omitted",
+			InsertQuery: InsertStruct("users", testStructMappingUser{}, StructOptionOmitZero),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Err: ErrFieldsIsRequired,
+			},
+		},
+		{
+			Name:        fmt.Sprintf("insert struct with dialect %s", DialectPostgres),
+			InsertQuery: InsertStruct("users", &testStructMappingUser{Name: "name1", Age: 20}),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into users(created_by, name, age) values ($1, $2, $3)",
+				Args:  []interface{}{"", "name1", 20},
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].InsertQuery.ToSQLWithArgs(DialectPostgres)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %v, got %v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
+
+func TestStructMapping_UpdateStruct(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		UpdateQuery *UpdateQuery
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		UpdateQuery *UpdateQuery
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:        "value is not struct",
+			UpdateQuery: UpdateStruct("users", nil).Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Err: ErrValueIsNotStruct,
+			},
+		},
+		{
+			Name: fmt.Sprintf("update struct with dialect %s and omit zero option", DialectMySQL),
+			UpdateQuery: UpdateStruct("users", testStructMappingUser{ID: 1, Name: "name1"}, StructOptionOmitZero).
+				Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update users set name = ? where id = ?",
+				Args:  []interface{}{"name1", 1},
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].UpdateQuery.ToSQLWithArgs(DialectMySQL)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %v, got %v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
@@ -3,9 +3,12 @@ package goqube
 import "fmt"
 
 type Table struct {
+	Database    string
 	Name        string
 	SelectQuery *SelectQuery
+	Raw         *Raw
 	Alias       string
+	IndexHints  []*IndexHint
 }
 
 func NewTable(name string) *Table {
@@ -20,11 +23,38 @@ func NewSelectQueryTable(selectQuery *SelectQuery) *Table {
 	}
 }
 
+func NewRawTable(raw *Raw) *Table {
+	return &Table{
+		Raw: raw,
+	}
+}
+
+func SelectAs(selectQuery *SelectQuery, alias string) *Table {
+	return NewSelectQueryTable(selectQuery).As(alias)
+}
+
+func RawAs(raw *Raw, alias string) *Table {
+	return NewRawTable(raw).As(alias)
+}
+
+func (t *Table) FromDatabase(database string) *Table {
+	t.Database = database
+	return t
+}
+
 func (t *Table) As(alias string) *Table {
 	t.Alias = alias
 	return t
 }
 
+func (t *Table) qualifier() string {
+	if t.Alias != "" {
+		return t.Alias
+	}
+
+	return t.Name
+}
+
 func (t *Table) validate(dialect Dialect) error {
 	if dialect == "" {
 		return ErrDialectIsRequired
@@ -34,31 +64,57 @@ func (t *Table) validate(dialect Dialect) error {
 		return ErrConflictTableNameAndTableSelectQuery
 	}
 
-	if t.Name == "" && t.SelectQuery == nil {
+	if t.Raw != nil && (t.Name != "" || t.Database != "" || t.SelectQuery != nil) {
+		return ErrConflictTableRaw
+	}
+
+	if t.Name == "" && t.SelectQuery == nil && t.Raw == nil {
 		return ErrNameIsRequired
 	}
 
-	if t.Alias == "" && t.SelectQuery != nil {
+	if t.Database != "" && t.SelectQuery != nil {
+		return ErrConflictTableDatabaseAndTableSelectQuery
+	}
+
+	if t.Database != "" && !isValidIdentifier(t.Database) {
+		return ErrIdentifierIsInvalid
+	}
+
+	if t.Alias == "" && (t.SelectQuery != nil || t.Raw != nil) {
 		return ErrAliasIsRequired
 	}
 
-	return nil
+	if !isValidAlias(t.Alias) {
+		return ErrAliasIsInvalid
+	}
+
+	return t.validateIndexHints(dialect)
 }
 
-func (t *Table) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+func (t *Table) toSQLWithArgs(bc *buildContext, args []interface{}) (string, []interface{}, error) {
 	var (
 		table string
 		err   error
 	)
 
-	err = t.validate(dialect)
+	err = t.validate(bc.dialect)
 	if err != nil {
 		return "", nil, err
 	}
 
-	table = t.Name
+	table = bc.qualifiedTableName(t.Database, t.Name)
+
 	if t.SelectQuery != nil {
-		table, args, err = t.SelectQuery.ToSQLWithArgsWithAlias(dialect, args)
+		table, args, err = t.SelectQuery.toSQLWithArgsWithAlias(bc, args)
+		if err != nil {
+			return "", nil, err
+		}
+
+		table = fmt.Sprintf("(%s)", table)
+	}
+
+	if t.Raw != nil {
+		table, args, err = t.Raw.toSQLWithArgs(bc, args)
 		if err != nil {
 			return "", nil, err
 		}
@@ -69,20 +125,32 @@ func (t *Table) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []in
 	return table, args, nil
 }
 
-func (t *Table) ToSQLWithArgsWithAlias(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+func (t *Table) toSQLWithArgsWithAlias(bc *buildContext, args []interface{}) (string, []interface{}, error) {
 	var (
 		table string
 		err   error
 	)
 
-	table, args, err = t.ToSQLWithArgs(dialect, args)
+	table, args, err = t.toSQLWithArgs(bc, args)
 	if err != nil {
 		return "", nil, err
 	}
 
 	if t.Alias != "" {
-		table = fmt.Sprintf("%s as %s", table, t.Alias)
+		table = table + " as " + quoteAlias(bc.dialect, t.Alias)
+	}
+
+	if len(t.IndexHints) > 0 {
+		table = table + t.indexHintsSQL()
 	}
 
 	return table, args, nil
 }
+
+func (t *Table) ToSQLWithArgs(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+	return t.toSQLWithArgs(newDialectBuildContext(dialect), args)
+}
+
+func (t *Table) ToSQLWithArgsWithAlias(dialect Dialect, args []interface{}) (string, []interface{}, error) {
+	return t.toSQLWithArgsWithAlias(newDialectBuildContext(dialect), args)
+}
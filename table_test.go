@@ -1,6 +1,10 @@
 package goqube
 
-import "testing"
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
 
 func testTable_TableEquality(t *testing.T, expectation, actual *Table) {
 	if expectation == nil && actual == nil {
@@ -15,6 +19,10 @@ func testTable_TableEquality(t *testing.T, expectation, actual *Table) {
 		t.Errorf("expectation is %+v, got nil", expectation)
 	}
 
+	if expectation.Database != actual.Database {
+		t.Errorf("expectation table database is %s, got %s", expectation.Database, actual.Database)
+	}
+
 	if expectation.Name != actual.Name {
 		t.Errorf("expectation table name is %s, got %s", expectation.Name, actual.Name)
 	}
@@ -31,6 +39,10 @@ func testTable_TableEquality(t *testing.T, expectation, actual *Table) {
 		t.Errorf("expectation select query is %+v, got %+v", expectation.SelectQuery, actual.SelectQuery)
 	}
 
+	if !deepEqual(expectation.Raw, actual.Raw) {
+		t.Errorf("expectation raw is %+v, got %+v", expectation.Raw, actual.Raw)
+	}
+
 	if expectation.Alias != actual.Alias {
 		t.Errorf("expectation operator is %s, got %s", expectation.Alias, actual.Alias)
 	}
@@ -62,6 +74,34 @@ func TestTable_NewSelectQueryTable(t *testing.T) {
 	)
 }
 
+func TestTable_NewRawTable(t *testing.T) {
+	testTable_TableEquality(t, &Table{Raw: NewRaw("select 1")}, NewRawTable(NewRaw("select 1")))
+}
+
+func TestTable_SelectAs(t *testing.T) {
+	var (
+		selectQuery *SelectQuery
+		expectation *Table
+	)
+
+	selectQuery = Select(NewField("field1")).From(NewTable("table1"))
+	expectation = &Table{
+		SelectQuery: selectQuery,
+		Alias:       "alias1",
+	}
+
+	testTable_TableEquality(t, expectation, SelectAs(selectQuery, "alias1"))
+}
+
+func TestTable_RawAs(t *testing.T) {
+	var expectation *Table = &Table{
+		Raw:   NewRaw("select ?", 1),
+		Alias: "alias1",
+	}
+
+	testTable_TableEquality(t, expectation, RawAs(NewRaw("select ?", 1), "alias1"))
+}
+
 func TestTable_As(t *testing.T) {
 	testTable_TableEquality(
 		t,
@@ -74,6 +114,10 @@ func TestTable_As(t *testing.T) {
 	)
 }
 
+func TestTable_FromDatabase(t *testing.T) {
+	testTable_TableEquality(t, &Table{Database: "database1", Name: "table1"}, NewTable("table1").FromDatabase("database1"))
+}
+
 func TestTable_validate(t *testing.T) {
 	var testCases []struct {
 		Name        string
@@ -107,6 +151,42 @@ func TestTable_validate(t *testing.T) {
 			Dialect:     DialectPostgres,
 			Expectation: ErrNameIsRequired,
 		},
+		{
+			Name: "database is not empty and select query is not nil",
+			Table: &Table{
+				Database:    "database1",
+				SelectQuery: &SelectQuery{},
+				Alias:       "alias1",
+			},
+			Dialect:     DialectPostgres,
+			Expectation: ErrConflictTableDatabaseAndTableSelectQuery,
+		},
+		{
+			Name: "raw is not nil and name is not empty",
+			Table: &Table{
+				Name: "table1",
+				Raw:  NewRaw("select 1"),
+			},
+			Dialect:     DialectPostgres,
+			Expectation: ErrConflictTableRaw,
+		},
+		{
+			Name: "raw is not nil and database is not empty",
+			Table: &Table{
+				Database: "database1",
+				Raw:      NewRaw("select 1"),
+			},
+			Dialect:     DialectPostgres,
+			Expectation: ErrConflictTableRaw,
+		},
+		{
+			Name: "alias is empty and raw is not nil",
+			Table: &Table{
+				Raw: NewRaw("select 1"),
+			},
+			Dialect:     DialectPostgres,
+			Expectation: ErrAliasIsRequired,
+		},
 		{
 			Name: "alias is empty and select query is not nil",
 			Table: &Table{
@@ -190,6 +270,22 @@ func TestTable_ToSQLWithArgs(t *testing.T) {
 				Err:   nil,
 			},
 		},
+		{
+			Name: "database is not empty and name is not empty",
+			Table: &Table{
+				Database: "database1",
+				Name:     "table1",
+			},
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "database1.table1",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
 		{
 			Name: "name is empty and select query is not nil and to sql with args with alias is error",
 			Table: &Table{
@@ -231,6 +327,32 @@ func TestTable_ToSQLWithArgs(t *testing.T) {
 				Err:   nil,
 			},
 		},
+		{
+			Name:  "raw is not nil and to sql with args is error",
+			Table: RawAs(NewRaw("select ?"), "alias1"),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errPlaceholderCountf, ErrPlaceholderCountMismatch, 1, 0),
+			},
+		},
+		{
+			Name:  "raw is not nil",
+			Table: RawAs(NewRaw("select field1 from table1 where field2 = ?", "value1"), "alias1"),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "(select field1 from table1 where field2 = $1)",
+				Args:  []interface{}{"value1"},
+				Err:   nil,
+			},
+		},
 	}
 
 	for i := range testCases {
@@ -357,3 +479,93 @@ func TestTable_ToSQLWithArgsWithAlias(t *testing.T) {
 		})
 	}
 }
+
+func TestTable_FromDatabaseWithConfig(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Query       Query
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Query       Query
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:    "database is invalid",
+			Dialect: DialectPostgres,
+			Query:   Select(NewField("id")).From(NewTable("AuditLogs").FromDatabase("archive; drop")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrIdentifierIsInvalid,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			Query:   Select(NewField("id")).From(NewTable("AuditLogs").FromDatabase("archive")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from archive.app_audit_logs",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s", DialectMySQL),
+			Dialect: DialectMySQL,
+			Query:   Select(NewField("id")).From(NewTable("AuditLogs").FromDatabase("archive")),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from archive.app_audit_logs",
+				Args:  []interface{}{},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				config      *Config = NewConfig(testCases[i].Dialect).SetTablePrefix("app_").SetNameMapper(NewNameMapper().MapTables(SnakeCase))
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = config.Build(testCases[i].Query)
+
+			if !errors.Is(actualErr, testCases[i].Expectation.Err) {
+				t.Errorf("expectation error is %v, got %v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %v, got %v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package goqube
+
+import (
+	"context"
+	"reflect"
+	"sort"
+)
+
+type Span interface {
+	SetAttribute(key string, value interface{})
+	RecordError(err error)
+	End()
+}
+
+type Tracer interface {
+	StartSpan(ctx context.Context, name string) (context.Context, Span)
+}
+
+type SpanAttributesFunc func(ctx context.Context, query Query) map[string]interface{}
+
+func (e *Executor) SetTracer(tracer Tracer) *Executor {
+	e.Tracer = tracer
+	return e
+}
+
+func (e *Executor) SetSpanAttributes(spanAttributes SpanAttributesFunc) *Executor {
+	e.SpanAttributes = spanAttributes
+	return e
+}
+
+func spanName(query Query) string {
+	var (
+		namer interface{ StatementName() string }
+		ok    bool
+	)
+
+	namer, ok = query.(interface{ StatementName() string })
+	if !ok || reflect.ValueOf(query).IsNil() || namer.StatementName() == "" {
+		return "goqube.query"
+	}
+
+	return namer.StatementName()
+}
+
+func (e *Executor) startSpan(ctx context.Context, query Query) (context.Context, Span) {
+	var (
+		span       Span
+		attributes map[string]interface{}
+		keys       []string
+	)
+
+	if e.Tracer == nil {
+		return ctx, nil
+	}
+
+	ctx, span = e.Tracer.StartSpan(ctx, spanName(query))
+	if span == nil {
+		return ctx, nil
+	}
+
+	if e.Config != nil && dialectDBSystemMap[e.Config.Dialect] != "" {
+		span.SetAttribute("db.system", dialectDBSystemMap[e.Config.Dialect])
+	}
+
+	if e.SpanAttributes != nil {
+		attributes = e.SpanAttributes(ctx, query)
+
+		keys = make([]string, 0, len(attributes))
+		for key := range attributes {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for i := range keys {
+			span.SetAttribute(keys[i], attributes[keys[i]])
+		}
+	}
+
+	return ctx, span
+}
+
+func endSpan(span Span, sqlQuery string, rowsKey string, rows int64, err error) {
+	if span == nil {
+		return
+	}
+
+	if sqlQuery != "" {
+		span.SetAttribute("db.statement", sqlQuery)
+	}
+
+	if err == nil && rows >= 0 {
+		span.SetAttribute(rowsKey, rows)
+	}
+
+	if err != nil {
+		span.RecordError(err)
+	}
+
+	span.End()
+}
+
+func scannedRowCount(dest interface{}) int64 {
+	var destValue reflect.Value = reflect.ValueOf(dest).Elem()
+
+	if destValue.Kind() == reflect.Slice && destValue.Type().Elem().Kind() != reflect.Uint8 {
+		return int64(destValue.Len())
+	}
+
+	return 1
+}
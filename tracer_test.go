@@ -0,0 +1,118 @@
+package goqube
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+)
+
+type fakeSpan struct {
+	name       string
+	attributes map[string]interface{}
+	err        error
+	ended      bool
+}
+
+func (s *fakeSpan) SetAttribute(key string, value interface{}) {
+	s.attributes[key] = value
+}
+
+func (s *fakeSpan) RecordError(err error) {
+	s.err = err
+}
+
+func (s *fakeSpan) End() {
+	s.ended = true
+}
+
+type fakeTracer struct {
+	spans []*fakeSpan
+}
+
+func (t *fakeTracer) StartSpan(ctx context.Context, name string) (context.Context, Span) {
+	var span *fakeSpan = &fakeSpan{name: name, attributes: map[string]interface{}{}}
+
+	t.spans = append(t.spans, span)
+
+	return ctx, span
+}
+
+func TestExecutor_SetTracer(t *testing.T) {
+	var (
+		tracer   *fakeTracer = &fakeTracer{}
+		executor *Executor   = NewExecutor(nil, DialectPostgres).SetTracer(tracer).SetSpanAttributes(func(ctx context.Context, query Query) map[string]interface{} {
+			return map[string]interface{}{"service": "checkout"}
+		})
+	)
+
+	if executor.Tracer != tracer {
+		t.Errorf("expectation tracer is %+v, got %+v", tracer, executor.Tracer)
+	}
+
+	if executor.SpanAttributes == nil {
+		t.Error("expectation span attributes is not nil, got nil")
+	}
+}
+
+func TestExecutor_Tracing(t *testing.T) {
+	var (
+		tracer   *fakeTracer = &fakeTracer{}
+		executor *Executor
+		users    []executorTestUser
+		err      error
+	)
+
+	executor = NewExecutor(openFakeDB(t, &fakeDriverResult{
+		columns: []string{"id", "name"},
+		rows:    [][]driver.Value{{int64(1), "name1"}, {int64(2), "name2"}},
+	}), DialectPostgres).
+		SetTracer(tracer).
+		SetSpanAttributes(func(ctx context.Context, query Query) map[string]interface{} {
+			return map[string]interface{}{"app": "checkout"}
+		})
+
+	_, err = executor.Exec(context.Background(), DeleteFrom("users").Where(Eq("id", 1)))
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %s", err.Error())
+	}
+
+	err = executor.Query(context.Background(), Select(NewField("id"), NewField("name")).From(NewTable("users")).Where(Eq("status", "active")), &users)
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %s", err.Error())
+	}
+
+	_, err = executor.Exec(context.Background(), Update("users"))
+	if err == nil {
+		t.Fatal("expectation error is not nil, got nil")
+	}
+
+	if len(tracer.spans) != 3 {
+		t.Fatalf("expectation spans length is %d, got %d", 3, len(tracer.spans))
+	}
+
+	if tracer.spans[0].name != "delete_users_by_id" {
+		t.Errorf("expectation span name is %s, got %s", "delete_users_by_id", tracer.spans[0].name)
+	}
+
+	if !deepEqual(map[string]interface{}{"app": "checkout", "db.system": "postgresql", "db.statement": "delete from users where id = $1", "db.rows_affected": int64(1)}, tracer.spans[0].attributes) {
+		t.Errorf("expectation attributes is %+v, got %+v", map[string]interface{}{"app": "checkout", "db.system": "postgresql", "db.statement": "delete from users where id = $1", "db.rows_affected": int64(1)}, tracer.spans[0].attributes)
+	}
+
+	if !deepEqual(map[string]interface{}{"app": "checkout", "db.system": "postgresql", "db.statement": "select id, name from users where status = $1", "db.rows": int64(2)}, tracer.spans[1].attributes) {
+		t.Errorf("expectation attributes is %+v, got %+v", map[string]interface{}{"app": "checkout", "db.system": "postgresql", "db.statement": "select id, name from users where status = $1", "db.rows": int64(2)}, tracer.spans[1].attributes)
+	}
+
+	if tracer.spans[2].err == nil || !tracer.spans[2].ended {
+		t.Errorf("expectation failed span records error and ends, got %+v", tracer.spans[2])
+	}
+
+	if _, ok := tracer.spans[2].attributes["db.statement"]; ok {
+		t.Errorf("expectation failed build has no statement, got %+v", tracer.spans[2].attributes)
+	}
+
+	for i := range tracer.spans {
+		if !tracer.spans[i].ended {
+			t.Errorf("expectation span %d is ended", i)
+		}
+	}
+}
@@ -0,0 +1,305 @@
+package goqube
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+type TransactionStep struct {
+	Savepoint string
+	Query     string
+	Args      []interface{}
+}
+
+func (s *TransactionStep) SavepointSQL() string {
+	return fmt.Sprintf("savepoint %s", s.Savepoint)
+}
+
+func (s *TransactionStep) ReleaseSQL() string {
+	return fmt.Sprintf("release savepoint %s", s.Savepoint)
+}
+
+func (s *TransactionStep) RollbackSQL() string {
+	return fmt.Sprintf("rollback to savepoint %s", s.Savepoint)
+}
+
+type TransactionPlan struct {
+	Query     Query
+	Key       *Field
+	Keys      interface{}
+	ChunkSize int
+	Savepoint string
+}
+
+func NewTransactionPlan(query Query) *TransactionPlan {
+	return &TransactionPlan{
+		Query:     query,
+		Savepoint: "chunk",
+	}
+}
+
+func (p *TransactionPlan) ChunkBy(key *Field, keys interface{}, chunkSize int) *TransactionPlan {
+	p.Key = key
+	p.Keys = keys
+	p.ChunkSize = chunkSize
+	return p
+}
+
+func (p *TransactionPlan) validate(dialect Dialect) error {
+	if dialect == "" {
+		return ErrDialectIsRequired
+	}
+
+	return p.validatePlan()
+}
+
+func (p *TransactionPlan) validatePlan() error {
+	if p.Query == nil {
+		return ErrQueryIsRequired
+	}
+
+	switch p.Query.(type) {
+	case *UpdateQuery, *DeleteQuery:
+	default:
+		return fmt.Errorf(errUnsupportedQueryTypef, p.Query)
+	}
+
+	if p.Key == nil {
+		return ErrFieldIsRequired
+	}
+
+	if p.ChunkSize <= 0 {
+		return ErrChunkSizeIsRequired
+	}
+
+	if p.Savepoint == "" {
+		return ErrSavepointIsRequired
+	}
+
+	return nil
+}
+
+func (p *TransactionPlan) chunkQuery(chunk []interface{}) Query {
+	var keyFilter *Filter = NewFilter().SetCondition(p.Key, OperatorIn, NewFilterValue(chunk))
+
+	switch query := p.Query.(type) {
+	case *UpdateQuery:
+		var chunkQuery UpdateQuery = *query
+		chunkQuery.Filter = keyFilter
+		if query.Filter != nil {
+			chunkQuery.Filter = NewFilter().SetLogic(LogicAnd).AddFilters(query.Filter, keyFilter)
+		}
+		return &chunkQuery
+
+	case *DeleteQuery:
+		var chunkQuery DeleteQuery = *query
+		chunkQuery.Filter = keyFilter
+		if query.Filter != nil {
+			chunkQuery.Filter = NewFilter().SetLogic(LogicAnd).AddFilters(query.Filter, keyFilter)
+		}
+		return &chunkQuery
+	}
+
+	return nil
+}
+
+func (p *TransactionPlan) chunkQueries() ([]Query, error) {
+	var (
+		keys    []interface{}
+		queries []Query
+		err     error
+	)
+
+	keys, err = typedSliceToInterfaceSlice(p.Keys)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(keys) == 0 {
+		return nil, ErrValuesIsRequired
+	}
+
+	queries = []Query{}
+	for start := 0; start < len(keys); start += p.ChunkSize {
+		var end int = start + p.ChunkSize
+
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		queries = append(queries, p.chunkQuery(keys[start:end]))
+	}
+
+	return queries, nil
+}
+
+func (p *TransactionPlan) toSteps(bc *buildContext) ([]*TransactionStep, error) {
+	var (
+		queries []Query
+		steps   []*TransactionStep
+		err     error
+	)
+
+	err = p.validate(bc.dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	queries, err = p.chunkQueries()
+	if err != nil {
+		return nil, err
+	}
+
+	steps = []*TransactionStep{}
+	for i := range queries {
+		var step *TransactionStep = &TransactionStep{
+			Savepoint: fmt.Sprintf("%s_%d", p.Savepoint, i+1),
+		}
+
+		step.Query, step.Args, err = bc.config.build(bc, queries[i])
+		if err != nil {
+			return nil, err
+		}
+
+		steps = append(steps, step)
+	}
+
+	return steps, nil
+}
+
+func (p *TransactionPlan) ToSteps(dialect Dialect) ([]*TransactionStep, error) {
+	return p.toSteps(newDialectBuildContext(dialect))
+}
+
+func (c *Config) TransactionSteps(transactionPlan *TransactionPlan) ([]*TransactionStep, error) {
+	var err error = c.validateVariant()
+	if err != nil {
+		return nil, err
+	}
+
+	return transactionPlan.toSteps(newBuildContext(c))
+}
+
+func (p *TransactionPlan) Execute(ctx context.Context, runner Runner) error {
+	var (
+		queries []Query
+		err     error
+	)
+
+	if runner == nil {
+		return ErrRunnerIsRequired
+	}
+
+	err = p.validatePlan()
+	if err != nil {
+		return err
+	}
+
+	queries, err = p.chunkQueries()
+	if err != nil {
+		return err
+	}
+
+	for i := range queries {
+		err = runner.RunInTransaction(ctx, func(runner Runner) error {
+			var err error
+
+			_, err = runner.Exec(ctx, queries[i])
+
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *Executor) ExecTransactionPlan(ctx context.Context, transactionPlan *TransactionPlan) error {
+	var (
+		steps []*TransactionStep
+		err   error
+	)
+
+	if transactionPlan == nil {
+		return ErrQueryIsRequired
+	}
+
+	if e.DB == nil && e.tx == nil {
+		return ErrDBIsRequired
+	}
+
+	if e.Config == nil {
+		return ErrConfigIsRequired
+	}
+
+	steps, err = e.Config.TransactionSteps(transactionPlan)
+	if err != nil {
+		return err
+	}
+
+	for i := range steps {
+		if e.tx != nil {
+			err = e.execStepInSavepoint(ctx, steps[i])
+		} else {
+			err = e.execStepInTransaction(ctx, steps[i])
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *Executor) execStepInTransaction(ctx context.Context, step *TransactionStep) error {
+	var (
+		tx  *sql.Tx
+		err error
+	)
+
+	tx, err = e.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = e.WithTx(tx).execContext(ctx, step.Query, step.Args)
+	if err != nil {
+		var rollbackErr error = tx.Rollback()
+		if rollbackErr != nil {
+			return fmt.Errorf(errRollbackf, err, rollbackErr)
+		}
+
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (e *Executor) execStepInSavepoint(ctx context.Context, step *TransactionStep) error {
+	var err error
+
+	_, err = e.tx.ExecContext(ctx, step.SavepointSQL())
+	if err != nil {
+		return err
+	}
+
+	_, err = e.execContext(ctx, step.Query, step.Args)
+	if err != nil {
+		var rollbackErr error
+
+		_, rollbackErr = e.tx.ExecContext(ctx, step.RollbackSQL())
+		if rollbackErr != nil {
+			return fmt.Errorf(errRollbackf, err, rollbackErr)
+		}
+
+		return err
+	}
+
+	_, err = e.tx.ExecContext(ctx, step.ReleaseSQL())
+
+	return err
+}
@@ -0,0 +1,494 @@
+package goqube
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestTransactionPlan_NewTransactionPlan(t *testing.T) {
+	var (
+		query  *DeleteQuery
+		actual *TransactionPlan
+	)
+
+	query = Delete().From("table1")
+	actual = NewTransactionPlan(query).ChunkBy(NewField("id"), []int{1, 2, 3}, 2)
+
+	if actual.Query != query {
+		t.Errorf("expectation query is %+v, got %+v", query, actual.Query)
+	}
+
+	if !deepEqual(NewField("id"), actual.Key) {
+		t.Errorf("expectation key is %+v, got %+v", NewField("id"), actual.Key)
+	}
+
+	if !deepEqual([]int{1, 2, 3}, actual.Keys) {
+		t.Errorf("expectation keys is %+v, got %+v", []int{1, 2, 3}, actual.Keys)
+	}
+
+	if actual.ChunkSize != 2 {
+		t.Errorf("expectation chunk size is %d, got %d", 2, actual.ChunkSize)
+	}
+
+	if actual.Savepoint != "chunk" {
+		t.Errorf("expectation savepoint is %s, got %s", "chunk", actual.Savepoint)
+	}
+}
+
+func TestTransactionPlan_validate(t *testing.T) {
+	var testCases []struct {
+		Name            string
+		Dialect         Dialect
+		TransactionPlan *TransactionPlan
+		Expectation     error
+	} = []struct {
+		Name            string
+		Dialect         Dialect
+		TransactionPlan *TransactionPlan
+		Expectation     error
+	}{
+		{
+			Name:            "dialect is empty",
+			Dialect:         "",
+			TransactionPlan: &TransactionPlan{},
+			Expectation:     ErrDialectIsRequired,
+		},
+		{
+			Name:            "query is nil",
+			Dialect:         DialectPostgres,
+			TransactionPlan: &TransactionPlan{},
+			Expectation:     ErrQueryIsRequired,
+		},
+		{
+			Name:            "query is not update or delete",
+			Dialect:         DialectPostgres,
+			TransactionPlan: &TransactionPlan{Query: Insert()},
+			Expectation:     fmt.Errorf(errUnsupportedQueryTypef, Insert()),
+		},
+		{
+			Name:            "key is nil",
+			Dialect:         DialectPostgres,
+			TransactionPlan: &TransactionPlan{Query: Delete()},
+			Expectation:     ErrFieldIsRequired,
+		},
+		{
+			Name:            "chunk size is zero",
+			Dialect:         DialectPostgres,
+			TransactionPlan: &TransactionPlan{Query: Delete(), Key: NewField("id")},
+			Expectation:     ErrChunkSizeIsRequired,
+		},
+		{
+			Name:            "savepoint is empty",
+			Dialect:         DialectPostgres,
+			TransactionPlan: &TransactionPlan{Query: Delete(), Key: NewField("id"), ChunkSize: 1},
+			Expectation:     ErrSavepointIsRequired,
+		},
+		{
+			Name:            "transaction plan is valid",
+			Dialect:         DialectPostgres,
+			TransactionPlan: NewTransactionPlan(Delete()).ChunkBy(NewField("id"), []int{1}, 1),
+			Expectation:     nil,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual error = testCases[i].TransactionPlan.validate(testCases[i].Dialect)
+
+			if testCases[i].Expectation != nil && actual == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation == nil && actual != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation != nil && actual != nil && testCases[i].Expectation.Error() != actual.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Error(), actual.Error())
+			}
+		})
+	}
+}
+
+func TestTransactionPlan_ToSteps(t *testing.T) {
+	var testCases []struct {
+		Name            string
+		Dialect         Dialect
+		TransactionPlan *TransactionPlan
+		Expectation     struct {
+			Steps []*TransactionStep
+			Err   error
+		}
+	} = []struct {
+		Name            string
+		Dialect         Dialect
+		TransactionPlan *TransactionPlan
+		Expectation     struct {
+			Steps []*TransactionStep
+			Err   error
+		}
+	}{
+		{
+			Name:            "transaction plan is invalid",
+			Dialect:         DialectPostgres,
+			TransactionPlan: &TransactionPlan{},
+			Expectation: struct {
+				Steps []*TransactionStep
+				Err   error
+			}{
+				Steps: nil,
+				Err:   ErrQueryIsRequired,
+			},
+		},
+		{
+			Name:            "keys is not slice",
+			Dialect:         DialectPostgres,
+			TransactionPlan: NewTransactionPlan(Delete().From("table1")).ChunkBy(NewField("id"), 1, 2),
+			Expectation: struct {
+				Steps []*TransactionStep
+				Err   error
+			}{
+				Steps: nil,
+				Err:   fmt.Errorf(errUnsupportedValueTypef, "int"),
+			},
+		},
+		{
+			Name:            "keys is empty",
+			Dialect:         DialectPostgres,
+			TransactionPlan: NewTransactionPlan(Delete().From("table1")).ChunkBy(NewField("id"), []int{}, 2),
+			Expectation: struct {
+				Steps []*TransactionStep
+				Err   error
+			}{
+				Steps: nil,
+				Err:   ErrValuesIsRequired,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("chunked update with dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			TransactionPlan: NewTransactionPlan(
+				Update("table1").
+					Set("field1", "value1").
+					Where(NewFilter().SetCondition(NewField("field2"), OperatorEqual, NewFilterValue("value2"))),
+			).ChunkBy(NewField("id"), []int{1, 2, 3}, 2),
+			Expectation: struct {
+				Steps []*TransactionStep
+				Err   error
+			}{
+				Steps: []*TransactionStep{
+					{
+						Savepoint: "chunk_1",
+						Query:     "update table1 set field1 = $1 where field2 = $2 and id in ($3, $4)",
+						Args:      []interface{}{"value1", "value2", 1, 2},
+					},
+					{
+						Savepoint: "chunk_2",
+						Query:     "update table1 set field1 = $1 where field2 = $2 and id in ($3)",
+						Args:      []interface{}{"value1", "value2", 3},
+					},
+				},
+				Err: nil,
+			},
+		},
+		{
+			Name:            fmt.Sprintf("chunked delete without filter with dialect %s", DialectMySQL),
+			Dialect:         DialectMySQL,
+			TransactionPlan: NewTransactionPlan(Delete().From("table1")).ChunkBy(NewField("id"), []int{1, 2, 3}, 3),
+			Expectation: struct {
+				Steps []*TransactionStep
+				Err   error
+			}{
+				Steps: []*TransactionStep{
+					{
+						Savepoint: "chunk_1",
+						Query:     "delete from table1 where id in (?, ?, ?)",
+						Args:      []interface{}{1, 2, 3},
+					},
+				},
+				Err: nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualSteps []*TransactionStep
+				actualErr   error
+			)
+
+			actualSteps, actualErr = testCases[i].TransactionPlan.ToSteps(testCases[i].Dialect)
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if len(testCases[i].Expectation.Steps) != len(actualSteps) {
+				t.Errorf("expectation length of steps is %d, got %d", len(testCases[i].Expectation.Steps), len(actualSteps))
+			}
+
+			for j := range testCases[i].Expectation.Steps {
+				if !deepEqual(testCases[i].Expectation.Steps[j], actualSteps[j]) {
+					t.Errorf("expectation element of steps is %+v, got %+v", testCases[i].Expectation.Steps[j], actualSteps[j])
+				}
+			}
+		})
+	}
+}
+
+type transactionPlanTestRunner struct {
+	queries []string
+	failOn  string
+}
+
+func (r *transactionPlanTestRunner) Exec(ctx context.Context, query Query) (sql.Result, error) {
+	var (
+		sqlQuery string
+		err      error
+	)
+
+	sqlQuery, _, err = NewConfig(DialectPostgres).Build(query)
+	if err != nil {
+		return nil, err
+	}
+
+	r.queries = append(r.queries, sqlQuery)
+	if sqlQuery == r.failOn {
+		return nil, errors.New("lock timeout")
+	}
+
+	return nil, nil
+}
+
+func (r *transactionPlanTestRunner) Query(ctx context.Context, query Query, dest interface{}) error {
+	return nil
+}
+
+func (r *transactionPlanTestRunner) RunInTransaction(ctx context.Context, fn func(runner Runner) error) error {
+	var err error
+
+	r.queries = append(r.queries, "begin")
+
+	err = fn(r)
+	if err != nil {
+		r.queries = append(r.queries, "rollback")
+		return err
+	}
+
+	r.queries = append(r.queries, "commit")
+
+	return nil
+}
+
+func TestTransactionPlan_Execute(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Runner      Runner
+		Plan        *TransactionPlan
+		Expectation struct {
+			Queries []string
+			Err     error
+		}
+	} = []struct {
+		Name        string
+		Runner      Runner
+		Plan        *TransactionPlan
+		Expectation struct {
+			Queries []string
+			Err     error
+		}
+	}{
+		{
+			Name:   "runner is nil",
+			Runner: nil,
+			Plan:   NewTransactionPlan(Delete().From("table1")).ChunkBy(NewField("id"), []int{1, 2, 3}, 2),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     ErrRunnerIsRequired,
+			},
+		},
+		{
+			Name:   "plan is invalid",
+			Runner: &transactionPlanTestRunner{},
+			Plan:   NewTransactionPlan(Delete().From("table1")),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: nil,
+				Err:     ErrFieldIsRequired,
+			},
+		},
+		{
+			Name:   "all steps are succeed",
+			Runner: &transactionPlanTestRunner{},
+			Plan:   NewTransactionPlan(Delete().From("table1")).ChunkBy(NewField("id"), []int{1, 2, 3}, 2),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: []string{
+					"begin",
+					"delete from table1 where id in ($1, $2)",
+					"commit",
+					"begin",
+					"delete from table1 where id in ($1)",
+					"commit",
+				},
+				Err: nil,
+			},
+		},
+		{
+			Name:   "second step is error",
+			Runner: &transactionPlanTestRunner{failOn: "delete from table1 where id in ($1)"},
+			Plan:   NewTransactionPlan(Delete().From("table1")).ChunkBy(NewField("id"), []int{1, 2, 3}, 2),
+			Expectation: struct {
+				Queries []string
+				Err     error
+			}{
+				Queries: []string{
+					"begin",
+					"delete from table1 where id in ($1, $2)",
+					"commit",
+					"begin",
+					"delete from table1 where id in ($1)",
+					"rollback",
+				},
+				Err: errors.New("lock timeout"),
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQueries []string
+				actualErr     error
+			)
+
+			actualErr = testCases[i].Plan.Execute(context.Background(), testCases[i].Runner)
+
+			if runner, ok := testCases[i].Runner.(*transactionPlanTestRunner); ok {
+				actualQueries = runner.queries
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation.Err == nil && actualErr != nil {
+				t.Error("expectation error is nil, got not nil")
+			}
+
+			if testCases[i].Expectation.Err != nil && actualErr != nil && testCases[i].Expectation.Err.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Err.Error(), actualErr.Error())
+			}
+
+			if !deepEqual(testCases[i].Expectation.Queries, actualQueries) {
+				t.Errorf("expectation queries is %v, got %v", testCases[i].Expectation.Queries, actualQueries)
+			}
+		})
+	}
+}
+
+func TestTransactionPlan_ExecuteWithExecutor(t *testing.T) {
+	var (
+		db   *sql.DB
+		plan *TransactionPlan = NewTransactionPlan(Delete().From("table1")).ChunkBy(NewField("id"), []int{1, 2, 3}, 2)
+		err  error
+	)
+
+	db = openFakeDB(t, nil)
+
+	err = plan.Execute(context.Background(), NewExecutor(db, DialectPostgres))
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %s", err.Error())
+	}
+
+	if !deepEqual([]string{"begin", "delete from table1 where id in ($1, $2)", "commit", "begin", "delete from table1 where id in ($1)", "commit"}, fakeDriverInstance.queries) {
+		t.Errorf("expectation queries is %+v, got %+v", []string{"begin", "delete from table1 where id in ($1, $2)", "commit", "begin", "delete from table1 where id in ($1)", "commit"}, fakeDriverInstance.queries)
+	}
+}
+
+func TestConfig_TransactionSteps(t *testing.T) {
+	var (
+		config *Config = NewConfig(DialectPostgres).SetTablePrefix("app_")
+		actual []*TransactionStep
+		err    error
+	)
+
+	actual, err = config.TransactionSteps(NewTransactionPlan(Delete().From("table1")).ChunkBy(NewField("id"), []int{1, 2, 3}, 2))
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %s", err.Error())
+	}
+
+	if !deepEqual([]*TransactionStep{
+		{Savepoint: "chunk_1", Query: "delete from app_table1 where id in ($1, $2)", Args: []interface{}{1, 2}},
+		{Savepoint: "chunk_2", Query: "delete from app_table1 where id in ($1)", Args: []interface{}{3}},
+	}, actual) {
+		t.Errorf("expectation steps is %+v, got %+v", []*TransactionStep{
+			{Savepoint: "chunk_1", Query: "delete from app_table1 where id in ($1, $2)", Args: []interface{}{1, 2}},
+			{Savepoint: "chunk_2", Query: "delete from app_table1 where id in ($1)", Args: []interface{}{3}},
+		}, actual)
+	}
+}
+
+func TestExecutor_ExecTransactionPlan(t *testing.T) {
+	var (
+		db       *sql.DB
+		tx       *sql.Tx
+		executor *Executor
+		plan     *TransactionPlan = NewTransactionPlan(Delete().From("table1")).ChunkBy(NewField("id"), []int{1, 2, 3}, 2)
+		err      error
+	)
+
+	db = openFakeDB(t, nil)
+	executor = NewExecutor(db, DialectPostgres)
+
+	err = executor.ExecTransactionPlan(context.Background(), nil)
+	if err != ErrQueryIsRequired {
+		t.Errorf("expectation error is %v, got %v", ErrQueryIsRequired, err)
+	}
+
+	err = executor.ExecTransactionPlan(context.Background(), plan)
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %s", err.Error())
+	}
+
+	if !deepEqual([]string{"begin", "delete from table1 where id in ($1, $2)", "commit", "begin", "delete from table1 where id in ($1)", "commit"}, fakeDriverInstance.queries) {
+		t.Errorf("expectation queries is %+v, got %+v", []string{"begin", "delete from table1 where id in ($1, $2)", "commit", "begin", "delete from table1 where id in ($1)", "commit"}, fakeDriverInstance.queries)
+	}
+
+	db = openFakeDB(t, nil)
+
+	tx, err = db.Begin()
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %s", err.Error())
+	}
+
+	err = NewExecutor(db, DialectPostgres).WithTx(tx).ExecTransactionPlan(context.Background(), plan)
+	if err != nil {
+		t.Fatalf("expectation error is nil, got %s", err.Error())
+	}
+
+	if !deepEqual([]string{"begin", "savepoint chunk_1", "delete from table1 where id in ($1, $2)", "release savepoint chunk_1", "savepoint chunk_2", "delete from table1 where id in ($1)", "release savepoint chunk_2"}, fakeDriverInstance.queries) {
+		t.Errorf("expectation queries is %+v, got %+v", []string{"begin", "savepoint chunk_1", "delete from table1 where id in ($1, $2)", "release savepoint chunk_1", "savepoint chunk_2", "delete from table1 where id in ($1)", "release savepoint chunk_2"}, fakeDriverInstance.queries)
+	}
+
+	tx.Rollback()
+}
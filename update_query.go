@@ -1,14 +1,29 @@
 package goqube
 
 import (
+	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 )
 
+type UpdateRowSet struct {
+	Fields      []string
+	SelectQuery *SelectQuery
+}
+
 type UpdateQuery struct {
+	Database    string
 	Table       string
+	Fields      []string
 	FieldsValue map[string]interface{}
+	RowSets     []*UpdateRowSet
 	Filter      *Filter
+	Returnings  []*Field
+	ChangesTo   string
+	Comments    map[string]string
+	valuesErr   error
 }
 
 func Update(table string) *UpdateQuery {
@@ -18,77 +33,351 @@ func Update(table string) *UpdateQuery {
 	}
 }
 
+func (u *UpdateQuery) FromDatabase(database string) *UpdateQuery {
+	u.Database = database
+	return u
+}
+
 func (u *UpdateQuery) Set(field string, value interface{}) *UpdateQuery {
+	if _, ok := u.FieldsValue[field]; !ok {
+		u.Fields = append(u.Fields, field)
+	}
+
 	u.FieldsValue[field] = value
 	return u
 }
 
+func (u *UpdateQuery) getFields() []string {
+	var (
+		fields []string
+		rest   []string
+	)
+
+	fields = []string{}
+	for _, field := range u.Fields {
+		if _, ok := u.FieldsValue[field]; ok && !containsString(fields, field) {
+			fields = append(fields, field)
+		}
+	}
+
+	for field := range u.FieldsValue {
+		if !containsString(fields, field) {
+			rest = append(rest, field)
+		}
+	}
+
+	sort.Strings(rest)
+
+	return append(fields, rest...)
+}
+
+func (u *UpdateQuery) SetRow(selectQuery *SelectQuery, fields ...string) *UpdateQuery {
+	u.RowSets = append(u.RowSets, &UpdateRowSet{
+		Fields:      fields,
+		SelectQuery: selectQuery,
+	})
+	return u
+}
+
+func (u *UpdateQuery) TrackChanges(column string) *UpdateQuery {
+	u.ChangesTo = column
+	return u
+}
+
 func (u *UpdateQuery) Where(filter *Filter) *UpdateQuery {
 	u.Filter = filter
 	return u
 }
 
+func (u *UpdateQuery) Returning(fields ...*Field) *UpdateQuery {
+	u.Returnings = fields
+	return u
+}
+
+func (u *UpdateQuery) validateRowSets() error {
+	var fields []string
+
+	for i := range u.RowSets {
+		if u.RowSets[i] == nil {
+			return ErrFieldsIsRequired
+		}
+
+		if len(u.RowSets[i].Fields) == 0 {
+			return ErrFieldsIsRequired
+		}
+
+		if u.RowSets[i].SelectQuery == nil {
+			return ErrQueryIsRequired
+		}
+
+		if len(u.RowSets[i].SelectQuery.Fields) != len(u.RowSets[i].Fields) {
+			return ErrValueLengthIsNotEqualToFieldsLength
+		}
+
+		for _, field := range u.RowSets[i].Fields {
+			if field == "" {
+				return ErrFieldIsRequired
+			}
+
+			if _, ok := u.FieldsValue[field]; ok || containsString(fields, field) {
+				return ErrFieldIsDuplicated
+			}
+
+			fields = append(fields, field)
+		}
+	}
+
+	return nil
+}
+
 func (u *UpdateQuery) validate(dialect Dialect) error {
+	var err error
+
 	if dialect == "" {
 		return ErrDialectIsRequired
 	}
 
+	err = validateComments(u.Comments)
+	if err != nil {
+		return err
+	}
+
 	if u.Table == "" {
 		return ErrTableIsRequired
 	}
 
-	if len(u.FieldsValue) == 0 {
+	if u.Database != "" && !isValidIdentifier(u.Database) {
+		return ErrIdentifierIsInvalid
+	}
+
+	if u.valuesErr != nil {
+		return u.valuesErr
+	}
+
+	if len(u.FieldsValue) == 0 && len(u.RowSets) == 0 {
 		return ErrFieldsIsRequired
 	}
 
-	for field := range u.FieldsValue {
+	for fieldIndex := range u.Fields {
+		if containsString(u.Fields[:fieldIndex], u.Fields[fieldIndex]) {
+			return ErrFieldIsDuplicated
+		}
+
+		if _, ok := u.FieldsValue[u.Fields[fieldIndex]]; !ok {
+			return ErrValueIsRequired
+		}
+	}
+
+	for field, value := range u.FieldsValue {
 		if field == "" {
 			return ErrFieldIsRequired
 		}
+
+		err = validateValueKind(value, "")
+		if err != nil {
+			return err
+		}
+
+		switch v := value.(type) {
+		case *Field:
+			if v == nil {
+				return ErrFieldIsNil
+			}
+		case *Raw:
+			if v == nil {
+				return ErrSQLIsRequired
+			}
+		case *SelectQuery:
+			if v == nil {
+				return ErrQueryIsRequired
+			}
+		}
+	}
+
+	err = u.validateRowSets()
+	if err != nil {
+		return err
+	}
+
+	if u.ChangesTo != "" {
+		if _, ok := u.FieldsValue[u.ChangesTo]; ok {
+			return ErrFieldIsDuplicated
+		}
+
+		for i := range u.RowSets {
+			if containsString(u.RowSets[i].Fields, u.ChangesTo) {
+				return ErrFieldIsDuplicated
+			}
+		}
 	}
 
 	if u.Filter == nil {
 		return ErrFilterIsRequired
 	}
 
-	return nil
+	return validateReturning(dialect, u.Returnings)
 }
 
-func (u *UpdateQuery) ToSQLWithArgs(dialect Dialect) (string, []interface{}, error) {
+func (u *UpdateQuery) valueToSQLWithArgs(bc *buildContext, field string, value interface{}, args []interface{}) (string, []interface{}, error) {
 	var (
-		query        string
-		args         []interface{}
-		placeholders []string
-		whereClause  string
-		err          error
+		expression string
+		err        error
 	)
 
-	err = u.validate(dialect)
+	if literal, ok := literalValue(value); ok {
+		return literal, args, nil
+	}
+
+	switch v := value.(type) {
+	case *Field:
+		return v.toSQLWithArgs(bc, args)
+	case *Raw:
+		return v.toSQLWithArgs(bc, args)
+	case *Expression:
+		return v.toSQLWithArgs(bc, args)
+	case *SelectQuery:
+		expression, args, err = v.toSQLWithArgs(bc, args)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return fmt.Sprintf("(%s)", expression), args, nil
+	}
+
+	return bc.sensitiveValueWithPlaceholder(u.Table, field, value, args)
+}
+
+func (u *UpdateQuery) rowSetToSQLWithArgs(bc *buildContext, rowSet *UpdateRowSet, args []interface{}) ([]string, []interface{}, error) {
+	var (
+		assignments    []string
+		physicalFields []string
+		subquery       string
+		err            error
+	)
+
+	for _, field := range rowSet.Fields {
+		var skip bool
+
+		physicalFields = append(physicalFields, bc.tableColumn(u.Table, field))
+
+		skip, err = bc.skipGeneratedColumn(u.Table, field)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if skip {
+			return nil, nil, fmt.Errorf(errGeneratedColumnf, ErrGeneratedColumn, u.Table, field)
+		}
+	}
+
+	if bc.dialect == DialectPostgres {
+		subquery, args, err = rowSet.SelectQuery.toSQLWithArgs(bc, args)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		assignments = append(assignments, fmt.Sprintf("(%s) = (%s)", strings.Join(physicalFields, ", "), subquery))
+
+		return assignments, args, nil
+	}
+
+	for i, field := range physicalFields {
+		var scalarQuery SelectQuery = *rowSet.SelectQuery
+
+		scalarQuery.Fields = []*Field{rowSet.SelectQuery.Fields[i]}
+
+		subquery, args, err = scalarQuery.toSQLWithArgs(bc, args)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		assignments = append(assignments, fmt.Sprintf("%s = (%s)", field, subquery))
+	}
+
+	return assignments, args, nil
+}
+
+func (u *UpdateQuery) toSQLWithArgs(bc *buildContext, args []interface{}) (string, []interface{}, error) {
+	var (
+		query         string
+		placeholders  []string
+		changedFields []string
+		whereClause   string
+		returning     string
+		err           error
+	)
+
+	err = u.validate(bc.dialect)
 	if err != nil {
 		return "", nil, err
 	}
 
-	query = fmt.Sprintf("update %s", u.Table)
+	bc.pushTableScope(u.Table)
+	defer bc.popScope()
+
+	query = fmt.Sprintf("update %s", bc.qualifiedTableName(u.Database, u.Table))
 	placeholders = []string{}
+	changedFields = []string{}
 
-	for field, value := range u.FieldsValue {
+	for _, field := range u.getFields() {
 		var (
-			placeholderStartIdx int
-			placeholderEndIdx   int
-			placeholder         string
+			value       interface{} = u.FieldsValue[field]
+			skip        bool
+			placeholder string
 		)
 
-		args = append(args, value)
-		placeholderStartIdx = len(args)
-		placeholderEndIdx = len(args)
-		placeholder = fmt.Sprintf("%s = %s", field, getPlaceholder(dialect, placeholderStartIdx, placeholderEndIdx))
-		placeholders = append(placeholders, placeholder)
+		skip, err = bc.skipGeneratedColumn(u.Table, field)
+		if err != nil {
+			return "", nil, err
+		}
+
+		if skip {
+			continue
+		}
+
+		placeholder, args, err = u.valueToSQLWithArgs(bc, field, value, args)
+		if err != nil {
+			return "", nil, err
+		}
+
+		placeholders = append(placeholders, fmt.Sprintf("%s = %s", bc.tableColumn(u.Table, field), placeholder))
+		changedFields = append(changedFields, field)
+	}
+
+	for i := range u.RowSets {
+		var rowSet []string
+
+		rowSet, args, err = u.rowSetToSQLWithArgs(bc, u.RowSets[i], args)
+		if err != nil {
+			return "", nil, err
+		}
+
+		placeholders = append(placeholders, rowSet...)
+		changedFields = append(changedFields, u.RowSets[i].Fields...)
+	}
+
+	if len(placeholders) == 0 {
+		return "", nil, ErrFieldsIsRequired
+	}
+
+	if u.ChangesTo != "" {
+		var changedFieldsJSON []byte
+
+		changedFieldsJSON, err = json.Marshal(changedFields)
+		if err != nil {
+			return "", nil, err
+		}
+
+		args = append(args, string(changedFieldsJSON))
+		placeholders = append(placeholders, fmt.Sprintf("%s = %s", bc.tableColumn(u.Table, u.ChangesTo), getPlaceholder(bc.dialect, len(args), len(args))))
 	}
 
 	query = fmt.Sprintf("%s set %s", query, strings.Join(placeholders, ", "))
 
 	if u.Filter != nil {
-		whereClause, args, err = u.Filter.ToSQLWithArgs(dialect, args)
+		var traceStart time.Time = bc.startTrace()
+		whereClause, args, err = u.Filter.toRootSQLWithArgs(bc, args)
+		bc.endTrace(buildStageFilter, traceStart)
 		if err != nil {
 			return "", nil, err
 		}
@@ -98,5 +387,16 @@ func (u *UpdateQuery) ToSQLWithArgs(dialect Dialect) (string, []interface{}, err
 		}
 	}
 
+	returning, args, err = returningToSQLWithArgs(bc, u.Returnings, args)
+	if err != nil {
+		return "", nil, err
+	}
+
+	query = fmt.Sprintf("%s%s%s", query, returning, commentSQL(u.Comments))
+
 	return query, args, nil
 }
+
+func (u *UpdateQuery) ToSQLWithArgs(dialect Dialect) (string, []interface{}, error) {
+	return buildStatement(dialect, u, nil)
+}
@@ -1,7 +1,9 @@
 package goqube
 
 import (
+	"errors"
 	"fmt"
+	"reflect"
 	"testing"
 )
 
@@ -22,6 +24,10 @@ func testUpdateQuery_UpdateQueryEquality(t *testing.T, expectation, actual *Upda
 		t.Errorf("expected table is %s, got %s", expectation.Table, actual.Table)
 	}
 
+	if !deepEqual(expectation.Fields, actual.Fields) {
+		t.Errorf("expected fields is %v, got %v", expectation.Fields, actual.Fields)
+	}
+
 	if len(expectation.FieldsValue) != len(actual.FieldsValue) {
 		t.Errorf("expected length of fields value is %d, got %d", len(expectation.FieldsValue), len(actual.FieldsValue))
 	}
@@ -34,9 +40,17 @@ func testUpdateQuery_UpdateQueryEquality(t *testing.T, expectation, actual *Upda
 		}
 	}
 
+	if !deepEqual(expectation.RowSets, actual.RowSets) {
+		t.Errorf("expectation row sets is %v, got %v", expectation.RowSets, actual.RowSets)
+	}
+
 	if !deepEqual(expectation.Filter, actual.Filter) {
 		t.Errorf("expectation filter is %v, got %v", expectation.Filter, actual.Filter)
 	}
+
+	if !deepEqual(expectation.Returnings, actual.Returnings) {
+		t.Errorf("expectation returnings is %v, got %v", expectation.Returnings, actual.Returnings)
+	}
 }
 
 func TestUpdateQuery_Update(t *testing.T) {
@@ -61,7 +75,8 @@ func TestUpdateQuery_Set(t *testing.T) {
 	)
 
 	expectation = &UpdateQuery{
-		Table: "table1",
+		Table:  "table1",
+		Fields: []string{"field1", "field2"},
 		FieldsValue: map[string]interface{}{
 			"field1": "value1",
 			"field2": 2,
@@ -75,6 +90,31 @@ func TestUpdateQuery_Set(t *testing.T) {
 	testUpdateQuery_UpdateQueryEquality(t, expectation, actual)
 }
 
+func TestUpdateQuery_SetRow(t *testing.T) {
+	var (
+		selectQuery *SelectQuery
+		expectation *UpdateQuery
+		actual      *UpdateQuery
+	)
+
+	selectQuery = Select(NewField("name"), NewField("email")).From(NewTable("table2"))
+
+	expectation = &UpdateQuery{
+		Table:       "table1",
+		FieldsValue: map[string]interface{}{},
+		RowSets: []*UpdateRowSet{
+			{
+				Fields:      []string{"field1", "field2"},
+				SelectQuery: selectQuery,
+			},
+		},
+	}
+
+	actual = Update("table1").SetRow(selectQuery, "field1", "field2")
+
+	testUpdateQuery_UpdateQueryEquality(t, expectation, actual)
+}
+
 func TestUpdateQuery_Where(t *testing.T) {
 	var (
 		expectation *UpdateQuery
@@ -82,7 +122,8 @@ func TestUpdateQuery_Where(t *testing.T) {
 	)
 
 	expectation = &UpdateQuery{
-		Table: "table1",
+		Table:  "table1",
+		Fields: []string{"field1", "field2"},
 		FieldsValue: map[string]interface{}{
 			"field1": "value1",
 			"field2": 2,
@@ -115,6 +156,40 @@ func TestUpdateQuery_Where(t *testing.T) {
 	testUpdateQuery_UpdateQueryEquality(t, expectation, actual)
 }
 
+func TestUpdateQuery_TrackChanges(t *testing.T) {
+	var (
+		expectation *UpdateQuery
+		actual      *UpdateQuery
+	)
+
+	expectation = &UpdateQuery{
+		Table:       "table1",
+		FieldsValue: map[string]interface{}{},
+		ChangesTo:   "changed_fields",
+	}
+	actual = Update("table1").TrackChanges("changed_fields")
+
+	if !deepEqual(expectation, actual) {
+		t.Errorf("expectation update query is %+v, got %+v", expectation, actual)
+	}
+}
+
+func TestUpdateQuery_Returning(t *testing.T) {
+	var (
+		expectation *UpdateQuery
+		actual      *UpdateQuery
+	)
+
+	expectation = &UpdateQuery{
+		Table:       "table1",
+		FieldsValue: map[string]interface{}{},
+		Returnings:  []*Field{NewField("id")},
+	}
+	actual = Update("table1").Returning(NewField("id"))
+
+	testUpdateQuery_UpdateQueryEquality(t, expectation, actual)
+}
+
 func TestUpdateQuery_validate(t *testing.T) {
 	var testCases []struct {
 		Name        string
@@ -159,6 +234,85 @@ func TestUpdateQuery_validate(t *testing.T) {
 			},
 			Expectation: ErrFieldIsRequired,
 		},
+		{
+			Name:    "value kind is unsupported",
+			Dialect: DialectPostgres,
+			UpdateQuery: &UpdateQuery{
+				Table: "table1",
+				FieldsValue: map[string]interface{}{
+					"field1": func() {},
+				},
+			},
+			Expectation: &UnsupportedValueTypeError{Kind: reflect.Func},
+		},
+		{
+			Name:    "fields is duplicated",
+			Dialect: DialectPostgres,
+			UpdateQuery: &UpdateQuery{
+				Table:       "table1",
+				Fields:      []string{"field1", "field1"},
+				FieldsValue: map[string]interface{}{"field1": "value1"},
+			},
+			Expectation: ErrFieldIsDuplicated,
+		},
+		{
+			Name:    "fields value is missing",
+			Dialect: DialectPostgres,
+			UpdateQuery: &UpdateQuery{
+				Table:       "table1",
+				Fields:      []string{"field1", "field2"},
+				FieldsValue: map[string]interface{}{"field1": "value1"},
+			},
+			Expectation: ErrValueIsRequired,
+		},
+		{
+			Name:        "field value is nil field",
+			Dialect:     DialectPostgres,
+			UpdateQuery: Update("table1").Set("field1", (*Field)(nil)),
+			Expectation: ErrFieldIsNil,
+		},
+		{
+			Name:        "field value is nil raw",
+			Dialect:     DialectPostgres,
+			UpdateQuery: Update("table1").Set("field1", (*Raw)(nil)),
+			Expectation: ErrSQLIsRequired,
+		},
+		{
+			Name:        "field value is nil select query",
+			Dialect:     DialectPostgres,
+			UpdateQuery: Update("table1").Set("field1", (*SelectQuery)(nil)),
+			Expectation: ErrQueryIsRequired,
+		},
+		{
+			Name:        "row set fields is empty",
+			Dialect:     DialectPostgres,
+			UpdateQuery: Update("table1").SetRow(Select(NewField("name")).From(NewTable("table2"))),
+			Expectation: ErrFieldsIsRequired,
+		},
+		{
+			Name:        "row set select query is nil",
+			Dialect:     DialectPostgres,
+			UpdateQuery: Update("table1").SetRow(nil, "field1"),
+			Expectation: ErrQueryIsRequired,
+		},
+		{
+			Name:        "row set fields length is not equal to select query fields length",
+			Dialect:     DialectPostgres,
+			UpdateQuery: Update("table1").SetRow(Select(NewField("name")).From(NewTable("table2")), "field1", "field2"),
+			Expectation: ErrValueLengthIsNotEqualToFieldsLength,
+		},
+		{
+			Name:        "row set field is empty",
+			Dialect:     DialectPostgres,
+			UpdateQuery: Update("table1").SetRow(Select(NewField("name")).From(NewTable("table2")), ""),
+			Expectation: ErrFieldIsRequired,
+		},
+		{
+			Name:        "row set field is duplicated",
+			Dialect:     DialectPostgres,
+			UpdateQuery: Update("table1").Set("field1", "value1").SetRow(Select(NewField("name")).From(NewTable("table2")), "field1"),
+			Expectation: ErrFieldIsDuplicated,
+		},
 		{
 			Name:    "filter is empty",
 			Dialect: DialectPostgres,
@@ -246,6 +400,48 @@ func TestUpdateQuery_ToSQLWithArgs(t *testing.T) {
 				Err:   ErrTableIsRequired,
 			},
 		},
+		{
+			Name:        "track changes column is also set",
+			UpdateQuery: Update("table1").Set("field1", "value1").Set("changed_fields", "[]").TrackChanges("changed_fields").Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Dialect:     DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrFieldIsDuplicated,
+			},
+		},
+		{
+			Name:        fmt.Sprintf("update with dialect %s with track changes", DialectPostgres),
+			UpdateQuery: Update("table1").Set("field2", "value2").Set("field1", NullValue).TrackChanges("changed_fields").Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Dialect:     DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update table1 set field2 = $1, field1 = null, changed_fields = $2 where id = $3",
+				Args:  []interface{}{"value2", `["field2","field1"]`, 1},
+				Err:   nil,
+			},
+		},
+		{
+			Name:        fmt.Sprintf("update with dialect %s with track changes and row set", DialectMySQL),
+			UpdateQuery: Update("table1").Set("field1", "value1").SetRow(Select(NewField("field2"), NewField("field3")).From(NewTable("table2")), "field2", "field3").TrackChanges("changed_fields").Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Dialect:     DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update table1 set field1 = ?, field2 = (select field2 from table2), field3 = (select field3 from table2), changed_fields = ? where id = ?",
+				Args:  []interface{}{"value1", `["field1","field2","field3"]`, 1},
+				Err:   nil,
+			},
+		},
 
 		{
 			Name: fmt.Sprintf("update with dialect %s with filter is not nil and filter to sql with args is error", DialectPostgres),
@@ -299,6 +495,181 @@ func TestUpdateQuery_ToSQLWithArgs(t *testing.T) {
 				Err:   nil,
 			},
 		},
+		{
+			Name: fmt.Sprintf("update with dialect %s with row set", DialectPostgres),
+			UpdateQuery: Update("table1").
+				SetRow(
+					Select(NewField("name"), NewField("email")).
+						From(NewTable("table2")).
+						Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(7))),
+					"field1",
+					"field2",
+				).
+				Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update table1 set (field1, field2) = (select name, email from table2 where id = $1) where id = $2",
+				Args:  []interface{}{7, 1},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("update with dialect %s with row set", DialectMySQL),
+			UpdateQuery: Update("table1").
+				SetRow(
+					Select(NewField("name"), NewField("email")).
+						From(NewTable("table2")).
+						Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(7))),
+					"field1",
+					"field2",
+				).
+				Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update table1 set field1 = (select name from table2 where id = ?), field2 = (select email from table2 where id = ?) where id = ?",
+				Args:  []interface{}{7, 7, 1},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("update with dialect %s with row set select query is invalid", DialectPostgres),
+			UpdateQuery: Update("table1").
+				SetRow(Select(NewField("name")), "field1").
+				Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrTableIsRequired,
+			},
+		},
+		{
+			Name:        fmt.Sprintf("update with dialect %s with raw expression value", DialectPostgres),
+			UpdateQuery: Update("table1").Set("counter", NewRaw("counter + ?", 2)).Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Dialect:     DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update table1 set counter = counter + $1 where id = $2",
+				Args:  []interface{}{2, 1},
+				Err:   nil,
+			},
+		},
+		{
+			Name:        fmt.Sprintf("update with dialect %s with column value", DialectMySQL),
+			UpdateQuery: Update("table1").Set("previous_status", NewField("status").FromTable("table1")).Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Dialect:     DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update table1 set previous_status = table1.status where id = ?",
+				Args:  []interface{}{1},
+				Err:   nil,
+			},
+		},
+		{
+			Name:        fmt.Sprintf("update with dialect %s with subquery value", DialectPostgres),
+			UpdateQuery: Update("table1").Set("total", Select(NewField("count(*)")).From(NewTable("table2")).Where(NewFilter().SetCondition(NewField("status"), OperatorEqual, NewFilterValue("done")))).Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Dialect:     DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update table1 set total = (select count(*) from table2 where status = $1) where id = $2",
+				Args:  []interface{}{"done", 1},
+				Err:   nil,
+			},
+		},
+		{
+			Name:        fmt.Sprintf("update with dialect %s with invalid expression value", DialectPostgres),
+			UpdateQuery: Update("table1").Set("counter", NewRaw("counter + ?")).Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Dialect:     DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   fmt.Errorf(errPlaceholderCountf, ErrPlaceholderCountMismatch, 1, 0),
+			},
+		},
+		{
+			Name: fmt.Sprintf("update with dialect %s with ordered fields", DialectPostgres),
+			UpdateQuery: Update("table1").
+				Set("field3", 3).
+				Set("field1", NewRaw("now()")).
+				Set("field2", "value2").
+				Set("field3", 4).
+				Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update table1 set field3 = $1, field1 = now(), field2 = $2 where id = $3",
+				Args:  []interface{}{4, "value2", 1},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("update with dialect %s with fields value without fields", DialectMySQL),
+			UpdateQuery: &UpdateQuery{
+				Table: "table1",
+				FieldsValue: map[string]interface{}{
+					"field2": 2,
+					"field1": 1,
+					"field3": 3,
+				},
+				Filter: NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1)),
+			},
+			Dialect: DialectMySQL,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update table1 set field1 = ?, field2 = ?, field3 = ? where id = ?",
+				Args:  []interface{}{1, 2, 3, 1},
+				Err:   nil,
+			},
+		},
+		{
+			Name: fmt.Sprintf("update with dialect %s and explicit null and default values", DialectPostgres),
+			UpdateQuery: Update("table1").
+				Set("field1", NullValue).
+				Set("field2", DefaultValue).
+				Set("field3", nil).
+				Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))),
+			Dialect: DialectPostgres,
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update table1 set field1 = null, field2 = default, field3 = $1 where id = $2",
+				Args:  []interface{}{nil, 1},
+				Err:   nil,
+			},
+		},
 	}
 
 	for i := 0; i < len(testCases); i++ {
@@ -339,3 +710,93 @@ func TestUpdateQuery_ToSQLWithArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateQuery_FromDatabase(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Query       Query
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Query       Query
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:    "database is invalid",
+			Dialect: DialectPostgres,
+			Query:   Update("AuditLogs").FromDatabase("archive; drop").Set("user_id", 1).Where(Eq("id", 2)),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   ErrIdentifierIsInvalid,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s", DialectPostgres),
+			Dialect: DialectPostgres,
+			Query:   Update("AuditLogs").FromDatabase("archive").Set("user_id", 1).Where(Eq("id", 2)),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update archive.app_audit_logs set user_id = $1 where id = $2",
+				Args:  []interface{}{1, 2},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    fmt.Sprintf("dialect %s", DialectMySQL),
+			Dialect: DialectMySQL,
+			Query:   Update("AuditLogs").FromDatabase("archive").Set("user_id", 1).Where(Eq("id", 2)),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update archive.app_audit_logs set user_id = ? where id = ?",
+				Args:  []interface{}{1, 2},
+				Err:   nil,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				config      *Config = NewConfig(testCases[i].Dialect).SetTablePrefix("app_").SetNameMapper(NewNameMapper().MapTables(SnakeCase))
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = config.Build(testCases[i].Query)
+
+			if !errors.Is(actualErr, testCases[i].Expectation.Err) {
+				t.Errorf("expectation error is %v, got %v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %v, got %v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
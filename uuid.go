@@ -0,0 +1,104 @@
+package goqube
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+)
+
+type UUIDValue string
+
+func UUID(value string) UUIDValue {
+	return UUIDValue(value)
+}
+
+func (u UUIDValue) Value() (driver.Value, error) {
+	return string(u), nil
+}
+
+type ULIDValue string
+
+func (u ULIDValue) Value() (driver.Value, error) {
+	return string(u), nil
+}
+
+func identifierBytes(reflectValue reflect.Value) []byte {
+	var bytes []byte = make([]byte, reflectValue.Len())
+
+	for i := range bytes {
+		bytes[i] = byte(reflectValue.Index(i).Uint())
+	}
+
+	return bytes
+}
+
+func formatUUID(bytes []byte) string {
+	var encoded string = hex.EncodeToString(bytes)
+
+	return encoded[0:8] + "-" + encoded[8:12] + "-" + encoded[12:16] + "-" + encoded[16:20] + "-" + encoded[20:32]
+}
+
+func formatULID(bytes []byte) string {
+	var (
+		encoded []byte = make([]byte, ulidEncodedLength)
+		bits    uint
+		buffer  uint32
+		index   int = ulidEncodedLength - 1
+	)
+
+	for i := len(bytes) - 1; i >= 0; i-- {
+		buffer |= uint32(bytes[i]) << bits
+		bits += 8
+
+		for bits >= 5 && index >= 0 {
+			encoded[index] = ulidAlphabet[buffer&0x1f]
+			buffer >>= 5
+			bits -= 5
+			index--
+		}
+	}
+
+	for index >= 0 {
+		encoded[index] = ulidAlphabet[buffer&0x1f]
+		buffer >>= 5
+		index--
+	}
+
+	return string(encoded)
+}
+
+func adaptIdentifierValue(value interface{}) (interface{}, bool) {
+	var reflectValue reflect.Value = reflect.ValueOf(value)
+
+	if reflectValue.Kind() == reflect.Ptr && !reflectValue.IsNil() {
+		reflectValue = reflectValue.Elem()
+	}
+
+	if reflectValue.Kind() != reflect.Array || reflectValue.Len() != identifierByteLength || reflectValue.Type().Elem().Kind() != reflect.Uint8 {
+		return nil, false
+	}
+
+	switch reflectValue.Type().Name() {
+	case uuidTypeName:
+		return UUIDValue(formatUUID(identifierBytes(reflectValue))), true
+	case ulidTypeName:
+		if stringer, ok := reflectValue.Interface().(fmt.Stringer); ok {
+			return ULIDValue(stringer.String()), true
+		}
+
+		return ULIDValue(formatULID(identifierBytes(reflectValue))), true
+	}
+
+	return nil, false
+}
+
+func valuePlaceholder(dialect Dialect, value interface{}, index int) string {
+	var placeholder string = getPlaceholder(dialect, index, index)
+
+	if _, ok := value.(UUIDValue); ok && dialect == DialectPostgres {
+		return placeholder + postgresUUIDCast
+	}
+
+	return placeholder
+}
@@ -0,0 +1,292 @@
+package goqube
+
+import (
+	"testing"
+)
+
+func TestUUID_UUID(t *testing.T) {
+	var (
+		expectation UUIDValue = UUIDValue("123e4567-e89b-12d3-a456-426614174000")
+		actual      UUIDValue = UUID("123e4567-e89b-12d3-a456-426614174000")
+	)
+
+	if expectation != actual {
+		t.Errorf("expectation uuid is %s, got %s", expectation, actual)
+	}
+}
+
+func TestUUID_formatUUID(t *testing.T) {
+	var (
+		expectation string = "123e4567-e89b-12d3-a456-426614174000"
+		actual      string = formatUUID([]byte{0x12, 0x3e, 0x45, 0x67, 0xe8, 0x9b, 0x12, 0xd3, 0xa4, 0x56, 0x42, 0x66, 0x14, 0x17, 0x40, 0x00})
+	)
+
+	if expectation != actual {
+		t.Errorf("expectation uuid is %s, got %s", expectation, actual)
+	}
+}
+
+func TestUUID_formatULID(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Bytes       []byte
+		Expectation string
+	} = []struct {
+		Name        string
+		Bytes       []byte
+		Expectation string
+	}{
+		{
+			Name:        "zero",
+			Bytes:       make([]byte, 16),
+			Expectation: "00000000000000000000000000",
+		},
+		{
+			Name:        "max",
+			Bytes:       []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			Expectation: "7ZZZZZZZZZZZZZZZZZZZZZZZZZ",
+		},
+		{
+			Name:        "one",
+			Bytes:       []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1},
+			Expectation: "00000000000000000000000001",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = formatULID(testCases[i].Bytes)
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation ulid is %s, got %s", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestUUID_adaptIdentifierValue(t *testing.T) {
+	type UUID [16]byte
+	type ULID [16]byte
+	type Hash [16]byte
+
+	var (
+		uuid      UUID = UUID{0x12, 0x3e, 0x45, 0x67, 0xe8, 0x9b, 0x12, 0xd3, 0xa4, 0x56, 0x42, 0x66, 0x14, 0x17, 0x40, 0x00}
+		nilUUID   *UUID
+		testCases []struct {
+			Name        string
+			Value       interface{}
+			Expectation struct {
+				Value interface{}
+				OK    bool
+			}
+		}
+	)
+
+	testCases = []struct {
+		Name        string
+		Value       interface{}
+		Expectation struct {
+			Value interface{}
+			OK    bool
+		}
+	}{
+		{
+			Name:  "uuid",
+			Value: uuid,
+			Expectation: struct {
+				Value interface{}
+				OK    bool
+			}{
+				Value: UUIDValue("123e4567-e89b-12d3-a456-426614174000"),
+				OK:    true,
+			},
+		},
+		{
+			Name:  "uuid pointer",
+			Value: &uuid,
+			Expectation: struct {
+				Value interface{}
+				OK    bool
+			}{
+				Value: UUIDValue("123e4567-e89b-12d3-a456-426614174000"),
+				OK:    true,
+			},
+		},
+		{
+			Name:  "nil uuid pointer",
+			Value: nilUUID,
+			Expectation: struct {
+				Value interface{}
+				OK    bool
+			}{
+				Value: nil,
+				OK:    false,
+			},
+		},
+		{
+			Name:  "ulid",
+			Value: ULID{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1},
+			Expectation: struct {
+				Value interface{}
+				OK    bool
+			}{
+				Value: ULIDValue("00000000000000000000000001"),
+				OK:    true,
+			},
+		},
+		{
+			Name:  "other 16 byte array",
+			Value: Hash{},
+			Expectation: struct {
+				Value interface{}
+				OK    bool
+			}{
+				Value: nil,
+				OK:    false,
+			},
+		},
+		{
+			Name:  "string",
+			Value: "123e4567-e89b-12d3-a456-426614174000",
+			Expectation: struct {
+				Value interface{}
+				OK    bool
+			}{
+				Value: nil,
+				OK:    false,
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualValue interface{}
+				actualOK    bool
+			)
+
+			actualValue, actualOK = adaptIdentifierValue(testCases[i].Value)
+
+			if testCases[i].Expectation.OK != actualOK {
+				t.Errorf("expectation ok is %t, got %t", testCases[i].Expectation.OK, actualOK)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Value, actualValue) {
+				t.Errorf("expectation value is %v, got %v", testCases[i].Expectation.Value, actualValue)
+			}
+		})
+	}
+}
+
+func TestUUID_ToSQLWithArgs(t *testing.T) {
+	type UUID [16]byte
+
+	var (
+		uuid      UUID      = UUID{0x12, 0x3e, 0x45, 0x67, 0xe8, 0x9b, 0x12, 0xd3, 0xa4, 0x56, 0x42, 0x66, 0x14, 0x17, 0x40, 0x00}
+		uuidValue UUIDValue = UUIDValue("123e4567-e89b-12d3-a456-426614174000")
+		testCases []struct {
+			Name        string
+			Config      *Config
+			Query       Query
+			Expectation struct {
+				Query string
+				Args  []interface{}
+			}
+		}
+	)
+
+	testCases = []struct {
+		Name        string
+		Config      *Config
+		Query       Query
+		Expectation struct {
+			Query string
+			Args  []interface{}
+		}
+	}{
+		{
+			Name:   "select query with dialect postgres",
+			Config: NewConfig(DialectPostgres).SetStrictArgs(true),
+			Query:  Select(NewField("id")).From(NewTable("users")).Where(And(Eq("id", uuid), In("tenant_id", []UUID{uuid}))).Limit(1),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+			}{
+				Query: "select id from users where id = $1::uuid and tenant_id in ($2) limit $3",
+				Args:  []interface{}{uuidValue, uuidValue, 1},
+			},
+		},
+		{
+			Name:   "select query with dialect mysql",
+			Config: NewConfig(DialectMySQL),
+			Query:  Select(NewField("id")).From(NewTable("users")).Where(Eq("id", &uuid)).Limit(1),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+			}{
+				Query: "select id from users where id = ? limit ?",
+				Args:  []interface{}{uuidValue, 1},
+			},
+		},
+		{
+			Name:   "select query with uuid string",
+			Config: NewConfig(DialectPostgres),
+			Query:  Select(NewField("id")).From(NewTable("users")).Where(Eq("id", UUIDValue("123e4567-e89b-12d3-a456-426614174000"))).Limit(1),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+			}{
+				Query: "select id from users where id = $1::uuid limit $2",
+				Args:  []interface{}{uuidValue, 1},
+			},
+		},
+		{
+			Name:   "insert query with dialect postgres",
+			Config: NewConfig(DialectPostgres),
+			Query:  InsertInto("users").Value("id", uuid).Value("name", "john"),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+			}{
+				Query: "insert into users(id, name) values ($1::uuid, $2)",
+				Args:  []interface{}{uuidValue, "john"},
+			},
+		},
+		{
+			Name:   "update query with dialect mysql",
+			Config: NewConfig(DialectMySQL),
+			Query:  Update("users").Set("owner_id", uuid).Where(Eq("id", uuid)),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+			}{
+				Query: "update users set owner_id = ? where id = ?",
+				Args:  []interface{}{uuidValue, uuidValue},
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Config.Build(testCases[i].Query)
+
+			if actualErr != nil {
+				t.Errorf("expectation error is nil, got %s", actualErr.Error())
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %v, got %v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
@@ -0,0 +1,373 @@
+package goqube
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type ValidationError struct {
+	Path string
+	Err  error
+}
+
+func (e *ValidationError) Error() string {
+	if e.Path == "" {
+		return e.Err.Error()
+	}
+
+	return fmt.Sprintf(errValidationf, e.Path, e.Err.Error())
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	var messages []string
+
+	for i := range e {
+		messages = append(messages, e[i].Error())
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+func (e ValidationErrors) Is(target error) bool {
+	for i := range e {
+		if errors.Is(e[i], target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type validator struct {
+	dialect Dialect
+	errs    ValidationErrors
+}
+
+func validationPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return fmt.Sprintf("%s.%s", path, name)
+}
+
+func validateAll(dialect Dialect, validate func(v *validator)) error {
+	var v *validator = &validator{dialect: dialect}
+
+	if dialect == "" {
+		return ValidationErrors{{Err: ErrDialectIsRequired}}
+	}
+
+	validate(v)
+
+	if len(v.errs) == 0 {
+		return nil
+	}
+
+	return v.errs
+}
+
+func (v *validator) check(path string, err error) {
+	if pathErr, ok := err.(*PathError); ok {
+		path = validationPath(path, pathErr.Path)
+		err = pathErr.Err
+	}
+
+	if err != nil {
+		v.errs = append(v.errs, &ValidationError{Path: path, Err: err})
+	}
+}
+
+func (v *validator) query(path string, query Query) {
+	switch q := query.(type) {
+	case *SelectQuery:
+		v.selectQuery(path, q)
+	case *CompoundQuery:
+		v.compoundQuery(path, q)
+	case *InsertQuery:
+		v.insertQuery(path, q)
+	case *UpdateQuery:
+		v.updateQuery(path, q)
+	case *DeleteQuery:
+		v.deleteQuery(path, q)
+	case *Raw:
+		v.raw(path, q)
+	}
+}
+
+func (v *validator) selectQuery(path string, selectQuery *SelectQuery) {
+	v.check(path, selectQuery.validate(v.dialect))
+
+	v.fields(validationPath(path, "Fields"), selectQuery.Fields)
+
+	if selectQuery.Table != nil {
+		v.table(validationPath(path, "Table"), selectQuery.Table)
+	}
+
+	for i := range selectQuery.Joins {
+		if selectQuery.Joins[i] != nil {
+			v.join(validationPath(path, fmt.Sprintf("Joins[%d]", i)), selectQuery.Joins[i])
+		}
+	}
+
+	if selectQuery.Filter != nil {
+		v.filter(validationPath(path, "Filter"), selectQuery.Filter)
+	}
+
+	v.groupByFields(validationPath(path, "GroupByFields"), selectQuery.GroupByFields)
+
+	v.sorts(validationPath(path, "Sorts"), selectQuery.Sorts)
+}
+
+func (v *validator) compoundQuery(path string, compoundQuery *CompoundQuery) {
+	v.check(path, compoundQuery.validate(v.dialect))
+
+	for i := range compoundQuery.Branches {
+		if compoundQuery.Branches[i] != nil {
+			v.query(validationPath(path, fmt.Sprintf("Branches[%d].Query", i)), compoundQuery.Branches[i].Query)
+		}
+	}
+
+	v.sorts(validationPath(path, "Sorts"), compoundQuery.Sorts)
+}
+
+func (v *validator) insertQuery(path string, insertQuery *InsertQuery) {
+	v.check(path, insertQuery.validate(v.dialect))
+	v.fields(validationPath(path, "Returnings"), insertQuery.Returnings)
+}
+
+func (v *validator) updateQuery(path string, updateQuery *UpdateQuery) {
+	v.check(path, updateQuery.validate(v.dialect))
+
+	for _, field := range updateQuery.getFields() {
+		var valuePath string = validationPath(path, fmt.Sprintf("FieldsValue[%s]", field))
+
+		switch value := updateQuery.FieldsValue[field].(type) {
+		case *Field:
+			if value != nil {
+				v.field(valuePath, value)
+			}
+		case *Raw:
+			if value != nil {
+				v.raw(valuePath, value)
+			}
+		case *SelectQuery:
+			if value != nil {
+				v.selectQuery(valuePath, value)
+			}
+		case *Expression:
+			if value != nil {
+				v.expression(valuePath, value)
+			}
+		}
+	}
+
+	for i := range updateQuery.RowSets {
+		if updateQuery.RowSets[i] != nil && updateQuery.RowSets[i].SelectQuery != nil {
+			v.selectQuery(validationPath(path, fmt.Sprintf("RowSets[%d].SelectQuery", i)), updateQuery.RowSets[i].SelectQuery)
+		}
+	}
+
+	if updateQuery.Filter != nil {
+		v.filter(validationPath(path, "Filter"), updateQuery.Filter)
+	}
+
+	v.fields(validationPath(path, "Returnings"), updateQuery.Returnings)
+}
+
+func (v *validator) deleteQuery(path string, deleteQuery *DeleteQuery) {
+	v.check(path, deleteQuery.validate(v.dialect))
+
+	if deleteQuery.Filter != nil {
+		v.filter(validationPath(path, "Filter"), deleteQuery.Filter)
+	}
+
+	v.fields(validationPath(path, "Returnings"), deleteQuery.Returnings)
+}
+
+func (v *validator) fields(path string, fields []*Field) {
+	for i := range fields {
+		if fields[i] != nil {
+			v.field(fmt.Sprintf("%s[%d]", path, i), fields[i])
+		}
+	}
+}
+
+func (v *validator) groupByFields(path string, fields []*Field) {
+	for i := range fields {
+		if fields[i] == nil {
+			continue
+		}
+
+		if fields[i].isBareSelectQuery() {
+			v.selectQuery(validationPath(fmt.Sprintf("%s[%d]", path, i), "SelectQuery"), fields[i].SelectQuery)
+			continue
+		}
+
+		v.field(fmt.Sprintf("%s[%d]", path, i), fields[i])
+	}
+}
+
+func (v *validator) field(path string, field *Field) {
+	v.check(path, field.validate(v.dialect))
+
+	if field.SelectQuery != nil {
+		v.selectQuery(validationPath(path, "SelectQuery"), field.SelectQuery)
+	}
+
+	if field.Raw != nil {
+		v.raw(validationPath(path, "Raw"), field.Raw)
+	}
+
+	if field.Expression != nil {
+		v.expression(validationPath(path, "Expression"), field.Expression)
+	}
+}
+
+func (v *validator) expression(path string, expression *Expression) {
+	v.check(path, expression.validate(v.dialect))
+
+	if expression.Case != nil {
+		for i := range expression.Case.Whens {
+			if expression.Case.Whens[i] != nil {
+				v.filter(fmt.Sprintf("%s[%d]", validationPath(path, "Case.Whens"), i), expression.Case.Whens[i])
+			}
+		}
+	}
+
+	for i := range expression.Operands {
+		var operandPath string = fmt.Sprintf("%s[%d]", validationPath(path, "Operands"), i)
+
+		switch operand := expression.Operands[i].(type) {
+		case *Field:
+			if operand != nil {
+				v.field(operandPath, operand)
+			}
+		case *Expression:
+			if operand != nil {
+				v.expression(operandPath, operand)
+			}
+		case *Raw:
+			if operand != nil {
+				v.raw(operandPath, operand)
+			}
+		}
+	}
+}
+
+func (v *validator) sorts(path string, sorts []*Sort) {
+	for i := range sorts {
+		if sorts[i] == nil {
+			continue
+		}
+
+		var sortPath string = fmt.Sprintf("%s[%d]", path, i)
+
+		v.check(sortPath, sorts[i].validate(v.dialect))
+
+		if sorts[i].Field != nil && sorts[i].Field.isBareSelectQuery() {
+			v.selectQuery(validationPath(sortPath, "Field.SelectQuery"), sorts[i].Field.SelectQuery)
+			continue
+		}
+
+		if sorts[i].Field != nil {
+			v.field(validationPath(sortPath, "Field"), sorts[i].Field)
+		}
+	}
+}
+
+func (v *validator) table(path string, table *Table) {
+	v.check(path, table.validate(v.dialect))
+
+	if table.SelectQuery != nil {
+		v.selectQuery(validationPath(path, "SelectQuery"), table.SelectQuery)
+	}
+
+	if table.Raw != nil {
+		v.raw(validationPath(path, "Raw"), table.Raw)
+	}
+}
+
+func (v *validator) join(path string, join *Join) {
+	v.check(path, join.validate(v.dialect))
+
+	if join.Table != nil {
+		v.table(validationPath(path, "Table"), join.Table)
+	}
+
+	if join.Filter != nil {
+		v.filter(validationPath(path, "Filter"), join.Filter)
+	}
+
+	if join.Table != nil {
+		v.check(validationPath(path, "Filter"), join.validateFilterReference())
+	}
+}
+
+func (v *validator) filter(path string, filter *Filter) {
+	v.check(path, filter.validateCondition(v.dialect))
+
+	if filter.Field != nil {
+		v.field(validationPath(path, "Field"), filter.Field)
+	}
+
+	if filter.Value != nil {
+		var valuePath string = validationPath(path, "Value")
+
+		v.check(valuePath, filter.Value.validate(v.dialect))
+
+		if filter.Value.SelectQuery != nil {
+			v.selectQuery(validationPath(valuePath, "SelectQuery"), filter.Value.SelectQuery)
+		}
+
+		if filter.Value.Expression != nil {
+			v.expression(validationPath(valuePath, "Expression"), filter.Value.Expression)
+		}
+
+		if filter.Value.Raw != nil {
+			v.raw(validationPath(valuePath, "Raw"), filter.Value.Raw)
+		}
+	}
+
+	for i := range filter.Filters {
+		var filterPath string = validationPath(path, fmt.Sprintf("Filters[%d]", i))
+
+		if filter.Filters[i] == nil {
+			v.check(filterPath, ErrFilterIsNil)
+			continue
+		}
+
+		v.filter(filterPath, filter.Filters[i])
+	}
+}
+
+func (v *validator) raw(path string, raw *Raw) {
+	v.check(path, raw.validate(v.dialect))
+}
+
+func (s *SelectQuery) ValidateAll(dialect Dialect) error {
+	return validateAll(dialect, func(v *validator) { v.selectQuery("", s) })
+}
+
+func (c *CompoundQuery) ValidateAll(dialect Dialect) error {
+	return validateAll(dialect, func(v *validator) { v.compoundQuery("", c) })
+}
+
+func (i *InsertQuery) ValidateAll(dialect Dialect) error {
+	return validateAll(dialect, func(v *validator) { v.insertQuery("", i) })
+}
+
+func (u *UpdateQuery) ValidateAll(dialect Dialect) error {
+	return validateAll(dialect, func(v *validator) { v.updateQuery("", u) })
+}
+
+func (d *DeleteQuery) ValidateAll(dialect Dialect) error {
+	return validateAll(dialect, func(v *validator) { v.deleteQuery("", d) })
+}
@@ -0,0 +1,203 @@
+package goqube
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestValidationError_Error(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Err         error
+		Expectation string
+	} = []struct {
+		Name        string
+		Err         error
+		Expectation string
+	}{
+		{
+			Name:        "validation error without path",
+			Err:         &ValidationError{Err: ErrTableIsRequired},
+			Expectation: "table is required",
+		},
+		{
+			Name:        "validation error with path",
+			Err:         &ValidationError{Path: "Joins[1].Filter.Field", Err: ErrColumnIsRequired},
+			Expectation: "Joins[1].Filter.Field: column is required",
+		},
+		{
+			Name: "validation errors",
+			Err: ValidationErrors{
+				{Path: "Fields[0]", Err: ErrColumnIsRequired},
+				{Path: "Sorts[0]", Err: ErrFieldIsRequired},
+			},
+			Expectation: "Fields[0]: column is required; Sorts[0]: field is required",
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual string = testCases[i].Err.Error()
+
+			if testCases[i].Expectation != actual {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func TestValidationErrors_Is(t *testing.T) {
+	var err error = ValidationErrors{
+		{Path: "Fields[0]", Err: ErrColumnIsRequired},
+		{Path: "Filter", Err: fmt.Errorf(errSortColumnf, ErrSortColumnIsNotAllowed, "name")},
+	}
+
+	if !errors.Is(err, ErrColumnIsRequired) {
+		t.Errorf("expectation error is %s, got %s", ErrColumnIsRequired, err)
+	}
+
+	if !errors.Is(err, ErrSortColumnIsNotAllowed) {
+		t.Errorf("expectation error is %s, got %s", ErrSortColumnIsNotAllowed, err)
+	}
+
+	if errors.Is(err, ErrTableIsRequired) {
+		t.Errorf("expectation error is not %s, got %s", ErrTableIsRequired, err)
+	}
+}
+
+func TestValidateAll(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Dialect     Dialect
+		Validate    func(dialect Dialect) error
+		Expectation error
+	} = []struct {
+		Name        string
+		Dialect     Dialect
+		Validate    func(dialect Dialect) error
+		Expectation error
+	}{
+		{
+			Name:    "dialect is empty",
+			Dialect: "",
+			Validate: func(dialect Dialect) error {
+				return Select(NewField("id")).From(NewTable("users")).ValidateAll(dialect)
+			},
+			Expectation: ValidationErrors{{Err: ErrDialectIsRequired}},
+		},
+		{
+			Name:    "select query is valid",
+			Dialect: DialectPostgres,
+			Validate: func(dialect Dialect) error {
+				return Select(NewField("id")).
+					From(NewTable("users")).
+					Where(NewFilter().SetCondition(NewField("id"), OperatorEqual, NewFilterValue(1))).
+					ValidateAll(dialect)
+			},
+			Expectation: nil,
+		},
+		{
+			Name:    "select query with multiple invalid nodes",
+			Dialect: DialectPostgres,
+			Validate: func(dialect Dialect) error {
+				return Select(NewField(""), NewSelectQueryField(Select(NewField("id")))).
+					From(NewTable("users").As("u")).
+					Join(InnerJoin(NewTable("orders").As("o")).On(NewFilter().SetCondition(NewField("user_id").FromTable("o"), OperatorEqual, NewColumnFilterValue("id").FromTable("u")))).
+					Join(LeftJoin(NewTable("payments").As("p")).On(NewFilter().SetCondition(NewField(""), OperatorEqual, NewColumnFilterValue("order_id").FromTable("o")))).
+					Where(
+						NewFilter().
+							SetLogic(LogicAnd).
+							AddFilter(NewField("status"), "", NewFilterValue("active")).
+							AddFilter(NewField("id"), OperatorIn, NewSelectQueryFilterValue(Select(NewField("user_id")))),
+					).
+					OrderBy(NewSort(nil, SortDirectionAscending)).
+					ValidateAll(dialect)
+			},
+			Expectation: ValidationErrors{
+				{Path: "Fields[0]", Err: ErrColumnIsRequired},
+				{Path: "Fields[1]", Err: ErrAliasIsRequired},
+				{Path: "Fields[1].SelectQuery", Err: ErrTableIsRequired},
+				{Path: "Joins[1].Filter.Field", Err: ErrColumnIsRequired},
+				{Path: "Joins[1].Filter", Err: ErrJoinFilterIsUnreferenced},
+				{Path: "Filter.Filters[0]", Err: ErrOperatorIsRequired},
+				{Path: "Filter.Filters[1].Value.SelectQuery", Err: ErrTableIsRequired},
+				{Path: "Sorts[0]", Err: ErrFieldIsRequired},
+			},
+		},
+		{
+			Name:    "compound query",
+			Dialect: DialectPostgres,
+			Validate: func(dialect Dialect) error {
+				return Union(Select(NewField("id")).From(NewTable("users")), Select(NewField("id")), NewRaw("")).ValidateAll(dialect)
+			},
+			Expectation: ValidationErrors{
+				{Path: "Branches[1].Query", Err: ErrTableIsRequired},
+				{Path: "Branches[2].Query", Err: ErrSQLIsRequired},
+			},
+		},
+		{
+			Name:    "insert query",
+			Dialect: DialectMySQL,
+			Validate: func(dialect Dialect) error {
+				return InsertInto("users").Value("name", "name1").Returning(NewField("")).ValidateAll(dialect)
+			},
+			Expectation: ValidationErrors{
+				{Err: ErrUnsupportedReturning},
+				{Path: "Returnings[0]", Err: ErrColumnIsRequired},
+			},
+		},
+		{
+			Name:    "update query",
+			Dialect: DialectPostgres,
+			Validate: func(dialect Dialect) error {
+				return Update("users").
+					Set("name", NewField("")).
+					Set("total", Select(NewField("count(*)"))).
+					Set("score", NewArithmeticExpression(ExpressionOperatorAdd, NewField(""), 1)).
+					SetRow(Select(NewField("code")), "region").
+					Where(NewFilter().SetCondition(nil, OperatorEqual, NewFilterValue(1))).
+					ValidateAll(dialect)
+			},
+			Expectation: ValidationErrors{
+				{Path: "FieldsValue[name]", Err: ErrColumnIsRequired},
+				{Path: "FieldsValue[total]", Err: ErrTableIsRequired},
+				{Path: "FieldsValue[score].Operands[0]", Err: ErrColumnIsRequired},
+				{Path: "RowSets[0].SelectQuery", Err: ErrTableIsRequired},
+				{Path: "Filter", Err: ErrFieldIsRequired},
+			},
+		},
+		{
+			Name:    "delete query",
+			Dialect: DialectPostgres,
+			Validate: func(dialect Dialect) error {
+				return DeleteFrom("").
+					Where(NewFilter().SetLogic(LogicOr).AddFilters(nil, NewFilter().SetCondition(NewField("id"), OperatorEqual, nil))).
+					ValidateAll(dialect)
+			},
+			Expectation: ValidationErrors{
+				{Err: ErrTableIsRequired},
+				{Path: "Filter.Filters[0]", Err: ErrFilterIsNil},
+				{Path: "Filter.Filters[1]", Err: &FilterConditionError{Column: "id", Operator: OperatorEqual, Err: ErrValueIsRequired}},
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actualErr error = testCases[i].Validate(testCases[i].Dialect)
+
+			if testCases[i].Expectation != nil && actualErr == nil {
+				t.Error("expectation error is not nil, got nil")
+			}
+
+			if testCases[i].Expectation == nil && actualErr != nil {
+				t.Errorf("expectation error is nil, got %s", actualErr.Error())
+			}
+
+			if testCases[i].Expectation != nil && actualErr != nil && testCases[i].Expectation.Error() != actualErr.Error() {
+				t.Errorf("expectation error is %s, got %s", testCases[i].Expectation.Error(), actualErr.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package goqube
+
+import (
+	"database/sql/driver"
+	"math/big"
+	"reflect"
+)
+
+var valuerType reflect.Type = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+
+type decimalValue string
+
+func (d decimalValue) Value() (driver.Value, error) {
+	return string(d), nil
+}
+
+func normalizeValue(value interface{}) interface{} {
+	switch typedValue := value.(type) {
+	case *big.Int:
+		if typedValue == nil {
+			return nil
+		}
+
+		return decimalValue(typedValue.String())
+
+	case *big.Float:
+		if typedValue == nil {
+			return nil
+		}
+
+		return decimalValue(typedValue.Text('f', -1))
+
+	case *big.Rat:
+		if typedValue == nil {
+			return nil
+		}
+
+		if typedValue.IsInt() {
+			return decimalValue(typedValue.Num().String())
+		}
+
+		return decimalValue(typedValue.FloatString(bigRatPrecision))
+	}
+
+	return adaptValue(value)
+}
+
+func patternValue(value interface{}) (string, bool) {
+	var reflectValue reflect.Value
+
+	if valuer, isValuer := value.(driver.Valuer); isValuer {
+		var (
+			driverValue driver.Value
+			err         error
+		)
+
+		reflectValue = reflect.ValueOf(value)
+		if reflectValue.Kind() == reflect.Ptr && reflectValue.IsNil() {
+			return "", false
+		}
+
+		driverValue, err = valuer.Value()
+		if err != nil {
+			return "", false
+		}
+
+		value = driverValue
+	}
+
+	reflectValue = reflect.Indirect(reflect.ValueOf(value))
+	if reflectValue.Kind() != reflect.String {
+		return "", false
+	}
+
+	return reflectValue.String(), true
+}
+
+func isValuer(value interface{}) bool {
+	var isValuer bool
+
+	_, isValuer = normalizeValue(value).(driver.Valuer)
+
+	return isValuer
+}
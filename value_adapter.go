@@ -0,0 +1,65 @@
+package goqube
+
+import "reflect"
+
+type ValueAdapter func(value interface{}) (interface{}, bool)
+
+var defaultValueAdapters []ValueAdapter = []ValueAdapter{
+	adaptIdentifierValue,
+}
+
+func (c *Config) RegisterValueAdapter(adapters ...ValueAdapter) *Config {
+	c.ValueAdapters = append(c.ValueAdapters, adapters...)
+	return c
+}
+
+func adaptValue(value interface{}) interface{} {
+	for i := range defaultValueAdapters {
+		if adapted, ok := defaultValueAdapters[i](value); ok {
+			return adapted
+		}
+	}
+
+	return value
+}
+
+func (bc *buildContext) adaptValue(value interface{}) interface{} {
+	if _, ok := value.(ParamValue); ok {
+		return value
+	}
+
+	for i := range bc.config.ValueAdapters {
+		if bc.config.ValueAdapters[i] == nil {
+			continue
+		}
+
+		if adapted, ok := bc.config.ValueAdapters[i](value); ok {
+			return normalizeValue(adapted)
+		}
+	}
+
+	return normalizeValue(value)
+}
+
+func (bc *buildContext) adaptSliceValue(value interface{}) interface{} {
+	var (
+		reflectValue reflect.Value
+		values       []interface{}
+	)
+
+	if len(bc.config.ValueAdapters) == 0 {
+		return value
+	}
+
+	reflectValue = reflect.ValueOf(value)
+	if reflectValue.Kind() != reflect.Slice && reflectValue.Kind() != reflect.Array {
+		return value
+	}
+
+	values = make([]interface{}, reflectValue.Len())
+	for i := range values {
+		values[i] = bc.adaptValue(reflectValue.Index(i).Interface())
+	}
+
+	return values
+}
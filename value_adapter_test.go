@@ -0,0 +1,189 @@
+package goqube
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestValueAdapter_adaptValue(t *testing.T) {
+	type UUID [16]byte
+
+	var testCases []struct {
+		Name        string
+		Value       interface{}
+		Expectation interface{}
+	} = []struct {
+		Name        string
+		Value       interface{}
+		Expectation interface{}
+	}{
+		{
+			Name:        "value is adapted",
+			Value:       UUID{},
+			Expectation: UUIDValue("00000000-0000-0000-0000-000000000000"),
+		},
+		{
+			Name:        "value is not adapted",
+			Value:       1,
+			Expectation: 1,
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual interface{} = adaptValue(testCases[i].Value)
+
+			if !deepEqual(testCases[i].Expectation, actual) {
+				t.Errorf("expectation value is %v, got %v", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+type testValueAdapterStatus int
+
+type testValueAdapterMoney struct {
+	Cents int64
+}
+
+func testValueAdapter(value interface{}) (interface{}, bool) {
+	switch typedValue := value.(type) {
+	case testValueAdapterStatus:
+		return []string{"active", "inactive"}[typedValue], true
+	case testValueAdapterMoney:
+		return fmt.Sprintf("%d.%02d", typedValue.Cents/100, typedValue.Cents%100), true
+	}
+
+	return nil, false
+}
+
+func TestValueAdapter_RegisterValueAdapter(t *testing.T) {
+	var (
+		config  *Config = NewConfig(DialectPostgres).RegisterValueAdapter(testValueAdapter, nil)
+		builder *Builder
+	)
+
+	if len(config.ValueAdapters) != 2 {
+		t.Errorf("expectation value adapters length is 2, got %d", len(config.ValueAdapters))
+	}
+
+	builder = NewBuilder(DialectPostgres)
+	if len(builder.RegisterValueAdapter(testValueAdapter).Config().ValueAdapters) != 1 {
+		t.Error("expectation registered builder has 1 value adapter")
+	}
+
+	if len(builder.Config().ValueAdapters) != 0 {
+		t.Error("expectation original builder has no value adapter")
+	}
+}
+
+func TestValueAdapter_Build(t *testing.T) {
+	var testCases []struct {
+		Name        string
+		Builder     *Builder
+		Query       Query
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	} = []struct {
+		Name        string
+		Builder     *Builder
+		Query       Query
+		Expectation struct {
+			Query string
+			Args  []interface{}
+			Err   error
+		}
+	}{
+		{
+			Name:    "select query",
+			Builder: NewBuilder(DialectPostgres, WithStrictArgs(true), WithValueAdapter(testValueAdapter)),
+			Query: Select(NewField("id")).
+				From(NewTable("products")).
+				Where(And(
+					Eq("status", testValueAdapterStatus(0)),
+					In("status", []testValueAdapterStatus{0, 1}),
+					Gt("price", testValueAdapterMoney{Cents: 1234}),
+				)).
+				Limit(1),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "select id from products where status = $1 and status in ($2, $3) and price > $4 limit $5",
+				Args:  []interface{}{"active", "active", "inactive", "12.34", 1},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    "insert query",
+			Builder: NewBuilder(DialectMySQL).RegisterValueAdapter(testValueAdapter),
+			Query:   InsertInto("products").Value("price", testValueAdapterMoney{Cents: 5}).Value("status", testValueAdapterStatus(1)),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "insert into products(price, status) values (?, ?)",
+				Args:  []interface{}{"0.05", "inactive"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    "update query",
+			Builder: NewBuilder(DialectPostgres, WithValueAdapter(testValueAdapter)),
+			Query:   Update("products").Set("price", testValueAdapterMoney{Cents: 100}).Where(Eq("status", testValueAdapterStatus(0))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "update products set price = $1 where status = $2",
+				Args:  []interface{}{"1.00", "active"},
+				Err:   nil,
+			},
+		},
+		{
+			Name:    "strict args without value adapter",
+			Builder: NewBuilder(DialectPostgres, WithStrictArgs(true)),
+			Query:   Update("products").Set("price", testValueAdapterMoney{Cents: 100}).Where(Eq("status", testValueAdapterStatus(0))),
+			Expectation: struct {
+				Query string
+				Args  []interface{}
+				Err   error
+			}{
+				Query: "",
+				Args:  nil,
+				Err:   &ArgTypeError{Path: "set.price", Type: reflect.TypeOf(testValueAdapterMoney{})},
+			},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualQuery string
+				actualArgs  []interface{}
+				actualErr   error
+			)
+
+			actualQuery, actualArgs, actualErr = testCases[i].Builder.Build(testCases[i].Query)
+
+			if !deepEqual(testCases[i].Expectation.Err, actualErr) {
+				t.Errorf("expectation error is %v, got %v", testCases[i].Expectation.Err, actualErr)
+			}
+
+			if testCases[i].Expectation.Query != actualQuery {
+				t.Errorf("expectation query is %s, got %s", testCases[i].Expectation.Query, actualQuery)
+			}
+
+			if !deepEqual(testCases[i].Expectation.Args, actualArgs) {
+				t.Errorf("expectation args is %v, got %v", testCases[i].Expectation.Args, actualArgs)
+			}
+		})
+	}
+}
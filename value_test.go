@@ -0,0 +1,158 @@
+package goqube
+
+import (
+	"database/sql"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func Test_normalizeValue(t *testing.T) {
+	var (
+		nilInt    *big.Int
+		testCases []struct {
+			Name        string
+			Value       interface{}
+			Expectation interface{}
+		}
+	)
+
+	testCases = []struct {
+		Name        string
+		Value       interface{}
+		Expectation interface{}
+	}{
+		{
+			Name:        "big int",
+			Value:       big.NewInt(-42),
+			Expectation: decimalValue("-42"),
+		},
+		{
+			Name:        "nil big int",
+			Value:       nilInt,
+			Expectation: nil,
+		},
+		{
+			Name:        "big float",
+			Value:       big.NewFloat(0.125),
+			Expectation: decimalValue("0.125"),
+		},
+		{
+			Name:        "big rat is integer",
+			Value:       big.NewRat(10, 2),
+			Expectation: decimalValue("5"),
+		},
+		{
+			Name:        "big rat",
+			Value:       big.NewRat(1, 4),
+			Expectation: decimalValue("0.250000000000000000000000000000"),
+		},
+		{
+			Name:        "other value",
+			Value:       sql.NullInt64{Int64: 1, Valid: true},
+			Expectation: sql.NullInt64{Int64: 1, Valid: true},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var actual interface{} = normalizeValue(testCases[i].Value)
+
+			if !reflect.DeepEqual(testCases[i].Expectation, actual) {
+				t.Errorf("expectation value is %+v, got %+v", testCases[i].Expectation, actual)
+			}
+		})
+	}
+}
+
+func Test_patternValue(t *testing.T) {
+	var (
+		name      string = "go"
+		nilNull   *sql.NullString
+		testCases []struct {
+			Name        string
+			Value       interface{}
+			Expectation struct {
+				Pattern string
+				Ok      bool
+			}
+		}
+	)
+
+	testCases = []struct {
+		Name        string
+		Value       interface{}
+		Expectation struct {
+			Pattern string
+			Ok      bool
+		}
+	}{
+		{
+			Name:  "string",
+			Value: "go",
+			Expectation: struct {
+				Pattern string
+				Ok      bool
+			}{Pattern: "go", Ok: true},
+		},
+		{
+			Name:  "string pointer",
+			Value: &name,
+			Expectation: struct {
+				Pattern string
+				Ok      bool
+			}{Pattern: "go", Ok: true},
+		},
+		{
+			Name:  "valid null string",
+			Value: sql.NullString{String: "go", Valid: true},
+			Expectation: struct {
+				Pattern string
+				Ok      bool
+			}{Pattern: "go", Ok: true},
+		},
+		{
+			Name:  "invalid null string",
+			Value: sql.NullString{},
+			Expectation: struct {
+				Pattern string
+				Ok      bool
+			}{Pattern: "", Ok: false},
+		},
+		{
+			Name:  "nil valuer pointer",
+			Value: nilNull,
+			Expectation: struct {
+				Pattern string
+				Ok      bool
+			}{Pattern: "", Ok: false},
+		},
+		{
+			Name:  "int",
+			Value: 1,
+			Expectation: struct {
+				Pattern string
+				Ok      bool
+			}{Pattern: "", Ok: false},
+		},
+	}
+
+	for i := range testCases {
+		t.Run(testCases[i].Name, func(t *testing.T) {
+			var (
+				actualPattern string
+				actualOk      bool
+			)
+
+			actualPattern, actualOk = patternValue(testCases[i].Value)
+
+			if testCases[i].Expectation.Pattern != actualPattern {
+				t.Errorf("expectation pattern is %s, got %s", testCases[i].Expectation.Pattern, actualPattern)
+			}
+
+			if testCases[i].Expectation.Ok != actualOk {
+				t.Errorf("expectation ok is %t, got %t", testCases[i].Expectation.Ok, actualOk)
+			}
+		})
+	}
+}